@@ -0,0 +1,325 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"github.com/dgrijalva/jwt-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Minimal number of characters in the password of the account.
+const minPasswordLength = 8
+
+// Number of iterations of the password hash function.
+const passwordHashIterations = 100000
+
+// Time, for which the account token is valid.
+const accountTokenLifetime = 30 * 24 * time.Hour
+
+// accountAudience marks account tokens, so
+// that they are not accepted as game tokens.
+const accountAudience = "account"
+
+// AccountRecord is a persisted account of the player.
+// Accounts are identified by their usernames.
+type AccountRecord struct {
+	Username     string
+	PasswordHash string // hex-encoded
+	PasswordSalt string // hex-encoded
+	DisplayName  string
+	Avatar       string
+	CreatedTime  time.Time
+}
+
+func (r AccountRecord) toPBProfile(stats *pb.PlayerStats) *pb.Profile {
+	return &pb.Profile{
+		Username:    r.Username,
+		DisplayName: r.DisplayName,
+		Avatar:      r.Avatar,
+		CreatedTime: r.CreatedTime.UnixNano() / int64(time.Millisecond),
+		Stats:       stats,
+	}
+}
+
+// hashPassword returns PBKDF2-HMAC-SHA256 of the password
+// with provided salt. Single block of the output is used.
+func hashPassword(password string, salt []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(password))
+	mac.Write(salt)
+	mac.Write([]byte{0, 0, 0, 1})
+	u := mac.Sum(nil)
+	res := make([]byte, len(u))
+	copy(res, u)
+	for i := 1; i < passwordHashIterations; i++ {
+		mac.Reset()
+		mac.Write(u)
+		u = mac.Sum(u[:0])
+		for j := range res {
+			res[j] ^= u[j]
+		}
+	}
+	return res
+}
+
+// checkPassword returns true, if the password matches the account.
+func (r AccountRecord) checkPassword(password string) bool {
+	salt, err := hex.DecodeString(r.PasswordSalt)
+	if err != nil {
+		return false
+	}
+	hash, err := hex.DecodeString(r.PasswordHash)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(hashPassword(password, salt), hash) == 1
+}
+
+// accountBook caches accounts of the players and
+// saves them to the storage, if any.
+type accountBook struct {
+	mutex    sync.Mutex
+	storage  Storage // nil, if accounts are not persisted
+	accounts map[username]AccountRecord
+	logger   *zap.Logger
+}
+
+func newAccountBook(storage Storage, logger *zap.Logger) *accountBook {
+	return &accountBook{
+		storage:  storage,
+		accounts: make(map[username]AccountRecord),
+		logger:   logger,
+	}
+}
+
+// get returns the account with provided username,
+// which is loaded from the storage, if it is not cached yet.
+func (b *accountBook) get(username username) (AccountRecord, bool, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.getLocked(username)
+}
+
+// getLocked is the same as get, but
+// the calling function has to acquire the lock.
+func (b *accountBook) getLocked(username username) (AccountRecord, bool, error) {
+	if record, ok := b.accounts[username]; ok {
+		return record, true, nil
+	}
+	if b.storage == nil {
+		return AccountRecord{}, false, nil
+	}
+	record, ok, err := b.storage.LoadAccount(string(username))
+	if err != nil || !ok {
+		return AccountRecord{}, false, err
+	}
+	b.accounts[username] = record
+	return record, true, nil
+}
+
+// save inserts or replaces the account. If "create" is true,
+// it returns an error, if the account already exists.
+func (b *accountBook) save(record AccountRecord, create bool) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if create {
+		_, ok, err := b.getLocked(username(record.Username))
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("username %v is already registered", record.Username)
+		}
+	}
+	if b.storage != nil {
+		if err := b.storage.SaveAccount(record); err != nil {
+			return err
+		}
+	}
+	b.accounts[username(record.Username)] = record
+	return nil
+}
+
+// newAccountToken returns signed token, which
+// proves that the player has logged in to the account.
+func (s *Server) newAccountToken(username username) (string, error) {
+	claims := authClaims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   string(username),
+			Audience:  accountAudience,
+			ExpiresAt: time.Now().Add(accountTokenLifetime).Unix(),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.authSecret)
+}
+
+// parseAccountToken returns the username of the account,
+// for which the token has been issued.
+func (s *Server) parseAccountToken(token string) (username, error) {
+	claims := &authClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return s.authSecret, nil
+	})
+	if err == nil && claims.Audience != accountAudience {
+		err = fmt.Errorf("token is not issued for the account")
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "invalid account token: %v", err)
+	}
+	return username(claims.Subject), nil
+}
+
+// resolveUsername returns the username, with which the player joins
+// games. Players with account token use the username of the account.
+// Other players cannot use usernames of registered accounts.
+func (s *Server) resolveUsername(reqUsername username, accountToken string) (username, error) {
+	if accountToken != "" {
+		return s.parseAccountToken(accountToken)
+	}
+	if reqUsername == "" {
+		return reqUsername, nil
+	}
+	_, ok, err := s.accounts.get(reqUsername)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to load account %v: %v", reqUsername, err)
+	}
+	if ok {
+		return "", status.Errorf(
+			codes.PermissionDenied,
+			"username %v is registered, log in to join with it",
+			reqUsername,
+		)
+	}
+	return reqUsername, nil
+}
+
+// getPBProfile returns the profile of the account together
+// with the statistics of the player.
+func (s *Server) getPBProfile(record AccountRecord) (*pb.Profile, error) {
+	stats, err := s.getPBPlayerStats(username(record.Username))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load stats of %v: %v", record.Username, err)
+	}
+	return record.toPBProfile(stats), nil
+}
+
+// Register creates the account with provided username and password.
+func (s *Server) Register(_ context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	reqUsername := username(req.GetUsername())
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate salt: %v", err)
+	}
+	record := AccountRecord{
+		Username:     string(reqUsername),
+		PasswordHash: hex.EncodeToString(hashPassword(req.GetPassword(), salt)),
+		PasswordSalt: hex.EncodeToString(salt),
+		DisplayName:  req.GetDisplayName(),
+		Avatar:       req.GetAvatar(),
+		CreatedTime:  s.clock.Now(),
+	}
+	if record.DisplayName == "" {
+		record.DisplayName = record.Username
+	}
+	if err := s.accounts.save(record, true); err != nil {
+		return nil, status.Errorf(codes.AlreadyExists, err.Error())
+	}
+	s.logger.Info("Account registered", zap.String("username", record.Username))
+
+	token, err := s.newAccountToken(reqUsername)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue account token: %v", err)
+	}
+	profile, err := s.getPBProfile(record)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RegisterResponse{AccountToken: token, Profile: profile}, nil
+}
+
+// Login returns the account token, if the password matches the account.
+func (s *Server) Login(_ context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	reqUsername := username(req.GetUsername())
+	record, ok, err := s.accounts.get(reqUsername)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load account %v: %v", reqUsername, err)
+	}
+	if !ok || !record.checkPassword(req.GetPassword()) {
+		return nil, status.Errorf(codes.Unauthenticated, "wrong username or password")
+	}
+
+	token, err := s.newAccountToken(reqUsername)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue account token: %v", err)
+	}
+	profile, err := s.getPBProfile(record)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LoginResponse{AccountToken: token, Profile: profile}, nil
+}
+
+// GetProfile returns the public profile of the account.
+func (s *Server) GetProfile(_ context.Context, req *pb.GetProfileRequest) (*pb.GetProfileResponse, error) {
+	reqUsername := username(req.GetUsername())
+	record, ok, err := s.accounts.get(reqUsername)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load account %v: %v", reqUsername, err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "there is no account with username %v", reqUsername)
+	}
+
+	profile, err := s.getPBProfile(record)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetProfileResponse{Profile: profile}, nil
+}
+
+// UpdateProfile changes the display name or the avatar of the account.
+func (s *Server) UpdateProfile(_ context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	accountUsername, err := s.parseAccountToken(req.GetAccountToken())
+	if err != nil {
+		return nil, err
+	}
+	record, ok, err := s.accounts.get(accountUsername)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load account %v: %v", accountUsername, err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "there is no account with username %v", accountUsername)
+	}
+
+	if req.GetDisplayName() != "" {
+		record.DisplayName = req.GetDisplayName()
+	}
+	if req.GetAvatar() != "" {
+		record.Avatar = req.GetAvatar()
+	}
+	if err := s.accounts.save(record, false); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save account %v: %v", accountUsername, err)
+	}
+
+	profile, err := s.getPBProfile(record)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateProfileResponse{Profile: profile}, nil
+}
@@ -0,0 +1,293 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+)
+
+// Points, which the player has to have for the "first million" achievement.
+const millionPoints = 1000000
+
+// Number of questions, which the player has to answer correctly
+// in a row for the "question streak" achievement.
+const questionStreakLength = 10
+
+// AchievementRecord is a persisted unlock of the achievement
+// by the player. Players are identified by their usernames.
+type AchievementRecord struct {
+	Username      string
+	AchievementID string
+	Time          time.Time
+}
+
+// achievementRule describes the achievement and the condition, on
+// which it is unlocked. Conditions are checked with write lock acquired.
+type achievementRule struct {
+	id          string
+	name        string
+	description string
+	// checked for the players affected by every event
+	// of the active game, if not nil
+	onEvent func(g *game, player *player, event JournalEvent) bool
+	// checked for every player, when the game finishes, if not nil
+	onFinish func(g *game, player *player, won bool) bool
+}
+
+var achievementRules = []achievementRule{
+	{
+		id:          "first_million",
+		name:        "First million",
+		description: "Have a million points",
+		onEvent: func(_ *game, player *player, _ JournalEvent) bool {
+			return player.points >= millionPoints
+		},
+	},
+	{
+		id:          "question_streak",
+		name:        "Know-it-all",
+		description: "Answer 10 questions in a row correctly",
+		onEvent: func(g *game, player *player, event JournalEvent) bool {
+			return event.Kind == EventQuestionAnswer && g.getQuestionStreak(player.userID) >= questionStreakLength
+		},
+	},
+	{
+		id:          "debt_free_win",
+		name:        "Debt free",
+		description: "Win the game without taking any credit",
+		onFinish: func(g *game, player *player, won bool) bool {
+			return won && !g.hasEvent(player.userID, EventCredit)
+		},
+	},
+}
+
+func getAchievementRule(id string) (achievementRule, bool) {
+	for _, rule := range achievementRules {
+		if rule.id == id {
+			return rule, true
+		}
+	}
+	return achievementRule{}, false
+}
+
+func (r AchievementRecord) toPBAchievement() *pb.Achievement {
+	rule, _ := getAchievementRule(r.AchievementID)
+	return &pb.Achievement{
+		AchievementId: r.AchievementID,
+		Name:          rule.name,
+		Description:   rule.description,
+		UnlockTime:    r.Time.UnixNano() / int64(time.Millisecond),
+	}
+}
+
+// getQuestionStreak returns the number of the latest questions, which
+// the player has answered correctly in a row. Questions, which have
+// expired, break the streak. The calling function has to acquire
+// at least read lock.
+func (g *game) getQuestionStreak(userID userID) int32 {
+	streak := int32(0)
+	for i := len(g.journal) - 1; i >= 0; i-- {
+		event := g.journal[i]
+		if event.UserID != string(userID) {
+			continue
+		}
+		if event.Kind == EventQuestionTimeout || (event.Kind == EventQuestionAnswer && event.Value == 0) {
+			break
+		}
+		if event.Kind == EventQuestionAnswer {
+			streak++
+		}
+	}
+	return streak
+}
+
+// hasEvent returns true, if the journal contains the event of provided
+// kind for the player. The calling function has to acquire at least
+// read lock.
+func (g *game) hasEvent(userID userID, kind string) bool {
+	for _, event := range g.journal {
+		if event.UserID == string(userID) && event.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// achievementBook caches unlocked achievements of the
+// players and saves new unlocks to the storage, if any.
+type achievementBook struct {
+	mutex        sync.Mutex
+	storage      Storage // nil, if achievements are not persisted
+	achievements map[username][]AchievementRecord
+	clock        Clock
+	logger       *zap.Logger
+}
+
+func newAchievementBook(storage Storage, clock Clock, logger *zap.Logger) *achievementBook {
+	return &achievementBook{
+		storage:      storage,
+		achievements: make(map[username][]AchievementRecord),
+		clock:        clock,
+		logger:       logger,
+	}
+}
+
+// get returns achievements, which the player has unlocked,
+// which are loaded from the storage, if they are not cached yet.
+func (b *achievementBook) get(username username) []AchievementRecord {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	res := make([]AchievementRecord, len(b.getLocked(username)))
+	copy(res, b.getLocked(username))
+	return res
+}
+
+// getLocked is the same as get, but the returned slice is not
+// copied. The calling function has to acquire the lock.
+func (b *achievementBook) getLocked(username username) []AchievementRecord {
+	if records, ok := b.achievements[username]; ok {
+		return records
+	}
+	records := []AchievementRecord{}
+	if b.storage != nil {
+		saved, err := b.storage.LoadAchievements(string(username))
+		if err != nil {
+			b.logger.Error("Failed to load achievements", zap.String("username", string(username)), zap.Error(err))
+		} else if saved != nil {
+			records = saved
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Time.Before(records[j].Time)
+	})
+	b.achievements[username] = records
+	return records
+}
+
+// unlock unlocks the achievement for the player. It returns false,
+// if the player has already unlocked it. It is safe to call it on nil
+// book, in which case nothing is unlocked.
+func (b *achievementBook) unlock(username username, id string) (AchievementRecord, bool) {
+	if b == nil {
+		return AchievementRecord{}, false
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	records := b.getLocked(username)
+	for _, record := range records {
+		if record.AchievementID == id {
+			return AchievementRecord{}, false
+		}
+	}
+	record := AchievementRecord{
+		Username:      string(username),
+		AchievementID: id,
+		Time:          b.clock.Now(),
+	}
+	b.achievements[username] = append(records, record)
+	if b.storage != nil {
+		if err := b.storage.AddAchievement(record); err != nil {
+			b.logger.Error("Failed to save achievement", zap.String("username", string(username)), zap.Error(err))
+		}
+	}
+	return record, true
+}
+
+// unlockAchievement unlocks the achievement for the player and returns
+// the event, which has to be sent to the player, or nil, if the player
+// has unlocked it before, or achievements are not unlocked in the game. The calling function has to acquire at least
+// read lock.
+func (g *game) unlockAchievement(player *player, rule achievementRule) *pb.StreamResponse {
+	if g.config.practice || player.bot {
+		return nil
+	}
+	record, ok := g.achievements.unlock(player.username, rule.id)
+	if !ok {
+		return nil
+	}
+	g.logger.Info(
+		"Achievement unlocked",
+		zap.String("user_id", string(player.userID)),
+		zap.String("achievement_id", rule.id),
+	)
+
+	return getAchievementMessage(record.toPBAchievement())
+}
+
+// checkEventAchievements checks achievements of the players affected
+// by the event, which has just been applied to the active game.
+// The calling function has to acquire write lock.
+func (g *game) checkEventAchievements(event JournalEvent, players ...*player) {
+	if g.achievements == nil || g.state != activeState {
+		return
+	}
+	for _, player := range players {
+		if player == nil {
+			continue
+		}
+		for _, rule := range achievementRules {
+			if rule.onEvent == nil || !rule.onEvent(g, player, event) {
+				continue
+			}
+			if msg := g.unlockAchievement(player, rule); msg != nil {
+				userID := player.userID
+				go func() {
+					g.sendTo(userID, msg)
+				}()
+			}
+		}
+	}
+}
+
+// checkFinishAchievements checks achievements of the players, who have
+// finished the game, and returns the events, which have to be sent to
+// them before the end of the game. The calling function has to acquire
+// write lock.
+func (g *game) checkFinishAchievements() map[userID][]*pb.StreamResponse {
+	res := make(map[userID][]*pb.StreamResponse)
+	if g.achievements == nil {
+		return res
+	}
+	winners := g.findWinners()
+	for userID, player := range g.players {
+		if player.bot {
+			continue
+		}
+		for _, rule := range achievementRules {
+			if rule.onFinish == nil || !rule.onFinish(g, player, winners[userID]) {
+				continue
+			}
+			if msg := g.unlockAchievement(player, rule); msg != nil {
+				res[userID] = append(res[userID], msg)
+			}
+		}
+	}
+	return res
+}
+
+func getAchievementMessage(achievement *pb.Achievement) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Achievement{
+			Achievement: achievement,
+		},
+	}
+}
+
+// GetAchievements returns achievements, which the player has unlocked.
+func (s *Server) GetAchievements(_ context.Context, req *pb.GetAchievementsRequest) (*pb.GetAchievementsResponse, error) {
+	reqUsername := username(req.GetUsername())
+
+	records := s.achievements.get(reqUsername)
+	achievements := make([]*pb.Achievement, len(records))
+	for i, record := range records {
+		achievements[i] = record.toPBAchievement()
+	}
+	return &pb.GetAchievementsResponse{Achievements: achievements}, nil
+}
@@ -0,0 +1,258 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// AdminTokenMetadataKey is the metadata key, under which
+// requests to the Admin service carry the admin token.
+const AdminTokenMetadataKey = "admin-token"
+
+// adminMethodPrefix is the prefix of full names of Admin service methods.
+const adminMethodPrefix = "/server.Admin/"
+
+// WithAdminToken enables the Admin service. Requests to it
+// are accepted only if they carry provided token.
+// Without this option, all requests to the Admin service are rejected.
+func WithAdminToken(token string) ServerOption {
+	return func(s *Server) {
+		s.adminToken = token
+	}
+}
+
+// adminServer implements the Admin service on top of the game server.
+type adminServer struct {
+	server *Server
+}
+
+// authorizeAdmin is a unary interceptor, which rejects requests
+// to the Admin service without valid admin token or verified
+// client certificate. Requests to other services are passed through.
+func (s *Server) authorizeAdmin(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, adminMethodPrefix) {
+		return handler(ctx, req)
+	}
+
+	if hasVerifiedClientCert(ctx) {
+		return handler(ctx, req)
+	}
+	if s.adminToken == "" {
+		return nil, status.Errorf(codes.PermissionDenied, "admin service is disabled")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(AdminTokenMetadataKey)
+	if len(tokens) != 1 || subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(s.adminToken)) != 1 {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid admin token")
+	}
+	return handler(ctx, req)
+}
+
+// ListGames returns the waiting game, the rooms, and the active games.
+func (a *adminServer) ListGames(_ context.Context, _ *pb.ListGamesRequest) (*pb.ListGamesResponse, error) {
+	s := a.server
+	s.mutex.RLock()
+	games := []*game{s.waitingGame}
+	for _, room := range s.rooms {
+		games = append(games, room)
+	}
+	for _, game := range s.activeGames {
+		games = append(games, game)
+	}
+	s.mutex.RUnlock()
+
+	res := &pb.ListGamesResponse{}
+	for _, game := range games {
+		res.Games = append(res.Games, game.getPBAdminGame())
+	}
+	return res, nil
+}
+
+// InspectGame returns the full state of the game including
+// outstanding credits and deposits of all players.
+func (a *adminServer) InspectGame(_ context.Context, req *pb.InspectGameRequest) (*pb.InspectGameResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+
+	game := a.server.getGame(reqGameID)
+	if game == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
+	}
+
+	return a.getInspectGameResponseMessage(game), nil
+}
+
+// FinishGame finishes the game with provided id. If it is the waiting
+// game, the new waiting game is created for other users to join.
+func (a *adminServer) FinishGame(_ context.Context, req *pb.FinishGameRequest) (*pb.FinishGameResponse, error) {
+	s := a.server
+	reqGameID := gameID(req.GetGameId())
+
+	s.mutex.Lock()
+	var game *game = nil
+	if s.waitingGame.gameID == reqGameID {
+		game = s.waitingGame
+		s.waitingGame = s.newGame(s.gameConfig)
+	} else if room, ok := s.rooms[reqGameID]; ok {
+		game = room
+		delete(s.rooms, reqGameID)
+	} else if activeGame, ok := s.activeGames[reqGameID]; ok {
+		game = activeGame
+		delete(s.activeGames, reqGameID)
+	}
+	s.mutex.Unlock()
+
+	if game == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
+	}
+
+	game.logger.Info("Game is finished by admin")
+	game.finish()
+	return &pb.FinishGameResponse{}, nil
+}
+
+// SetDefaultConfig overrides the default config of the server,
+// which is used by the games created afterwards.
+func (a *adminServer) SetDefaultConfig(_ context.Context, req *pb.SetDefaultConfigRequest) (*pb.SetDefaultConfigResponse, error) {
+	s := a.server
+	s.mutex.RLock()
+	config, err := s.getOverriddenConfig(s.gameConfig, req.GetConfig())
+	s.mutex.RUnlock()
+
+	if err == nil {
+		err = s.SetGameConfig(config)
+	}
+	if err != nil {
+		err = fmt.Errorf("invalid game config overrides: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.SetDefaultConfigResponse{Config: config.toPBGameConfig()}, nil
+}
+
+// EvictPlayer removes the player from the game in any state.
+func (a *adminServer) EvictPlayer(_ context.Context, req *pb.EvictPlayerRequest) (*pb.EvictPlayerResponse, error) {
+	s := a.server
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game := s.getGame(reqGameID)
+	if game == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
+	}
+
+	if err := game.evictPlayer(reqUserID); err != nil {
+		err = fmt.Errorf("failed to evict player: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	game.logger.Info("Player is evicted by admin", zap.String("user_id", string(reqUserID)))
+
+	s.pruneRoom(game)
+	return &pb.EvictPlayerResponse{}, nil
+}
+
+// PauseGame pauses any active game.
+func (a *adminServer) PauseGame(_ context.Context, req *pb.PauseGameRequest) (*pb.PauseGameResponse, error) {
+	game, err := a.server.getActiveGame(gameID(req.GetGameId()))
+	if err != nil {
+		return nil, err
+	}
+	if err := game.pause(""); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.PauseGameResponse{}, nil
+}
+
+// ResumeGame resumes any paused game.
+func (a *adminServer) ResumeGame(_ context.Context, req *pb.ResumeGameRequest) (*pb.ResumeGameResponse, error) {
+	game, err := a.server.getActiveGame(gameID(req.GetGameId()))
+	if err != nil {
+		return nil, err
+	}
+	if err := game.resume(""); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.ResumeGameResponse{}, nil
+}
+
+// ExportGame returns the journal and the final state of the finished
+// game as JSON, so that it can be analysed offline.
+func (a *adminServer) ExportGame(_ context.Context, req *pb.ExportGameRequest) (*pb.ExportGameResponse, error) {
+	s := a.server
+	reqGameID := req.GetGameId()
+
+	if s.storage == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "server doesn't keep journals of finished games")
+	}
+	events, err := s.storage.LoadEvents(reqGameID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load journal of game %v: %v", reqGameID, err)
+	}
+	if len(events) == 0 {
+		return nil, status.Errorf(codes.NotFound, "there is no journal for game %v", reqGameID)
+	}
+	if events[len(events)-1].Kind != EventFinish {
+		return nil, status.Errorf(codes.FailedPrecondition, "game %v is not finished yet", reqGameID)
+	}
+
+	export, err := getPBGameExport(events)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rebuild game %v: %v", reqGameID, err)
+	}
+	data, err := protojson.Marshal(export)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode game %v: %v", reqGameID, err)
+	}
+	return &pb.ExportGameResponse{Json: string(data)}, nil
+}
+
+// As this function uses Readlock, it cannot be called while holding the game lock.
+func (g *game) getPBAdminGame() *pb.AdminGame {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	state := g.getPBGameState()
+	return &pb.AdminGame{
+		GameId:        string(g.gameID),
+		Name:          g.name,
+		Status:        state.Status,
+		PlayerCount:   int32(len(g.players)),
+		RemainingTime: state.RemainingTime,
+	}
+}
+
+func (a *adminServer) getInspectGameResponseMessage(game *game) *pb.InspectGameResponse {
+	game.mutex.RLock()
+	defer game.mutex.RUnlock()
+
+	var players []*pb.AdminPlayer
+	for _, player := range game.players {
+		players = append(players, &pb.AdminPlayer{
+			Player:    player.toPBPlayer(),
+			Credits:   positionsToPB(player.credits, game.config, game.clock.Now()),
+			Deposits:  positionsToPB(player.deposits, game.config, game.clock.Now()),
+			Connected: player.stream != nil,
+		})
+	}
+
+	return &pb.InspectGameResponse{
+		State:   game.getPBGameState(),
+		Name:    game.name,
+		Config:  game.config.toPBGameConfig(),
+		Players: players,
+	}
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+)
+
+// AFKPolicy defines, what happens to the player of the active game,
+// who hasn't acted for the AFK time. The player is flagged as AFK in
+// any case, and the flag is cleared, once the player acts again.
+type AFKPolicy int32
+
+const (
+	// FlagAFK only flags the player.
+	FlagAFK AFKPolicy = AFKPolicy(pb.AfkPolicy_AFK_POLICY_FLAG)
+	// ManageAFK deposits the deposits of the player again, when their time ends.
+	ManageAFK AFKPolicy = AFKPolicy(pb.AfkPolicy_AFK_POLICY_MANAGE)
+	// ForfeitAFK makes the player forfeit the game, so that the player
+	// cannot win it and is ranked after the other players.
+	ForfeitAFK AFKPolicy = AFKPolicy(pb.AfkPolicy_AFK_POLICY_FORFEIT)
+)
+
+// Interval of checking, which players are AFK.
+const afkCheckInterval = time.Second
+
+// afkActions are the kinds of the events, which the players make by their
+// own requests. Other events, e.g. returns of credits, happen to players,
+// so they don't count as actions.
+var afkActions = map[string]bool{
+	EventCredit:          true,
+	EventDeposit:         true,
+	EventRepayCredit:     true,
+	EventWithdrawDeposit: true,
+	EventLottery:         true,
+	EventQuestionBid:     true,
+	EventQuestionAnswer:  true,
+	EventLoanOffer:       true,
+	EventLoanAccept:      true,
+	EventLoanRepay:       true,
+	EventBuyShares:       true,
+	EventSellShares:      true,
+	EventInsurance:       true,
+	EventSteal:           true,
+	EventStealFailure:    true,
+	EventGuard:           true,
+}
+
+// WithAFK makes the active game flag the players, who haven't acted for
+// provided number of seconds, as AFK and handle them by provided policy.
+// Without this option, AFK players are not detected.
+func WithAFK(seconds int32, policy AFKPolicy) GameConfigOption {
+	return func(c *GameConfig) {
+		c.afkTime = seconds
+		c.afkPolicy = policy
+	}
+}
+
+// scheduleAFKCheck schedules the next check of AFK players.
+func (g *game) scheduleAFKCheck() {
+	g.clock.AfterFunc(afkCheckInterval, func() {
+		g.checkAFK()
+	})
+}
+
+// checkAFK flags the players, who haven't acted for the AFK time, and
+// clears the flag of the players, who have acted since. Bots are never
+// AFK. Players, who become AFK, forfeit the game, if the policy says so.
+// It is repeated, until the game is finished.
+func (g *game) checkAFK() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state != activeState || g.deferWhilePaused(g.checkAFK) {
+		return
+	}
+
+	now := g.clock.Now()
+	afkTime := time.Duration(g.config.afkTime) * time.Second
+	var msgs []*pb.StreamResponse
+	forfeits := 0
+	for userID, player := range g.players {
+		afk := !player.bot && now.Sub(player.lastActionTime) >= afkTime
+		if afk == player.afk {
+			continue
+		}
+		player.afk = afk
+		if afk && g.config.afkPolicy == ForfeitAFK && !player.forfeited {
+			g.apply(newEvent(EventForfeit, userID, 0))
+			forfeits++
+			g.logger.Info("Player has forfeited the game for being AFK", zap.String("user_id", string(userID)))
+		}
+		msgs = append(msgs, getAFKMessage(player))
+	}
+	if forfeits > 0 {
+		// the last solvent player may be left alone
+		g.checkWinCondition()
+		g.persist()
+	}
+	if len(msgs) > 0 {
+		go func() {
+			for _, msg := range msgs {
+				g.broadcast(msg)
+			}
+		}()
+	}
+
+	g.scheduleAFKCheck()
+}
+
+func getAFKMessage(player *player) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Afk_{
+			Afk: &pb.StreamResponse_Afk{
+				UserId:    string(player.userID),
+				Afk:       player.afk,
+				Forfeited: player.forfeited,
+			},
+		},
+	}
+}
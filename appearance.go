@@ -0,0 +1,108 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Maximal number of characters in the id of the avatar.
+const maxAvatarIDLength = 64
+
+// Colors closer than this distance in RGB space are hard to tell apart,
+// so the player, who requests such a color, gets one from the palette.
+const minColorDistance = 64
+
+// colorPalette is the list of well distinguishable colors, from which
+// players get the first free one, if they don't request their own.
+// Colors are farther from each other than minColorDistance.
+var colorPalette = []string{
+	"#e6194b", // red
+	"#3cb44b", // green
+	"#4363d8", // blue
+	"#ffe119", // yellow
+	"#f58231", // orange
+	"#911eb4", // purple
+	"#42d4f4", // cyan
+	"#f032e6", // magenta
+	"#bfef45", // lime
+	"#fabed4", // pink
+	"#469990", // teal
+	"#9a6324", // brown
+}
+
+var (
+	avatarIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]*$`)
+	colorPattern    = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+)
+
+// appearance is how the clients render the player.
+type appearance struct {
+	avatarID string
+	color    string // requested color, which is replaced, if it is taken
+}
+
+// checkAppearance returns error, if the avatar id or the color,
+// which are both optional, are malformed.
+func checkAppearance(avatarID string, color string) error {
+	if len(avatarID) > maxAvatarIDLength || !avatarIDPattern.MatchString(avatarID) {
+		return fmt.Errorf(
+			"avatar id can have up to %d letters, digits, \"_\", or \"-\" characters, received: %q",
+			maxAvatarIDLength,
+			avatarID,
+		)
+	}
+	if color != "" && !colorPattern.MatchString(color) {
+		return fmt.Errorf("color has to be in #rrggbb format, received: %q", color)
+	}
+	return nil
+}
+
+// getColorDistance returns the distance of the colors in RGB space.
+// Both colors have to be in #rrggbb format.
+func getColorDistance(a string, b string) float64 {
+	var sum float64
+	for i := 1; i < 7; i += 2 {
+		// colors are checked, so parsing cannot fail
+		x, _ := strconv.ParseUint(a[i:i+2], 16, 8)
+		y, _ := strconv.ParseUint(b[i:i+2], 16, 8)
+		sum += math.Pow(float64(x)-float64(y), 2)
+	}
+	return math.Sqrt(sum)
+}
+
+// getFreeColor returns the requested color, unless it is empty or too
+// close to the color of another player. Otherwise, it returns the first
+// color of the palette, which is free, or the least used one, if none is.
+// The calling function has to acquire at least read lock.
+func (g *game) getFreeColor(requested string) string {
+	isFree := func(color string) bool {
+		for _, player := range g.players {
+			// players of the games journaled before colors don't have them
+			if player.color != "" && getColorDistance(color, player.color) < minColorDistance {
+				return false
+			}
+		}
+		return true
+	}
+	if requested = strings.ToLower(requested); requested != "" && isFree(requested) {
+		return requested
+	}
+
+	uses := make(map[string]int, len(colorPalette))
+	for _, player := range g.players {
+		uses[player.color]++
+	}
+	least := colorPalette[0]
+	for _, color := range colorPalette {
+		if isFree(color) {
+			return color
+		}
+		if uses[color] < uses[least] {
+			least = color
+		}
+	}
+	return least
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AuthMetadataKey is the metadata key, under which requests
+// of the player carry the auth token as "Bearer <token>".
+const AuthMetadataKey = "authorization"
+
+// authScheme prefixes the auth token in the metadata.
+const authScheme = "Bearer "
+
+// gameMethodPrefix is the prefix of full names of Game service methods.
+const gameMethodPrefix = "/server.Game/"
+
+// publicMethods of the Game service can be called without auth token,
+// because the player doesn't have it yet or the request is not bound to
+// the player. Reconnect is authenticated by the session token instead.
+var publicMethods = map[string]bool{
+	gameMethodPrefix + "Join":             true,
+	gameMethodPrefix + "JoinRoom":         true,
+	gameMethodPrefix + "CreateRoom":       true,
+	gameMethodPrefix + "ListRooms":        true,
+	gameMethodPrefix + "ListGames":        true,
+	gameMethodPrefix + "Enqueue":          true,
+	gameMethodPrefix + "Reconnect":        true,
+	gameMethodPrefix + "Replay":           true,
+	gameMethodPrefix + "GetGameSummary":   true,
+	gameMethodPrefix + "ListGameHistory":  true,
+	gameMethodPrefix + "GetLeaderboard":   true,
+	gameMethodPrefix + "Register":         true,
+	gameMethodPrefix + "Login":            true,
+	gameMethodPrefix + "GetProfile":       true,
+	gameMethodPrefix + "UpdateProfile":    true,
+	gameMethodPrefix + "GetPlayerStats":   true,
+	gameMethodPrefix + "GetAchievements":  true,
+	gameMethodPrefix + "GetChallenges":    true,
+	gameMethodPrefix + "CreateTournament": true,
+	gameMethodPrefix + "JoinTournament":   true,
+	gameMethodPrefix + "GetStandings":     true,
+}
+
+// authClaims bind the player to the game. Subject holds the user id.
+type authClaims struct {
+	GameID string `json:"game_id"`
+	jwt.StandardClaims
+}
+
+// gameRequest is implemented by requests, which refer to the game.
+type gameRequest interface {
+	GetGameId() string
+}
+
+// playerRequest is implemented by requests, which refer to the player.
+type playerRequest interface {
+	GetUserId() string
+}
+
+// WithAuthSecret makes server sign auth tokens with provided secret.
+// Without this option, random secret is generated, so tokens issued
+// before restart are not accepted by the restored server.
+func WithAuthSecret(secret []byte) ServerOption {
+	return func(s *Server) {
+		s.authSecret = secret
+	}
+}
+
+// newAuthSecret returns random secret for signing auth tokens.
+func newAuthSecret() []byte {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(fmt.Sprintf("failed to generate auth secret: %v", err))
+	}
+	return secret
+}
+
+// newAuthToken returns signed token, which allows the player
+// to send requests on behalf of the user in the game.
+func (s *Server) newAuthToken(userID userID, gameID gameID) (string, error) {
+	claims := authClaims{
+		GameID:         string(gameID),
+		StandardClaims: jwt.StandardClaims{Subject: string(userID)},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.authSecret)
+}
+
+// authenticate checks that the request carries the auth token issued
+// for the user and the game, which are referred to by the request.
+func (s *Server) authenticate(ctx context.Context, method string, req interface{}) error {
+	if !strings.HasPrefix(method, gameMethodPrefix) || publicMethods[method] {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(AuthMetadataKey)
+	if len(values) != 1 || !strings.HasPrefix(values[0], authScheme) {
+		return status.Errorf(codes.Unauthenticated, "missing auth token")
+	}
+
+	claims := &authClaims{}
+	_, err := jwt.ParseWithClaims(strings.TrimPrefix(values[0], authScheme), claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return s.authSecret, nil
+	})
+	if err != nil {
+		err = fmt.Errorf("invalid auth token: %v", err)
+		return status.Errorf(codes.Unauthenticated, err.Error())
+	}
+	if claims.Audience == accountAudience {
+		return status.Errorf(codes.Unauthenticated, "account token cannot be used in the game")
+	}
+
+	if r, ok := req.(gameRequest); ok && r.GetGameId() != claims.GameID {
+		return status.Errorf(codes.PermissionDenied, "auth token is issued for another game")
+	}
+	if r, ok := req.(playerRequest); ok && r.GetUserId() != claims.Subject {
+		return status.Errorf(codes.PermissionDenied, "auth token is issued for another user")
+	}
+	return nil
+}
+
+// authenticateUnary is a unary interceptor, which rejects
+// requests of the players without valid auth token.
+func (s *Server) authenticateUnary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := s.authenticate(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authenticateStream is a stream interceptor, which rejects
+// requests of the players without valid auth token.
+func (s *Server) authenticateStream(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &checkedStream{ServerStream: ss, check: func(req interface{}) error {
+		return s.authenticate(ss.Context(), info.FullMethod, req)
+	}})
+}
+
+// checkedStream checks the request as soon as the handler receives it.
+// Error of the check is returned to the handler instead of the request.
+type checkedStream struct {
+	grpc.ServerStream
+	check func(req interface{}) error
+}
+
+func (c *checkedStream) RecvMsg(m interface{}) error {
+	if err := c.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return c.check(m)
+}
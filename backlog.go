@@ -0,0 +1,69 @@
+package server
+
+import (
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+)
+
+// Number of the latest broadcast events, which are kept to be sent
+// again to the players, who have missed them.
+const backlogSize = 256
+
+// Number of the events queued for the stream of the player, which fits
+// the missed events sent on reconnect and the events broadcast meanwhile.
+const streamQueueSize = 2 * backlogSize
+
+// storeBacklog keeps the broadcast event, which replaces the event
+// broadcast backlogSize events before it. The calling function has
+// to acquire streamMutex.
+func (g *game) storeBacklog(response *pb.StreamResponse) {
+	if g.backlog == nil {
+		g.backlog = make([]*pb.StreamResponse, backlogSize)
+	}
+	g.backlog[response.Sequence%backlogSize] = response
+}
+
+// getBacklog returns the events broadcast after provided sequence number
+// and false, if some of them aren't kept anymore or the sequence number
+// is not known. The calling function has to acquire streamMutex.
+func (g *game) getBacklog(lastSeen int64) ([]*pb.StreamResponse, bool) {
+	latest := g.getStreamSequence()
+	if lastSeen <= 0 || lastSeen > latest || latest-lastSeen > backlogSize {
+		return nil, false
+	}
+	var events []*pb.StreamResponse
+	for sequence := lastSeen + 1; sequence <= latest; sequence++ {
+		event := g.backlog[sequence%backlogSize]
+		if event == nil || event.Sequence != sequence {
+			return nil, false
+		}
+		events = append(events, event)
+	}
+	return events, true
+}
+
+// catchUp queues the events, which the player has missed since provided
+// sequence number, for the new stream of the player. If they aren't
+// kept anymore, the full state of the game is queued instead. The
+// calling function has to acquire write lock and streamMutex.
+func (g *game) catchUp(player *player, lastSeen int64) {
+	events, ok := g.getBacklog(lastSeen)
+	if !ok {
+		events = []*pb.StreamResponse{g.getStateMessage()}
+	}
+	for _, event := range events {
+		if ok && !player.subscriptions.includes(event) {
+			continue
+		}
+		g.queue(player, event)
+	}
+	// either the player has seen the start, or it is in the
+	// backlog, or the state tells that the game is active
+	player.gameStartNotified = g.state != waitingState
+	g.logger.Debug(
+		"Missed events are sent",
+		zap.String("user_id", string(player.userID)),
+		zap.Int64("last_seen", lastSeen),
+		zap.Bool("backlog", ok),
+	)
+}
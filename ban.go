@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultBanDuration is the duration of bans, for which
+// the administrator hasn't provided any duration.
+const defaultBanDuration = time.Hour
+
+// WithBanDuration changes the duration of bans, for which
+// the administrator hasn't provided any duration.
+// Without this option, such bans last for an hour.
+func WithBanDuration(duration time.Duration) ServerOption {
+	return func(s *Server) {
+		s.bans.duration = duration
+	}
+}
+
+// banKey identifies banned players either by their
+// username or by the address they connect from.
+type banKey struct {
+	kind  pb.BanKind
+	value string
+}
+
+// banList holds the end times of bans. Expired bans
+// are removed, when the list is accessed.
+type banList struct {
+	mutex    sync.Mutex
+	duration time.Duration
+	bans     map[banKey]time.Time
+	clock    Clock
+}
+
+func newBanList() *banList {
+	return &banList{
+		duration: defaultBanDuration,
+		bans:     make(map[banKey]time.Time),
+		clock:    systemClock{},
+	}
+}
+
+// pruneExpired removes bans, which have ended.
+// The calling function has to acquire the lock.
+func (b *banList) pruneExpired() {
+	now := b.clock.Now()
+	for key, endTime := range b.bans {
+		if !now.Before(endTime) {
+			delete(b.bans, key)
+		}
+	}
+}
+
+// ban bans the key for provided duration, or for the duration of the
+// list, if it is 0. Existing ban of the key is replaced. Returns the
+// end time of the ban.
+func (b *banList) ban(key banKey, duration time.Duration) time.Time {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if duration == 0 {
+		duration = b.duration
+	}
+	endTime := b.clock.Now().Add(duration)
+	b.bans[key] = endTime
+	return endTime
+}
+
+// unban removes the ban of the key. Returns false, if it is not banned.
+func (b *banList) unban(key banKey) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.pruneExpired()
+	if _, ok := b.bans[key]; !ok {
+		return false
+	}
+	delete(b.bans, key)
+	return true
+}
+
+// getEndTime returns the end time of the ban of the key
+// and false, if the key is not banned.
+func (b *banList) getEndTime(key banKey) (time.Time, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	endTime, ok := b.bans[key]
+	if !ok || !b.clock.Now().Before(endTime) {
+		return time.Time{}, false
+	}
+	return endTime, true
+}
+
+func (b *banList) toPBBans() []*pb.Ban {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.pruneExpired()
+	res := make([]*pb.Ban, 0, len(b.bans))
+	for key, endTime := range b.bans {
+		res = append(res, getPBBan(key, endTime))
+	}
+	return res
+}
+
+func getPBBan(key banKey, endTime time.Time) *pb.Ban {
+	return &pb.Ban{
+		Kind:    key.kind,
+		Value:   key.value,
+		EndTime: endTime.UnixNano() / int64(time.Millisecond),
+	}
+}
+
+// checkBan returns PermissionDenied error, if either the username
+// or the address, from which the request has been sent, is banned.
+func (s *Server) checkBan(ctx context.Context, username username) error {
+	keys := []banKey{
+		{kind: pb.BanKind_BAN_KIND_USERNAME, value: string(username)},
+		{kind: pb.BanKind_BAN_KIND_ADDRESS, value: peerHost(ctx)},
+	}
+	for _, key := range keys {
+		if endTime, ok := s.bans.getEndTime(key); ok {
+			return newStatusError(
+				codes.PermissionDenied,
+				ReasonBanned,
+				time.Until(endTime),
+				"%v is banned until %v",
+				key.value,
+				endTime.UTC().Format(time.RFC3339),
+			)
+		}
+	}
+	return nil
+}
+
+// Ban bans the username or the address from joining games.
+func (a *adminServer) Ban(_ context.Context, req *pb.BanRequest) (*pb.BanResponse, error) {
+	key := banKey{kind: req.GetKind(), value: req.GetValue()}
+	endTime := a.server.bans.ban(key, time.Duration(req.GetDuration())*time.Second)
+	a.server.logger.Info(
+		"Ban is added by admin",
+		zap.String("kind", key.kind.String()),
+		zap.String("value", key.value),
+		zap.Time("end_time", endTime),
+	)
+	return &pb.BanResponse{Ban: getPBBan(key, endTime)}, nil
+}
+
+// Unban removes the ban of the username or the address.
+func (a *adminServer) Unban(_ context.Context, req *pb.UnbanRequest) (*pb.UnbanResponse, error) {
+	key := banKey{kind: req.GetKind(), value: req.GetValue()}
+	if !a.server.bans.unban(key) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v is not banned", key.value)
+	}
+	a.server.logger.Info(
+		"Ban is removed by admin",
+		zap.String("kind", key.kind.String()),
+		zap.String("value", key.value),
+	)
+	return &pb.UnbanResponse{}, nil
+}
+
+// ListBans returns all bans, which have not ended yet.
+func (a *adminServer) ListBans(_ context.Context, _ *pb.ListBansRequest) (*pb.ListBansResponse, error) {
+	return &pb.ListBansResponse{Bans: a.server.bans.toPBBans()}, nil
+}
@@ -0,0 +1,126 @@
+package server
+
+import "fmt"
+
+// BankConditions describe the config of the game and the current
+// state of the bank, on which the bank strategy bases its decisions.
+type BankConditions struct {
+	CreditInterest  int32 // percentage for the whole credit time from the config
+	DepositInterest int32 // percentage for the whole deposit time from the config
+	CreditLimit     int32 // percentage of the net worth from the config
+	BankPoints      int32 // points, which the bank has now
+	Credits         int32 // outstanding credits of all players
+	Deposits        int32 // outstanding deposits of all players
+}
+
+// BankStrategy decides on interest rates and credit limits of the bank.
+// The decision is recorded in the journal, so that the strategy doesn't
+// have to be deterministic. It has to be safe for concurrent use.
+type BankStrategy interface {
+	// CreditInterest returns interest of the credit, which is granted
+	// now to the player with provided credit score.
+	CreditInterest(conditions BankConditions, creditScore int32) int32
+	// DepositInterest returns interest of the deposit, which is made now.
+	DepositInterest(conditions BankConditions) int32
+	// CreditLimit returns maximal value of outstanding credits of the
+	// player with provided net worth and credit score.
+	CreditLimit(conditions BankConditions, netWorth int32, creditScore int32) int32
+}
+
+// WithBankStrategy makes the bank of every game follow provided strategy.
+// Without this option, DefaultBankStrategy is used.
+func WithBankStrategy(strategy BankStrategy) ServerOption {
+	return func(s *Server) {
+		s.bankStrategy = strategy
+	}
+}
+
+// NewBankStrategy returns the built-in strategy with provided name,
+// which is either "default" or "demand".
+func NewBankStrategy(name string) (BankStrategy, error) {
+	switch name {
+	case "default":
+		return DefaultBankStrategy{}, nil
+	case "demand":
+		return DemandBankStrategy{}, nil
+	}
+	return nil, fmt.Errorf("unknown bank strategy %q", name)
+}
+
+// DefaultBankStrategy charges interest from the config and scales
+// credit interest and limit by the credit score of the player.
+type DefaultBankStrategy struct{}
+
+// CreditInterest returns credit interest from the config for the default
+// score, and it goes down to half of it for the maximal score and up to
+// one and a half of it for the minimal score. It is never lower than the
+// deposit interest, so that credits cannot be deposited with profit.
+func (DefaultBankStrategy) CreditInterest(conditions BankConditions, creditScore int32) int32 {
+	interest := getNumberProportion(conditions.CreditInterest, 150-creditScore)
+	if interest < conditions.DepositInterest {
+		return conditions.DepositInterest
+	}
+	return interest
+}
+
+// DepositInterest returns deposit interest from the config.
+func (DefaultBankStrategy) DepositInterest(conditions BankConditions) int32 {
+	return conditions.DepositInterest
+}
+
+// CreditLimit returns credit limit from the config for the default score,
+// and it goes down to half of it for the minimal score and up to one and
+// a half of it for the maximal score.
+func (DefaultBankStrategy) CreditLimit(conditions BankConditions, netWorth int32, creditScore int32) int32 {
+	return getNumberProportion(getNumberProportion(netWorth, conditions.CreditLimit), 50+creditScore)
+}
+
+// DemandBankStrategy follows DefaultBankStrategy, while the bank has
+// lent out none of its points. As the demand for credits grows, it raises
+// both interest rates, so that borrowing gets more expensive and saving
+// gets more attractive, and it rations credits by lowering credit limits.
+// When all points of the bank are lent out, rates are doubled, and no
+// more credits are granted.
+type DemandBankStrategy struct{}
+
+// getUtilization returns percentage of the points of the bank,
+// which are lent out as credits.
+func (DemandBankStrategy) getUtilization(conditions BankConditions) int32 {
+	funds := int64(conditions.BankPoints) + int64(conditions.Credits)
+	if funds <= 0 || conditions.Credits <= 0 {
+		return 0
+	}
+	return int32(int64(conditions.Credits) * 100 / funds)
+}
+
+func (s DemandBankStrategy) CreditInterest(conditions BankConditions, creditScore int32) int32 {
+	interest := DefaultBankStrategy{}.CreditInterest(conditions, creditScore)
+	return getNumberProportion(interest, 100+s.getUtilization(conditions))
+}
+
+func (s DemandBankStrategy) DepositInterest(conditions BankConditions) int32 {
+	return getNumberProportion(conditions.DepositInterest, 100+s.getUtilization(conditions))
+}
+
+func (s DemandBankStrategy) CreditLimit(conditions BankConditions, netWorth int32, creditScore int32) int32 {
+	limit := DefaultBankStrategy{}.CreditLimit(conditions, netWorth, creditScore)
+	return getNumberProportion(limit, 100-s.getUtilization(conditions))
+}
+
+// getBankConditions returns the current conditions of the bank of the game.
+// The calling function has to acquire at least read lock.
+func (g *game) getBankConditions() BankConditions {
+	conditions := BankConditions{
+		CreditInterest:  g.config.creditInterest,
+		DepositInterest: g.config.depositInterest,
+		CreditLimit:     g.config.creditLimit,
+		BankPoints:      g.bankPoints,
+	}
+	for _, player := range g.players {
+		conditions.Credits += player.getOutstandingCredit()
+		for _, deposit := range player.deposits {
+			conditions.Deposits += deposit.value
+		}
+	}
+	return conditions
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// Maximal interval, for which the events are collected into the batch.
+const maxBatchInterval = time.Second
+
+// Maximal number of the events in the batch, which is also
+// used, if the client hasn't provided the size of the batch.
+const maxBatchSize = 100
+
+// batchingStream collects the events sent on the stream of the player
+// and sends them together in the Batch event, either after the batch
+// interval or once the batch is full. Events are sent right away, if
+// the interval is 0.
+type batchingStream struct {
+	pb.Game_StreamServer
+	mutex    sync.Mutex
+	interval time.Duration
+	size     int
+	events   []*pb.StreamResponse
+	timer    *time.Timer // flushes the batch, nil, if the batch is empty
+	logger   *zap.Logger
+}
+
+// newBatchingStream wraps the stream, so that the events are batched
+// with provided interval in milliseconds and size.
+func newBatchingStream(
+	stream pb.Game_StreamServer, interval int32, size int32, logger *zap.Logger,
+) (*batchingStream, error) {
+	if interval < 0 || time.Duration(interval)*time.Millisecond > maxBatchInterval {
+		return nil, fmt.Errorf("batch interval has to be from 0 to %d, received: %d", maxBatchInterval/time.Millisecond, interval)
+	}
+	if size < 0 || size > maxBatchSize {
+		return nil, fmt.Errorf("batch size has to be from 0 to %d, received: %d", maxBatchSize, size)
+	}
+	if size == 0 {
+		size = maxBatchSize
+	}
+	return &batchingStream{
+		Game_StreamServer: stream,
+		interval:          time.Duration(interval) * time.Millisecond,
+		size:              int(size),
+		logger:            logger,
+	}, nil
+}
+
+// Send adds the event to the batch. The batch is sent, if it is full.
+func (s *batchingStream) Send(response *pb.StreamResponse) error {
+	if s.interval == 0 {
+		return s.Game_StreamServer.Send(response)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.events = append(s.events, response)
+	if len(s.events) >= s.size {
+		return s.flushLocked()
+	}
+	if s.timer == nil {
+		s.timer = time.AfterFunc(s.interval, func() {
+			if err := s.Flush(); err != nil {
+				s.logger.Warn("Could not send batch", zap.Error(err))
+			}
+		})
+	}
+	return nil
+}
+
+// Flush sends the collected events right away.
+func (s *batchingStream) Flush() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.flushLocked()
+}
+
+// flushLocked sends the collected events as a single event, or as is, if
+// there is only one of them. The calling function has to acquire the lock.
+func (s *batchingStream) flushLocked() error {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	events := s.events
+	s.events = nil
+	switch len(events) {
+	case 0:
+		return nil
+	case 1:
+		return s.Game_StreamServer.Send(events[0])
+	}
+	return s.Game_StreamServer.Send(getBatchMessage(coalesceTransactions(events)))
+}
+
+// coalesceTransactions removes the players from the transactions, if they
+// are listed in any of the later transactions, since clients only need
+// their latest points. Changed events are copied, since the same events
+// are sent to other players as well.
+func coalesceTransactions(events []*pb.StreamResponse) []*pb.StreamResponse {
+	res := make([]*pb.StreamResponse, len(events))
+	listed := make(map[string]bool)
+	for i := len(events) - 1; i >= 0; i-- {
+		res[i] = events[i]
+		transaction := events[i].GetTransaction()
+		if transaction == nil {
+			continue
+		}
+		var players []*pb.Player
+		for _, player := range transaction.Players {
+			if !listed[player.UserId] {
+				players = append(players, player)
+			}
+		}
+		if len(players) < len(transaction.Players) {
+			event := proto.Clone(events[i]).(*pb.StreamResponse)
+			event.GetTransaction().Players = players
+			res[i] = event
+		}
+		for _, player := range players {
+			listed[player.UserId] = true
+		}
+	}
+	return res
+}
+
+// getBatchMessage returns the Batch event, which carries the
+// sequence number of the latest event in it.
+func getBatchMessage(events []*pb.StreamResponse) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Batch_{
+			Batch: &pb.StreamResponse_Batch{
+				Events: events,
+			},
+		},
+		Sequence: events[len(events)-1].Sequence,
+	}
+}
@@ -0,0 +1,557 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Time between the decisions of the bot.
+const botTickTime = time.Second
+
+// Maximal number of bots added by a single request.
+const maxBotsPerRequest = 10
+
+// BotActionKind defines what the bot does on its turn.
+type BotActionKind int
+
+const (
+	// BotIdle does nothing.
+	BotIdle BotActionKind = iota
+	// BotCredit takes the credit of the value of the action.
+	BotCredit
+	// BotDeposit makes the deposit of the value of the action.
+	BotDeposit
+	// BotLottery plays the lottery in the cell with the index equal
+	// to the value of the action.
+	BotLottery
+	// BotQuestion generates the question with the bid equal to
+	// the value of the action and answers it right away.
+	BotQuestion
+)
+
+// BotAction is the decision of the bot on its turn.
+type BotAction struct {
+	Kind  BotActionKind
+	Value int32
+}
+
+// BotState is what the bot knows about the game, when it decides.
+type BotState struct {
+	Points            int32
+	NetWorth          int32 // points together with deposits without credits
+	OutstandingCredit int32
+	RemainingTime     int32 // seconds until the game finishes
+	LotteryReady      bool  // true, if the bot can play the lottery
+	LotteryCells      int32 // number of cells in the lottery grid
+	MaxBid            int32 // maximal bid on the question
+}
+
+// Bot makes decisions on behalf of the player driven by the server.
+// Decisions are carried out by the same handlers as requests of
+// regular players, so they are validated in the same way.
+type Bot interface {
+	// Act is called every second of the active game.
+	Act(state BotState) BotAction
+	// Answer returns the index (from 1 to 4) of the answer to the
+	// question, which the bot has generated. The index of the correct
+	// answer is provided, so that the bot can be as skilled as needed.
+	Answer(question string, answers []string, correct int32) int32
+}
+
+// BotProfile is the strategy of the RandomBot. Weights are relative
+// chances of the actions on every turn, e.g. the bot with deposit
+// weight 3 and idle weight 1 deposits on 3 turns out of 4.
+type BotProfile struct {
+	Name           string
+	IdleWeight     int32
+	CreditWeight   int32 // credits are taken only without outstanding ones
+	DepositWeight  int32
+	LotteryWeight  int32 // lottery is played only, when it is ready
+	QuestionWeight int32
+	MinStake       int32 // minimal value of the action as percentage of points
+	MaxStake       int32 // maximal value of the action as percentage of points
+	Accuracy       int32 // percentage of the questions answered correctly
+}
+
+// Strategy profiles of the bots.
+var (
+	// BalancedBotProfile does everything equally often with
+	// small stakes and answers questions at random.
+	BalancedBotProfile = BotProfile{
+		Name:           "balanced",
+		IdleWeight:     1,
+		CreditWeight:   1,
+		DepositWeight:  1,
+		LotteryWeight:  1,
+		QuestionWeight: 1,
+		MinStake:       10,
+		MaxStake:       30,
+		Accuracy:       25,
+	}
+	// ConservativeBotProfile saves with small deposits, rarely
+	// takes risks, and never borrows.
+	ConservativeBotProfile = BotProfile{
+		Name:           "conservative",
+		IdleWeight:     2,
+		DepositWeight:  6,
+		LotteryWeight:  1,
+		QuestionWeight: 1,
+		MinStake:       5,
+		MaxStake:       15,
+		Accuracy:       70,
+	}
+	// AggressiveBotProfile borrows big and invests the credits.
+	AggressiveBotProfile = BotProfile{
+		Name:           "aggressive",
+		CreditWeight:   5,
+		DepositWeight:  2,
+		LotteryWeight:  1,
+		QuestionWeight: 2,
+		MinStake:       20,
+		MaxStake:       50,
+		Accuracy:       50,
+	}
+	// GamblerBotProfile plays the lottery and bets high on questions.
+	GamblerBotProfile = BotProfile{
+		Name:           "gambler",
+		CreditWeight:   1,
+		LotteryWeight:  6,
+		QuestionWeight: 3,
+		MinStake:       30,
+		MaxStake:       80,
+		Accuracy:       30,
+	}
+)
+
+// DefaultBotProfiles are the profiles, which bots of the server take in turn.
+var DefaultBotProfiles = []BotProfile{ConservativeBotProfile, AggressiveBotProfile, GamblerBotProfile}
+
+func (p BotProfile) validate() error {
+	weights := []int32{p.IdleWeight, p.CreditWeight, p.DepositWeight, p.LotteryWeight, p.QuestionWeight}
+	sum := int32(0)
+	for _, weight := range weights {
+		if weight < 0 {
+			return fmt.Errorf("weights of bot profile %q cannot be negative", p.Name)
+		}
+		sum += weight
+	}
+	if sum == 0 {
+		return fmt.Errorf("bot profile %q has to have at least one positive weight", p.Name)
+	}
+	if p.MinStake < 0 || p.MinStake > p.MaxStake || p.MaxStake > 100 {
+		return fmt.Errorf("stakes of bot profile %q have to satisfy 0 <= min <= max <= 100", p.Name)
+	}
+	if p.Accuracy < 0 || p.Accuracy > 100 {
+		return fmt.Errorf("accuracy of bot profile %q has to be from 0 to 100", p.Name)
+	}
+	return nil
+}
+
+// RandomBot makes random decisions with the chances
+// and stakes defined by its profile.
+type RandomBot struct {
+	mutex   sync.Mutex
+	rand    *rand.Rand
+	profile BotProfile
+}
+
+// NewRandomBot returns the bot with the balanced profile,
+// whose decisions are determined by the seed.
+func NewRandomBot(seed int64) *RandomBot {
+	return NewProfileBot(BalancedBotProfile, seed)
+}
+
+// NewProfileBot returns the bot with provided profile,
+// whose decisions are determined by the seed.
+func NewProfileBot(profile BotProfile, seed int64) *RandomBot {
+	return &RandomBot{rand: rand.New(rand.NewSource(seed)), profile: profile}
+}
+
+// seed replaces the seed of the bot by the one derived from the game.
+func (b *RandomBot) seed(seed int64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.rand = rand.New(rand.NewSource(seed))
+}
+
+// seededBot is the bot, which is seeded by the game, once it starts,
+// so that the game with bots is reproduced from its seed.
+type seededBot interface {
+	Bot
+	seed(seed int64)
+}
+
+func (b *RandomBot) Act(state BotState) BotAction {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	p := b.profile
+	// actions, which cannot be done now, are never chosen
+	weights := map[BotActionKind]int32{
+		BotIdle:     p.IdleWeight,
+		BotDeposit:  p.DepositWeight,
+		BotQuestion: p.QuestionWeight,
+	}
+	if state.OutstandingCredit == 0 {
+		weights[BotCredit] = p.CreditWeight
+	}
+	if state.LotteryReady && state.LotteryCells > 0 {
+		weights[BotLottery] = p.LotteryWeight
+	}
+	sum := int32(0)
+	for _, weight := range weights {
+		sum += weight
+	}
+	if sum <= 0 {
+		return BotAction{Kind: BotIdle}
+	}
+
+	// map iteration order is random, so kinds are iterated in order
+	kind := BotIdle
+	roll := b.rand.Int31n(sum)
+	for _, k := range []BotActionKind{BotIdle, BotCredit, BotDeposit, BotLottery, BotQuestion} {
+		if roll < weights[k] {
+			kind = k
+			break
+		}
+		roll -= weights[k]
+	}
+
+	switch kind {
+	case BotLottery:
+		return BotAction{Kind: BotLottery, Value: b.rand.Int31n(state.LotteryCells) + 1}
+	case BotIdle:
+		return BotAction{Kind: BotIdle}
+	}
+	stake := p.MinStake + b.rand.Int31n(p.MaxStake-p.MinStake+1)
+	value := getNumberProportion(state.Points, stake)
+	if kind == BotQuestion && state.MaxBid > 0 && value > state.MaxBid {
+		value = state.MaxBid
+	}
+	if value <= 0 {
+		return BotAction{Kind: BotIdle}
+	}
+	return BotAction{Kind: kind, Value: value}
+}
+
+func (b *RandomBot) Answer(_ string, answers []string, correct int32) int32 {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.rand.Int31n(100) < b.profile.Accuracy || len(answers) < 2 {
+		return correct
+	}
+	// any of the wrong answers
+	answer := b.rand.Int31n(int32(len(answers))-1) + 1
+	if answer >= correct {
+		answer++
+	}
+	return answer
+}
+
+// WithBots makes the server create bots with provided function.
+// Without this option, bots are RandomBots, which take the bot
+// profiles of the server in turn and are seeded by the game.
+func WithBots(newBot func() Bot) ServerOption {
+	return func(s *Server) {
+		s.newBot = newBot
+	}
+}
+
+// WithBotProfiles sets the profiles, which bots of the server take in
+// turn, and which the host can choose from, when adding bots. Without
+// this option, DefaultBotProfiles are used.
+func WithBotProfiles(profiles ...BotProfile) ServerOption {
+	return func(s *Server) {
+		s.botProfiles = profiles
+	}
+}
+
+// WithBotFill makes the server fill the lobby, which the host starts
+// with fewer than provided number of players, with bots, as long as
+// the game is not full. Without this option, lobbies are not filled.
+func WithBotFill(size int32) ServerOption {
+	return func(s *Server) {
+		s.botFill = size
+	}
+}
+
+// newProfileBots returns the function, which creates RandomBots
+// with provided profiles in turn.
+func newProfileBots(profiles []BotProfile) func() Bot {
+	var count uint64
+	return func() Bot {
+		i := atomic.AddUint64(&count, 1) - 1
+		profile := profiles[i%uint64(len(profiles))]
+		// bots are seeded by the game, once it starts
+		return NewProfileBot(profile, 0)
+	}
+}
+
+// getValidBotProfiles returns the profiles set by WithBotProfiles
+// without the invalid ones, which are logged.
+func (s *Server) getValidBotProfiles() []BotProfile {
+	var profiles []BotProfile
+	for _, profile := range s.botProfiles {
+		if err := profile.validate(); err != nil {
+			s.logger.Warn("Bot profile is ignored", zap.Error(err))
+			continue
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles
+}
+
+// getBotProfile returns the profile of the server with provided name.
+func (s *Server) getBotProfile(name string) (BotProfile, bool) {
+	for _, profile := range s.botProfiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return BotProfile{}, false
+}
+
+// getBotUsername returns the first username of the form "Bot N",
+// which is not taken in the game. The calling function has to
+// acquire at least read lock.
+func (g *game) getBotUsername() username {
+	taken := make(map[username]bool)
+	for _, player := range g.players {
+		taken[player.username] = true
+	}
+	for i := 1; ; i++ {
+		name := username(fmt.Sprintf("Bot %d", i))
+		if !taken[name] {
+			return name
+		}
+	}
+}
+
+// addBot adds the bot to the waiting game.
+func (g *game) addBot() (userID, error) {
+	g.mutex.RLock()
+	name := g.getBotUsername()
+	g.mutex.RUnlock()
+
+	return g.joinPlayer(name, "", appearance{}, true)
+}
+
+// getCorrectAnswer returns the index of the correct answer to the
+// question, which the player has generated, or false, if there is
+// no such question.
+func (g *game) getCorrectAnswer(userID userID, questionID questionID) (int32, bool) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	player, ok := g.players[userID]
+	if !ok {
+		return 0, false
+	}
+	question, ok := player.questions[questionID]
+	if !ok {
+		return 0, false
+	}
+	return question.correctAnswer, true
+}
+
+// getBotState returns what the bot knows about the game. It returns
+// false, if the bot has left the game, or the game is finished.
+func (g *game) getBotState(userID userID) (BotState, bool) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	player, ok := g.players[userID]
+	if !ok || g.state == finishedState {
+		return BotState{}, false
+	}
+	state := BotState{
+		Points:            player.points,
+		NetWorth:          player.getNetWorth(),
+		OutstandingCredit: player.getOutstandingCredit(),
+		LotteryCells:      g.config.lotteryCellCount(),
+	}
+	if g.state == activeState {
+		state.RemainingTime = g.getRemainingTime()
+		state.LotteryReady = player.getLotteryRemainingTime(g.config.lotteryTime, g.clock.Now()) == 0
+	}
+	if g.config.questionMaxBid > 0 {
+		state.MaxBid = getNumberProportion(player.points, g.config.questionMaxBid)
+	}
+	return state, true
+}
+
+// getBotSeed returns the seed of the bot, which is the seed of the game
+// plus the number of the bot in the order, in which bots have joined it.
+func (g *game) getBotSeed(botID userID) int64 {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	index := int64(0)
+	for _, event := range g.journal {
+		if event.Kind != EventJoin || !event.Bot {
+			continue
+		}
+		index++
+		if userID(event.UserID) == botID {
+			break
+		}
+	}
+	return g.seed + index
+}
+
+// addBots adds up to provided number of bots to the waiting game
+// and starts them. Bots are created by newBot. Returns ids of
+// the added bots.
+func (s *Server) addBots(game *game, count int32, newBot func() Bot) []userID {
+	var botIDs []userID
+	for i := int32(0); i < count; i++ {
+		botID, err := game.addBot()
+		if err != nil {
+			game.logger.Info("Could not add bot", zap.Error(err))
+			break
+		}
+		botIDs = append(botIDs, botID)
+		go s.runBot(game, botID, newBot())
+	}
+	if len(botIDs) > 0 {
+		game.logger.Info("Bots have been added", zap.Int("bots", len(botIDs)))
+	}
+	return botIDs
+}
+
+// runBot lets the bot act every second of the active game, which
+// is not paused, until the game finishes or the bot leaves it.
+func (s *Server) runBot(game *game, botID userID, bot Bot) {
+	ticker := s.clock.NewTicker(botTickTime)
+	defer ticker.Stop()
+
+	seeded := false
+	for range ticker.C() {
+		state, ok := game.getBotState(botID)
+		if !ok {
+			return
+		}
+		if game.isWaiting() || game.isPaused() {
+			continue
+		}
+		if bot, ok := bot.(seededBot); ok && !seeded {
+			bot.seed(game.getBotSeed(botID))
+			seeded = true
+		}
+		if err := s.doBotAction(game, botID, bot, bot.Act(state)); err != nil {
+			game.logger.Debug("Bot action failed", zap.String("user_id", string(botID)), zap.Error(err))
+		}
+	}
+}
+
+// doBotAction carries out the decision of the bot with the handlers,
+// which serve the requests of regular players. Requests are validated
+// like the requests of the players, since they skip the interceptors.
+func (s *Server) doBotAction(game *game, botID userID, bot Bot, action BotAction) error {
+	ctx := context.Background()
+	reqGameID := string(game.gameID)
+	reqUserID := string(botID)
+
+	var err error
+	switch action.Kind {
+	case BotCredit:
+		req := &pb.CreditRequest{GameId: reqGameID, UserId: reqUserID, Value: action.Value}
+		if err = s.validateRequest(req); err == nil {
+			_, err = s.Credit(ctx, req)
+		}
+	case BotDeposit:
+		req := &pb.DepositRequest{GameId: reqGameID, UserId: reqUserID, Value: action.Value}
+		if err = s.validateRequest(req); err == nil {
+			_, err = s.Deposit(ctx, req)
+		}
+	case BotLottery:
+		req := &pb.LotteryRequest{GameId: reqGameID, UserId: reqUserID, CellIndex: action.Value}
+		if err = s.validateRequest(req); err == nil {
+			_, err = s.Lottery(ctx, req)
+		}
+	case BotQuestion:
+		req := &pb.GenerateQuestionRequest{GameId: reqGameID, UserId: reqUserID, BidPoints: action.Value}
+		if err = s.validateRequest(req); err != nil {
+			break
+		}
+		var res *pb.GenerateQuestionResponse
+		res, err = s.GenerateQuestion(ctx, req)
+		if err != nil {
+			break
+		}
+		correct, ok := game.getCorrectAnswer(botID, questionID(res.QuestionId))
+		if !ok {
+			return fmt.Errorf("question %v has not been found", res.QuestionId)
+		}
+		answerReq := &pb.AnswerQuestionRequest{
+			GameId:     reqGameID,
+			UserId:     reqUserID,
+			QuestionId: res.QuestionId,
+			Answer:     bot.Answer(res.Question, res.Answers, correct),
+		}
+		if err = s.validateRequest(answerReq); err == nil {
+			_, err = s.AnswerQuestion(ctx, answerReq)
+		}
+	}
+	return err
+}
+
+// fillWithBots adds bots to the lobby, which has fewer players than the
+// bot fill of the server, but not more than provided max players, if it
+// is positive. The calling function has to acquire server lock.
+func (s *Server) fillWithBots(game *game, maxPlayers int32) {
+	size := s.botFill
+	if maxPlayers > 0 && size > maxPlayers {
+		size = maxPlayers
+	}
+	if count := size - int32(game.getPlayerCount()); count > 0 {
+		s.addBots(game, count, s.newBot)
+	}
+}
+
+// AddBots adds bots to the waiting game on behalf of the host.
+func (s *Server) AddBots(_ context.Context, req *pb.AddBotsRequest) (*pb.AddBotsResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqCount := req.GetCount()
+
+	newBot := s.newBot
+	if name := req.GetProfile(); name != "" {
+		profile, ok := s.getBotProfile(name)
+		if !ok {
+			err := fmt.Errorf("there is no bot profile %q", name)
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		newBot = func() Bot {
+			return NewProfileBot(profile, 0)
+		}
+	}
+	game, err := s.getLobby(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+	if !game.isHost(reqUserID) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the host of the game can add bots")
+	}
+
+	botIDs := s.addBots(game, reqCount, newBot)
+	if len(botIDs) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "bots cannot be added to the game")
+	}
+	res := &pb.AddBotsResponse{}
+	for _, botID := range botIDs {
+		res.UserIds = append(res.UserIds, string(botID))
+	}
+	return res, nil
+}
@@ -0,0 +1,306 @@
+package server
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+)
+
+// Number of challenges, which are given to the players every day.
+const dailyChallengeCount = 3
+
+// Layout of the day, for which the challenge is given.
+const challengeDayLayout = "2006-01-02"
+
+// ChallengeRecord is a persisted progress of the player in the daily
+// challenge. Players are identified by their usernames.
+type ChallengeRecord struct {
+	Username    string
+	Day         string // in UTC, e.g. "2020-11-30"
+	ChallengeID string
+	Progress    int32
+}
+
+// challengeTemplate describes the challenge, which may be given
+// on any day. Progress is made in the finished games.
+type challengeTemplate struct {
+	id          string
+	description string
+	target      int32
+	reward      int32 // coins for completing the challenge
+	// returns progress, which the player has made in the game,
+	// the calling function has to acquire at least read lock
+	progress func(g *game, player *player, won bool) int32
+}
+
+var challengeTemplates = []challengeTemplate{
+	{
+		id:          "play_games",
+		description: "Finish 3 games",
+		target:      3,
+		reward:      50,
+		progress: func(_ *game, _ *player, _ bool) int32 {
+			return 1
+		},
+	},
+	{
+		id:          "win_games",
+		description: "Win 2 games",
+		target:      2,
+		reward:      100,
+		progress: func(_ *game, _ *player, won bool) int32 {
+			return boolToInt32(won)
+		},
+	},
+	{
+		id:          "deposit_only_win",
+		description: "Win a game using only deposits",
+		target:      1,
+		reward:      150,
+		progress: func(g *game, player *player, won bool) int32 {
+			others := g.countEvents(player.userID, func(event JournalEvent) bool {
+				switch event.Kind {
+				// thefts and taxes are not caused by the player
+				case EventJoin, EventDeposit, EventReturnDeposit, EventWithdrawDeposit,
+					EventTheft, EventTax, EventInsurancePayout:
+					return false
+				}
+				return true
+			})
+			deposits := g.countEvents(player.userID, func(event JournalEvent) bool {
+				return event.Kind == EventDeposit
+			})
+			return boolToInt32(won && deposits > 0 && others == 0)
+		},
+	},
+	{
+		id:          "answer_questions",
+		description: "Answer 5 questions correctly",
+		target:      5,
+		reward:      100,
+		progress: func(g *game, player *player, _ bool) int32 {
+			return g.countEvents(player.userID, func(event JournalEvent) bool {
+				return event.Kind == EventQuestionAnswer && event.Value > 0
+			})
+		},
+	},
+	{
+		id:          "lottery_wins",
+		description: "Win the lottery 3 times",
+		target:      3,
+		reward:      50,
+		progress: func(g *game, player *player, _ bool) int32 {
+			return g.countEvents(player.userID, func(event JournalEvent) bool {
+				return event.Kind == EventLottery && event.Value > 0
+			})
+		},
+	},
+	{
+		id:          "steals",
+		description: "Steal from other players 3 times",
+		target:      3,
+		reward:      100,
+		progress: func(g *game, player *player, _ bool) int32 {
+			return g.countEvents(player.userID, func(event JournalEvent) bool {
+				return event.Kind == EventSteal
+			})
+		},
+	},
+}
+
+func boolToInt32(value bool) int32 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+func getChallengeTemplate(id string) (challengeTemplate, bool) {
+	for _, template := range challengeTemplates {
+		if template.id == id {
+			return template, true
+		}
+	}
+	return challengeTemplate{}, false
+}
+
+// getChallengeDay returns the day, to which provided time belongs.
+func getChallengeDay(t time.Time) string {
+	return t.UTC().Format(challengeDayLayout)
+}
+
+// getDailyChallenges returns the challenges given on the day, to which
+// provided time belongs. Challenges rotate every day at midnight UTC,
+// and they are the same for all players.
+func getDailyChallenges(t time.Time) []challengeTemplate {
+	day := t.UTC().Unix() / int64(24*time.Hour/time.Second)
+	perm := rand.New(rand.NewSource(day)).Perm(len(challengeTemplates))
+	res := make([]challengeTemplate, dailyChallengeCount)
+	for i := range res {
+		res[i] = challengeTemplates[perm[i]]
+	}
+	return res
+}
+
+// countEvents returns the number of the events of the player in the
+// journal of the game, which match the filter. The calling
+// function has to acquire at least read lock.
+func (g *game) countEvents(userID userID, filter func(JournalEvent) bool) int32 {
+	count := int32(0)
+	for _, event := range g.journal {
+		if event.UserID == string(userID) && filter(event) {
+			count++
+		}
+	}
+	return count
+}
+
+// challengeBook caches progress of the players in daily
+// challenges and saves it to the storage, if any.
+type challengeBook struct {
+	mutex   sync.Mutex
+	storage Storage // nil, if progress is not persisted
+	records map[username][]ChallengeRecord
+	logger  *zap.Logger
+}
+
+func newChallengeBook(storage Storage, logger *zap.Logger) *challengeBook {
+	return &challengeBook{
+		storage: storage,
+		records: make(map[username][]ChallengeRecord),
+		logger:  logger,
+	}
+}
+
+// get returns progress of the player in all challenges, which
+// is loaded from the storage, if it is not cached yet.
+func (b *challengeBook) get(username username) []ChallengeRecord {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	res := make([]ChallengeRecord, len(b.getLocked(username)))
+	copy(res, b.getLocked(username))
+	return res
+}
+
+// getLocked is the same as get, but the returned slice is not
+// copied. The calling function has to acquire the lock.
+func (b *challengeBook) getLocked(username username) []ChallengeRecord {
+	if records, ok := b.records[username]; ok {
+		return records
+	}
+	var records []ChallengeRecord
+	if b.storage != nil {
+		saved, err := b.storage.LoadChallenges(string(username))
+		if err != nil {
+			b.logger.Error("Failed to load challenges", zap.String("username", string(username)), zap.Error(err))
+		} else {
+			records = saved
+		}
+	}
+	b.records[username] = records
+	return records
+}
+
+// addProgress adds progress of the player in the challenge given on
+// provided day. Progress stops at the target of the challenge. It is
+// safe to call it on nil book, in which case nothing is changed.
+func (b *challengeBook) addProgress(username username, day string, template challengeTemplate, progress int32) {
+	if b == nil || progress <= 0 {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	records := b.getLocked(username)
+	ind := -1
+	for i, record := range records {
+		if record.Day == day && record.ChallengeID == template.id {
+			ind = i
+		}
+	}
+	if ind == -1 {
+		records = append(records, ChallengeRecord{Username: string(username), Day: day, ChallengeID: template.id})
+		ind = len(records) - 1
+	}
+	if records[ind].Progress >= template.target {
+		return
+	}
+	records[ind].Progress += progress
+	if records[ind].Progress > template.target {
+		records[ind].Progress = template.target
+	}
+	b.records[username] = records
+	if b.storage == nil {
+		return
+	}
+	if err := b.storage.SaveChallenge(records[ind]); err != nil {
+		b.logger.Error("Failed to save challenge", zap.String("username", string(username)), zap.Error(err))
+	}
+}
+
+// updateChallenges adds progress, which the players have made in the
+// game, to the challenges of the day. The calling function has to
+// acquire at least read lock.
+func (g *game) updateChallenges() {
+	if g.challenges == nil {
+		return
+	}
+	now := g.clock.Now()
+	day := getChallengeDay(now)
+	winners := g.findWinners()
+	for userID, player := range g.players {
+		if player.bot {
+			continue
+		}
+		for _, template := range getDailyChallenges(now) {
+			progress := template.progress(g, player, winners[userID])
+			g.challenges.addProgress(player.username, day, template, progress)
+		}
+	}
+}
+
+// GetChallenges returns the challenges of the day with the progress of
+// the player and the coins, which the player has earned by completing
+// challenges on all days.
+func (s *Server) GetChallenges(_ context.Context, req *pb.GetChallengesRequest) (*pb.GetChallengesResponse, error) {
+	reqUsername := username(req.GetUsername())
+
+	now := s.clock.Now()
+	day := getChallengeDay(now)
+	records := s.challenges.get(reqUsername)
+	progress := make(map[string]int32)
+	coins := int32(0)
+	for _, record := range records {
+		if record.Day == day {
+			progress[record.ChallengeID] = record.Progress
+		}
+		if template, ok := getChallengeTemplate(record.ChallengeID); ok && record.Progress >= template.target {
+			coins += template.reward
+		}
+	}
+
+	daily := getDailyChallenges(now)
+	challenges := make([]*pb.Challenge, len(daily))
+	for i, template := range daily {
+		challenges[i] = &pb.Challenge{
+			ChallengeId: template.id,
+			Description: template.description,
+			Target:      template.target,
+			Progress:    progress[template.id],
+			Completed:   progress[template.id] >= template.target,
+			Reward:      template.reward,
+		}
+	}
+	endTime := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+	return &pb.GetChallengesResponse{
+		Challenges: challenges,
+		Coins:      coins,
+		EndTime:    endTime.UnixNano() / int64(time.Millisecond),
+	}, nil
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cs489-team11/server/pb"
+	"go.opentelemetry.io/otel/label"
+)
+
+// Maximal number of characters in a single chat message.
+const maxChatMessageLength = 280
+
+// Number of the latest chat messages, which are kept in every game.
+// Chat is not persisted, so history is lost after restart.
+const chatHistorySize = 100
+
+// chatMessage is the message, which the player has sent to the chat.
+type chatMessage struct {
+	userID   userID
+	username username
+	text     string
+	time     time.Time
+	team     int32 // team, to which the message is sent, 0 if it is sent to all players
+}
+
+func (m chatMessage) toPBChatMessage() *pb.ChatMessage {
+	return &pb.ChatMessage{
+		UserId:   string(m.userID),
+		Username: string(m.username),
+		Text:     m.text,
+		Time:     m.time.UnixNano() / int64(time.Millisecond),
+		Team:     m.team,
+	}
+}
+
+// getPBChatHistory returns the kept chat messages, which the player
+// can see, from the oldest. Team messages are seen only by the team.
+// WARNING: This function doesn't use any locks, so make sure that
+// goroutine, which calls this function, uses at least read-lock.
+func (g *game) getPBChatHistory(viewerID userID) []*pb.ChatMessage {
+	res := make([]*pb.ChatMessage, 0, len(g.chat))
+	for _, message := range g.chat {
+		if message.team != 0 && !g.isTeammate(viewerID, message.team) {
+			continue
+		}
+		res = append(res, message.toPBChatMessage())
+	}
+	return res
+}
+
+// validateChatText returns the text without surrounding whitespace
+// or an error, if it is empty or too long.
+func validateChatText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", fmt.Errorf("chat message cannot be empty")
+	}
+	if length := utf8.RuneCountInString(text); length > maxChatMessageLength {
+		return "", fmt.Errorf(
+			"chat message cannot be longer than %d characters, received: %d",
+			maxChatMessageLength,
+			length,
+		)
+	}
+	return text, nil
+}
+
+// sendChat adds the message of the player to the chat history
+// and broadcasts it to all players of the game or only to the team
+// of the player, if "teamOnly" is true. Players can chat both before
+// and during the game. Words, which are not allowed, are masked.
+func (g *game) sendChat(ctx context.Context, userID userID, text string, teamOnly bool) (*pb.ChatMessage, error) {
+	ctx, span := startSpan(ctx, "game.sendChat", append(g.spanAttrs(userID), label.Int("length", len(text)))...)
+	defer span.End()
+
+	text, err := validateChatText(text)
+	if err != nil {
+		return nil, err
+	}
+	if g.words != nil {
+		text, _ = g.words.Filter(text)
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	player, ok := g.players[userID]
+	if !ok {
+		return nil, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if teamOnly && player.team == 0 {
+		return nil, fmt.Errorf("game has no teams")
+	}
+
+	message := chatMessage{
+		userID:   userID,
+		username: player.username,
+		text:     text,
+		time:     g.clock.Now(),
+	}
+	if teamOnly {
+		message.team = player.team
+	}
+	g.chat = append(g.chat, message)
+	if len(g.chat) > chatHistorySize {
+		g.chat = g.chat[len(g.chat)-chatHistorySize:]
+	}
+
+	pbMessage := message.toPBChatMessage()
+	go func() {
+		if message.team != 0 {
+			g.broadcastToTeam(message.team, getChatMessage(pbMessage))
+		} else {
+			g.broadcast(getChatMessage(pbMessage))
+		}
+	}()
+
+	return pbMessage, nil
+}
+
+func getChatMessage(message *pb.ChatMessage) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Chat{
+			Chat: message,
+		},
+	}
+}
@@ -2,21 +2,40 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 
 	"github.com/cs489-team11/server/pb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 )
 
 // SampleClient is a simple client for testing purposes
 type SampleClient struct {
-	GameClient pb.GameClient
-	Username   username
-	UserID     userID
-	GameID     gameID
-	Config     GameConfig
-	Stream     pb.Game_StreamClient
+	GameClient   pb.GameClient
+	Username     username
+	Locale       string // language of the questions, default language is used, if empty
+	AvatarID     string
+	Color        string // requested color, replaced with the final one on join
+	UserID       userID
+	GameID       gameID
+	SessionToken sessionToken
+	AuthToken    string
+	Config       GameConfig
+	Stream       pb.Game_StreamClient
+	RoomCode     string // code of the room, which the client creates or joins
+	AccountToken string // set by Register and Login
+	// sequence number of the latest received event, events broadcast
+	// since then are sent again on the new stream, if it is set
+	LastSeenSequence int64
+	// categories of the events sent on the stream, all, if empty
+	Categories []pb.EventCategory
+	// milliseconds and number of events, after which the batched
+	// events are sent, events are not batched, if interval is 0
+	BatchInterval int32
+	BatchSize     int32
 }
 
 func NewSampleClient() *SampleClient {
@@ -26,7 +45,16 @@ func NewSampleClient() *SampleClient {
 }
 
 func (c *SampleClient) Connect(addr string) error {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	return c.dial(addr, grpc.WithInsecure())
+}
+
+// ConnectTLS connects to the server, which serves over TLS.
+func (c *SampleClient) ConnectTLS(addr string, config *tls.Config) error {
+	return c.dial(addr, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+}
+
+func (c *SampleClient) dial(addr string, opt grpc.DialOption) error {
+	conn, err := grpc.Dial(addr, opt)
 	if err != nil {
 		return fmt.Errorf("Could not connect to server at %s", addr)
 	}
@@ -34,9 +62,21 @@ func (c *SampleClient) Connect(addr string) error {
 	return nil
 }
 
+// authContext returns context, which carries the auth token of the client.
+func (c *SampleClient) authContext() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), AuthMetadataKey, authScheme+c.AuthToken)
+}
+
 func (c *SampleClient) ProcessJoinResponse(res *pb.JoinResponse) {
 	c.UserID = userID(res.UserId)
 	c.GameID = gameID(res.GameId)
+	if res.Username != "" {
+		// the username is suffixed, if it is taken in the game
+		c.Username = username(res.Username)
+	}
+	c.Color = res.Color
+	c.SessionToken = sessionToken(res.SessionToken)
+	c.AuthToken = res.AuthToken
 	c.Config = NewGameConfig(
 		res.Duration, res.PlayerPoints, res.BankPointsPerPlayer,
 		res.CreditInterest, res.DepositInterest,
@@ -44,6 +84,31 @@ func (c *SampleClient) ProcessJoinResponse(res *pb.JoinResponse) {
 		res.TheftTime, res.TheftPercentage,
 		res.LotteryTime, res.LotteryMaxWin,
 		res.QuestionWinPercentage,
+		WithQuestionTime(res.QuestionTime),
+		WithQuestionMaxBid(res.QuestionMaxBidPercentage),
+		WithLotteryGrid(res.LotteryRows, res.LotteryColumns),
+		WithLotteryPayouts(res.LotteryPayouts...),
+		WithJackpot(res.JackpotPercentage, res.JackpotChance),
+		WithDepositPenalty(res.DepositPenaltyPercentage),
+		WithInterestMode(InterestMode(res.InterestMode), res.InterestTicks),
+		WithCreditLimit(res.CreditLimitPercentage),
+		WithMarket(res.MarketAssets, res.MarketUnits),
+		WithStock(res.StockPrice, res.StockVolatilityPercentage, res.StockTickTime),
+		WithInsurance(res.InsurancePremium, res.InsuranceCoveragePercentage),
+		WithTax(res.TaxTime, taxBracketsFromPB(res.TaxBrackets)),
+		WithInflation(res.InflationRate, res.InflationTime),
+		WithSteal(res.StealChance, res.StealPenaltyPercentage),
+		WithMaskedThieves(res.MaskThieves),
+		WithGuard(res.GuardPrice, res.GuardTime, res.GuardProtectionPercentage),
+		WithPlayerLimits(res.MinPlayers, res.MaxPlayers),
+		WithAutoStart(res.AutoStart),
+		WithStartCountdown(res.StartCountdown),
+		WithTeams(res.TeamCount),
+		WithGameMode(GameMode(res.Mode), res.CoopTarget),
+		WithPractice(res.Practice),
+		WithWinCondition(WinCondition(res.WinCondition), res.WinTarget),
+		WithTimeTicks(res.TimeTickTime),
+		WithAFK(res.AfkTime, AFKPolicy(res.AfkPolicy)),
 	)
 }
 
@@ -62,13 +127,257 @@ func (c *SampleClient) JoinGame() (*pb.JoinResponse, error) {
 	return res, nil
 }
 
+// JoinPractice joins a new practice game with provided number
+// of bots, which is started right away.
+func (c *SampleClient) JoinPractice(bots int32) (*pb.JoinResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := c.GetJoinRequest()
+	req.Practice = true
+	req.PracticeBots = bots
+	res, err := c.GameClient.Join(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join practice game: %v", err)
+	}
+	c.ProcessJoinResponse(res)
+	return res, nil
+}
+
+func (c *SampleClient) CreateRoom(name string, config *pb.GameConfig) (*pb.CreateRoomResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := &pb.CreateRoomRequest{Name: name, Config: config, Code: c.RoomCode}
+	res, err := c.GameClient.CreateRoom(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create room: %v", err)
+	}
+	log.Printf("Room %s has been created with id %v\n", name, res.GameId)
+	return res, nil
+}
+
+func (c *SampleClient) ListRooms() (*pb.ListRoomsResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	res, err := c.GameClient.ListRooms(context.Background(), &pb.ListRoomsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rooms: %v", err)
+	}
+	return res, nil
+}
+
+func (c *SampleClient) ListGames() (*pb.ListLobbiesResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	res, err := c.GameClient.ListGames(context.Background(), &pb.ListLobbiesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list games: %v", err)
+	}
+	return res, nil
+}
+
+// Register creates the account with the username of the client,
+// which then joins games with the account token.
+func (c *SampleClient) Register(password string) (*pb.Profile, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := &pb.RegisterRequest{
+		Username: string(c.Username),
+		Password: password,
+	}
+	res, err := c.GameClient.Register(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register: %v", err)
+	}
+	c.AccountToken = res.AccountToken
+	return res.Profile, nil
+}
+
+// Login logs in to the account with the username of the
+// client, which then joins games with the account token.
+func (c *SampleClient) Login(password string) (*pb.Profile, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := &pb.LoginRequest{
+		Username: string(c.Username),
+		Password: password,
+	}
+	res, err := c.GameClient.Login(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to log in: %v", err)
+	}
+	c.AccountToken = res.AccountToken
+	return res.Profile, nil
+}
+
+func (c *SampleClient) GetPlayerStats() (*pb.PlayerStats, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := &pb.GetPlayerStatsRequest{Username: string(c.Username)}
+	res, err := c.GameClient.GetPlayerStats(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player stats: %v", err)
+	}
+	return res.Stats, nil
+}
+
+func (c *SampleClient) GetAchievements() ([]*pb.Achievement, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := &pb.GetAchievementsRequest{Username: string(c.Username)}
+	res, err := c.GameClient.GetAchievements(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievements: %v", err)
+	}
+	return res.Achievements, nil
+}
+
+func (c *SampleClient) GetChallenges() (*pb.GetChallengesResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := &pb.GetChallengesRequest{Username: string(c.Username)}
+	res, err := c.GameClient.GetChallenges(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenges: %v", err)
+	}
+	return res, nil
+}
+
+func (c *SampleClient) GetLeaderboard(
+	window pb.LeaderboardWindow,
+	order pb.LeaderboardOrder,
+	offset int32,
+	limit int32,
+) (*pb.GetLeaderboardResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := &pb.GetLeaderboardRequest{
+		Window: window,
+		Order:  order,
+		Offset: offset,
+		Limit:  limit,
+	}
+	res, err := c.GameClient.GetLeaderboard(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard: %v", err)
+	}
+	return res, nil
+}
+
+// Enqueue waits until the client is matched with other players
+// of similar rating and joins the room created for them.
+func (c *SampleClient) Enqueue(rating int32) (*pb.JoinResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := c.GetEnqueueRequest(rating)
+	stream, err := c.GameClient.Enqueue(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue: %v", err)
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return nil, fmt.Errorf("failed to wait for match: %v", err)
+		}
+		if res := event.GetMatchFound(); res != nil {
+			log.Printf("Match found: %v\n", res)
+			c.ProcessJoinResponse(res)
+			return res, nil
+		}
+	}
+}
+
+func (c *SampleClient) CreateTournament(
+	name string,
+	rounds int32,
+	startDelay int32,
+	breakTime int32,
+	config *pb.GameConfig,
+) (string, error) {
+	if c.GameClient == nil {
+		return "", fmt.Errorf("Client is not connected to server")
+	}
+
+	req := &pb.CreateTournamentRequest{
+		Name:       name,
+		Rounds:     rounds,
+		Config:     config,
+		StartDelay: startDelay,
+		BreakTime:  breakTime,
+	}
+	res, err := c.GameClient.CreateTournament(context.Background(), req)
+	if err != nil {
+		return "", fmt.Errorf("failed to create tournament: %v", err)
+	}
+	return res.TournamentId, nil
+}
+
+// JoinTournament registers the client for the tournament and returns the
+// stream of its events. Call ProcessJoinResponse with the join response
+// of every started round before playing it.
+func (c *SampleClient) JoinTournament(tournamentID string) (pb.Game_JoinTournamentClient, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := &pb.JoinTournamentRequest{
+		TournamentId: tournamentID,
+		Username:     string(c.Username),
+		Locale:       c.Locale,
+		AccountToken: c.AccountToken,
+		AvatarId:     c.AvatarID,
+		Color:        c.Color,
+	}
+	stream, err := c.GameClient.JoinTournament(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join tournament %v: %v", tournamentID, err)
+	}
+	return stream, nil
+}
+
+func (c *SampleClient) JoinRoom(gameID string) (*pb.JoinResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("Client is not connected to server")
+	}
+
+	req := c.GetJoinRoomRequest(gameID)
+	res, err := c.GameClient.JoinRoom(context.Background(), req)
+	log.Printf("Join room response: %v\n", res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join room: %v", err)
+	}
+	c.ProcessJoinResponse(res)
+	return res, nil
+}
+
 func (c *SampleClient) LeaveGame() error {
 	if c.GameClient == nil {
 		return fmt.Errorf("Client is not connected to server")
 	}
 
 	req := c.GetLeaveRequest()
-	res, err := c.GameClient.Leave(context.Background(), req)
+	res, err := c.GameClient.Leave(c.authContext(), req)
 	log.Printf("Leave response: %v.\n", res)
 	if err != nil {
 		return fmt.Errorf("failed to leave game: %v", err)
@@ -82,7 +391,7 @@ func (c *SampleClient) OpenStream() error {
 	}
 
 	req := c.GetStreamRequest()
-	stream, err := c.GameClient.Stream(context.Background(), req)
+	stream, err := c.GameClient.Stream(c.authContext(), req)
 	if err != nil {
 		return fmt.Errorf("failed to open stream with server: %v", err)
 	}
@@ -91,13 +400,38 @@ func (c *SampleClient) OpenStream() error {
 	return nil
 }
 
+// Reconnect replaces client's stream with the new one. The first event
+// received on the new stream contains the state of the game, unless
+// the missed events are sent again.
+func (c *SampleClient) Reconnect() error {
+	if c.GameClient == nil {
+		return fmt.Errorf("Client is not connected to server")
+	}
+
+	req := c.GetReconnectRequest()
+	stream, err := c.GameClient.Reconnect(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to reconnect to server: %v", err)
+	}
+	c.Stream = stream
+	log.Printf("Player %v reconnected successfully.\n", c.UserID)
+	return nil
+}
+
 func (c *SampleClient) StartGame() error {
+	return c.StartGameWithConfig(nil)
+}
+
+// StartGameWithConfig starts the game, overriding its
+// config with non-zero values of provided config.
+func (c *SampleClient) StartGameWithConfig(config *pb.GameConfig) error {
 	if c.GameClient == nil {
 		return fmt.Errorf("client is not connected to server")
 	}
 
 	req := c.GetStartRequest()
-	_, err := c.GameClient.Start(context.Background(), req)
+	req.Config = config
+	_, err := c.GameClient.Start(c.authContext(), req)
 	if err != nil {
 		return fmt.Errorf("failed to start the game: %v", err)
 	}
@@ -105,13 +439,27 @@ func (c *SampleClient) StartGame() error {
 	return nil
 }
 
+func (c *SampleClient) CancelStart() error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetCancelStartRequest()
+	_, err := c.GameClient.CancelStart(c.authContext(), req)
+	if err != nil {
+		return fmt.Errorf("failed to cancel the start: %v", err)
+	}
+	log.Printf("The start of the game with id %v has been cancelled by %v.\n", c.GameID, c.UserID)
+	return nil
+}
+
 func (c *SampleClient) TakeCredit(val int32) (*pb.CreditResponse, error) {
 	if c.GameClient == nil {
 		return nil, fmt.Errorf("client is not connected to server")
 	}
 
 	req := c.GetCreditRequest(val)
-	res, err := c.GameClient.Credit(context.Background(), req)
+	res, err := c.GameClient.Credit(c.authContext(), req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to take credit: %v", err)
 	}
@@ -122,113 +470,779 @@ func (c *SampleClient) TakeCredit(val int32) (*pb.CreditResponse, error) {
 	return res, nil
 }
 
-func (c *SampleClient) TakeDeposit(val int32) (*pb.DepositResponse, error) {
+// RepayCredit returns provided part of the credit with provided id before
+// its time ends. The whole credit is returned, if the part is 0.
+func (c *SampleClient) RepayCredit(positionID string, val int32) (*pb.RepayCreditResponse, error) {
 	if c.GameClient == nil {
 		return nil, fmt.Errorf("client is not connected to server")
 	}
 
-	req := c.GetDepositRequest(val)
-	res, err := c.GameClient.Deposit(context.Background(), req)
+	req := c.GetRepayCreditRequest(positionID, val)
+	res, err := c.GameClient.RepayCredit(c.authContext(), req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to take deposit: %v", err)
+		return nil, fmt.Errorf("failed to repay credit: %v", err)
 	}
 	log.Printf(
-		"user %v, deposit amount: %v, success: %v, explanation: %v\n",
-		c.UserID, val, res.Success, res.Explanation,
+		"user %v, credit %v, repaid: %v, success: %v, explanation: %v\n",
+		c.UserID, positionID, res.Value, res.Success, res.Explanation,
 	)
 	return res, nil
 }
 
-func (c *SampleClient) PlayLottery(cellIndex int32) (*pb.LotteryResponse, error) {
+func (c *SampleClient) OfferLoan(
+	borrowerID string, val int32, interest int32, seconds int32,
+) (*pb.OfferLoanResponse, error) {
 	if c.GameClient == nil {
 		return nil, fmt.Errorf("client is not connected to server")
 	}
 
-	req := c.GetLotteryRequest(cellIndex)
-	res, err := c.GameClient.Lottery(context.Background(), req)
+	req := c.GetOfferLoanRequest(borrowerID, val, interest, seconds)
+	res, err := c.GameClient.OfferLoan(c.authContext(), req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to play lottery: %v", err)
+		return nil, fmt.Errorf("failed to offer loan: %v", err)
+	}
+	log.Printf("user %v, offered loan %v to user %v\n", c.UserID, res.LoanId, borrowerID)
+	return res, nil
+}
+
+func (c *SampleClient) AcceptLoan(loanID string) (*pb.AcceptLoanResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetAcceptLoanRequest(loanID)
+	res, err := c.GameClient.AcceptLoan(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept loan: %v", err)
 	}
 	log.Printf(
-		"user %v, cell index: %v, success: %v, cell values: %v, win points: %v\n",
-		c.UserID, cellIndex, res.Success, res.CellValues, res.WinPoints,
+		"user %v, loan %v, success: %v, explanation: %v\n",
+		c.UserID, loanID, res.Success, res.Explanation,
 	)
 	return res, nil
 }
 
-func (c *SampleClient) DoGenerateQuestion(bidPoints int32) (*pb.GenerateQuestionResponse, error) {
+func (c *SampleClient) BuyShares(shares int32) (*pb.BuySharesResponse, error) {
 	if c.GameClient == nil {
 		return nil, fmt.Errorf("client is not connected to server")
 	}
 
-	req := c.GetGenerateQuestionRequest(bidPoints)
-	res, err := c.GameClient.GenerateQuestion(context.Background(), req)
+	req := c.GetBuySharesRequest(shares)
+	res, err := c.GameClient.BuyShares(c.authContext(), req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate question: %v", err)
+		return nil, fmt.Errorf("failed to buy shares: %v", err)
 	}
 	log.Printf(
-		"user %v, bid points %v, question id: %v, question: %v, answers: %v\n",
-		c.UserID, bidPoints, res.QuestionId, res.Question, res.Answers,
+		"user %v, bought %v shares for %v, success: %v, explanation: %v\n",
+		c.UserID, shares, res.Value, res.Success, res.Explanation,
 	)
 	return res, nil
 }
 
-func (c *SampleClient) DoAnswerQuestion(qID string, userAnswer int32) (*pb.AnswerQuestionResponse, error) {
+func (c *SampleClient) SellShares(shares int32) (*pb.SellSharesResponse, error) {
 	if c.GameClient == nil {
 		return nil, fmt.Errorf("client is not connected to server")
 	}
 
-	req := c.GetAnswerQuestionRequest(questionID(qID), userAnswer)
-	res, err := c.GameClient.AnswerQuestion(context.Background(), req)
+	req := c.GetSellSharesRequest(shares)
+	res, err := c.GameClient.SellShares(c.authContext(), req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to answer question: %v", err)
+		return nil, fmt.Errorf("failed to sell shares: %v", err)
 	}
 	log.Printf(
-		"user %v, question id: %v, user answer: %v, answer_is_correct: %v, correct answer: %v, win points: %v",
-		c.UserID, qID, userAnswer, res.AnswerIsCorrect, res.CorrectAnswer, res.WinPoints,
+		"user %v, sold %v shares for %v, success: %v, explanation: %v\n",
+		c.UserID, shares, res.Value, res.Success, res.Explanation,
 	)
 	return res, nil
 }
 
-func (c *SampleClient) GetJoinRequest() *pb.JoinRequest {
-	return &pb.JoinRequest{
-		Username: string(c.Username),
+func (c *SampleClient) Steal(targetID string) (*pb.StealResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
 	}
+
+	req := c.GetStealRequest(targetID)
+	res, err := c.GameClient.Steal(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to steal: %v", err)
+	}
+	log.Printf(
+		"user %v, steal from %v, stolen: %v, penalty: %v, success: %v, explanation: %v\n",
+		c.UserID, targetID, res.Stolen, res.Penalty, res.Success, res.Explanation,
+	)
+	return res, nil
 }
 
-func (c *SampleClient) GetLeaveRequest() *pb.LeaveRequest {
-	return &pb.LeaveRequest{
-		UserId: string(c.UserID),
-		GameId: string(c.GameID),
+func (c *SampleClient) SendChat(text string) (*pb.SendChatResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetSendChatRequest(text)
+	res, err := c.GameClient.SendChat(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send chat message: %v", err)
+	}
+	log.Printf("user %v, chat message: %v\n", c.UserID, res.Message.Text)
+	return res, nil
+}
+
+// SendTeamChat sends the message only to the team of the client.
+func (c *SampleClient) SendTeamChat(text string) (*pb.SendChatResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetSendChatRequest(text)
+	req.TeamOnly = true
+	res, err := c.GameClient.SendChat(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send team chat message: %v", err)
 	}
+	log.Printf("user %v, team chat message: %v\n", c.UserID, res.Message.Text)
+	return res, nil
 }
 
-func (c *SampleClient) GetStreamRequest() *pb.StreamRequest {
-	return &pb.StreamRequest{
+func (c *SampleClient) PauseGame() error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.PauseGameRequest{
 		UserId: string(c.UserID),
 		GameId: string(c.GameID),
 	}
+	_, err := c.GameClient.PauseGame(c.authContext(), req)
+	if err != nil {
+		return fmt.Errorf("failed to pause game: %v", err)
+	}
+	return nil
 }
 
-func (c *SampleClient) GetStartRequest() *pb.StartRequest {
-	return &pb.StartRequest{
+func (c *SampleClient) ResumeGame() error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.ResumeGameRequest{
+		UserId: string(c.UserID),
 		GameId: string(c.GameID),
 	}
+	_, err := c.GameClient.ResumeGame(c.authContext(), req)
+	if err != nil {
+		return fmt.Errorf("failed to resume game: %v", err)
+	}
+	return nil
 }
 
-func (c *SampleClient) GetCreditRequest(val int32) *pb.CreditRequest {
-	return &pb.CreditRequest{
+func (c *SampleClient) ExtendGame() (*pb.ExtendGameResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.ExtendGameRequest{
 		UserId: string(c.UserID),
 		GameId: string(c.GameID),
-		Value:  val,
 	}
+	res, err := c.GameClient.ExtendGame(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to vote to extend game: %v", err)
+	}
+	return res, nil
 }
 
-func (c *SampleClient) GetDepositRequest(val int32) *pb.DepositRequest {
-	return &pb.DepositRequest{
+func (c *SampleClient) AnswerOvertime(answer int32) (*pb.AnswerOvertimeResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.AnswerOvertimeRequest{
 		UserId: string(c.UserID),
 		GameId: string(c.GameID),
-		Value:  val,
+		Answer: answer,
+	}
+	res, err := c.GameClient.AnswerOvertime(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to answer overtime question: %v", err)
+	}
+	return res, nil
+}
+
+// AddBots adds bots with provided profile to the waiting game, if the
+// client is its host. Bots take profiles of the server in turn, if the
+// profile is empty. Returns ids of the added bots.
+func (c *SampleClient) AddBots(count int32, profile string) ([]string, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.AddBotsRequest{
+		UserId:  string(c.UserID),
+		GameId:  string(c.GameID),
+		Count:   count,
+		Profile: profile,
+	}
+	res, err := c.GameClient.AddBots(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add bots: %v", err)
+	}
+	return res.GetUserIds(), nil
+}
+
+func (c *SampleClient) ChooseTeam(team int32) error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.ChooseTeamRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+		Team:   team,
+	}
+	_, err := c.GameClient.ChooseTeam(c.authContext(), req)
+	if err != nil {
+		return fmt.Errorf("failed to choose team: %v", err)
+	}
+	return nil
+}
+
+func (c *SampleClient) Rename(name string) (*pb.RenameResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.RenameRequest{
+		UserId:   string(c.UserID),
+		GameId:   string(c.GameID),
+		Username: name,
+	}
+	res, err := c.GameClient.Rename(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename: %v", err)
+	}
+	c.Username = username(res.GetUsername())
+	return res, nil
+}
+
+func (c *SampleClient) KickPlayer(targetID string) error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetKickPlayerRequest(targetID)
+	_, err := c.GameClient.KickPlayer(c.authContext(), req)
+	if err != nil {
+		return fmt.Errorf("failed to kick player: %v", err)
+	}
+	log.Printf("user %v, kicked player: %v\n", c.UserID, targetID)
+	return nil
+}
+
+func (c *SampleClient) React(emote pb.Emote) error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetReactRequest(emote)
+	_, err := c.GameClient.React(c.authContext(), req)
+	if err != nil {
+		return fmt.Errorf("failed to react: %v", err)
+	}
+	log.Printf("user %v, reaction: %v\n", c.UserID, emote)
+	return nil
+}
+
+func (c *SampleClient) HireGuard() (*pb.HireGuardResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetHireGuardRequest()
+	res, err := c.GameClient.HireGuard(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hire guard: %v", err)
+	}
+	log.Printf(
+		"user %v, guard price: %v, success: %v, explanation: %v\n",
+		c.UserID, res.Price, res.Success, res.Explanation,
+	)
+	return res, nil
+}
+
+func (c *SampleClient) BuyInsurance() (*pb.BuyInsuranceResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetBuyInsuranceRequest()
+	res, err := c.GameClient.BuyInsurance(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to buy insurance: %v", err)
+	}
+	log.Printf(
+		"user %v, insurance premium: %v, success: %v, explanation: %v\n",
+		c.UserID, res.Premium, res.Success, res.Explanation,
+	)
+	return res, nil
+}
+
+func (c *SampleClient) TakeDeposit(val int32) (*pb.DepositResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetDepositRequest(val)
+	res, err := c.GameClient.Deposit(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take deposit: %v", err)
+	}
+	log.Printf(
+		"user %v, deposit amount: %v, success: %v, explanation: %v\n",
+		c.UserID, val, res.Success, res.Explanation,
+	)
+	return res, nil
+}
+
+// WithdrawDeposit returns provided part of the deposit with provided id
+// before its time ends. The whole deposit is returned, if the part is 0.
+func (c *SampleClient) WithdrawDeposit(positionID string, val int32) (*pb.WithdrawDepositResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetWithdrawDepositRequest(positionID, val)
+	res, err := c.GameClient.WithdrawDeposit(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to withdraw deposit: %v", err)
+	}
+	log.Printf(
+		"user %v, deposit %v, withdrawn: %v, penalty: %v\n",
+		c.UserID, positionID, res.Value, res.Penalty,
+	)
+	return res, nil
+}
+
+func (c *SampleClient) PlayLottery(cellIndex int32) (*pb.LotteryResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetLotteryRequest(cellIndex)
+	res, err := c.GameClient.Lottery(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to play lottery: %v", err)
+	}
+	log.Printf(
+		"user %v, cell index: %v, success: %v, cell values: %v, win points: %v\n",
+		c.UserID, cellIndex, res.Success, res.CellValues, res.WinPoints,
+	)
+	return res, nil
+}
+
+func (c *SampleClient) DoGenerateQuestion(bidPoints int32) (*pb.GenerateQuestionResponse, error) {
+	return c.DoGenerateQuestionWithFilter(bidPoints, "", "")
+}
+
+// DoGenerateQuestionWithFilter asks for the question of provided category
+// and difficulty. Empty values match questions of any category or difficulty.
+func (c *SampleClient) DoGenerateQuestionWithFilter(
+	bidPoints int32, category string, difficulty string,
+) (*pb.GenerateQuestionResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetGenerateQuestionRequest(bidPoints)
+	req.Category = category
+	req.Difficulty = difficulty
+	res, err := c.GameClient.GenerateQuestion(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate question: %v", err)
+	}
+	log.Printf(
+		"user %v, bid points %v, question id: %v, question: %v, answers: %v\n",
+		c.UserID, bidPoints, res.QuestionId, res.Question, res.Answers,
+	)
+	return res, nil
+}
+
+func (c *SampleClient) DoAnswerQuestion(qID string, userAnswer int32) (*pb.AnswerQuestionResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetAnswerQuestionRequest(questionID(qID), userAnswer)
+	res, err := c.GameClient.AnswerQuestion(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to answer question: %v", err)
+	}
+	log.Printf(
+		"user %v, question id: %v, user answer: %v, answer_is_correct: %v, correct answer: %v, win points: %v",
+		c.UserID, qID, userAnswer, res.AnswerIsCorrect, res.CorrectAnswer, res.WinPoints,
+	)
+	return res, nil
+}
+
+func (c *SampleClient) GetGameState() (*pb.GetGameStateResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetGameStateRequest()
+	res, err := c.GameClient.GetGameState(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game state: %v", err)
+	}
+	log.Printf("user %v, game state: %v\n", c.UserID, res)
+	return res, nil
+}
+
+// GetTransactions returns the page of the ledger of the player.
+func (c *SampleClient) GetTransactions(offset int32, limit int32) (*pb.GetTransactionsResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.GetTransactionsRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+		Offset: offset,
+		Limit:  limit,
+	}
+	res, err := c.GameClient.GetTransactions(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %v", err)
+	}
+	return res, nil
+}
+
+func (c *SampleClient) PlaceOrder(
+	asset string, side pb.OrderSide, quantity int32, price int32,
+) (*pb.PlaceOrderResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetPlaceOrderRequest(asset, side, quantity, price)
+	res, err := c.GameClient.PlaceOrder(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to place order: %v", err)
+	}
+	log.Printf(
+		"user %v, order %v, filled: %v, remaining: %v\n",
+		c.UserID, res.OrderId, res.FilledQuantity, res.RemainingQuantity,
+	)
+	return res, nil
+}
+
+func (c *SampleClient) CancelOrder(orderID string) error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetCancelOrderRequest(orderID)
+	_, err := c.GameClient.CancelOrder(c.authContext(), req)
+	if err != nil {
+		return fmt.Errorf("failed to cancel order: %v", err)
+	}
+	log.Printf("user %v, cancelled order %v\n", c.UserID, orderID)
+	return nil
+}
+
+func (c *SampleClient) GetMarket() (*pb.GetMarketResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetMarketRequest()
+	res, err := c.GameClient.GetMarket(c.authContext(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get market: %v", err)
+	}
+	log.Printf("user %v, market: %v\n", c.UserID, res)
+	return res, nil
+}
+
+// Replay returns the stream of events of the finished game played
+// at provided speed. Stream is closed after the last event.
+func (c *SampleClient) Replay(gameID string, speed float64) (pb.Game_ReplayClient, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.ReplayRequest{
+		GameId: gameID,
+		Speed:  speed,
+	}
+	stream, err := c.GameClient.Replay(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay game %v: %v", gameID, err)
+	}
+	return stream, nil
+}
+
+// GetGameSummary returns the results of the game, which has finished
+// recently, e.g. while the client has been disconnected.
+func (c *SampleClient) GetGameSummary(gameID string) (*pb.GameSummary, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.GetGameSummaryRequest{GameId: gameID}
+	res, err := c.GameClient.GetGameSummary(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get summary of game %v: %v", gameID, err)
+	}
+	return res.Summary, nil
+}
+
+// ListGameHistory returns the games, which the player has finished in
+// provided time range (in milliseconds since epoch, not bounded, if 0),
+// from the newest to the oldest.
+func (c *SampleClient) ListGameHistory(since, until int64, offset, limit int32) (*pb.ListGameHistoryResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := &pb.ListGameHistoryRequest{
+		Username: string(c.Username),
+		Since:    since,
+		Until:    until,
+		Offset:   offset,
+		Limit:    limit,
+	}
+	res, err := c.GameClient.ListGameHistory(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list game history: %v", err)
+	}
+	return res, nil
+}
+
+func (c *SampleClient) GetJoinRequest() *pb.JoinRequest {
+	return &pb.JoinRequest{
+		Username:     string(c.Username),
+		Locale:       c.Locale,
+		AccountToken: c.AccountToken,
+		AvatarId:     c.AvatarID,
+		Color:        c.Color,
+	}
+}
+
+func (c *SampleClient) GetEnqueueRequest(rating int32) *pb.EnqueueRequest {
+	return &pb.EnqueueRequest{
+		Username:     string(c.Username),
+		Rating:       rating,
+		Locale:       c.Locale,
+		AccountToken: c.AccountToken,
+		AvatarId:     c.AvatarID,
+		Color:        c.Color,
+	}
+}
+
+func (c *SampleClient) GetJoinRoomRequest(gameID string) *pb.JoinRoomRequest {
+	return &pb.JoinRoomRequest{
+		GameId:       gameID,
+		Username:     string(c.Username),
+		Locale:       c.Locale,
+		Code:         c.RoomCode,
+		AccountToken: c.AccountToken,
+		AvatarId:     c.AvatarID,
+		Color:        c.Color,
+	}
+}
+
+func (c *SampleClient) GetLeaveRequest() *pb.LeaveRequest {
+	return &pb.LeaveRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetStreamRequest() *pb.StreamRequest {
+	return &pb.StreamRequest{
+		UserId:           string(c.UserID),
+		GameId:           string(c.GameID),
+		LastSeenSequence: c.LastSeenSequence,
+		Categories:       c.Categories,
+		BatchInterval:    c.BatchInterval,
+		BatchSize:        c.BatchSize,
+	}
+}
+
+func (c *SampleClient) GetReconnectRequest() *pb.ReconnectRequest {
+	return &pb.ReconnectRequest{
+		GameId:           string(c.GameID),
+		SessionToken:     string(c.SessionToken),
+		LastSeenSequence: c.LastSeenSequence,
+		Categories:       c.Categories,
+		BatchInterval:    c.BatchInterval,
+		BatchSize:        c.BatchSize,
+	}
+}
+
+func (c *SampleClient) GetGameStateRequest() *pb.GetGameStateRequest {
+	return &pb.GetGameStateRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetPlaceOrderRequest(
+	asset string, side pb.OrderSide, quantity int32, price int32,
+) *pb.PlaceOrderRequest {
+	return &pb.PlaceOrderRequest{
+		UserId:   string(c.UserID),
+		GameId:   string(c.GameID),
+		Asset:    asset,
+		Side:     side,
+		Quantity: quantity,
+		Price:    price,
+	}
+}
+
+func (c *SampleClient) GetCancelOrderRequest(orderID string) *pb.CancelOrderRequest {
+	return &pb.CancelOrderRequest{
+		UserId:  string(c.UserID),
+		GameId:  string(c.GameID),
+		OrderId: orderID,
+	}
+}
+
+func (c *SampleClient) GetMarketRequest() *pb.GetMarketRequest {
+	return &pb.GetMarketRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetStartRequest() *pb.StartRequest {
+	return &pb.StartRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetCancelStartRequest() *pb.CancelStartRequest {
+	return &pb.CancelStartRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetCreditRequest(val int32) *pb.CreditRequest {
+	return &pb.CreditRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+		Value:  val,
+	}
+}
+
+func (c *SampleClient) GetRepayCreditRequest(positionID string, val int32) *pb.RepayCreditRequest {
+	return &pb.RepayCreditRequest{
+		UserId:     string(c.UserID),
+		GameId:     string(c.GameID),
+		PositionId: positionID,
+		Value:      val,
+	}
+}
+
+func (c *SampleClient) GetOfferLoanRequest(
+	borrowerID string, val int32, interest int32, seconds int32,
+) *pb.OfferLoanRequest {
+	return &pb.OfferLoanRequest{
+		UserId:     string(c.UserID),
+		GameId:     string(c.GameID),
+		BorrowerId: borrowerID,
+		Value:      val,
+		Interest:   interest,
+		Time:       seconds,
+	}
+}
+
+func (c *SampleClient) GetAcceptLoanRequest(loanID string) *pb.AcceptLoanRequest {
+	return &pb.AcceptLoanRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+		LoanId: loanID,
+	}
+}
+
+func (c *SampleClient) GetBuySharesRequest(shares int32) *pb.BuySharesRequest {
+	return &pb.BuySharesRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+		Shares: shares,
+	}
+}
+
+func (c *SampleClient) GetSellSharesRequest(shares int32) *pb.SellSharesRequest {
+	return &pb.SellSharesRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+		Shares: shares,
+	}
+}
+
+func (c *SampleClient) GetStealRequest(targetID string) *pb.StealRequest {
+	return &pb.StealRequest{
+		UserId:   string(c.UserID),
+		GameId:   string(c.GameID),
+		TargetId: targetID,
+	}
+}
+
+func (c *SampleClient) GetSendChatRequest(text string) *pb.SendChatRequest {
+	return &pb.SendChatRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+		Text:   text,
+	}
+}
+
+func (c *SampleClient) GetKickPlayerRequest(targetID string) *pb.KickPlayerRequest {
+	return &pb.KickPlayerRequest{
+		UserId:   string(c.UserID),
+		GameId:   string(c.GameID),
+		TargetId: targetID,
+	}
+}
+
+func (c *SampleClient) GetReactRequest(emote pb.Emote) *pb.ReactRequest {
+	return &pb.ReactRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+		Emote:  emote,
+	}
+}
+
+func (c *SampleClient) GetHireGuardRequest() *pb.HireGuardRequest {
+	return &pb.HireGuardRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetBuyInsuranceRequest() *pb.BuyInsuranceRequest {
+	return &pb.BuyInsuranceRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetDepositRequest(val int32) *pb.DepositRequest {
+	return &pb.DepositRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+		Value:  val,
+	}
+}
+
+func (c *SampleClient) GetWithdrawDepositRequest(positionID string, val int32) *pb.WithdrawDepositRequest {
+	return &pb.WithdrawDepositRequest{
+		UserId:     string(c.UserID),
+		GameId:     string(c.GameID),
+		PositionId: positionID,
+		Value:      val,
 	}
 }
 
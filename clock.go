@@ -0,0 +1,208 @@
+package server
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock tells the time and runs the timers of the games, so that
+// tests can fast-forward the games instead of waiting.
+type Clock interface {
+	Now() time.Time
+	// AfterFunc calls provided function in its own goroutine,
+	// once the duration has elapsed.
+	AfterFunc(d time.Duration, f func()) Timer
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is the timer created by Clock.AfterFunc. Its methods
+// behave the same as the methods of time.Timer.
+type Timer interface {
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// Ticker delivers the ticks of the clock on its channel.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// WithClock makes server use provided clock for the games, the
+// matchmaking, the tournaments, the bans, the rate limits, and the
+// replays. Without this option, the system clock is used.
+func WithClock(clock Clock) ServerOption {
+	return func(s *Server) {
+		s.clock = clock
+	}
+}
+
+// sleep blocks, until the duration has elapsed on the clock.
+func sleep(clock Clock, d time.Duration) {
+	done := make(chan struct{})
+	clock.AfterFunc(d, func() {
+		close(done)
+	})
+	<-done
+}
+
+// systemClock is the Clock backed by the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+func (systemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{ticker: time.NewTicker(d)}
+}
+
+type systemTicker struct {
+	ticker *time.Ticker
+}
+
+func (t systemTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+func (t systemTicker) Stop() {
+	t.ticker.Stop()
+}
+
+// ManualClock is the Clock, which stands still, until it is advanced.
+// Timers are fired by Advance in the order of their times.
+type ManualClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers []*manualTimer // pending timers
+}
+
+// NewManualClock returns the clock, which starts at provided time.
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+// Now returns the current time of the clock.
+func (c *ManualClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.now
+}
+
+// AfterFunc calls provided function, once the clock is advanced by
+// the duration. It is called right away, if the duration isn't positive.
+func (c *ManualClock) AfterFunc(d time.Duration, f func()) Timer {
+	t := &manualTimer{clock: c, f: f}
+	t.Reset(d)
+	return t
+}
+
+// NewTicker returns the ticker, which ticks every time
+// the clock is advanced by the duration.
+func (c *ManualClock) NewTicker(d time.Duration) Ticker {
+	t := &manualTicker{c: make(chan time.Time, 1)}
+	var tick func()
+	tick = func() {
+		select {
+		case t.c <- c.Now():
+		default:
+		}
+		t.timer.Reset(d)
+	}
+	t.timer = c.AfterFunc(d, tick)
+	return t
+}
+
+// Advance moves the clock forward by the duration and calls the functions
+// of the timers, which fire meanwhile, one by one. Functions of the timers
+// are called after the clock is moved to their times, and Advance returns
+// after all of them have returned.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	end := c.now.Add(d)
+	c.mutex.Unlock()
+
+	for {
+		c.mutex.Lock()
+		if len(c.timers) == 0 || c.timers[0].when.After(end) {
+			c.now = end
+			c.mutex.Unlock()
+			return
+		}
+		t := c.timers[0]
+		c.timers = c.timers[1:]
+		c.now = t.when
+		c.mutex.Unlock()
+
+		t.f()
+	}
+}
+
+// remove removes the timer from the pending timers and returns true, if it
+// has been pending. The calling function has to acquire the lock.
+func (c *ManualClock) remove(t *manualTimer) bool {
+	for i, pending := range c.timers {
+		if pending == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// add adds the timer to the pending timers, which are ordered by their
+// times. The calling function has to acquire the lock.
+func (c *ManualClock) add(t *manualTimer) {
+	i := sort.Search(len(c.timers), func(i int) bool {
+		return c.timers[i].when.After(t.when)
+	})
+	c.timers = append(c.timers, nil)
+	copy(c.timers[i+1:], c.timers[i:])
+	c.timers[i] = t
+}
+
+type manualTimer struct {
+	clock *ManualClock
+	when  time.Time
+	f     func()
+}
+
+func (t *manualTimer) Stop() bool {
+	t.clock.mutex.Lock()
+	defer t.clock.mutex.Unlock()
+
+	return t.clock.remove(t)
+}
+
+func (t *manualTimer) Reset(d time.Duration) bool {
+	t.clock.mutex.Lock()
+	defer t.clock.mutex.Unlock()
+
+	pending := t.clock.remove(t)
+	if d <= 0 {
+		go t.f()
+		return pending
+	}
+	t.when = t.clock.now.Add(d)
+	t.clock.add(t)
+	return pending
+}
+
+type manualTicker struct {
+	c     chan time.Time
+	timer Timer
+}
+
+func (t *manualTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *manualTicker) Stop() {
+	t.timer.Stop()
+}
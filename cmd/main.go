@@ -1,15 +1,92 @@
 package main
 
 import (
+	"database/sql"
 	"flag"
 	"fmt"
-	"log"
 	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/cs489-team11/server"
+	_ "github.com/lib/pq"
+	"go.uber.org/zap"
 )
 
+// optional flags have to be provided before the positional arguments
+var dbURL = flag.String("db", "", "PostgreSQL connection string; if set, active games are persisted and restored after restart")
+var adminToken = flag.String("admin-token", os.Getenv("ADMIN_TOKEN"), "token required by the Admin service; the service is disabled, if empty")
+var metricsAddr = flag.String("metrics", "", "address of the HTTP endpoint with Prometheus metrics, e.g. 0.0.0.0:9100")
+var grpcWebAddr = flag.String("grpc-web", "", "address of the HTTP endpoint for gRPC-Web and WebSocket clients, e.g. 0.0.0.0:8080")
+var debugAddr = flag.String("debug", "", "loopback address of the HTTP endpoint with pprof profiles and runtime info, e.g. localhost:6060")
+var questionsPath = flag.String("questions", "", "JSON or CSV file with the question pack; reloaded on SIGHUP; if empty, questions are fetched from Open Trivia DB with built-in questions as fallback")
+var bannedWords = flag.String("banned-words", "", "file with the words, one per line, which are not allowed in usernames and masked in chat messages; nothing is filtered, if empty")
+var logLevel = flag.String("log-level", "info", "minimum level of logged messages: debug, info, warn, or error")
+var logSampling = flag.Int("log-sampling", 10, "only every nth successful call of the methods, which players poll, e.g. GetGameState, is logged; every call is logged, if 1")
+var lotteryRows = flag.Int("lottery-rows", 3, "number of rows in the lottery grid")
+var lotteryColumns = flag.Int("lottery-columns", 3, "number of columns in the lottery grid")
+var lotteryPayouts = flag.String("lottery-payouts", "", "comma-separated payout table of the lottery as percentages of the max win, e.g. 0,0,50,100; default payouts are used, if empty")
+var creditLimit = flag.Int("credit-limit", 200, "maximum outstanding credits of the player as percentage of his net worth")
+var marketAssets = flag.String("market-assets", "", "comma-separated assets traded on the market, e.g. gold,oil; there is no market, if empty")
+var marketUnits = flag.Int("market-units", 10, "units of every market asset, which each player has at the start")
+var stockPrice = flag.Int("stock-price", 0, "initial price of the share of the stock; there is no stock, if 0")
+var stockVolatility = flag.Int("stock-volatility", 10, "maximum change of the stock price in one tick as percentage")
+var stockTickTime = flag.Int("stock-tick-time", 5, "seconds between the changes of the stock price")
+var insurancePremium = flag.Int("insurance-premium", 0, "points paid for the insurance against the next theft; there is no insurance, if 0")
+var insuranceCoverage = flag.Int("insurance-coverage", 100, "percentage of the stolen points paid back to the insured player")
+var taxTime = flag.Int("tax-time", 0, "seconds between the collections of the wealth tax; there is no tax, if 0")
+var taxBrackets = flag.String("tax-brackets", "0:10", "comma-separated tax brackets as threshold:percentage, e.g. 0:5,500:20; points above the threshold are taxed at the percentage")
+var inflationRate = flag.Int("inflation-rate", 0, "percentage, by which lottery and question payouts grow every inflation tick; there is no inflation, if 0")
+var inflationTime = flag.Int("inflation-time", 10, "seconds between the inflation ticks")
+var bankStrategy = flag.String("bank-strategy", "default", "how the bank sets interest rates and credit limits: default or demand")
+var stealChance = flag.Int("steal-chance", 0, "chance of the successful steal from the chosen player as percentage; players cannot steal, if 0")
+var stealPenalty = flag.Int("steal-penalty", 50, "percentage of the points, which the caught thief tried to steal, paid to the target")
+var maskThieves = flag.Bool("mask-thieves", false, "hide thieves, who have not been caught, from other players")
+var guardPrice = flag.Int("guard-price", 0, "points paid for the guard, which protects the player from thefts; there are no guards, if 0")
+var guardTime = flag.Int("guard-time", 10, "seconds, for which the guard protects the player")
+var guardProtection = flag.Int("guard-protection", 50, "percentage, by which the guard reduces stolen points and the chance to steal from the player")
+var minPlayers = flag.Int("min-players", 1, "minimum number of players, with which the game can be started")
+var maxPlayers = flag.Int("max-players", 0, "maximum number of players in the game; new waiting game is created, when it is full; not limited, if 0")
+var autoStart = flag.Bool("auto-start", false, "start the game automatically, once max players have joined it")
+var teams = flag.Int("teams", 0, "number of teams from 2 to 8, which pool their points; the team with the most points wins; there are no teams, if 0")
+var startCountdown = flag.Int("start-countdown", 0, "seconds from 5 to 10 between Start request and the start of the game, which the host can cancel; the game starts right away, if 0")
+var gameMode = flag.String("mode", "competitive", "how the players win the game: competitive or coop, in which they have to extract -coop-target points from the bank together")
+var winCondition = flag.String("win-condition", "points", "how the winner of competitive games without teams is chosen: points, net-worth, target (first to reach -win-target net worth), or last-solvent")
+var winTarget = flag.Int("win-target", 2000, "net worth, by reaching which the player wins the game right away with target win condition")
+var timeTick = flag.Int("time-tick", 10, "seconds between the broadcasts of the remaining time of the active game; it is not broadcast, if 0")
+var coopTarget = flag.Int("coop-target", 1000, "points, by which the net worth of all players has to grow in co-op mode")
+var interestMode = flag.String("interest-mode", "simple", "how the interest of credits and deposits is charged: simple or compound")
+var interestTicks = flag.Int("interest-ticks", 10, "number of times the interest is compounded during the credit or deposit time in compound mode")
+var depositPenalty = flag.Int("deposit-penalty", 0, "percentage of the deposit kept by the bank, if it is withdrawn before its time ends")
+var jackpotPercentage = flag.Int("jackpot-percentage", 0, "percentage of the lottery max win and of lost question bids put into the jackpot; there is no jackpot, if 0")
+var jackpotChance = flag.Int("jackpot-chance", 1, "chance of winning the jackpot in every lottery play as percentage")
+var questionTime = flag.Int("question-time", 0, "seconds to answer the question, after which the bid is forfeited; no deadline, if 0")
+var usernameMinLength = flag.Int("username-min-length", 1, "minimum length of the usernames in characters")
+var usernameMaxLength = flag.Int("username-max-length", 20, "maximum length of the usernames in characters")
+var usernamePolicy = flag.String("username-policy", "suffix", "what happens, when the username is taken in the game: suffix (e.g. \"Bob 2\") or reject")
+var afkTime = flag.Int("afk-time", 0, "seconds, after which the player, who hasn't acted, is flagged as AFK; AFK players are not detected, if 0")
+var afkPolicy = flag.String("afk-policy", "flag", "what happens to AFK players: flag, manage (their deposits are deposited again), or forfeit")
+var questionMaxBid = flag.Int("question-max-bid", 0, "maximum bid on a question as percentage of player's points; players can bid all of their points, if 0")
+var authSecret = flag.String("auth-secret", os.Getenv("AUTH_SECRET"), "secret for signing auth tokens of the players; random, if empty")
+var userRate = flag.Int("user-rate", 0, "requests per second allowed for a single player; not limited, if 0")
+var userBurst = flag.Int("user-burst", 20, "burst of requests allowed for a single player")
+var peerRate = flag.Int("peer-rate", 0, "requests per second allowed from a single address; not limited, if 0")
+var peerBurst = flag.Int("peer-burst", 100, "burst of requests allowed from a single address")
+var banDuration = flag.Int("ban-duration", 3600, "seconds, for which the username or the address is banned by the Admin service, unless the ban sets its own duration")
+var seed = flag.Int64("seed", 0, "seed of the random numbers of every game, for reproducing the games; games are seeded with their start time, if 0")
+var heartbeat = flag.Int("heartbeat", 15, "seconds between Heartbeat events sent on every player stream; heartbeats are not sent, if 0")
+var matchSize = flag.Int("match-size", 2, "number of players, which are matched by the Enqueue RPC into a single game")
+var matchWindow = flag.Int("match-window", 100, "maximal difference of ratings of the matched players")
+var botFill = flag.Int("bot-fill", 0, "number of players, up to which the lobby is filled with bots, when the host starts it; lobbies are not filled, if 0")
+var botProfiles = flag.String("bot-profiles", "conservative,aggressive,gambler", "comma separated profiles of bots in name[:accuracy] format, which bots take in turn; profiles are conservative, aggressive, gambler, and balanced")
+var matchGrowth = flag.Int("match-growth", 50, "rating points, by which the match window grows every second of waiting")
+var tlsCert = flag.String("tls-cert", "", "PEM file with the server certificate; if set, the server accepts only TLS connections")
+var tlsKey = flag.String("tls-key", "", "PEM file with the private key of the server certificate")
+var tlsClientCA = flag.String("tls-client-ca", "", "PEM file with CAs of client certificates, which are allowed to call the Admin service")
+
 func parseArgs(
 	servAddr *string,
 	duration *int32,
@@ -124,6 +201,135 @@ func parseArgs(
 	*questionWinPercentage = int32(arg12)
 }
 
+// parsePayouts parses comma-separated list of percentages.
+func parsePayouts(list string) []int32 {
+	if list == "" {
+		return nil
+	}
+
+	var payouts []int32
+	for _, item := range strings.Split(list, ",") {
+		payout, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil {
+			fmt.Printf("%s is not an integer\n", item)
+			os.Exit(2)
+		}
+		if payout < 0 || payout > 100 {
+			fmt.Printf("Lottery payouts (%d) have to be from 0 to 100 percent.\n", payout)
+			os.Exit(1)
+		}
+		payouts = append(payouts, int32(payout))
+	}
+	return payouts
+}
+
+func parseBotProfiles(list string) []server.BotProfile {
+	known := []server.BotProfile{
+		server.BalancedBotProfile,
+		server.ConservativeBotProfile,
+		server.AggressiveBotProfile,
+		server.GamblerBotProfile,
+	}
+
+	var profiles []server.BotProfile
+	for _, item := range strings.Split(list, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) > 2 {
+			fmt.Printf("%s is not a bot profile in name[:accuracy] format\n", item)
+			os.Exit(2)
+		}
+		found := false
+		var profile server.BotProfile
+		for _, p := range known {
+			if p.Name == parts[0] {
+				profile, found = p, true
+			}
+		}
+		if !found {
+			fmt.Printf("Bot profile (%s) has to be conservative, aggressive, gambler, or balanced.\n", parts[0])
+			os.Exit(1)
+		}
+		if len(parts) == 2 {
+			accuracy, err := strconv.Atoi(parts[1])
+			if err != nil {
+				fmt.Printf("%s is not an integer\n", parts[1])
+				os.Exit(2)
+			}
+			if accuracy < 0 || accuracy > 100 {
+				fmt.Printf("Bot accuracy (%d) has to be from 0 to 100 percent.\n", accuracy)
+				os.Exit(1)
+			}
+			profile.Accuracy = int32(accuracy)
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles
+}
+
+func parseTaxBrackets(list string) []server.TaxBracket {
+	if list == "" {
+		return nil
+	}
+
+	var brackets []server.TaxBracket
+	for _, item := range strings.Split(list, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 2 {
+			fmt.Printf("%s is not a tax bracket in threshold:percentage format\n", item)
+			os.Exit(2)
+		}
+		threshold, err := strconv.Atoi(parts[0])
+		if err != nil {
+			fmt.Printf("%s is not an integer\n", parts[0])
+			os.Exit(2)
+		}
+		percentage, err := strconv.Atoi(parts[1])
+		if err != nil {
+			fmt.Printf("%s is not an integer\n", parts[1])
+			os.Exit(2)
+		}
+		if threshold < 0 || percentage < 0 || percentage > 100 {
+			fmt.Printf(
+				"Tax bracket threshold (%d) cannot be negative and its percentage (%d) has to be from 0 to 100 percent.\n",
+				threshold,
+				percentage,
+			)
+			os.Exit(1)
+		}
+		if len(brackets) > 0 && int32(threshold) <= brackets[len(brackets)-1].Threshold {
+			fmt.Printf("Tax brackets have to be sorted by their thresholds.\n")
+			os.Exit(1)
+		}
+		brackets = append(brackets, server.TaxBracket{Threshold: int32(threshold), Percentage: int32(percentage)})
+	}
+	return brackets
+}
+
+func parseAssets(list string) []string {
+	if list == "" {
+		return nil
+	}
+
+	var assets []string
+	for _, item := range strings.Split(list, ",") {
+		assets = append(assets, strings.TrimSpace(item))
+	}
+	return assets
+}
+
+// reloadOnHangup reloads the question pack every time SIGHUP is received.
+func reloadOnHangup(questions *server.FileQuestionProvider, logger *zap.Logger) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	for range hangup {
+		if err := questions.Reload(); err != nil {
+			logger.Error("Failed to reload questions", zap.Error(err))
+			continue
+		}
+		logger.Info("Reloaded questions", zap.Int("count", questions.Len()))
+	}
+}
+
 func main() {
 	var servAddr string // for localhost, it needs to be "0.0.0.0:9090"
 	var duration int32
@@ -185,6 +391,267 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *lotteryRows < 1 || *lotteryRows > 10 || *lotteryColumns < 1 || *lotteryColumns > 10 || *lotteryRows**lotteryColumns < 2 {
+		fmt.Printf(
+			"Lottery grid (%dx%d) has to have at least 2 cells and from 1 to 10 rows and columns.\n",
+			*lotteryRows,
+			*lotteryColumns,
+		)
+		os.Exit(1)
+	}
+
+	if *creditLimit <= 0 {
+		fmt.Printf("Credit limit percentage (%d) has to be positive.\n", *creditLimit)
+		os.Exit(1)
+	}
+
+	if *marketUnits < 0 {
+		fmt.Printf("Market units (%d) cannot be negative.\n", *marketUnits)
+		os.Exit(1)
+	}
+
+	if *stockPrice < 0 || *stockVolatility < 0 || *stockVolatility > 100 || *stockTickTime <= 0 {
+		fmt.Printf(
+			"Stock price (%d) cannot be negative, stock volatility (%d) has to be from 0 to 100 percent, "+
+				"and stock tick time (%d) has to be positive.\n",
+			*stockPrice,
+			*stockVolatility,
+			*stockTickTime,
+		)
+		os.Exit(1)
+	}
+
+	if *insurancePremium < 0 || *insuranceCoverage < 0 || *insuranceCoverage > 100 {
+		fmt.Printf(
+			"Insurance premium (%d) cannot be negative and insurance coverage (%d) has to be from 0 to 100 percent.\n",
+			*insurancePremium,
+			*insuranceCoverage,
+		)
+		os.Exit(1)
+	}
+
+	if *taxTime < 0 {
+		fmt.Printf("Tax time (%d) cannot be negative.\n", *taxTime)
+		os.Exit(1)
+	}
+
+	if *inflationRate < 0 || *inflationTime <= 0 {
+		fmt.Printf(
+			"Inflation rate (%d) cannot be negative and inflation time (%d) has to be positive.\n",
+			*inflationRate,
+			*inflationTime,
+		)
+		os.Exit(1)
+	}
+
+	if *timeTick < 0 {
+		fmt.Printf("Time tick (%d) cannot be negative.\n", *timeTick)
+		os.Exit(1)
+	}
+
+	if *stealChance < 0 || *stealChance > 100 || *stealPenalty < 0 || *stealPenalty > 100 {
+		fmt.Printf(
+			"Steal chance (%d) and steal penalty (%d) have to be from 0 to 100 percent.\n",
+			*stealChance,
+			*stealPenalty,
+		)
+		os.Exit(1)
+	}
+
+	if *guardPrice < 0 || *guardTime <= 0 || *guardProtection < 0 || *guardProtection > 100 {
+		fmt.Printf(
+			"Guard price (%d) cannot be negative, guard time (%d) has to be positive, and guard protection (%d) has to be from 0 to 100 percent.\n",
+			*guardPrice,
+			*guardTime,
+			*guardProtection,
+		)
+		os.Exit(1)
+	}
+
+	if *minPlayers <= 0 || *maxPlayers < 0 || (*maxPlayers > 0 && *maxPlayers < *minPlayers) {
+		fmt.Printf(
+			"Min players (%d) has to be positive and max players (%d) has to be either 0 or at least min players.\n",
+			*minPlayers,
+			*maxPlayers,
+		)
+		os.Exit(1)
+	}
+
+	if *autoStart && *maxPlayers == 0 {
+		fmt.Println("Max players have to be limited for auto start.")
+		os.Exit(1)
+	}
+
+	if *startCountdown != 0 && (*startCountdown < 5 || *startCountdown > 10) {
+		fmt.Printf("Start countdown has to be either 0 or from 5 to 10 seconds, received: %d.\n", *startCountdown)
+		os.Exit(1)
+	}
+
+	if *teams != 0 && (*teams < 2 || *teams > 8) {
+		fmt.Printf("Number of teams has to be either 0 or from 2 to 8, received: %d.\n", *teams)
+		os.Exit(1)
+	}
+
+	if *botFill < 0 {
+		fmt.Printf("Bot fill (%d) cannot be negative.\n", *botFill)
+		os.Exit(1)
+	}
+
+	if *matchSize < *minPlayers || *matchSize < 2 || (*maxPlayers > 0 && *matchSize > *maxPlayers) || *matchWindow < 0 || *matchGrowth < 0 {
+		fmt.Printf(
+			"Match size (%d) has to be at least 2 and within player limits, match window (%d) and growth (%d) cannot be negative.\n",
+			*matchSize,
+			*matchWindow,
+			*matchGrowth,
+		)
+		os.Exit(1)
+	}
+
+	if *banDuration <= 0 {
+		fmt.Printf("Ban duration has to be positive, received: %d.\n", *banDuration)
+		os.Exit(1)
+	}
+
+	if *heartbeat < 0 {
+		fmt.Printf("Heartbeat interval cannot be negative, received: %d.\n", *heartbeat)
+		os.Exit(1)
+	}
+
+	strategy, err := server.NewBankStrategy(*bankStrategy)
+	if err != nil {
+		fmt.Printf("Bank strategy has to be default or demand, received: %s.\n", *bankStrategy)
+		os.Exit(1)
+	}
+
+	mode := server.SimpleInterest
+	switch *interestMode {
+	case "simple":
+	case "compound":
+		mode = server.CompoundInterest
+		if *interestTicks <= 0 {
+			fmt.Printf("Interest ticks (%d) have to be positive in compound mode.\n", *interestTicks)
+			os.Exit(1)
+		}
+	default:
+		fmt.Printf("Interest mode (%s) has to be either simple or compound.\n", *interestMode)
+		os.Exit(1)
+	}
+
+	gameModeValue := server.CompetitiveMode
+	switch *gameMode {
+	case "competitive":
+	case "coop":
+		gameModeValue = server.CoopMode
+		if *coopTarget <= 0 {
+			fmt.Printf("Co-op target (%d) has to be positive.\n", *coopTarget)
+			os.Exit(1)
+		}
+		if *teams != 0 {
+			fmt.Println("Players cannot be split into teams in co-op mode.")
+			os.Exit(1)
+		}
+	default:
+		fmt.Printf("Game mode (%s) has to be either competitive or coop.\n", *gameMode)
+		os.Exit(1)
+	}
+
+	winConditionValue := server.WinByPoints
+	switch *winCondition {
+	case "points":
+	case "net-worth":
+		winConditionValue = server.WinByNetWorth
+	case "target":
+		winConditionValue = server.WinByTarget
+		if int32(*winTarget) <= playerPoints {
+			fmt.Printf("Win target (%d) has to be greater than player points (%d).\n", *winTarget, playerPoints)
+			os.Exit(1)
+		}
+	case "last-solvent":
+		winConditionValue = server.WinByLastSolvent
+	default:
+		fmt.Printf("Win condition (%s) has to be points, net-worth, target, or last-solvent.\n", *winCondition)
+		os.Exit(1)
+	}
+	if winConditionValue != server.WinByPoints && (gameModeValue == server.CoopMode || *teams != 0) {
+		fmt.Println("Win condition can be changed only in competitive games without teams.")
+		os.Exit(1)
+	}
+
+	if *depositPenalty < 0 || *depositPenalty > 100 {
+		fmt.Printf("Deposit penalty (%d) has to be from 0 to 100 percent.\n", *depositPenalty)
+		os.Exit(1)
+	}
+
+	if *jackpotPercentage < 0 || *jackpotPercentage > 100 || *jackpotChance < 0 || *jackpotChance > 100 {
+		fmt.Printf(
+			"Jackpot percentage (%d) and chance (%d) have to be from 0 to 100 percent.\n",
+			*jackpotPercentage,
+			*jackpotChance,
+		)
+		os.Exit(1)
+	}
+
+	if *questionTime < 0 || int32(*questionTime) >= duration {
+		fmt.Printf(
+			"Question time (%d)sec cannot be negative and has to be less than duration of a game (%d).\n",
+			*questionTime,
+			duration,
+		)
+		os.Exit(1)
+	}
+
+	if *questionMaxBid < 0 || *questionMaxBid > 100 {
+		fmt.Printf("Question max bid percentage (%d) has to be from 0 to 100 percent.\n", *questionMaxBid)
+		os.Exit(1)
+	}
+
+	if *usernameMinLength < 1 || *usernameMaxLength < *usernameMinLength || *usernameMaxLength > 64 {
+		fmt.Printf(
+			"Username length bounds (%d and %d) have to be from 1 to 64, and min length cannot exceed max length.\n",
+			*usernameMinLength,
+			*usernameMaxLength,
+		)
+		os.Exit(1)
+	}
+
+	usernamePolicyValue := server.SuffixUsernames
+	switch *usernamePolicy {
+	case "suffix":
+	case "reject":
+		usernamePolicyValue = server.RejectUsernames
+	default:
+		fmt.Printf("Username policy (%s) has to be either suffix or reject.\n", *usernamePolicy)
+		os.Exit(1)
+	}
+
+	if *afkTime < 0 {
+		fmt.Printf("AFK time (%d) cannot be negative.\n", *afkTime)
+		os.Exit(1)
+	}
+
+	afkPolicyValue := server.FlagAFK
+	switch *afkPolicy {
+	case "flag":
+	case "manage":
+		afkPolicyValue = server.ManageAFK
+	case "forfeit":
+		afkPolicyValue = server.ForfeitAFK
+	default:
+		fmt.Printf("AFK policy (%s) has to be flag, manage, or forfeit.\n", *afkPolicy)
+		os.Exit(1)
+	}
+
+	if *userRate < 0 || *peerRate < 0 || *userBurst <= 0 || *peerBurst <= 0 {
+		fmt.Printf(
+			"User (%d) and peer (%d) rates cannot be negative, user (%d) and peer (%d) bursts have to be positive.\n",
+			*userRate,
+			*peerRate,
+			*userBurst,
+			*peerBurst,
+		)
+		os.Exit(1)
+	}
+
 	gameConfig := server.NewGameConfig(
 		duration,
 		playerPoints,
@@ -198,11 +665,112 @@ func main() {
 		lotteryTime,
 		lotteryMaxWin,
 		questionWinPercentage,
+		server.WithLotteryGrid(int32(*lotteryRows), int32(*lotteryColumns)),
+		server.WithLotteryPayouts(parsePayouts(*lotteryPayouts)...),
+		server.WithCreditLimit(int32(*creditLimit)),
+		server.WithMarket(parseAssets(*marketAssets), int32(*marketUnits)),
+		server.WithStock(int32(*stockPrice), int32(*stockVolatility), int32(*stockTickTime)),
+		server.WithInsurance(int32(*insurancePremium), int32(*insuranceCoverage)),
+		server.WithTax(int32(*taxTime), parseTaxBrackets(*taxBrackets)),
+		server.WithInflation(int32(*inflationRate), int32(*inflationTime)),
+		server.WithSteal(int32(*stealChance), int32(*stealPenalty)),
+		server.WithMaskedThieves(*maskThieves),
+		server.WithGuard(int32(*guardPrice), int32(*guardTime), int32(*guardProtection)),
+		server.WithPlayerLimits(int32(*minPlayers), int32(*maxPlayers)),
+		server.WithAutoStart(*autoStart),
+		server.WithStartCountdown(int32(*startCountdown)),
+		server.WithTeams(int32(*teams)),
+		server.WithGameMode(gameModeValue, int32(*coopTarget)),
+		server.WithWinCondition(winConditionValue, int32(*winTarget)),
+		server.WithTimeTicks(int32(*timeTick)),
+		server.WithInterestMode(mode, int32(*interestTicks)),
+		server.WithDepositPenalty(int32(*depositPenalty)),
+		server.WithJackpot(int32(*jackpotPercentage), int32(*jackpotChance)),
+		server.WithQuestionTime(int32(*questionTime)),
+		server.WithQuestionMaxBid(int32(*questionMaxBid)),
+		server.WithUsernameRules(int32(*usernameMinLength), int32(*usernameMaxLength), usernamePolicyValue),
+		server.WithAFK(int32(*afkTime), afkPolicyValue),
+		server.WithUserRateLimit(int32(*userRate), int32(*userBurst)),
+		server.WithPeerRateLimit(int32(*peerRate), int32(*peerBurst)),
 	)
 
-	s := server.NewServer(gameConfig)
+	logger, err := server.NewLogger(*logLevel)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	opts := []server.ServerOption{
+		server.WithLogger(logger),
+		server.WithBankStrategy(strategy),
+		server.WithBanDuration(time.Duration(*banDuration) * time.Second),
+		server.WithHeartbeat(time.Duration(*heartbeat) * time.Second),
+		server.WithLogSampling(int32(*logSampling)),
+		server.WithSeed(*seed),
+		server.WithMatchmaking(int32(*matchSize), int32(*matchWindow), int32(*matchGrowth)),
+		server.WithBotFill(int32(*botFill)),
+		server.WithBotProfiles(parseBotProfiles(*botProfiles)...),
+	}
+	if *dbURL != "" {
+		db, err := sql.Open("postgres", *dbURL)
+		if err != nil {
+			logger.Fatal("Failed to open database", zap.Error(err))
+		}
+		storage, err := server.NewSQLStorage(db)
+		if err != nil {
+			logger.Fatal("Failed to init storage", zap.Error(err))
+		}
+		opts = append(opts, server.WithStorage(storage))
+	}
+	if *adminToken != "" {
+		opts = append(opts, server.WithAdminToken(*adminToken))
+	}
+	if *metricsAddr != "" {
+		opts = append(opts, server.WithMetrics(*metricsAddr))
+	}
+	if *questionsPath != "" {
+		questions, err := server.NewFileQuestionProvider(*questionsPath)
+		if err != nil {
+			logger.Fatal("Failed to load questions", zap.Error(err))
+		}
+		logger.Info("Loaded questions", zap.Int("count", questions.Len()))
+		go reloadOnHangup(questions, logger)
+		opts = append(opts, server.WithQuestionProvider(questions))
+	}
+	if *bannedWords != "" {
+		words, err := server.LoadWordList(*bannedWords)
+		if err != nil {
+			logger.Fatal("Failed to load banned words", zap.Error(err))
+		}
+		logger.Info("Loaded banned words", zap.Int("count", words.Len()))
+		opts = append(opts, server.WithWordFilter(words))
+	}
+	if *grpcWebAddr != "" {
+		opts = append(opts, server.WithGRPCWeb(*grpcWebAddr))
+	}
+	if *debugAddr != "" {
+		opts = append(opts, server.WithDebug(*debugAddr))
+	}
+	if *authSecret != "" {
+		opts = append(opts, server.WithAuthSecret([]byte(*authSecret)))
+	}
+	if *tlsCert != "" {
+		tlsConfig, err := server.LoadTLSConfig(*tlsCert, *tlsKey, *tlsClientCA)
+		if err != nil {
+			logger.Fatal("Failed to load TLS config", zap.Error(err))
+		}
+		opts = append(opts, server.WithTLS(tlsConfig))
+	}
+
+	s := server.NewServer(gameConfig, opts...)
+	if *dbURL != "" {
+		if err := s.Restore(); err != nil {
+			logger.Fatal("Failed to restore games", zap.Error(err))
+		}
+	}
 	if _, err := s.Listen(servAddr); err != nil {
-		log.Fatalf("Server failed to listen: %v", err)
+		logger.Fatal("Server failed to listen", zap.Error(err))
 	}
 	s.Launch()
 }
@@ -0,0 +1,165 @@
+// Command server runs the game server with the settings from the config file,
+// the environment variables, and the flags, which take precedence in that
+// order. SIGHUP reloads the game config and the question pack, SIGINT and
+// SIGTERM shut the server down gracefully.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"github.com/cs489-team11/server/config"
+	_ "github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+var configPath = flag.String("config", "", "YAML file with the settings of the server; defaults are used, if empty; "+
+	"settings are overridden by the environment variables, e.g. LISTEN_ADDR or GAME_DURATION")
+var addr = flag.String("addr", "", "address, on which the server listens, e.g. 0.0.0.0:9090; overrides the config, if set")
+var logLevel = flag.String("log-level", "", "minimum level of logged messages: debug, info, warn, or error; overrides the config, if set")
+var metricsPort = flag.Int("metrics-port", 0, "port of the HTTP endpoint with Prometheus metrics on all interfaces; overrides the config, if set")
+var debugPort = flag.Int("debug-port", 0, "port of the HTTP endpoint with pprof profiles and runtime info on localhost; overrides the config, if set")
+var shutdownTimeout = flag.Duration("shutdown-timeout", 30*time.Second, "time given to active games to finish on SIGINT or SIGTERM")
+
+// applyFlags overrides the config with the flags, which are set.
+func applyFlags(conf *config.Config) error {
+	if *addr != "" {
+		conf.Addr = *addr
+	}
+	if *logLevel != "" {
+		conf.LogLevel = *logLevel
+	}
+	if *metricsPort < 0 || *metricsPort > 65535 {
+		return fmt.Errorf("metrics port has to be from 1 to 65535, received: %d", *metricsPort)
+	}
+	if *metricsPort != 0 {
+		conf.Metrics = fmt.Sprintf(":%d", *metricsPort)
+	}
+	if *debugPort < 0 || *debugPort > 65535 {
+		return fmt.Errorf("debug port has to be from 1 to 65535, received: %d", *debugPort)
+	}
+	if *debugPort != 0 {
+		conf.Debug = fmt.Sprintf("localhost:%d", *debugPort)
+	}
+	return conf.Validate()
+}
+
+// reloadOnHangup reloads the config file and the question pack every time
+// SIGHUP is received. The new game config becomes the default of the games
+// created afterwards; other settings require the restart.
+func reloadOnHangup(s *server.Server, questions *server.FileQuestionProvider, logger *zap.Logger) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	for range hangup {
+		if questions != nil {
+			if err := questions.Reload(); err != nil {
+				logger.Error("Failed to reload questions", zap.Error(err))
+			} else {
+				logger.Info("Reloaded questions", zap.Int("count", questions.Len()))
+			}
+		}
+		conf, err := config.LoadWithEnv(*configPath, os.LookupEnv)
+		if err == nil {
+			err = applyFlags(&conf)
+		}
+		if err != nil {
+			logger.Error("Failed to reload config", zap.Error(err))
+			continue
+		}
+		gameConfig, err := conf.GameConfig()
+		if err == nil {
+			err = s.SetGameConfig(gameConfig)
+		}
+		if err != nil {
+			logger.Error("Failed to reload game config", zap.Error(err))
+			continue
+		}
+		logger.Info("Reloaded game config")
+	}
+}
+
+// shutdownOnSignal waits for SIGINT or SIGTERM and shuts the server
+// down gracefully, giving active games the shutdown timeout to finish.
+func shutdownOnSignal(s *server.Server, logger *zap.Logger) {
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	sig := <-stop
+	logger.Info("Received signal", zap.String("signal", sig.String()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		logger.Warn("Active games were finished forcefully", zap.Error(err))
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	conf, err := config.LoadWithEnv(*configPath, os.LookupEnv)
+	if err == nil {
+		err = applyFlags(&conf)
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	logger, err := server.NewLogger(conf.LogLevel)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	gameConfig, err := conf.GameConfig()
+	if err != nil {
+		logger.Fatal("Invalid game config", zap.Error(err))
+	}
+	opts, err := conf.ServerOptions(logger)
+	if err != nil {
+		logger.Fatal("Invalid server config", zap.Error(err))
+	}
+	if conf.DB != "" {
+		db, err := sql.Open("postgres", conf.DB)
+		if err != nil {
+			logger.Fatal("Failed to open database", zap.Error(err))
+		}
+		defer db.Close()
+		storage, err := server.NewSQLStorage(db)
+		if err != nil {
+			logger.Fatal("Failed to init storage", zap.Error(err))
+		}
+		opts = append(opts, server.WithStorage(storage))
+	}
+	var questions *server.FileQuestionProvider
+	if conf.Questions != "" {
+		questions, err = server.NewFileQuestionProvider(conf.Questions)
+		if err != nil {
+			logger.Fatal("Failed to load questions", zap.Error(err))
+		}
+		logger.Info("Loaded questions", zap.Int("count", questions.Len()))
+		opts = append(opts, server.WithQuestionProvider(questions))
+	}
+
+	s := server.NewServer(gameConfig, opts...)
+	if conf.DB != "" {
+		if err := s.Restore(); err != nil {
+			logger.Fatal("Failed to restore games", zap.Error(err))
+		}
+	}
+	if _, err := s.Listen(conf.Addr); err != nil {
+		logger.Fatal("Server failed to listen", zap.Error(err))
+	}
+	go reloadOnHangup(s, questions, logger)
+	go s.Launch()
+	shutdownOnSignal(s, logger)
+	logger.Info("Server is stopped")
+}
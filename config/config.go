@@ -0,0 +1,529 @@
+// Package config loads the settings of the game server from a YAML file,
+// so that deployers don't have to construct GameConfig in code.
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the settings of the server. Fields missing in the
+// file keep their values from Default.
+type Config struct {
+	Addr       string `yaml:"addr" env:"LISTEN_ADDR"`
+	LogLevel   string `yaml:"log_level" env:"LOG_LEVEL"`
+	DB         string `yaml:"db" env:"DATABASE_URL"`          // games are not persisted, if empty
+	AdminToken string `yaml:"admin_token" env:"ADMIN_TOKEN"`  // Admin service is disabled, if empty
+	AuthSecret string `yaml:"auth_secret" env:"AUTH_SECRET"`  // random, if empty
+	Metrics    string `yaml:"metrics" env:"METRICS_ADDR"`     // metrics are not exposed, if empty
+	GRPCWeb    string `yaml:"grpc_web" env:"GRPC_WEB_ADDR"`   // gRPC-Web is not served, if empty
+	Debug      string `yaml:"debug" env:"DEBUG_ADDR"`         // pprof is not served, if empty; loopback only
+	Questions  string `yaml:"questions" env:"QUESTIONS_PATH"` // Open Trivia DB is used, if empty
+	// file with the words, which are not allowed in usernames and chat; nothing is filtered, if empty
+	BannedWords string `yaml:"banned_words" env:"BANNED_WORDS_PATH"`
+	// seconds between Heartbeat events, heartbeats are not sent, if 0
+	Heartbeat   int32       `yaml:"heartbeat" env:"HEARTBEAT"`
+	BanDuration int32       `yaml:"ban_duration" env:"BAN_DURATION"` // seconds
+	LogSampling int32       `yaml:"log_sampling" env:"LOG_SAMPLING"` // every nth successful call of polled methods is logged
+	Seed        int64       `yaml:"seed" env:"SEED"`                 // games are seeded with their start time, if 0
+	TLS         TLS         `yaml:"tls"`
+	Limits      Limits      `yaml:"limits"`
+	Matchmaking Matchmaking `yaml:"matchmaking"`
+	Bots        Bots        `yaml:"bots"`
+	Game        Game        `yaml:"game"`
+	Presets     Presets     `yaml:"presets"`
+	// translations of the explanations by locale, keyed by their English formats
+	Messages map[string]map[string]string `yaml:"messages"`
+}
+
+// TLS is the certificate of the server. The server accepts
+// plain connections, if the certificate is empty.
+type TLS struct {
+	Cert     string `yaml:"cert" env:"TLS_CERT"`
+	Key      string `yaml:"key" env:"TLS_KEY"`
+	ClientCA string `yaml:"client_ca" env:"TLS_CLIENT_CA"` // CAs of the clients allowed to call the Admin service
+}
+
+// Limits are the rate limits of the requests, which are not limited, if
+// the rate is 0.
+type Limits struct {
+	UserRate  int32 `yaml:"user_rate" env:"USER_RATE"`
+	UserBurst int32 `yaml:"user_burst" env:"USER_BURST"`
+	PeerRate  int32 `yaml:"peer_rate" env:"PEER_RATE"`
+	PeerBurst int32 `yaml:"peer_burst" env:"PEER_BURST"`
+}
+
+// Matchmaking is the settings of the Enqueue RPC.
+type Matchmaking struct {
+	Size   int32 `yaml:"size" env:"MATCH_SIZE"`
+	Window int32 `yaml:"window" env:"MATCH_WINDOW"`
+	Growth int32 `yaml:"growth" env:"MATCH_GROWTH"`
+}
+
+// Bots are the settings of the players driven by the server.
+type Bots struct {
+	Fill     int32    `yaml:"fill" env:"BOT_FILL"`         // lobbies are not filled, if 0
+	Profiles []string `yaml:"profiles" env:"BOT_PROFILES"` // names of the profiles, which bots take in turn
+}
+
+// Game is the default config of the games. Times are in seconds and
+// interests are in percents like in GameConfig.
+type Game struct {
+	Duration              int32 `yaml:"duration" env:"GAME_DURATION"`
+	PlayerPoints          int32 `yaml:"player_points" env:"PLAYER_POINTS"`
+	BankPointsPerPlayer   int32 `yaml:"bank_points_per_player" env:"BANK_POINTS_PER_PLAYER"`
+	CreditInterest        int32 `yaml:"credit_interest" env:"CREDIT_INTEREST"`
+	DepositInterest       int32 `yaml:"deposit_interest" env:"DEPOSIT_INTEREST"`
+	CreditTime            int32 `yaml:"credit_time" env:"CREDIT_TIME"`
+	DepositTime           int32 `yaml:"deposit_time" env:"DEPOSIT_TIME"`
+	TheftTime             int32 `yaml:"theft_time" env:"THEFT_TIME"`
+	TheftPercentage       int32 `yaml:"theft_percentage" env:"THEFT_PERCENTAGE"`
+	LotteryTime           int32 `yaml:"lottery_time" env:"LOTTERY_TIME"`
+	LotteryMaxWin         int32 `yaml:"lottery_max_win" env:"LOTTERY_MAX_WIN"`
+	QuestionWinPercentage int32 `yaml:"question_win_percentage" env:"QUESTION_WIN_PERCENTAGE"`
+
+	BankStrategy      string              `yaml:"bank_strategy" env:"BANK_STRATEGY"` // default or demand
+	LotteryRows       int32               `yaml:"lottery_rows" env:"LOTTERY_ROWS"`
+	LotteryColumns    int32               `yaml:"lottery_columns" env:"LOTTERY_COLUMNS"`
+	LotteryPayouts    []int32             `yaml:"lottery_payouts" env:"LOTTERY_PAYOUTS"`
+	CreditLimit       int32               `yaml:"credit_limit" env:"CREDIT_LIMIT"`
+	MarketAssets      []string            `yaml:"market_assets" env:"MARKET_ASSETS"`
+	MarketUnits       int32               `yaml:"market_units" env:"MARKET_UNITS"`
+	StockPrice        int32               `yaml:"stock_price" env:"STOCK_PRICE"`
+	StockVolatility   int32               `yaml:"stock_volatility" env:"STOCK_VOLATILITY"`
+	StockTickTime     int32               `yaml:"stock_tick_time" env:"STOCK_TICK_TIME"`
+	InsurancePremium  int32               `yaml:"insurance_premium" env:"INSURANCE_PREMIUM"`
+	InsuranceCoverage int32               `yaml:"insurance_coverage" env:"INSURANCE_COVERAGE"`
+	TaxTime           int32               `yaml:"tax_time" env:"TAX_TIME"`
+	TaxBrackets       []server.TaxBracket `yaml:"tax_brackets" env:"TAX_BRACKETS"`
+	InflationRate     int32               `yaml:"inflation_rate" env:"INFLATION_RATE"`
+	InflationTime     int32               `yaml:"inflation_time" env:"INFLATION_TIME"`
+	StealChance       int32               `yaml:"steal_chance" env:"STEAL_CHANCE"`
+	StealPenalty      int32               `yaml:"steal_penalty" env:"STEAL_PENALTY"`
+	MaskThieves       bool                `yaml:"mask_thieves" env:"MASK_THIEVES"`
+	GuardPrice        int32               `yaml:"guard_price" env:"GUARD_PRICE"`
+	GuardTime         int32               `yaml:"guard_time" env:"GUARD_TIME"`
+	GuardProtection   int32               `yaml:"guard_protection" env:"GUARD_PROTECTION"`
+	MinPlayers        int32               `yaml:"min_players" env:"MIN_PLAYERS"`
+	MaxPlayers        int32               `yaml:"max_players" env:"MAX_PLAYERS"`
+	AutoStart         bool                `yaml:"auto_start" env:"AUTO_START"`
+	StartCountdown    int32               `yaml:"start_countdown" env:"START_COUNTDOWN"`
+	Teams             int32               `yaml:"teams" env:"TEAMS"`
+	Mode              string              `yaml:"mode" env:"GAME_MODE"` // competitive or coop
+	CoopTarget        int32               `yaml:"coop_target" env:"COOP_TARGET"`
+	WinCondition      string              `yaml:"win_condition" env:"WIN_CONDITION"` // points, net-worth, target, or last-solvent
+	WinTarget         int32               `yaml:"win_target" env:"WIN_TARGET"`
+	TimeTick          int32               `yaml:"time_tick" env:"TIME_TICK"`
+	InterestMode      string              `yaml:"interest_mode" env:"INTEREST_MODE"` // simple or compound
+	InterestTicks     int32               `yaml:"interest_ticks" env:"INTEREST_TICKS"`
+	DepositPenalty    int32               `yaml:"deposit_penalty" env:"DEPOSIT_PENALTY"`
+	JackpotPercentage int32               `yaml:"jackpot_percentage" env:"JACKPOT_PERCENTAGE"`
+	JackpotChance     int32               `yaml:"jackpot_chance" env:"JACKPOT_CHANCE"`
+	QuestionTime      int32               `yaml:"question_time" env:"QUESTION_TIME"`
+	QuestionMaxBid    int32               `yaml:"question_max_bid" env:"QUESTION_MAX_BID"`
+	UsernameMinLength int32               `yaml:"username_min_length" env:"USERNAME_MIN_LENGTH"`
+	UsernameMaxLength int32               `yaml:"username_max_length" env:"USERNAME_MAX_LENGTH"`
+	UsernamePolicy    string              `yaml:"username_policy" env:"USERNAME_POLICY"` // suffix or reject
+	AFKTime           int32               `yaml:"afk_time" env:"AFK_TIME"`
+	AFKPolicy         string              `yaml:"afk_policy" env:"AFK_POLICY"` // flag, manage, or forfeit
+}
+
+// Presets are the named game configs registered in addition to the
+// built-in ones. Fields missing in the preset keep their values from
+// the game section of Default, not from the game section of the file.
+type Presets map[string]Game
+
+// UnmarshalYAML decodes every preset on top of the default game section.
+func (p *Presets) UnmarshalYAML(value *yaml.Node) error {
+	var nodes map[string]yaml.Node
+	if err := value.Decode(&nodes); err != nil {
+		return err
+	}
+	*p = make(Presets, len(nodes))
+	for name, node := range nodes {
+		game := Default().Game
+		if err := node.Decode(&game); err != nil {
+			return fmt.Errorf("invalid preset %s: %v", name, err)
+		}
+		(*p)[name] = game
+	}
+	return nil
+}
+
+// Default returns the config, with which the server is run,
+// if the file doesn't override it.
+func Default() Config {
+	return Config{
+		Addr:        "0.0.0.0:9090",
+		LogLevel:    "info",
+		Heartbeat:   15,
+		BanDuration: 3600,
+		LogSampling: 10,
+		Limits: Limits{
+			UserBurst: 20,
+			PeerBurst: 100,
+		},
+		Matchmaking: Matchmaking{
+			Size:   2,
+			Window: 100,
+			Growth: 50,
+		},
+		Bots: Bots{
+			Profiles: []string{"conservative", "aggressive", "gambler"},
+		},
+		Game: Game{
+			Duration:              300,
+			PlayerPoints:          200,
+			BankPointsPerPlayer:   400,
+			CreditInterest:        30,
+			DepositInterest:       20,
+			CreditTime:            15,
+			DepositTime:           15,
+			TheftTime:             25,
+			TheftPercentage:       15,
+			LotteryTime:           10,
+			LotteryMaxWin:         150,
+			QuestionWinPercentage: 150,
+
+			BankStrategy:      "default",
+			LotteryRows:       3,
+			LotteryColumns:    3,
+			CreditLimit:       200,
+			MarketUnits:       10,
+			StockVolatility:   10,
+			StockTickTime:     5,
+			InsuranceCoverage: 100,
+			TaxBrackets:       []server.TaxBracket{{Threshold: 0, Percentage: 10}},
+			InflationTime:     10,
+			StealPenalty:      50,
+			GuardTime:         10,
+			GuardProtection:   50,
+			MinPlayers:        1,
+			Mode:              "competitive",
+			CoopTarget:        1000,
+			WinCondition:      "points",
+			WinTarget:         2000,
+			TimeTick:          10,
+			InterestMode:      "simple",
+			InterestTicks:     10,
+			JackpotChance:     1,
+			UsernameMinLength: 1,
+			UsernameMaxLength: 20,
+			UsernamePolicy:    "suffix",
+			AFKPolicy:         "flag",
+		},
+	}
+}
+
+// Load reads the config from the YAML file on top of the defaults and
+// validates it. Unknown fields are rejected, so that misspelled
+// settings are not silently ignored.
+func Load(path string) (Config, error) {
+	c, err := read(path)
+	if err != nil {
+		return Config{}, err
+	}
+	if err := c.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	return c, nil
+}
+
+// read reads the config from the YAML file on top of the defaults.
+func read(path string) (Config, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+	default:
+		return Config{}, fmt.Errorf("config file %s has to be in YAML format with .yaml or .yml extension", path)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read config file: %v", err)
+	}
+
+	c := Default()
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	// empty file keeps the defaults
+	if err := decoder.Decode(&c); err != nil && err != io.EOF {
+		return Config{}, fmt.Errorf("failed to parse config file %s: %v", path, err)
+	}
+	return c, nil
+}
+
+// Validate checks the settings of the server and the rules of the
+// game. The first found violation is returned as error.
+func (c Config) Validate() error {
+	if c.Addr == "" {
+		return fmt.Errorf("address cannot be empty")
+	}
+	if _, err := server.NewLogger(c.LogLevel); err != nil {
+		return err
+	}
+	if c.Heartbeat < 0 || c.BanDuration < 0 {
+		return fmt.Errorf(
+			"heartbeat (%d)sec and ban duration (%d)sec cannot be negative",
+			c.Heartbeat,
+			c.BanDuration,
+		)
+	}
+	if (c.TLS.Cert == "") != (c.TLS.Key == "") {
+		return fmt.Errorf("TLS certificate and key have to be set together")
+	}
+	if c.TLS.ClientCA != "" && c.TLS.Cert == "" {
+		return fmt.Errorf("TLS client CA requires TLS certificate")
+	}
+	if c.Limits.UserRate < 0 || c.Limits.PeerRate < 0 || c.Limits.UserBurst <= 0 || c.Limits.PeerBurst <= 0 {
+		return fmt.Errorf(
+			"user (%d) and peer (%d) rates cannot be negative, user (%d) and peer (%d) bursts have to be positive",
+			c.Limits.UserRate,
+			c.Limits.PeerRate,
+			c.Limits.UserBurst,
+			c.Limits.PeerBurst,
+		)
+	}
+	if c.Matchmaking.Size < 2 || c.Matchmaking.Window < 0 || c.Matchmaking.Growth < 0 {
+		return fmt.Errorf(
+			"match size (%d) has to be at least 2, match window (%d) and growth (%d) cannot be negative",
+			c.Matchmaking.Size,
+			c.Matchmaking.Window,
+			c.Matchmaking.Growth,
+		)
+	}
+	if c.Bots.Fill < 0 {
+		return fmt.Errorf("bot fill cannot be negative, received: %d", c.Bots.Fill)
+	}
+	if _, err := c.botProfiles(); err != nil {
+		return err
+	}
+	if _, err := server.NewBankStrategy(c.Game.BankStrategy); err != nil {
+		return err
+	}
+	gameConfig, err := c.GameConfig()
+	if err != nil {
+		return err
+	}
+	if err := gameConfig.Validate(); err != nil {
+		return err
+	}
+	for name, game := range c.Presets {
+		preset, err := c.gameConfig(game)
+		if err == nil {
+			err = preset.Validate()
+		}
+		if err != nil {
+			return fmt.Errorf("invalid preset %s: %v", name, err)
+		}
+	}
+	for locale, messages := range c.Messages {
+		if err := server.CheckMessages(messages); err != nil {
+			return fmt.Errorf("invalid %s messages: %v", locale, err)
+		}
+	}
+	return nil
+}
+
+// GameConfig returns the default config of the games.
+func (c Config) GameConfig() (server.GameConfig, error) {
+	return c.gameConfig(c.Game)
+}
+
+// gameConfig converts the game section or the preset to the GameConfig.
+func (c Config) gameConfig(g Game) (server.GameConfig, error) {
+	interestMode := server.SimpleInterest
+	switch g.InterestMode {
+	case "simple":
+	case "compound":
+		interestMode = server.CompoundInterest
+	default:
+		return server.GameConfig{}, fmt.Errorf("interest mode has to be simple or compound, received: %q", g.InterestMode)
+	}
+
+	mode := server.CompetitiveMode
+	switch g.Mode {
+	case "competitive":
+	case "coop":
+		mode = server.CoopMode
+	default:
+		return server.GameConfig{}, fmt.Errorf("mode has to be competitive or coop, received: %q", g.Mode)
+	}
+
+	var winCondition server.WinCondition
+	switch g.WinCondition {
+	case "points":
+		winCondition = server.WinByPoints
+	case "net-worth":
+		winCondition = server.WinByNetWorth
+	case "target":
+		winCondition = server.WinByTarget
+	case "last-solvent":
+		winCondition = server.WinByLastSolvent
+	default:
+		return server.GameConfig{}, fmt.Errorf(
+			"win condition has to be points, net-worth, target, or last-solvent, received: %q",
+			g.WinCondition,
+		)
+	}
+
+	usernamePolicy := server.SuffixUsernames
+	switch g.UsernamePolicy {
+	case "suffix":
+	case "reject":
+		usernamePolicy = server.RejectUsernames
+	default:
+		return server.GameConfig{}, fmt.Errorf("username policy has to be suffix or reject, received: %q", g.UsernamePolicy)
+	}
+
+	afkPolicy := server.FlagAFK
+	switch g.AFKPolicy {
+	case "flag":
+	case "manage":
+		afkPolicy = server.ManageAFK
+	case "forfeit":
+		afkPolicy = server.ForfeitAFK
+	default:
+		return server.GameConfig{}, fmt.Errorf("AFK policy has to be flag, manage, or forfeit, received: %q", g.AFKPolicy)
+	}
+
+	return server.NewGameConfig(
+		g.Duration,
+		g.PlayerPoints,
+		g.BankPointsPerPlayer,
+		g.CreditInterest,
+		g.DepositInterest,
+		g.CreditTime,
+		g.DepositTime,
+		g.TheftTime,
+		g.TheftPercentage,
+		g.LotteryTime,
+		g.LotteryMaxWin,
+		g.QuestionWinPercentage,
+		server.WithLotteryGrid(g.LotteryRows, g.LotteryColumns),
+		server.WithLotteryPayouts(g.LotteryPayouts...),
+		server.WithCreditLimit(g.CreditLimit),
+		server.WithMarket(g.MarketAssets, g.MarketUnits),
+		server.WithStock(g.StockPrice, g.StockVolatility, g.StockTickTime),
+		server.WithInsurance(g.InsurancePremium, g.InsuranceCoverage),
+		server.WithTax(g.TaxTime, g.TaxBrackets),
+		server.WithInflation(g.InflationRate, g.InflationTime),
+		server.WithSteal(g.StealChance, g.StealPenalty),
+		server.WithMaskedThieves(g.MaskThieves),
+		server.WithGuard(g.GuardPrice, g.GuardTime, g.GuardProtection),
+		server.WithPlayerLimits(g.MinPlayers, g.MaxPlayers),
+		server.WithAutoStart(g.AutoStart),
+		server.WithStartCountdown(g.StartCountdown),
+		server.WithTeams(g.Teams),
+		server.WithGameMode(mode, g.CoopTarget),
+		server.WithWinCondition(winCondition, g.WinTarget),
+		server.WithTimeTicks(g.TimeTick),
+		server.WithInterestMode(interestMode, g.InterestTicks),
+		server.WithDepositPenalty(g.DepositPenalty),
+		server.WithJackpot(g.JackpotPercentage, g.JackpotChance),
+		server.WithQuestionTime(g.QuestionTime),
+		server.WithQuestionMaxBid(g.QuestionMaxBid),
+		server.WithUsernameRules(g.UsernameMinLength, g.UsernameMaxLength, usernamePolicy),
+		server.WithAFK(g.AFKTime, afkPolicy),
+		server.WithUserRateLimit(c.Limits.UserRate, c.Limits.UserBurst),
+		server.WithPeerRateLimit(c.Limits.PeerRate, c.Limits.PeerBurst),
+	), nil
+}
+
+// ServerOptions returns the options of the server, which don't need
+// resources owned by the caller. The storage and the question provider
+// are left to the caller, since they have to be closed or reloaded.
+func (c Config) ServerOptions(logger *zap.Logger) ([]server.ServerOption, error) {
+	strategy, err := server.NewBankStrategy(c.Game.BankStrategy)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := c.botProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []server.ServerOption{
+		server.WithLogger(logger),
+		server.WithBankStrategy(strategy),
+		server.WithBanDuration(time.Duration(c.BanDuration) * time.Second),
+		server.WithHeartbeat(time.Duration(c.Heartbeat) * time.Second),
+		server.WithLogSampling(c.LogSampling),
+		server.WithSeed(c.Seed),
+		server.WithMatchmaking(c.Matchmaking.Size, c.Matchmaking.Window, c.Matchmaking.Growth),
+		server.WithBotFill(c.Bots.Fill),
+		server.WithBotProfiles(profiles...),
+	}
+	for name, game := range c.Presets {
+		preset, err := c.gameConfig(game)
+		if err != nil {
+			return nil, fmt.Errorf("invalid preset %s: %v", name, err)
+		}
+		opts = append(opts, server.WithPreset(name, preset))
+	}
+	for locale, messages := range c.Messages {
+		opts = append(opts, server.WithMessages(locale, messages))
+	}
+	if c.AdminToken != "" {
+		opts = append(opts, server.WithAdminToken(c.AdminToken))
+	}
+	if c.Metrics != "" {
+		opts = append(opts, server.WithMetrics(c.Metrics))
+	}
+	if c.GRPCWeb != "" {
+		opts = append(opts, server.WithGRPCWeb(c.GRPCWeb))
+	}
+	if c.Debug != "" {
+		opts = append(opts, server.WithDebug(c.Debug))
+	}
+	if c.AuthSecret != "" {
+		opts = append(opts, server.WithAuthSecret([]byte(c.AuthSecret)))
+	}
+	if c.BannedWords != "" {
+		words, err := server.LoadWordList(c.BannedWords)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, server.WithWordFilter(words))
+	}
+	if c.TLS.Cert != "" {
+		tlsConfig, err := server.LoadTLSConfig(c.TLS.Cert, c.TLS.Key, c.TLS.ClientCA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS config: %v", err)
+		}
+		opts = append(opts, server.WithTLS(tlsConfig))
+	}
+	return opts, nil
+}
+
+// botProfiles returns the built-in bot profiles with the configured names.
+func (c Config) botProfiles() ([]server.BotProfile, error) {
+	known := []server.BotProfile{
+		server.BalancedBotProfile,
+		server.ConservativeBotProfile,
+		server.AggressiveBotProfile,
+		server.GamblerBotProfile,
+	}
+
+	var profiles []server.BotProfile
+	for _, name := range c.Bots.Profiles {
+		found := false
+		for _, profile := range known {
+			if profile.Name == name {
+				profiles = append(profiles, profile)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("bot profile has to be conservative, aggressive, gambler, or balanced, received: %q", name)
+		}
+	}
+	return profiles, nil
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cs489-team11/server"
+)
+
+// LoadWithEnv reads the config from the YAML file, or takes the defaults,
+// if the path is empty, and overrides its settings with the environment
+// variables returned by lookup, e.g. os.LookupEnv. Variables are named
+// by the env tags of the fields of Config. Lists are comma-separated, and
+// tax brackets are in threshold:percentage format.
+func LoadWithEnv(path string, lookup func(string) (string, bool)) (Config, error) {
+	c := Default()
+	if path != "" {
+		var err error
+		if c, err = read(path); err != nil {
+			return Config{}, err
+		}
+	}
+	if err := applyEnv(reflect.ValueOf(&c).Elem(), lookup); err != nil {
+		return Config{}, err
+	}
+	if err := c.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %v", err)
+	}
+	return c, nil
+}
+
+// applyEnv sets the fields of the struct, which have the env tag, from
+// the variables, which are set. Nested structs are walked recursively.
+func applyEnv(v reflect.Value, lookup func(string) (string, bool)) error {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name, ok := field.Tag.Lookup("env")
+		if !ok {
+			if field.Type.Kind() == reflect.Struct {
+				if err := applyEnv(v.Field(i), lookup); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		value, ok := lookup(name)
+		if !ok {
+			continue
+		}
+		if err := setEnvValue(v.Field(i), value); err != nil {
+			return fmt.Errorf("invalid value of %s environment variable (%q): %v", name, value, err)
+		}
+	}
+	return nil
+}
+
+// setEnvValue parses the value of the environment variable into the field.
+func setEnvValue(field reflect.Value, value string) error {
+	switch field.Interface().(type) {
+	case string:
+		field.SetString(value)
+	case bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case int32, int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case []string:
+		field.Set(reflect.ValueOf(splitList(value)))
+	case []int32:
+		var list []int32
+		for _, item := range splitList(value) {
+			n, err := strconv.ParseInt(item, 10, 32)
+			if err != nil {
+				return err
+			}
+			list = append(list, int32(n))
+		}
+		field.Set(reflect.ValueOf(list))
+	case []server.TaxBracket:
+		var brackets []server.TaxBracket
+		for _, item := range splitList(value) {
+			parts := strings.Split(item, ":")
+			if len(parts) != 2 {
+				return fmt.Errorf("%s is not a tax bracket in threshold:percentage format", item)
+			}
+			threshold, err := strconv.ParseInt(parts[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			percentage, err := strconv.ParseInt(parts[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			brackets = append(brackets, server.TaxBracket{Threshold: int32(threshold), Percentage: int32(percentage)})
+		}
+		field.Set(reflect.ValueOf(brackets))
+	default:
+		return fmt.Errorf("unsupported type %v", field.Type())
+	}
+	return nil
+}
+
+// splitList splits the comma-separated list, which is empty, if the value is.
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+)
+
+// getCoopProgress returns the growth of the net worth of all players
+// since the start of the game. The calling function has to acquire
+// at least read lock.
+func (g *game) getCoopProgress() int32 {
+	progress := int32(0)
+	for _, player := range g.players {
+		progress += player.getNetWorth() - g.config.playerPoints
+	}
+	return progress
+}
+
+// checkCoopProgress broadcasts the collective progress of the players,
+// if it has changed after the event, which has just been applied to the
+// active game in co-op mode. Once the players reach the target, the game
+// is finished by its timer right away. The calling function has to
+// acquire write lock.
+func (g *game) checkCoopProgress() {
+	if g.config.mode != CoopMode || g.state != activeState {
+		return
+	}
+	progress := g.getCoopProgress()
+	if progress == g.coopProgress {
+		return
+	}
+	g.coopProgress = progress
+
+	if !g.coopReached && progress >= g.config.coopTarget {
+		g.coopReached = true
+		g.logger.Info("Co-op target is reached", zap.Int32("progress", progress))
+		// timer is not set yet, if the game is just being started,
+		// and it is never set for the replayed journal
+		if g.finishTimer != nil {
+			g.finishTimer.Reset(0)
+		}
+	}
+
+	msg := getCoopProgressMessage(g.getPBCoopProgress())
+	go func() {
+		g.broadcast(msg)
+	}()
+}
+
+// getPBCoopProgress returns the collective progress of the players or
+// nil, if the game is competitive. The calling function has to acquire
+// at least read lock.
+func (g *game) getPBCoopProgress() *pb.CoopProgress {
+	if g.config.mode != CoopMode {
+		return nil
+	}
+	return &pb.CoopProgress{
+		Progress: g.getCoopProgress(),
+		Target:   g.config.coopTarget,
+		Reached:  g.coopReached,
+	}
+}
+
+func getCoopProgressMessage(progress *pb.CoopProgress) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_CoopProgress{
+			CoopProgress: progress,
+		},
+	}
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+)
+
+// Seconds between the moment, when the game with auto start gets
+// full, and the start of the game, unless start countdown is configured.
+const autoStartCountdown = 3
+
+// Bounds of the configurable start countdown in seconds.
+const (
+	minStartCountdown = 5
+	maxStartCountdown = 10
+)
+
+// startCountdown is the countdown to the start of the waiting game or the room.
+type startCountdown struct {
+	config GameConfig // config, with which the game will be started
+	auto   bool       // true, if the game has got full and has auto start
+}
+
+// isFull returns true, if the game has max players.
+func (g *game) isFull() bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return g.config.maxPlayers > 0 && int32(len(g.players)) >= g.config.maxPlayers
+}
+
+// beginCountdown sets the countdown of the game. Returns false,
+// if the game is already counting down or is not waiting anymore.
+func (g *game) beginCountdown(countdown *startCountdown) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.countdown != nil || g.state != waitingState {
+		return false
+	}
+	g.countdown = countdown
+	return true
+}
+
+// isCountingDown returns true, if the game
+// is still counting down with the countdown.
+func (g *game) isCountingDown(countdown *startCountdown) bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return g.countdown == countdown
+}
+
+// endCountdown removes the countdown of the game. Returns false, if
+// the game is not counting down with it anymore, e.g. if the countdown
+// has been cancelled.
+func (g *game) endCountdown(countdown *startCountdown) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.countdown != countdown {
+		return false
+	}
+	g.countdown = nil
+	return true
+}
+
+// cancelStart cancels the countdown to the start of the game
+// on behalf of the host and notifies the players.
+func (g *game) cancelStart(hostID userID) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if hostID == "" || g.hostID != hostID {
+		return fmt.Errorf("%w can cancel the start of the game", errNotHost)
+	}
+	if g.countdown == nil {
+		return fmt.Errorf("game is not counting down to the start")
+	}
+	g.countdown = nil
+	g.logger.Info("Start of the game is cancelled by the host")
+
+	go g.broadcast(getCountdownMessage(0, true))
+	return nil
+}
+
+// getCountdownTime returns seconds of the countdown before the start of
+// the game. When the game is started by its host, the game starts right
+// away, if the countdown is not configured.
+func (c GameConfig) getCountdownTime(auto bool) int32 {
+	if auto && c.startCountdown == 0 {
+		return autoStartCountdown
+	}
+	return c.startCountdown
+}
+
+// scheduleAutoStart begins the countdown to the start of the waiting game
+// or the room, if it has auto start and has just got full.
+func (s *Server) scheduleAutoStart(game *game) {
+	if !game.config.autoStart || !game.isFull() {
+		return
+	}
+	countdown := &startCountdown{config: game.config, auto: true}
+	if !game.beginCountdown(countdown) {
+		return
+	}
+	game.logger.Info("Game is full, counting down to the start")
+	s.countDown(game, countdown, game.config.getCountdownTime(true))
+}
+
+// countDown broadcasts the remaining seconds every second and starts
+// the game, when they run out. The countdown is cancelled, if the game
+// gets fewer players than needed to start it, and it is stopped, if
+// it has been cancelled by the host.
+func (s *Server) countDown(game *game, countdown *startCountdown, remainingTime int32) {
+	if !game.isCountingDown(countdown) {
+		return
+	}
+	if !game.isWaiting() {
+		game.endCountdown(countdown)
+		return
+	}
+	playerCount := int32(game.getPlayerCount())
+	if (countdown.auto && !game.isFull()) || playerCount < countdown.config.minPlayers {
+		if game.endCountdown(countdown) {
+			game.logger.Info("Countdown is cancelled, since players have left the game")
+			go game.broadcast(getCountdownMessage(0, true))
+		}
+		return
+	}
+	if remainingTime <= 0 {
+		s.startCountedDown(game, countdown)
+		return
+	}
+
+	go game.broadcast(getCountdownMessage(remainingTime, false))
+	s.clock.AfterFunc(time.Second, func() {
+		s.countDown(game, countdown, remainingTime-1)
+	})
+}
+
+// startCountedDown starts the waiting game or the room with the config
+// of the countdown. Nothing is done, if the countdown has been cancelled.
+func (s *Server) startCountedDown(game *game, countdown *startCountdown) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.shuttingDown {
+		return
+	}
+	if _, ok := s.rooms[game.gameID]; !ok && s.waitingGame != game {
+		return
+	}
+	if !game.endCountdown(countdown) {
+		return
+	}
+	game.logger.Info("Game is started after the countdown", zap.Int("players", game.getPlayerCount()))
+	s.startLobby(game, countdown.config)
+}
+
+func getCountdownMessage(remainingTime int32, cancelled bool) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Countdown_{
+			Countdown: &pb.StreamResponse_Countdown{
+				RemainingTime: remainingTime,
+				Cancelled:     cancelled,
+			},
+		},
+	}
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Sampling rates of the lock contention and blocking profiles,
+// which are enabled only together with the debug endpoint.
+const (
+	debugMutexProfileFraction = 10
+	debugBlockProfileRate     = int(time.Millisecond)
+)
+
+// WithDebug makes server serve pprof profiles and the runtime info on
+// provided address, which has to be a loopback one, e.g. localhost:6060.
+// Profiles of lock contention and blocking are enabled as well.
+// The endpoint is started by Listen.
+func WithDebug(addr string) ServerOption {
+	return func(s *Server) {
+		s.debugAddr = addr
+	}
+}
+
+// debugInfo is the runtime info served on /debug/info as JSON.
+type debugInfo struct {
+	Goroutines     int         `json:"goroutines"`
+	ActiveGames    int         `json:"active_games"`
+	Rooms          int         `json:"rooms"`
+	Tournaments    int         `json:"tournaments"`
+	WaitingPlayers int         `json:"waiting_players"`
+	Games          []debugGame `json:"games"`
+}
+
+// debugGame is the info of the active game or the room.
+type debugGame struct {
+	GameID  string `json:"game_id"`
+	Players int    `json:"players"`
+	Streams int    `json:"streams"` // each open stream holds a goroutine
+}
+
+// listenDebug starts the HTTP endpoint with pprof profiles and
+// the runtime info, if the server has been created with WithDebug option.
+func (s *Server) listenDebug() error {
+	if s.debugAddr == "" {
+		return nil
+	}
+	if err := checkLoopbackAddr(s.debugAddr); err != nil {
+		s.logger.Error("Invalid debug address", zap.Error(err))
+		return err
+	}
+
+	listener, err := net.Listen("tcp", s.debugAddr)
+	if err != nil {
+		s.logger.Error("Failed to init debug listener", zap.Error(err))
+		return err
+	}
+	s.logger.Info("Initialized debug listener", zap.String("addr", listener.Addr().String()))
+
+	runtime.SetMutexProfileFraction(debugMutexProfileFraction)
+	runtime.SetBlockProfileRate(debugBlockProfileRate)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/info", s.serveDebugInfo)
+	s.debugListener = listener
+	s.debugServer = &http.Server{Handler: mux}
+	go s.debugServer.Serve(listener)
+	return nil
+}
+
+// checkLoopbackAddr returns error, unless the host of
+// the address is localhost or a loopback IP.
+func checkLoopbackAddr(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return fmt.Errorf("debug endpoint has to listen on loopback address, received: %s", addr)
+	}
+	return nil
+}
+
+// DebugAddr returns the address of the debug endpoint
+// or empty string, if it is not enabled.
+func (s *Server) DebugAddr() string {
+	if s.debugListener == nil {
+		return ""
+	}
+	return s.debugListener.Addr().String()
+}
+
+func (s *Server) serveDebugInfo(w http.ResponseWriter, _ *http.Request) {
+	s.mutex.RLock()
+	info := debugInfo{
+		Goroutines:  runtime.NumGoroutine(),
+		ActiveGames: len(s.activeGames),
+		Rooms:       len(s.rooms),
+		Tournaments: len(s.tournaments),
+	}
+	waitingGame := s.waitingGame
+	games := make([]*game, 0, len(s.activeGames)+len(s.rooms))
+	for _, game := range s.activeGames {
+		games = append(games, game)
+	}
+	for _, room := range s.rooms {
+		games = append(games, room)
+	}
+	s.mutex.RUnlock()
+
+	info.WaitingPlayers = waitingGame.getPlayerCount()
+	info.Games = make([]debugGame, 0, len(games))
+	for _, game := range games {
+		players, streams := game.getStreamCount()
+		info.Games = append(info.Games, debugGame{GameID: string(game.gameID), Players: players, Streams: streams})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(info); err != nil {
+		s.logger.Warn("Could not write debug info", zap.Error(err))
+	}
+}
+
+// getStreamCount returns the number of players
+// and the number of their open streams.
+func (g *game) getStreamCount() (int, int) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	streams := 0
+	for _, player := range g.players {
+		if player.stream != nil {
+			streams++
+		}
+	}
+	return len(g.players), streams
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Seconds, by which the game is extended after the vote.
+const extensionTime = 30
+
+// Maximal number of times the game can be extended.
+const maxExtensions = 3
+
+// getNeededVotes returns the number of votes, with which the game is
+// extended, i.e. the majority of the players, who are not bots. The
+// calling function has to acquire at least read lock.
+func (g *game) getNeededVotes() int32 {
+	voters := int32(0)
+	for _, player := range g.players {
+		if !player.bot {
+			voters++
+		}
+	}
+	return voters/2 + 1
+}
+
+// getEndTime returns the time, when the active game finishes.
+// The calling function has to acquire at least read lock.
+func (g *game) getEndTime() time.Time {
+	return g.startTime.Add(time.Duration(g.config.duration) * time.Second)
+}
+
+// voteExtension records the vote of the player to extend the active game.
+// Once the majority has voted, the duration of the game is extended and
+// the finish timer is rescheduled. All players are notified with the
+// Extension event. Returns the votes, the needed votes, and true, if
+// the game has been extended.
+func (g *game) voteExtension(userID userID) (int32, int32, bool, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state != activeState {
+		return 0, 0, false, fmt.Errorf("only active game can be extended")
+	}
+	player, ok := g.players[userID]
+	if !ok {
+		return 0, 0, false, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if player.bot {
+		return 0, 0, false, fmt.Errorf("bots cannot vote")
+	}
+	if g.extensions >= maxExtensions {
+		return 0, 0, false, fmt.Errorf("game cannot be extended more than %d times", maxExtensions)
+	}
+	if g.extensionVotes[userID] {
+		return 0, 0, false, fmt.Errorf("player has already voted to extend the game")
+	}
+
+	g.extensionVotes[userID] = true
+	votes := int32(len(g.extensionVotes))
+	needed := g.getNeededVotes()
+	extended := false
+	if votes >= needed {
+		// the timer, which has already fired, is finishing the game
+		if g.finishTimer == nil || !g.finishTimer.Stop() {
+			return 0, 0, false, fmt.Errorf("game is already finishing")
+		}
+		g.config.duration += extensionTime
+		g.finishTimer.Reset(g.getEndTime().Sub(g.clock.Now()))
+		g.extensions++
+		for voterID := range g.extensionVotes {
+			delete(g.extensionVotes, voterID)
+		}
+		extended = true
+		g.persist()
+		g.logger.Info("Game is extended", zap.Int32("duration", g.config.duration))
+	}
+
+	msg := g.getExtensionMessage(userID, votes, needed, extended)
+	go func() {
+		g.broadcast(msg)
+	}()
+
+	return votes, needed, extended, nil
+}
+
+// getExtensionMessage returns the event about the vote of the player.
+// The calling function has to acquire at least read lock.
+func (g *game) getExtensionMessage(userID userID, votes int32, needed int32, extended bool) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Extension_{
+			Extension: &pb.StreamResponse_Extension{
+				UserId:        string(userID),
+				Votes:         votes,
+				Needed:        needed,
+				Extended:      extended,
+				EndTime:       g.getEndTime().UnixNano() / int64(time.Millisecond),
+				RemainingTime: g.getRemainingTime(),
+			},
+		},
+	}
+}
+
+// ExtendGame votes to extend the active game on behalf of the player.
+func (s *Server) ExtendGame(_ context.Context, req *pb.ExtendGameRequest) (*pb.ExtendGameResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+	votes, needed, extended, err := game.voteExtension(reqUserID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.ExtendGameResponse{Votes: votes, Needed: needed, Extended: extended}, nil
+}
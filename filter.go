@@ -0,0 +1,105 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// WordFilter finds the words, which are not allowed in usernames and chat
+// messages. It has to be safe for concurrent use.
+type WordFilter interface {
+	// Filter returns the text with every word, which is not allowed,
+	// masked by asterisks, and whether any word has been masked.
+	Filter(text string) (string, bool)
+}
+
+// WithWordFilter makes the server reject usernames and mask chat messages,
+// which contain the words found by provided filter. Without this option,
+// usernames and chat messages are not filtered.
+func WithWordFilter(filter WordFilter) ServerOption {
+	return func(s *Server) {
+		s.wordFilter = filter
+	}
+}
+
+// WordList is the filter of the words from the list. Words are matched
+// as a whole and regardless of the case, so that "class" doesn't match
+// "ass". Words are sequences of letters and digits, so "bad_word" is
+// checked as "bad" and "word".
+type WordList struct {
+	words map[string]bool
+}
+
+// NewWordList returns the filter of provided words.
+func NewWordList(words []string) *WordList {
+	l := &WordList{words: make(map[string]bool, len(words))}
+	for _, word := range words {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			l.words[word] = true
+		}
+	}
+	return l
+}
+
+// LoadWordList returns the filter of the words from the file with one
+// word per line. Empty lines and lines starting with "#" are skipped.
+func LoadWordList(path string) (*WordList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open word list: %v", err)
+	}
+	defer file.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read word list: %v", err)
+	}
+	return NewWordList(words), nil
+}
+
+// Len returns the number of words in the list.
+func (l *WordList) Len() int {
+	return len(l.words)
+}
+
+// Filter masks the words from the list by asterisks, one per character.
+func (l *WordList) Filter(text string) (string, bool) {
+	runes := []rune(text)
+	masked := false
+	for start := 0; start < len(runes); {
+		if !isWordRune(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+		if l.words[strings.ToLower(string(runes[start:end]))] {
+			for i := start; i < end; i++ {
+				runes[i] = '*'
+			}
+			masked = true
+		}
+		start = end
+	}
+	if !masked {
+		return text, false
+	}
+	return string(runes), true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -1,20 +1,66 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
 	"math"
-	"reflect"
+	"math/rand"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cs489-team11/server/pb"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/label"
+	"go.uber.org/zap"
 )
 
 type gameID string
 type gameState int
 
+// InterestMode defines how the interest of credits and deposits is charged.
+type InterestMode int32
+
+const (
+	// SimpleInterest is charged only on the initial value.
+	SimpleInterest InterestMode = InterestMode(pb.InterestMode_INTEREST_MODE_SIMPLE)
+	// CompoundInterest is charged every tick on the value
+	// together with the interest of the previous ticks.
+	CompoundInterest InterestMode = InterestMode(pb.InterestMode_INTEREST_MODE_COMPOUND)
+)
+
+// GameMode defines how the players win the game.
+type GameMode int32
+
+const (
+	// CompetitiveMode is won by the player or the team with the most points.
+	CompetitiveMode GameMode = GameMode(pb.GameMode_GAME_MODE_COMPETITIVE)
+	// CoopMode is won by all players together, if they extract
+	// the target amount of points from the bank in time.
+	CoopMode GameMode = GameMode(pb.GameMode_GAME_MODE_COOP)
+)
+
+// WinCondition defines, how the winner of the competitive
+// game without teams is decided.
+type WinCondition int32
+
+const (
+	// WinByPoints is won by the player with the most points,
+	// when the time runs out.
+	WinByPoints WinCondition = WinCondition(pb.WinCondition_WIN_CONDITION_POINTS)
+	// WinByNetWorth is won by the player with the highest net
+	// worth, when the time runs out.
+	WinByNetWorth WinCondition = WinCondition(pb.WinCondition_WIN_CONDITION_NET_WORTH)
+	// WinByTarget is won by the first player, whose net worth reaches
+	// the target, or by the highest net worth, when the time runs out.
+	WinByTarget WinCondition = WinCondition(pb.WinCondition_WIN_CONDITION_TARGET)
+	// WinByLastSolvent is won by the last player with positive net worth,
+	// or by the highest net worth, when the time runs out.
+	WinByLastSolvent WinCondition = WinCondition(pb.WinCondition_WIN_CONDITION_LAST_SOLVENT)
+)
+
 const (
 	waitingState gameState = iota
 	activeState
@@ -30,12 +76,350 @@ type GameConfig struct {
 	creditInterest        int32
 	depositInterest       int32
 	creditTime            int32
+	creditLimit           int32 // maximum outstanding credits as percentage of player's net worth
 	depositTime           int32
+	depositPenalty        int32 // percentage of the deposit kept by the bank on early withdrawal
+	interestMode          InterestMode
+	interestTicks         int32 // number of compounding ticks during the credit or deposit time
 	theftTime             int32
 	theftPercentage       int32
 	lotteryTime           int32
 	lotteryMaxWin         int32
+	lotteryRows           int32
+	lotteryColumns        int32
+	lotteryPayouts        []int32 // percentages of the max win, default payouts are used, if empty
+	jackpotPercentage     int32   // share of lost lottery max wins and question bids put into the jackpot
+	jackpotChance         int32   // chance of winning the jackpot in the lottery as percentage
 	questionWinPercentage int32
+	questionTime          int32    // seconds to answer the question, 0 means no deadline
+	questionMaxBid        int32    // maximum bid as percentage of player's points, 0 means no cap
+	marketAssets          []string // there is no market, if empty
+	marketUnits           int32    // units of every asset, which each player has at the start
+	stockPrice            int32    // initial price of the share, there is no stock, if 0
+	stockVolatility       int32    // maximum change of the price in one tick as percentage
+	stockTickTime         int32    // seconds between the changes of the price
+	insurancePremium      int32    // points paid for the insurance, there is no insurance, if 0
+	insuranceCoverage     int32    // percentage of stolen points paid back to the insured player
+	taxTime               int32    // seconds between the collections of the tax, there is no tax, if 0
+	taxBrackets           []TaxBracket
+	inflationRate         int32 // percentage, by which the price level rises, there is no inflation, if 0
+	inflationTime         int32 // seconds between the rises of the price level
+	stealChance           int32 // chance of the successful steal as percentage, players cannot steal, if 0
+	stealPenalty          int32 // percentage of the points, which the caught thief pays to the target
+	maskThieves           bool  // thieves, who are not caught, are not revealed to other players
+	guardPrice            int32 // points paid for the guard, there are no guards, if 0
+	guardTime             int32 // seconds, for which the guard protects the player
+	guardProtection       int32 // percentage, by which the guard reduces thefts
+	minPlayers            int32 // the game cannot be started with fewer players
+	maxPlayers            int32 // players cannot join the full game, not limited, if 0
+	autoStart             bool  // the full game is started without Start request
+	startCountdown        int32 // seconds between Start request and the start of the game
+	teamCount             int32 // players are split into that many teams, there are no teams, if 0
+	mode                  GameMode
+	coopTarget            int32 // points, which the players have to extract together in co-op mode
+	practice              bool  // the game doesn't count toward ratings, leaderboards, stats, and achievements
+	winCondition          WinCondition
+	winTarget             int32 // net worth, which wins the game with WinByTarget condition
+	timeTickTime          int32 // seconds between the broadcasts of the remaining time, not broadcast, if 0
+	usernameMinLength     int32 // in characters
+	usernameMaxLength     int32
+	usernamePolicy        UsernamePolicy
+	afkTime               int32 // seconds without actions, after which the player is AFK, not detected, if 0
+	afkPolicy             AFKPolicy
+
+	// rate limits are taken only from the config of the server,
+	// so that players cannot override them; 0 rate means no limit
+	userRateLimit int32 // requests per second of a single player
+	userRateBurst int32
+	peerRateLimit int32 // requests per second from a single address
+	peerRateBurst int32
+}
+
+// Players can owe up to twice their net worth,
+// unless credit limit is configured otherwise.
+const defaultCreditLimit = 200
+
+// Lottery grid is 3x3, unless it is configured otherwise.
+const (
+	defaultLotteryRows    = 3
+	defaultLotteryColumns = 3
+	maxLotteryGridSize    = 10 // maximum number of rows or columns
+)
+
+// GameConfigOption sets optional parameters of a GameConfig.
+type GameConfigOption func(*GameConfig)
+
+// WithUserRateLimit allows each player to send rate requests per
+// second on average with bursts of up to burst requests.
+// Without this option, requests of players are not limited.
+func WithUserRateLimit(rate int32, burst int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.userRateLimit = rate
+		c.userRateBurst = burst
+	}
+}
+
+// WithPeerRateLimit allows each client address to send rate requests
+// per second on average with bursts of up to burst requests.
+// Without this option, requests from addresses are not limited.
+func WithPeerRateLimit(rate int32, burst int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.peerRateLimit = rate
+		c.peerRateBurst = burst
+	}
+}
+
+// WithLotteryGrid sets dimensions of the lottery grid.
+// Without this option, the grid is 3x3.
+func WithLotteryGrid(rows int32, columns int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.lotteryRows = rows
+		c.lotteryColumns = columns
+	}
+}
+
+// WithLotteryPayouts sets payout table of the lottery as percentages of
+// the max win. Each payout is won in about the same number of cells, so
+// payouts, which have to be won more often, are repeated in the table.
+// Without this option, payouts are 0, 20, 30, 60, and 100 percent.
+func WithLotteryPayouts(payouts ...int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.lotteryPayouts = payouts
+	}
+}
+
+// WithMarket makes the game have the market, where players trade
+// provided assets with each other. Each player starts with provided
+// number of units of every asset. Without this option, there is no market.
+func WithMarket(assets []string, units int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.marketAssets = assets
+		c.marketUnits = units
+	}
+}
+
+// WithStock makes the game have the stock, shares of which players buy from
+// and sell to the bank. The price of the share starts at provided price, and
+// every provided number of seconds it changes randomly by up to provided
+// percentage. Without this option, there is no stock.
+func WithStock(price int32, volatility int32, tickTime int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.stockPrice = price
+		c.stockVolatility = volatility
+		c.stockTickTime = tickTime
+	}
+}
+
+// WithInsurance lets players insure themselves against the next theft by
+// paying provided premium to the bank. When the theft or the successful
+// steal happens, provided percentage of the stolen points is paid back
+// to every insured player, who has lost them.
+// Without this option, there is no insurance.
+func WithInsurance(premium int32, coverage int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.insurancePremium = premium
+		c.insuranceCoverage = coverage
+	}
+}
+
+// WithTax makes the game collect the wealth tax from the points of every
+// player each provided number of seconds. Points are taxed marginally by
+// provided brackets, which have to be sorted by their thresholds.
+// Without this option, there is no tax.
+func WithTax(tickTime int32, brackets []TaxBracket) GameConfigOption {
+	return func(c *GameConfig) {
+		c.taxTime = tickTime
+		c.taxBrackets = brackets
+	}
+}
+
+// WithInflation makes the price level of the game rise by provided percentage
+// every provided number of seconds. Lottery and question payouts are scaled
+// by the price level, so points lose their value over time.
+// Without this option, there is no inflation.
+func WithInflation(rate int32, tickTime int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.inflationRate = rate
+		c.inflationTime = tickTime
+	}
+}
+
+// WithSteal lets players steal theft percentage of the points of the
+// chosen player once per theft time. The steal succeeds with provided
+// chance (in percents). Otherwise, the thief pays provided percentage of
+// the points, which he tried to steal, to the target. Without this option,
+// players cannot steal.
+func WithSteal(chance int32, penalty int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.stealChance = chance
+		c.stealPenalty = penalty
+	}
+}
+
+// WithMaskedThieves hides the thieves, who have not been caught, in
+// steal events broadcast to the players, if "masked" is true. Without
+// this option, thieves are always revealed.
+func WithMaskedThieves(masked bool) GameConfigOption {
+	return func(c *GameConfig) {
+		c.maskThieves = masked
+	}
+}
+
+// WithGuard lets players hire the guard for provided price, which protects
+// them for provided number of seconds. The guard reduces points stolen in
+// thefts and the chance to steal from the player by provided percentage.
+// Without this option, there are no guards.
+func WithGuard(price int32, seconds int32, protection int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.guardPrice = price
+		c.guardTime = seconds
+		c.guardProtection = protection
+	}
+}
+
+// WithPlayerLimits makes the game refuse to start with less than min
+// players and refuse new players, when it has max players. Max equal
+// to 0 means that the number of players is not limited.
+// Without this option, games can be started by a single player, and
+// the number of players is not limited.
+func WithPlayerLimits(min int32, max int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.minPlayers = min
+		c.maxPlayers = max
+	}
+}
+
+// WithAutoStart makes the waiting game or the room start automatically
+// after a short countdown, once max players have joined it, if "autoStart"
+// is true. Max players have to be limited for auto start.
+// Without this option, games are started only by their hosts.
+func WithAutoStart(autoStart bool) GameConfigOption {
+	return func(c *GameConfig) {
+		c.autoStart = autoStart
+	}
+}
+
+// WithPractice makes the game a practice, if "practice" is true. Practice
+// can be started with fewer than min players, and it doesn't count toward
+// ratings, leaderboards, stats, achievements, and challenges. Without this
+// option, games count.
+func WithPractice(practice bool) GameConfigOption {
+	return func(c *GameConfig) {
+		c.practice = practice
+	}
+}
+
+// WithStartCountdown makes the game start provided number of seconds
+// after the host has requested it. Remaining seconds are broadcast to the
+// players every second, and the host can cancel the start in the meantime.
+// The countdown has to be from 5 to 10 seconds. It is used by auto start
+// as well. Without this option, the game starts right after the request.
+func WithStartCountdown(seconds int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.startCountdown = seconds
+	}
+}
+
+// WithTeams splits players of the game into provided number of teams,
+// which pool their points. The team with the most points wins the game.
+// Without this option, there are no teams.
+func WithTeams(count int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.teamCount = count
+	}
+}
+
+// WithGameMode sets how the players win the game. In co-op mode, the
+// players win together, if their net worth grows by provided target in
+// total, and the game finishes as soon as it does. The target is ignored
+// in competitive mode. Without this option, the game is competitive.
+func WithGameMode(mode GameMode, coopTarget int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.mode = mode
+		c.coopTarget = coopTarget
+	}
+}
+
+// WithWinCondition sets how the winner of the competitive game without
+// teams is decided. With WinByTarget and WinByLastSolvent conditions, the
+// game finishes as soon as the condition is met. The target is ignored by
+// other conditions. Without this option, the player with the most points
+// wins, when the time runs out.
+func WithWinCondition(condition WinCondition, target int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.winCondition = condition
+		c.winTarget = target
+	}
+}
+
+// WithTimeTicks makes the active game broadcast the remaining time every
+// provided number of seconds and when one minute and ten seconds are
+// left. Without this option, the remaining time is not broadcast.
+func WithTimeTicks(seconds int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.timeTickTime = seconds
+	}
+}
+
+// WithJackpot makes the game accumulate the jackpot, which grows by provided
+// percentage of the lottery max win every time the lottery is played without
+// winning and by the same percentage of the bid of every lost question.
+// Every lottery play wins the jackpot with provided chance (in percents).
+// Without this option, there is no jackpot.
+func WithJackpot(percentage int32, chance int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.jackpotPercentage = percentage
+		c.jackpotChance = chance
+	}
+}
+
+// WithCreditLimit allows players to have outstanding credits of up to
+// provided percentage of their net worth, i.e. their points together with
+// their deposits without their credits. Without this option, the limit is
+// 200 percent of the net worth.
+func WithCreditLimit(percentage int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.creditLimit = percentage
+	}
+}
+
+// WithInterestMode sets how the interest of credits and deposits is charged.
+// In compound mode, interest is compounded provided number of ticks during
+// the credit or deposit time, and interest from the config is split evenly
+// between the ticks. Ticks are ignored in simple mode.
+// Without this option, interest is simple.
+func WithInterestMode(mode InterestMode, ticks int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.interestMode = mode
+		c.interestTicks = ticks
+	}
+}
+
+// WithDepositPenalty makes the bank keep provided percentage of
+// the deposit, which is withdrawn before its time ends. Without
+// this option, early withdrawal only loses the interest.
+func WithDepositPenalty(percentage int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.depositPenalty = percentage
+	}
+}
+
+// WithQuestionTime gives players provided number of seconds to answer
+// generated questions. Bids of questions, which are not answered in
+// time, stay in the bank. Without this option, there is no deadline.
+func WithQuestionTime(seconds int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.questionTime = seconds
+	}
+}
+
+// WithQuestionMaxBid allows players to bid at most provided percentage
+// of their current points on a question. Without this option,
+// players can bid all of their points.
+func WithQuestionMaxBid(percentage int32) GameConfigOption {
+	return func(c *GameConfig) {
+		c.questionMaxBid = percentage
+	}
 }
 
 // NewGameConfig returns pointer to a newly created
@@ -53,20 +437,509 @@ func NewGameConfig(
 	lotteryTime int32,
 	lotteryMaxWin int32,
 	questionWinPercentage int32,
+	opts ...GameConfigOption,
 ) GameConfig {
-	return GameConfig{
+	c := GameConfig{
 		duration:              duration,
 		playerPoints:          playerPoints,
 		bankPointsPerPlayer:   bankPointsPerPlayer,
 		creditInterest:        creditInterest,
 		depositInterest:       depositInterest,
 		creditTime:            creditTime,
+		creditLimit:           defaultCreditLimit,
 		depositTime:           depositTime,
 		theftTime:             theftTime,
 		theftPercentage:       theftPercentage,
 		lotteryTime:           lotteryTime,
 		lotteryMaxWin:         lotteryMaxWin,
+		lotteryRows:           defaultLotteryRows,
+		lotteryColumns:        defaultLotteryColumns,
 		questionWinPercentage: questionWinPercentage,
+		minPlayers:            1,
+		usernameMinLength:     defaultUsernameMinLength,
+		usernameMaxLength:     defaultUsernameMaxLength,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// ConfigError lists the violations of the game logic found in the config.
+type ConfigError struct {
+	Violations []string
+}
+
+func (e *ConfigError) Error() string {
+	return strings.Join(e.Violations, "; ")
+}
+
+func (e *ConfigError) add(format string, args ...interface{}) {
+	e.Violations = append(e.Violations, fmt.Sprintf(format, args...))
+}
+
+// Validate checks that the config values are consistent with the game
+// logic. All found violations are returned as *ConfigError.
+func (c GameConfig) Validate() error {
+	errs := &ConfigError{}
+	positive := []struct {
+		name string
+		val  int32
+	}{
+		{"duration", c.duration},
+		{"player points", c.playerPoints},
+		{"bank points per player", c.bankPointsPerPlayer},
+		{"credit time", c.creditTime},
+		{"deposit time", c.depositTime},
+		{"theft time", c.theftTime},
+		{"lottery time", c.lotteryTime},
+	}
+	for _, field := range positive {
+		if field.val <= 0 {
+			errs.add("%s has to be positive, received: %d", field.name, field.val)
+		}
+	}
+
+	if c.creditInterest < 0 || c.depositInterest < 0 || c.theftPercentage < 0 ||
+		c.lotteryMaxWin < 0 || c.questionWinPercentage < 0 {
+		errs.add(
+			"credit (%d), deposit (%d), theft (%d) percentages, lottery max win (%d), "+
+				"and question win percentage (%d) cannot be negative",
+			c.creditInterest,
+			c.depositInterest,
+			c.theftPercentage,
+			c.lotteryMaxWin,
+			c.questionWinPercentage,
+		)
+	}
+
+	if c.creditInterest <= c.depositInterest {
+		errs.add(
+			"credit interest (%d) has to be larger than deposit interest (%d)",
+			c.creditInterest,
+			c.depositInterest,
+		)
+	}
+
+	if c.creditInterest >= 100 || c.depositInterest >= 100 || c.theftPercentage >= 100 {
+		errs.add(
+			"credit (%d), deposit (%d), theft (%d) percentages have to be less than 100 percent",
+			c.creditInterest,
+			c.depositInterest,
+			c.theftPercentage,
+		)
+	}
+
+	if c.creditTime >= c.duration || c.depositTime >= c.duration ||
+		c.theftTime >= c.duration || c.lotteryTime >= c.duration {
+		errs.add(
+			"credit (%d)sec, deposit (%d)sec, theft (%d)sec, lottery (%d)sec times have to be less than duration of a game (%d)",
+			c.creditTime,
+			c.depositTime,
+			c.theftTime,
+			c.lotteryTime,
+			c.duration,
+		)
+	}
+
+	if c.lotteryRows < 1 || c.lotteryRows > maxLotteryGridSize ||
+		c.lotteryColumns < 1 || c.lotteryColumns > maxLotteryGridSize ||
+		c.lotteryCellCount() < 2 {
+		errs.add(
+			"lottery grid (%dx%d) has to have at least 2 cells and from 1 to %d rows and columns",
+			c.lotteryRows,
+			c.lotteryColumns,
+			maxLotteryGridSize,
+		)
+	}
+
+	if len(c.lotteryPayouts) > maxLotteryGridSize*maxLotteryGridSize {
+		errs.add(
+			"lottery payout table cannot have more than %d payouts, received: %d",
+			maxLotteryGridSize*maxLotteryGridSize,
+			len(c.lotteryPayouts),
+		)
+	}
+	for _, payout := range c.lotteryPayouts {
+		if payout < 0 || payout > 100 {
+			errs.add("lottery payouts have to be from 0 to 100 percent, received: %d", payout)
+		}
+	}
+
+	if c.marketUnits < 0 {
+		errs.add("market units cannot be negative, received: %d", c.marketUnits)
+	}
+	marketAssets := make(map[string]bool)
+	for _, asset := range c.marketAssets {
+		if asset == "" || marketAssets[asset] {
+			errs.add("market assets have to be non-empty and unique, received: %q", c.marketAssets)
+		}
+		marketAssets[asset] = true
+	}
+
+	if c.stockPrice < 0 {
+		errs.add("stock price cannot be negative, received: %d", c.stockPrice)
+	}
+	if c.stockPrice > 0 && (c.stockVolatility < 0 || c.stockVolatility > 100 || c.stockTickTime <= 0) {
+		errs.add(
+			"stock volatility (%d) has to be from 0 to 100 percent and stock tick time (%d) has to be positive",
+			c.stockVolatility,
+			c.stockTickTime,
+		)
+	}
+
+	if c.insurancePremium < 0 || c.insuranceCoverage < 0 || c.insuranceCoverage > 100 {
+		errs.add(
+			"insurance premium (%d) cannot be negative and insurance coverage (%d) has to be from 0 to 100 percent",
+			c.insurancePremium,
+			c.insuranceCoverage,
+		)
+	}
+
+	if c.taxTime < 0 {
+		errs.add("tax time cannot be negative, received: %d", c.taxTime)
+	}
+	if c.taxTime > 0 && len(c.taxBrackets) == 0 {
+		errs.add("tax has to have at least one bracket")
+	}
+	for i, bracket := range c.taxBrackets {
+		if bracket.Threshold < 0 || bracket.Percentage < 0 || bracket.Percentage > 100 {
+			errs.add(
+				"tax bracket threshold (%d) cannot be negative and its percentage (%d) has to be from 0 to 100 percent",
+				bracket.Threshold,
+				bracket.Percentage,
+			)
+		}
+		if i > 0 && bracket.Threshold <= c.taxBrackets[i-1].Threshold {
+			errs.add("tax brackets have to be sorted by their thresholds")
+		}
+	}
+
+	if c.inflationRate < 0 {
+		errs.add("inflation rate cannot be negative, received: %d", c.inflationRate)
+	}
+	if c.inflationRate > 0 && c.inflationTime <= 0 {
+		errs.add("inflation time has to be positive, received: %d", c.inflationTime)
+	}
+
+	if c.stealChance < 0 || c.stealChance > 100 || c.stealPenalty < 0 || c.stealPenalty > 100 {
+		errs.add(
+			"steal chance (%d) and steal penalty (%d) have to be from 0 to 100 percent",
+			c.stealChance,
+			c.stealPenalty,
+		)
+	}
+
+	if c.guardPrice < 0 {
+		errs.add("guard price cannot be negative, received: %d", c.guardPrice)
+	}
+	if c.guardPrice > 0 && (c.guardTime <= 0 || c.guardProtection < 0 || c.guardProtection > 100) {
+		errs.add(
+			"guard time (%d) has to be positive and guard protection (%d) has to be from 0 to 100 percent",
+			c.guardTime,
+			c.guardProtection,
+		)
+	}
+
+	if c.minPlayers <= 0 || c.maxPlayers < 0 || (c.maxPlayers > 0 && c.maxPlayers < c.minPlayers) {
+		errs.add(
+			"min players (%d) has to be positive and max players (%d) has to be either 0 or at least min players",
+			c.minPlayers,
+			c.maxPlayers,
+		)
+	}
+
+	if c.autoStart && c.maxPlayers == 0 {
+		errs.add("max players have to be limited for auto start")
+	}
+
+	if c.startCountdown != 0 && (c.startCountdown < minStartCountdown || c.startCountdown > maxStartCountdown) {
+		errs.add(
+			"start countdown has to be either 0 or from %d to %d seconds, received: %d",
+			minStartCountdown,
+			maxStartCountdown,
+			c.startCountdown,
+		)
+	}
+
+	if c.teamCount != 0 && (c.teamCount < 2 || c.teamCount > maxTeamCount) {
+		errs.add(
+			"team count has to be either 0 or from 2 to %d, received: %d",
+			maxTeamCount,
+			c.teamCount,
+		)
+	}
+
+	if c.mode != CompetitiveMode && c.mode != CoopMode {
+		errs.add("unknown game mode: %d", c.mode)
+	}
+	if c.mode == CoopMode && c.coopTarget <= 0 {
+		errs.add("co-op target has to be positive, received: %d", c.coopTarget)
+	}
+	if c.mode == CoopMode && c.teamCount != 0 {
+		errs.add("players cannot be split into teams in co-op mode")
+	}
+
+	switch c.winCondition {
+	case WinByPoints, WinByNetWorth, WinByTarget, WinByLastSolvent:
+	default:
+		errs.add("unknown win condition: %d", c.winCondition)
+	}
+	if c.winCondition != WinByPoints && (c.mode != CompetitiveMode || c.teamCount != 0) {
+		errs.add("win condition can be set only in competitive games without teams")
+	}
+	if c.winCondition == WinByTarget && c.winTarget <= c.playerPoints {
+		errs.add(
+			"win target (%d) has to be more than initial points of the player (%d)",
+			c.winTarget,
+			c.playerPoints,
+		)
+	}
+
+	if c.timeTickTime < 0 {
+		errs.add("time tick time cannot be negative, received: %d", c.timeTickTime)
+	}
+
+	if c.creditLimit <= 0 {
+		errs.add("credit limit percentage has to be positive, received: %d", c.creditLimit)
+	}
+
+	if c.interestMode != SimpleInterest && c.interestMode != CompoundInterest {
+		errs.add("unknown interest mode: %d", c.interestMode)
+	}
+	if c.interestMode == CompoundInterest && c.interestTicks <= 0 {
+		errs.add("interest ticks have to be positive in compound mode, received: %d", c.interestTicks)
+	}
+
+	if c.depositPenalty < 0 || c.depositPenalty > 100 {
+		errs.add("deposit penalty (%d) has to be from 0 to 100 percent", c.depositPenalty)
+	}
+
+	if c.jackpotPercentage < 0 || c.jackpotPercentage > 100 || c.jackpotChance < 0 || c.jackpotChance > 100 {
+		errs.add(
+			"jackpot percentage (%d) and chance (%d) have to be from 0 to 100 percent",
+			c.jackpotPercentage,
+			c.jackpotChance,
+		)
+	}
+
+	if c.questionTime < 0 || c.questionTime >= c.duration {
+		errs.add(
+			"question time (%d)sec cannot be negative and has to be less than duration of a game (%d)",
+			c.questionTime,
+			c.duration,
+		)
+	}
+
+	if c.questionMaxBid < 0 || c.questionMaxBid > 100 {
+		errs.add(
+			"question max bid percentage (%d) has to be from 0 to 100 percent",
+			c.questionMaxBid,
+		)
+	}
+
+	if c.usernameMinLength < 1 || c.usernameMaxLength < c.usernameMinLength || c.usernameMaxLength > maxUsernameLength {
+		errs.add(
+			"username length bounds (%d and %d) have to be from 1 to %d, and min length cannot exceed max length",
+			c.usernameMinLength,
+			c.usernameMaxLength,
+			maxUsernameLength,
+		)
+	}
+	if c.usernamePolicy != SuffixUsernames && c.usernamePolicy != RejectUsernames {
+		errs.add("unknown username policy: %d", c.usernamePolicy)
+	}
+
+	if c.afkTime < 0 {
+		errs.add("AFK time cannot be negative, received: %d", c.afkTime)
+	}
+	if c.afkPolicy != FlagAFK && c.afkPolicy != ManageAFK && c.afkPolicy != ForfeitAFK {
+		errs.add("unknown AFK policy: %d", c.afkPolicy)
+	}
+
+	if c.userRateLimit < 0 || c.peerRateLimit < 0 {
+		errs.add(
+			"user (%d) and peer (%d) rate limits cannot be negative",
+			c.userRateLimit,
+			c.peerRateLimit,
+		)
+	}
+
+	if (c.userRateLimit > 0 && c.userRateBurst <= 0) || (c.peerRateLimit > 0 && c.peerRateBurst <= 0) {
+		errs.add(
+			"user (%d) and peer (%d) rate bursts have to be positive, if rate is limited",
+			c.userRateBurst,
+			c.peerRateBurst,
+		)
+	}
+
+	if len(errs.Violations) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// withPBOverrides returns copy of the config, in which values are
+// replaced by the non-zero values of the provided overrides.
+func (c GameConfig) withPBOverrides(overrides *pb.GameConfig) GameConfig {
+	override := func(dest *int32, val int32) {
+		if val != 0 {
+			*dest = val
+		}
+	}
+
+	override(&c.duration, overrides.GetDuration())
+	override(&c.playerPoints, overrides.GetPlayerPoints())
+	override(&c.bankPointsPerPlayer, overrides.GetBankPointsPerPlayer())
+	override(&c.creditInterest, overrides.GetCreditInterest())
+	override(&c.depositInterest, overrides.GetDepositInterest())
+	override(&c.creditTime, overrides.GetCreditTime())
+	override(&c.creditLimit, overrides.GetCreditLimitPercentage())
+	override(&c.depositTime, overrides.GetDepositTime())
+	override(&c.depositPenalty, overrides.GetDepositPenaltyPercentage())
+	override((*int32)(&c.interestMode), int32(overrides.GetInterestMode()))
+	override(&c.interestTicks, overrides.GetInterestTicks())
+	override(&c.theftTime, overrides.GetTheftTime())
+	override(&c.theftPercentage, overrides.GetTheftPercentage())
+	override(&c.lotteryTime, overrides.GetLotteryTime())
+	override(&c.lotteryMaxWin, overrides.GetLotteryMaxWin())
+	override(&c.lotteryRows, overrides.GetLotteryRows())
+	override(&c.lotteryColumns, overrides.GetLotteryColumns())
+	if len(overrides.GetLotteryPayouts()) > 0 {
+		c.lotteryPayouts = overrides.GetLotteryPayouts()
+	}
+	override(&c.jackpotPercentage, overrides.GetJackpotPercentage())
+	override(&c.jackpotChance, overrides.GetJackpotChance())
+	override(&c.questionWinPercentage, overrides.GetQuestionWinPercentage())
+	override(&c.questionTime, overrides.GetQuestionTime())
+	override(&c.questionMaxBid, overrides.GetQuestionMaxBidPercentage())
+	if len(overrides.GetMarketAssets()) > 0 {
+		c.marketAssets = overrides.GetMarketAssets()
+	}
+	override(&c.marketUnits, overrides.GetMarketUnits())
+	override(&c.stockPrice, overrides.GetStockPrice())
+	override(&c.stockVolatility, overrides.GetStockVolatilityPercentage())
+	override(&c.stockTickTime, overrides.GetStockTickTime())
+	override(&c.insurancePremium, overrides.GetInsurancePremium())
+	override(&c.insuranceCoverage, overrides.GetInsuranceCoveragePercentage())
+	override(&c.taxTime, overrides.GetTaxTime())
+	if len(overrides.GetTaxBrackets()) > 0 {
+		c.taxBrackets = taxBracketsFromPB(overrides.GetTaxBrackets())
+	}
+	override(&c.inflationRate, overrides.GetInflationRate())
+	override(&c.inflationTime, overrides.GetInflationTime())
+	override(&c.stealChance, overrides.GetStealChance())
+	override(&c.stealPenalty, overrides.GetStealPenaltyPercentage())
+	if overrides.GetMaskThieves() {
+		c.maskThieves = true
+	}
+	override(&c.guardPrice, overrides.GetGuardPrice())
+	override(&c.guardTime, overrides.GetGuardTime())
+	override(&c.guardProtection, overrides.GetGuardProtectionPercentage())
+	override(&c.minPlayers, overrides.GetMinPlayers())
+	override(&c.maxPlayers, overrides.GetMaxPlayers())
+	if overrides.GetAutoStart() {
+		c.autoStart = true
+	}
+	override(&c.startCountdown, overrides.GetStartCountdown())
+	override(&c.teamCount, overrides.GetTeamCount())
+	override((*int32)(&c.mode), int32(overrides.GetMode()))
+	override(&c.coopTarget, overrides.GetCoopTarget())
+	if overrides.GetPractice() {
+		c.practice = true
+	}
+	override((*int32)(&c.winCondition), int32(overrides.GetWinCondition()))
+	override(&c.winTarget, overrides.GetWinTarget())
+	override(&c.timeTickTime, overrides.GetTimeTickTime())
+	override(&c.usernameMinLength, overrides.GetUsernameMinLength())
+	override(&c.usernameMaxLength, overrides.GetUsernameMaxLength())
+	override((*int32)(&c.usernamePolicy), int32(overrides.GetUsernamePolicy()))
+	override(&c.afkTime, overrides.GetAfkTime())
+	override((*int32)(&c.afkPolicy), int32(overrides.GetAfkPolicy()))
+	return c
+}
+
+// lotteryCellCount returns number of cells in the lottery grid.
+func (c GameConfig) lotteryCellCount() int32 {
+	return c.lotteryRows * c.lotteryColumns
+}
+
+// hasMarketAsset returns true, if provided asset is traded on the market.
+func (c GameConfig) hasMarketAsset(asset string) bool {
+	for _, marketAsset := range c.marketAssets {
+		if marketAsset == asset {
+			return true
+		}
+	}
+	return false
+}
+
+// getLotteryPayouts returns payout table of the lottery.
+func (c GameConfig) getLotteryPayouts() []int32 {
+	if len(c.lotteryPayouts) == 0 {
+		return defaultLotteryPayouts
+	}
+	return c.lotteryPayouts
+}
+
+func (c GameConfig) toPBGameConfig() *pb.GameConfig {
+	return &pb.GameConfig{
+		Duration:                    c.duration,
+		PlayerPoints:                c.playerPoints,
+		BankPointsPerPlayer:         c.bankPointsPerPlayer,
+		CreditInterest:              c.creditInterest,
+		DepositInterest:             c.depositInterest,
+		CreditTime:                  c.creditTime,
+		CreditLimitPercentage:       c.creditLimit,
+		DepositTime:                 c.depositTime,
+		TheftTime:                   c.theftTime,
+		TheftPercentage:             c.theftPercentage,
+		LotteryTime:                 c.lotteryTime,
+		LotteryMaxWin:               c.lotteryMaxWin,
+		LotteryRows:                 c.lotteryRows,
+		LotteryColumns:              c.lotteryColumns,
+		LotteryPayouts:              c.getLotteryPayouts(),
+		JackpotPercentage:           c.jackpotPercentage,
+		JackpotChance:               c.jackpotChance,
+		DepositPenaltyPercentage:    c.depositPenalty,
+		InterestMode:                pb.InterestMode(c.interestMode),
+		InterestTicks:               c.interestTicks,
+		QuestionWinPercentage:       c.questionWinPercentage,
+		QuestionTime:                c.questionTime,
+		QuestionMaxBidPercentage:    c.questionMaxBid,
+		MarketAssets:                c.marketAssets,
+		MarketUnits:                 c.marketUnits,
+		StockPrice:                  c.stockPrice,
+		StockVolatilityPercentage:   c.stockVolatility,
+		StockTickTime:               c.stockTickTime,
+		InsurancePremium:            c.insurancePremium,
+		InsuranceCoveragePercentage: c.insuranceCoverage,
+		TaxTime:                     c.taxTime,
+		TaxBrackets:                 taxBracketsToPB(c.taxBrackets),
+		InflationRate:               c.inflationRate,
+		InflationTime:               c.inflationTime,
+		StealChance:                 c.stealChance,
+		StealPenaltyPercentage:      c.stealPenalty,
+		MaskThieves:                 c.maskThieves,
+		GuardPrice:                  c.guardPrice,
+		GuardTime:                   c.guardTime,
+		GuardProtectionPercentage:   c.guardProtection,
+		MinPlayers:                  c.minPlayers,
+		MaxPlayers:                  c.maxPlayers,
+		AutoStart:                   c.autoStart,
+		StartCountdown:              c.startCountdown,
+		TeamCount:                   c.teamCount,
+		Mode:                        pb.GameMode(c.mode),
+		CoopTarget:                  c.coopTarget,
+		Practice:                    c.practice,
+		WinCondition:                pb.WinCondition(c.winCondition),
+		WinTarget:                   c.winTarget,
+		TimeTickTime:                c.timeTickTime,
+		UsernameMinLength:           c.usernameMinLength,
+		UsernameMaxLength:           c.usernameMaxLength,
+		UsernamePolicy:              pb.UsernamePolicy(c.usernamePolicy),
+		AfkTime:                     c.afkTime,
+		AfkPolicy:                   pb.AfkPolicy(c.afkPolicy),
 	}
 }
 
@@ -76,11 +949,65 @@ func NewGameConfig(
 type game struct {
 	mutex             sync.RWMutex
 	gameID            gameID
+	name              string // set only for rooms
+	code              string // set only for rooms, which require the code to join
+	fixedUsernames    bool   // set for tournament rounds, which keep standings by usernames
 	state             gameState
 	config            GameConfig
 	players           map[userID]*player
+	hostID            userID // player, who can start the game and kick players
 	bankPoints        int32
+	bank              BankStrategy
+	words             WordFilter       // usernames and chat are not filtered, if nil
+	ratings           *ratingBook      // ratings are not updated, if nil
+	stats             *statsBook       // statistics are not updated, if nil
+	achievements      *achievementBook // achievements are not unlocked, if nil
+	challenges        *challengeBook   // progress in challenges is not made, if nil
+	summaries         *summaryBook     // summary is not kept after the finish, if nil
 	lotteryCellValues []int32
+	jackpot           int32            // part of bank's points, which is won in the lottery
+	loans             map[loanID]*loan // repaid loans are deleted
+	market            map[string]*orderBook
+	stockPrice        int32 // current price of the share, 0 if there is no stock
+	priceLevel        int32 // percentage, by which lottery and question payouts are scaled
+	startTime         time.Time
+	nextTheftTime     time.Time
+	storage           Storage // nil, if games are not persisted
+	journal           []JournalEvent
+	askedQuestions    map[string]bool // keys of questions asked in the game
+	chat              []chatMessage   // latest messages from the oldest
+	countdown         *startCountdown // nil, if the game is not counting down to the start
+	finishTimer       Timer           // finishes the active game, nil for games, which are not started
+	coopProgress      int32           // last broadcast progress of the players in co-op mode
+	coopReached       bool            // true, if the players have reached the target in co-op mode
+	pauseTime         time.Time       // zero, if the game is not paused
+	pauseTimer        Timer           // resumes the game after the maximal pause
+	extensionVotes    map[userID]bool // players, who have voted to extend the game since the last extension
+	extensions        int32           // number of times the game has been extended
+	overtime          *overtime       // nil, if the game has not ended in a tie
+	conditionWinnerID userID          // player, who has met the win condition, which finishes the game early
+	logger            *zap.Logger     // tagged with id of the game
+	// remaining durations of the timers stopped by the pause
+	pausedTimers map[Timer]time.Duration
+	// periodic actions, which have been due during the pause
+	pausedTicks []pausedTick
+	// serializes queueing of the events, so that every player
+	// receives them in the order of their sequence numbers
+	streamMutex sync.Mutex
+	// sequence number of the latest broadcast event, it is
+	// changed with streamMutex acquired and read atomically
+	streamSequence int64
+	// ring of the latest broadcast events, it is
+	// accessed with streamMutex acquired
+	backlog []*pb.StreamResponse
+	// closed, when the game is finished and its final events are sent
+	done  chan struct{}
+	clock Clock
+	// seed of the random numbers, chosen at the start,
+	// unless it is fixed by the server
+	seed       int64
+	rand       *rand.Rand // nil, until the game starts
+	randSource *countingSource
 }
 
 func getNumberProportion(num int32, percentage int32) int32 {
@@ -89,52 +1016,163 @@ func getNumberProportion(num int32, percentage int32) int32 {
 	return res
 }
 
-func generateLotteryCellValues(maxWin int32) []int32 {
-	// TODO: put cellCount to game config
-	cellCount := 9
+// getInterest returns the interest on the value after provided part (from 0 to 1)
+// of the credit or deposit time has passed. Interest is the percentage for the
+// whole time. In compound mode, it is split between the ticks, and the interest
+// of each passed tick is added to the value, on which later ticks are charged.
+// The result is rounded up.
+func (c GameConfig) getInterest(val int32, interest int32, passed float64) int32 {
+	if passed <= 0 {
+		return 0
+	}
+	if passed >= 1 && c.interestMode != CompoundInterest {
+		return getNumberProportion(val, interest)
+	}
+	if passed > 1 {
+		passed = 1
+	}
+
+	rate := float64(interest) / 100.0
+	if c.interestMode == CompoundInterest {
+		ticks := float64(c.interestTicks)
+		passedTicks := math.Floor(passed * ticks)
+		return int32(math.Ceil(float64(val) * (math.Pow(1+rate/ticks, passedTicks) - 1)))
+	}
+	return int32(math.Ceil(float64(val) * rate * passed))
+}
+
+// getValueWithInterest returns value together with
+// interest for the whole time, which is rounded up.
+func (c GameConfig) getValueWithInterest(val int32, interest int32) int32 {
+	return val + c.getInterest(val, interest, 1)
+}
 
-	res := make([]int32, cellCount)
+// getProRatedInterest returns the interest on provided part of the position
+// for the time passed from its start until provided time. It is rounded up.
+func (c GameConfig) getProRatedInterest(val int32, pos *position, interest int32, now time.Time) int32 {
+	total := pos.endTime.Sub(pos.startTime)
+	if total <= 0 {
+		return c.getInterest(val, interest, 1)
+	}
+	return c.getInterest(val, interest, now.Sub(pos.startTime).Seconds()/total.Seconds())
+}
+
+// defaultLotteryPayouts are percentages of the max win, which are won
+// in the cells of the lottery, unless payout table is configured.
+var defaultLotteryPayouts = []int32{0, 20, 30, 60, 100}
+
+// generateLotteryCellValues distributes payouts, which are percentages of
+// the max win, over the cells in the provided order. First and last payouts
+// are always present, others are skipped, if there are fewer cells than
+// payouts. Each payout takes about the same number of cells, so repeating
+// a payout in the table makes it more likely to be won.
+func generateLotteryCellValues(maxWin int32, cellCount int32, payouts []int32) []int32 {
+	if cellCount <= 0 || len(payouts) == 0 {
+		return []int32{}
+	}
 
-	winPoints1 := int32(0)
-	res[0] = winPoints1
-	res[1] = winPoints1
-	winPoints2 := getNumberProportion(maxWin, 20)
-	res[2] = winPoints2
-	res[3] = winPoints2
-	winPoints3 := getNumberProportion(maxWin, 30)
-	res[4] = winPoints3
-	res[5] = winPoints3
-	winPoints4 := getNumberProportion(maxWin, 60)
-	res[6] = winPoints4
-	res[7] = winPoints4
-	winPoints5 := maxWin
-	res[8] = winPoints5
+	res := make([]int32, cellCount)
+	if cellCount == 1 {
+		res[0] = getNumberProportion(maxWin, payouts[len(payouts)-1])
+		return res
+	}
+	payoutCount := int32(len(payouts))
+	for i := int32(0); i < cellCount; i++ {
+		res[i] = getNumberProportion(maxWin, payouts[i*(payoutCount-1)/(cellCount-1)])
+	}
 
 	return res
 }
 
 // Creates new game in waiting state.
-func newGame(config GameConfig) *game {
+// Storage can be nil, if the game doesn't have to be persisted.
+func newGame(config GameConfig, storage Storage, logger *zap.Logger) *game {
 	gameID := gameID(uuid.New().String())
-	lotteryCellValues := generateLotteryCellValues(config.lotteryMaxWin)
-	return &game{
-		gameID:            gameID,
-		state:             waitingState,
-		config:            config,
-		players:           make(map[userID]*player),
-		bankPoints:        0, // to be calculated in "start" function
-		lotteryCellValues: lotteryCellValues,
+	return newGameWithID(gameID, config, storage, logger)
+}
+
+// Creates game with provided id in waiting state. It is used for games,
+// which are created again from their records or journals.
+func newGameWithID(gameID gameID, config GameConfig, storage Storage, logger *zap.Logger) *game {
+	g := &game{
+		gameID:         gameID,
+		state:          waitingState,
+		config:         config,
+		players:        make(map[userID]*player),
+		bankPoints:     0, // to be calculated in "start" function
+		bank:           DefaultBankStrategy{},
+		loans:          make(map[loanID]*loan),
+		market:         make(map[string]*orderBook),
+		priceLevel:     initialPriceLevel,
+		askedQuestions: make(map[string]bool),
+		extensionVotes: make(map[userID]bool),
+		done:           make(chan struct{}),
+		clock:          systemClock{},
+		storage:        storage,
+		logger:         logger.With(zap.String("game_id", string(gameID))),
 	}
+	g.generateLotteryCellValues()
+	return g
 }
 
+// Errors returned, when the player cannot be added to the game.
+var (
+	errGameStarted = errors.New("game has been already started")
+	errGameFull    = errors.New("game is full")
+)
+
 // Creates a new player with a provided username
 // and adds it to the game.
-// NOTE: only should be called on game in waiting state.
-func (g *game) addPlayer(username username) userID {
+// Returns errGameStarted, if the game is not in waiting state anymore,
+// and errGameFull, if it already has max players. Errors of the username
+// check are returned as well, see getUsernameError.
+func (g *game) addPlayer(username username, locale string, appearance appearance) (userID, error) {
+	return g.joinPlayer(username, locale, appearance, false)
+}
+
+// joinPlayer is the same as addPlayer, but the player may be a bot.
+func (g *game) joinPlayer(username username, locale string, appearance appearance, bot bool) (userID, error) {
+	// rating may be loaded from the storage, so it is done without lock
+	rating := g.ratings.get(username).Rating
+
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
-	player := newPlayer(username, g.config.playerPoints)
-	g.players[player.userID] = player
+
+	if g.state != waitingState {
+		return "", errGameStarted
+	}
+	if g.config.maxPlayers > 0 && int32(len(g.players)) >= g.config.maxPlayers {
+		return "", errGameFull
+	}
+	// names of the bots are generated, so only their uniqueness is checked
+	if !bot {
+		if err := firstError(g.config.checkUsername(username), checkUsernameWords(g.words, username)); err != nil {
+			return "", err
+		}
+	}
+	uniqueUsername, err := g.getUniqueUsername(username)
+	if err != nil {
+		return "", err
+	}
+	if uniqueUsername != username {
+		// the suffixed username is a different player
+		username = uniqueUsername
+		rating = initialRating
+	}
+
+	// new player is used only for generating ids
+	newPlayer := newPlayer(username, g.config.playerPoints)
+	event := newEvent(EventJoin, newPlayer.userID, g.config.playerPoints)
+	event.Username = string(username)
+	event.SessionToken = string(newPlayer.sessionToken)
+	event.Team = g.getSmallestTeam()
+	event.Bot = bot
+	event.AvatarID = appearance.avatarID
+	event.Color = g.getFreeColor(appearance.color)
+	g.apply(event)
+	player := g.players[newPlayer.userID]
+	player.locale = locale
+	player.rating = rating
 
 	// broadcasting player joining
 	go func() {
@@ -142,21 +1180,183 @@ func (g *game) addPlayer(username username) userID {
 		g.broadcast(msg)
 	}()
 
-	return player.userID
+	return player.userID, nil
 }
 
-// Deletes player from the game.
-// NOTE: only should be called on game in waiting state.
-func (g *game) deletePlayer(userID userID) {
+// getAskedQuestions returns the copy of the set of questions asked in the game.
+func (g *game) getAskedQuestions() map[string]bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	asked := make(map[string]bool, len(g.askedQuestions))
+	for key := range g.askedQuestions {
+		asked[key] = true
+	}
+	return asked
+}
+
+// resetAskedQuestions allows all questions to be asked again.
+func (g *game) resetAskedQuestions() {
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
-	delete(g.players, userID)
+	g.askedQuestions = make(map[string]bool)
+}
+
+// getPlayerLocale returns the locale provided by the player on join.
+func (g *game) getPlayerLocale(userID userID) string {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	if player, ok := g.players[userID]; ok {
+		return player.locale
+	}
+	return ""
+}
+
+// Deletes player from the game. Deleting the player, who is not
+// in the game, e.g. who has already left, does nothing.
+// Returns false, if the game is not in waiting state anymore.
+func (g *game) deletePlayer(userID userID) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state != waitingState {
+		return false
+	}
+	if _, ok := g.players[userID]; !ok {
+		return true
+	}
+
+	g.apply(newEvent(EventLeave, userID, 0))
 
 	// broadcasting player leaving
+	hostID := g.hostID
+	go func() {
+		msg := g.getLeaveMessage(userID, hostID)
+		g.broadcast(msg)
+	}()
+
+	return true
+}
+
+// getNextHostID returns id of the player, who has joined the game first
+// among the players, which are still in the game. Bots never become
+// hosts. Returns empty id, if there are no such players. The calling function has to acquire at least
+// read lock.
+func (g *game) getNextHostID() userID {
+	for _, event := range g.journal {
+		if event.Kind != EventJoin || event.Bot {
+			continue
+		}
+		if _, ok := g.players[userID(event.UserID)]; ok {
+			return userID(event.UserID)
+		}
+	}
+	return ""
+}
+
+// errNotHost is returned, when the player, who is not the host,
+// requests the action allowed only to the host.
+var errNotHost = errors.New("only the host")
+
+// isHost returns true, if the player is the host of the game.
+func (g *game) isHost(userID userID) bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return userID != "" && userID == g.hostID
+}
+
+// kickPlayer removes the target from the waiting game on behalf of
+// the host. The target is notified with the Kicked event, and other
+// players are notified as if the target has left the game.
+func (g *game) kickPlayer(hostID userID, targetID userID) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state != waitingState {
+		return fmt.Errorf("players can be kicked only before the game starts")
+	}
+	if hostID == "" || g.hostID != hostID {
+		return fmt.Errorf("%w can kick players", errNotHost)
+	}
+	target, ok := g.players[targetID]
+	if !ok {
+		return fmt.Errorf("there is no player with id %v in the game", targetID)
+	}
+	if targetID == hostID {
+		return fmt.Errorf("host cannot kick himself")
+	}
+
+	// the event is sent before the player is deleted,
+	// since his stream is closed right after that
+	if target.stream != nil {
+		msg := getKickedMessage(hostID)
+		g.streamMutex.Lock()
+		msg.Sequence = g.getStreamSequence()
+		g.queue(target, msg)
+		g.streamMutex.Unlock()
+	}
+	g.apply(newEvent(EventLeave, targetID, 0))
+	g.logger.Info(
+		"Player has been kicked",
+		zap.String("user_id", string(targetID)),
+		zap.String("host_id", string(hostID)),
+	)
+
+	go func() {
+		msg := g.getLeaveMessage(targetID, hostID)
+		g.broadcast(msg)
+	}()
+
+	return nil
+}
+
+func getKickedMessage(hostID userID) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Kicked_{
+			Kicked: &pb.StreamResponse_Kicked{
+				HostId: string(hostID),
+			},
+		},
+	}
+}
+
+// evictPlayer removes player from the game in any state. Points of the player
+// go to the bank, and his outstanding credits and deposits are cancelled.
+func (g *game) evictPlayer(userID userID) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	player, ok := g.players[userID]
+	if !ok {
+		return fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if g.state == finishedState {
+		return fmt.Errorf("game %v is already finished", g.gameID)
+	}
+
+	if g.state == waitingState {
+		g.apply(newEvent(EventLeave, userID, 0))
+	} else {
+		for _, pos := range player.credits {
+			pos.timer.Stop()
+		}
+		for _, pos := range player.deposits {
+			pos.timer.Stop()
+		}
+		player.stopQuestionTimers()
+		g.apply(newEvent(EventEvict, userID, -player.points))
+		g.persist()
+	}
+
+	hostID := g.hostID
 	go func() {
-		msg := g.getLeaveMessage(userID)
+		msg := g.getLeaveMessage(userID, hostID)
 		g.broadcast(msg)
 	}()
+
+	return nil
 }
 
 // NOTE: This function uses readlock, so it has to be used carefully.
@@ -164,52 +1364,136 @@ func (g *game) getWinnerID() userID {
 	g.mutex.RLock()
 	defer g.mutex.RUnlock()
 
+	return g.findWinnerID()
+}
+
+// findWinnerID returns the player with the most points or the highest net
+// worth, depending on the win condition, unless the player has met the win
+// condition earlier. In team games, only the players of the winning team
+// are considered. Returns empty id in co-op mode and for the tie, which
+// overtime hasn't broken. The calling function has to acquire at least read lock.
+func (g *game) findWinnerID() userID {
 	noUserID := userID("")
+	if g.config.mode == CoopMode {
+		return noUserID
+	}
+	if g.conditionWinnerID != "" {
+		return g.conditionWinnerID
+	}
+	// tie is broken only by overtime
+	if g.overtime != nil {
+		return g.overtime.winnerID
+	}
 	winnerID := noUserID
+	winnerTeam := g.findWinnerTeam()
 	for _, player := range g.players {
-		if winnerID == noUserID || player.points > g.players[winnerID].points {
+		if player.team != winnerTeam || player.forfeited {
+			continue
+		}
+		if winnerID == noUserID || g.getPlayerScore(player) > g.getPlayerScore(g.players[winnerID]) {
 			winnerID = player.userID
 		}
 	}
 	return winnerID
 }
 
-func (g *game) start() {
+// start changes the game to active state. Provided config replaces
+// the one, which the game has been created with.
+func (g *game) start(config GameConfig) {
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
 
-	g.state = activeState
-	// bank points are calculated
-	g.bankPoints = int32(len(g.players)) * g.config.bankPointsPerPlayer
-
-	// marking each player as if he has just played the lottery
-	// users can play their first lottery after g.config.lotteryTime seconds.
-	for _, player := range g.players {
-		player.updateLastLotteryTime()
+	event := newEvent(EventStart, "", 0)
+	event.Config = &config
+	event.Seed = g.seed
+	if event.Seed == 0 {
+		event.Seed = g.clock.Now().UnixNano()
 	}
+	g.apply(event)
 
 	// broadcasting game start
+	msg := g.getStartMessage()
 	go func() {
-		msg := g.getStartMessage()
 		g.broadcast(msg)
 	}()
 
 	// launch theft timer
-	time.AfterFunc(time.Duration(g.config.theftTime)*time.Second, func() {
-		g.doTheft()
-	})
+	g.scheduleTheft()
+	if g.stockPrice > 0 {
+		g.scheduleStockTick()
+	}
+	if g.config.taxTime > 0 {
+		g.scheduleTax()
+	}
+	if g.config.inflationRate > 0 {
+		g.scheduleInflation()
+	}
+	if g.config.timeTickTime > 0 {
+		g.scheduleTimeTick()
+	}
+	if g.config.afkTime > 0 {
+		g.scheduleAFKCheck()
+	}
+
+	g.persist()
 }
 
+// finish marks the game as finished and broadcasts the final results.
+// Results of practice games are not recorded. Calling it on the already
+// finished game does nothing, so that game finished due to server
+// shutdown is not finished again by its timer.
 func (g *game) finish() {
 	g.mutex.Lock()
-	defer g.mutex.Unlock()
-	g.state = finishedState
+	if g.state == finishedState {
+		g.mutex.Unlock()
+		return
+	}
+	g.sellAllShares()
+	var achievements map[userID][]*pb.StreamResponse
+	counted := g.state == activeState && !g.config.practice
+	if counted {
+		g.updateRatings()
+		g.saveResults()
+		g.updateStats()
+		achievements = g.checkFinishAchievements()
+		g.updateChallenges()
+	}
+	g.apply(newEvent(EventFinish, "", 0))
+	g.persist()
+	if err := auditJournal(g.journal); err != nil {
+		g.logger.Error("Journal is inconsistent", zap.Error(err))
+	}
+	results := g.getPBGameResults()
+	g.mutex.Unlock()
 
-	go func() {
-		winnerUserID := g.getWinnerID()
-		msg := g.getFinishMessage(winnerUserID)
-		g.broadcast(msg)
-	}()
+	// achievements and results are sent before the end of the game
+	for userID, msgs := range achievements {
+		for _, msg := range msgs {
+			g.sendTo(userID, msg)
+		}
+	}
+	g.broadcast(getResultsMessage(results))
+	winnerUserID := g.getWinnerID()
+	msg := g.getFinishMessage(winnerUserID)
+	summary := &pb.GameSummary{
+		GameId:     string(g.gameID),
+		FinishTime: g.clock.Now().UnixNano() / int64(time.Millisecond),
+		Finish:     msg.GetFinish(),
+		Results:    results,
+	}
+	g.summaries.add(summary)
+	if counted {
+		g.saveHistory(summary)
+	}
+	g.broadcast(msg)
+	close(g.done)
+}
+
+func (g *game) getPlayerCount() int {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return len(g.players)
 }
 
 func (g *game) isFinished() bool {
@@ -219,210 +1503,447 @@ func (g *game) isFinished() bool {
 	return g.state == finishedState
 }
 
-// useCredit returns "True" and empty string, if credit can be granted.
-// Otherwise, it will return "False" and explanation why credit has not
-// been granted.
-func (g *game) useCredit(userID userID, val int32) (bool, string, error) {
+func (g *game) isWaiting() bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return g.state == waitingState
+}
+
+// useCredit returns "True", if credit can be granted. Otherwise, it will
+// return "False" and the outcome why credit has not been granted. In both
+// cases, credit limit of the player and its used part are returned as well,
+// and the explanation of the outcome describes them, if the credit is granted.
+func (g *game) useCredit(ctx context.Context, userID userID, val int32) (bool, outcome, int32, int32, error) {
+	ctx, span := startSpan(ctx, "game.useCredit", append(g.spanAttrs(userID), label.Int32("value", val))...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
 	player, ok := g.players[userID]
 	if !ok {
-		return false, "", fmt.Errorf("there is no player with id %v in the game", userID)
+		return false, outcome{}, 0, 0, fmt.Errorf("there is no player with id %v in the game", userID)
 	}
 
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
+	conditions := g.getBankConditions()
+	limit := g.bank.CreditLimit(conditions, player.getNetWorth(), player.creditScore)
+	used := player.getOutstandingCredit()
 
 	// bank doesn't have enough points to give the credit
 	// NOTE: this check can be deleted to allow bank to go down a bit
 	// but in that case, we would need to check that the user doesn't borrow too much
 	if g.bankPoints < val {
-		return false, "bank cannot grant the credit due to bank's undisclosed policies", nil
+		return false, deny(ReasonBankPolicy, 0, "bank cannot grant the credit due to bank's undisclosed policies"), limit, used, nil
 	}
 
-	if player.points <= 0 {
-		return false, "player with no money cannot take credit", nil
+	if limit <= 0 {
+		return false, deny(ReasonNoNetWorth, 0, "player with no net worth cannot take credit"), limit, used, nil
 	}
 
 	// trying to ask for too much money
-	if val > (player.points * 2) {
-		return false, "asking for too much money", nil
+	if used+val > limit {
+		out := deny(
+			ReasonCreditLimitExceeded, 0,
+			"asking for too much money: credit limit is %d, %d (%d%%) of it is used",
+			limit, used, getCreditUtilization(used, limit),
+		)
+		return false, out, limit, used, nil
 	}
 
-	g.bankPoints -= val
-	player.points += val
+	event := newEvent(EventCredit, userID, val)
+	event.RefID = string(newPositionID())
+	event.Interest = g.bank.CreditInterest(conditions, player.creditScore)
+	g.apply(event)
 
-	time.AfterFunc(time.Duration(g.config.creditTime)*time.Second, func() {
-		g.returnCredit(userID, val)
+	credit := player.credits[positionID(event.RefID)]
+	credit.timer = g.clock.AfterFunc(credit.endTime.Sub(g.clock.Now()), func() {
+		g.returnCredit(userID, credit.positionID)
 	})
+	g.persist()
+	span.AddEvent(ctx, "credit granted")
 
 	go func() {
 		msg := g.getUseCreditMessage(userID, val)
 		g.broadcast(msg)
 	}()
 
-	return true, "", nil
+	used += val
+	out := outcome{
+		format: "credit limit is %d, %d (%d%%) of it is used",
+		args:   []interface{}{limit, used, getCreditUtilization(used, limit)},
+	}
+	return true, out, limit, used, nil
+}
+
+// getCreditUtilization returns the used part of the credit limit in percents.
+func getCreditUtilization(used int32, limit int32) int32 {
+	return int32(math.Round(float64(used) * 100 / float64(limit)))
+}
+
+// useDeposit returns "True" and empty outcome, if deposit can be granted.
+// Otherwise, it will return "False" and the outcome why deposit has not
+// been granted.
+func (g *game) useDeposit(ctx context.Context, userID userID, val int32) (bool, outcome, error) {
+	ctx, span := startSpan(ctx, "game.useDeposit", append(g.spanAttrs(userID), label.Int32("value", val))...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	player, ok := g.players[userID]
+	if !ok {
+		return false, outcome{}, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+
+	if player.points < val {
+		return false, deny(ReasonInsufficientPoints, 0, "not allowed to deposit more than player has"), nil
+	}
+
+	g.makeDeposit(userID, val, false)
+	g.persist()
+	span.AddEvent(ctx, "deposit granted")
+
+	return true, outcome{}, nil
+}
+
+// makeDeposit moves provided points of the player to the new deposit,
+// which is returned, when its time ends. Auto is true for the deposits
+// rolled over for the AFK player. The calling function has to acquire
+// write lock.
+func (g *game) makeDeposit(userID userID, val int32, auto bool) {
+	event := newEvent(EventDeposit, userID, -val)
+	event.RefID = string(newPositionID())
+	event.Interest = g.bank.DepositInterest(g.getBankConditions())
+	event.Auto = auto
+	g.apply(event)
+
+	deposit := g.players[userID].deposits[positionID(event.RefID)]
+	deposit.timer = g.clock.AfterFunc(deposit.endTime.Sub(g.clock.Now()), func() {
+		g.returnDeposit(userID, deposit.positionID)
+	})
+
+	go func() {
+		msg := g.getUseDepositMessage(userID, val)
+		g.broadcast(msg)
+	}()
+}
+
+func (g *game) returnCredit(userID userID, positionID positionID) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	player, ok := g.players[userID]
+	if !ok {
+		g.logger.Warn("returnCredit has been called with user, who is not in this game", zap.String("user_id", string(userID)))
+		return
+	}
+
+	credit, ok := player.credits[positionID]
+	if !ok {
+		g.logger.Warn(
+			"returnCredit has been called with credit, which user doesn't have",
+			zap.String("user_id", string(userID)),
+			zap.String("position_id", string(positionID)),
+		)
+		return
+	}
+	valWithInterest := g.config.getValueWithInterest(credit.value, credit.interest)
+
+	event := newEvent(EventReturnCredit, userID, -valWithInterest)
+	event.RefID = string(positionID)
+	g.apply(event)
+	g.persist()
+
+	go func() {
+		msg := g.getReturnCreditMessage(userID, valWithInterest)
+		g.broadcast(msg)
+	}()
 }
 
-// useDeposit returns "True" and empty string, if deposit can be granted.
-// Otherwise, it will return "False" and explanation why deposit has not
-// been granted.
-func (g *game) useDeposit(userID userID, val int32) (bool, string, error) {
+// repayCredit returns provided part of the credit before its time ends, the
+// whole credit is returned, if the part is 0. Interest on the returned part is
+// charged only for the part of the credit time, which has passed. Interest on
+// the remaining part is charged, when the credit time ends.
+// It returns "False" and the outcome, if the player doesn't have enough points
+// to repay. Otherwise, paid points and remaining value of the credit are returned.
+func (g *game) repayCredit(
+	ctx context.Context, userID userID, positionID positionID, val int32,
+) (bool, outcome, int32, int32, error) {
+	ctx, span := startSpan(ctx, "game.repayCredit", g.spanAttrs(userID)...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
 	player, ok := g.players[userID]
 	if !ok {
-		return false, "", fmt.Errorf("there is no player with id %v in the game", userID)
+		return false, outcome{}, 0, 0, fmt.Errorf("there is no player with id %v in the game", userID)
 	}
 
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-
-	if player.points < val {
-		return false, "not allowed to deposit more than player has", nil
+	credit, ok := player.credits[positionID]
+	if !ok {
+		return false, outcome{}, 0, 0, fmt.Errorf("player %v doesn't have outstanding credit %v", userID, positionID)
+	}
+	if val > credit.value {
+		return false, outcome{}, 0, 0, fmt.Errorf("repaid value (%d) cannot exceed value of the credit (%d)", val, credit.value)
+	}
+	if val == 0 {
+		val = credit.value
 	}
 
-	g.bankPoints += val
-	player.points -= val
+	valWithInterest := val + g.config.getProRatedInterest(val, credit, credit.interest, g.clock.Now())
+	if player.points < valWithInterest {
+		out := deny(ReasonInsufficientPoints, 0, "not enough points to repay the credit (%d required)", valWithInterest)
+		return false, out, 0, credit.value, nil
+	}
 
-	time.AfterFunc(time.Duration(g.config.depositTime)*time.Second, func() {
-		g.returnDeposit(userID, val)
-	})
+	remainingVal := credit.value - val
+	if remainingVal == 0 {
+		credit.timer.Stop()
+	}
+	event := newEvent(EventRepayCredit, userID, -valWithInterest)
+	event.RefID = string(positionID)
+	event.Principal = val
+	g.apply(event)
+	g.persist()
+	span.AddEvent(ctx, "credit repaid")
 
 	go func() {
-		msg := g.getUseDepositMessage(userID, val)
+		msg := g.getRepayCreditMessage(userID, valWithInterest, remainingVal)
 		g.broadcast(msg)
 	}()
 
-	return true, "", nil
+	return true, outcome{}, valWithInterest, remainingVal, nil
 }
 
-func (g *game) returnCredit(userID userID, val int32) {
+func (g *game) returnDeposit(userID userID, positionID positionID) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
 	player, ok := g.players[userID]
 	if !ok {
-		log.Printf("returnCredit has been called with user %v, who is not in this game", userID)
+		g.logger.Warn("returnDeposit has been called with user, who is not in this game", zap.String("user_id", string(userID)))
 		return
 	}
 
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-
-	floatInterest := float64(val) * float64(g.config.creditInterest) / 100.0
-	interest := int32(math.Ceil(floatInterest))
-	valWithInterest := val + interest
+	deposit, ok := player.deposits[positionID]
+	if !ok {
+		g.logger.Warn(
+			"returnDeposit has been called with deposit, which user doesn't have",
+			zap.String("user_id", string(userID)),
+			zap.String("position_id", string(positionID)),
+		)
+		return
+	}
+	valWithInterest := g.config.getValueWithInterest(deposit.value, deposit.interest)
 
-	g.bankPoints += valWithInterest
-	player.points -= valWithInterest
+	event := newEvent(EventReturnDeposit, userID, valWithInterest)
+	event.RefID = string(positionID)
+	g.apply(event)
 
 	go func() {
-		msg := g.getReturnCreditMessage(userID, valWithInterest)
+		msg := g.getReturnDepositMessage(userID, valWithInterest)
 		g.broadcast(msg)
 	}()
-}
 
-func (g *game) returnDeposit(userID userID, val int32) {
-	player, ok := g.players[userID]
-	if !ok {
-		log.Printf("returnDeposit has been called with user %v, who is not in this game", userID)
-		return
+	// deposits of the AFK player are rolled over, while the game lasts
+	if player.afk && g.config.afkPolicy == ManageAFK && g.state == activeState && valWithInterest > 0 {
+		g.makeDeposit(userID, valWithInterest, true)
 	}
+	g.persist()
+}
+
+// withdrawDeposit returns provided part of the deposit to the player before
+// its time ends, the whole deposit is returned, if the part is 0. Interest is
+// not paid on the withdrawn part, and the penalty is kept by the bank. Interest
+// on the remaining part is paid, when the deposit time ends.
+// Returned values are the points given to the player, the penalty,
+// and remaining value of the deposit.
+func (g *game) withdrawDeposit(
+	ctx context.Context, userID userID, positionID positionID, val int32,
+) (int32, int32, int32, error) {
+	ctx, span := startSpan(ctx, "game.withdrawDeposit", g.spanAttrs(userID)...)
+	defer span.End()
 
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	player, ok := g.players[userID]
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
 
-	floatInterest := float64(val) * float64(g.config.depositInterest) / 100.0
-	interest := int32(math.Ceil(floatInterest))
-	valWithInterest := val + interest
+	deposit, ok := player.deposits[positionID]
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("player %v doesn't have deposit %v", userID, positionID)
+	}
+	if val > deposit.value {
+		return 0, 0, 0, fmt.Errorf("withdrawn value (%d) cannot exceed value of the deposit (%d)", val, deposit.value)
+	}
+	if val == 0 {
+		val = deposit.value
+	}
 
-	g.bankPoints -= valWithInterest
-	player.points += valWithInterest
+	penalty := getNumberProportion(val, g.config.depositPenalty)
+	remainingVal := deposit.value - val
+	if remainingVal == 0 {
+		deposit.timer.Stop()
+	}
+	event := newEvent(EventWithdrawDeposit, userID, val-penalty)
+	event.RefID = string(positionID)
+	event.Principal = val
+	g.apply(event)
+	g.persist()
+	span.AddEvent(ctx, "deposit withdrawn")
 
 	go func() {
-		msg := g.getReturnDepositMessage(userID, valWithInterest)
+		msg := g.getWithdrawDepositMessage(userID, val-penalty, penalty, remainingVal)
 		g.broadcast(msg)
 	}()
+
+	return val - penalty, penalty, remainingVal, nil
 }
 
-func (g *game) playLottery(userID userID, cellIndex int32) (bool, []int32, int32, error) {
+// playLottery returns "True", cell values, won points, and whether the
+// jackpot is won, if the player can play the lottery. Otherwise, it will
+// return "False" and the outcome with the time left until the next lottery.
+func (g *game) playLottery(
+	ctx context.Context, userID userID, cellIndex int32,
+) (bool, outcome, []int32, int32, bool, error) {
 	success := false
 	cellValues := []int32{}
 	winPoints := int32(0)
+	jackpotWon := false
 
-	player, ok := g.players[userID]
-	if !ok {
-		errMsg := fmt.Sprintf("playLottery has been called with user %v, who is not in this game", userID)
-		log.Printf(errMsg)
-		return success, cellValues, winPoints, fmt.Errorf(errMsg)
-	}
+	ctx, span := startSpan(ctx, "game.playLottery", g.spanAttrs(userID)...)
+	defer span.End()
 
 	// locking for reads and writes
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	player, ok := g.players[userID]
+	if !ok {
+		err := fmt.Errorf("playLottery has been called with user %v, who is not in this game", userID)
+		g.logger.Info("Lottery is refused", zap.String("user_id", string(userID)), zap.Error(err))
+		return success, outcome{}, cellValues, winPoints, jackpotWon, err
+	}
+
+	// the cell index is not checked by validateRequest, since
+	// the request racing with Start may see the grid of the lobby
+	if err := checkRange("cell index", cellIndex, 1, g.config.lotteryCellCount()); err != nil {
+		return success, outcome{}, cellValues, winPoints, jackpotWon, err
+	}
 
-	if !player.canPlayLottery(g.config.lotteryTime) {
-		timePassed := time.Since(player.lastLotteryTime).Seconds()
-		errMsg := fmt.Sprintf(
-			"please wait until next lottery time, only %f out of %d seconds have passed",
-			timePassed,
-			g.config.lotteryTime,
+	if !player.canPlayLottery(g.config.lotteryTime, g.clock.Now()) {
+		g.logger.Debug(
+			"Lottery is played too early",
+			zap.String("user_id", string(userID)),
+			zap.Duration("time_passed", g.clock.Now().Sub(player.lastLotteryTime)),
+			zap.Int32("lottery_time", g.config.lotteryTime),
 		)
-		log.Println(errMsg)
 		// err is nil, but success is false according to game logic
-		return success, cellValues, winPoints, nil
+		remainingTime := player.getLotteryRemainingTime(g.config.lotteryTime, g.clock.Now())
+		out := deny(
+			ReasonLotteryOnCooldown, time.Duration(remainingTime)*time.Second,
+			"player can play the lottery again in %d seconds", remainingTime,
+		)
+		return success, out, cellValues, winPoints, jackpotWon, nil
 	}
 
 	// all conditions for lottery are correct
 	// first, calculate lottery values
-	cellValues = RandShuffle(g.lotteryCellValues)
+	cellValues = g.randShuffle(g.lotteryCellValues)
 	winPoints = cellValues[cellIndex-1]
 	success = true
-
-	// record that player have just played lottery
-	player.updateLastLotteryTime()
+	span.SetAttributes(label.Int32("win_points", winPoints))
+
+	// records that player have just played lottery
+	// and adds won points to player
+	g.apply(newEvent(EventLottery, userID, winPoints))
+
+	// jackpot is won on top of the cell value
+	if g.jackpot > 0 && g.randChance(g.config.jackpotChance) {
+		jackpot := g.jackpot
+		g.apply(newEvent(EventJackpot, userID, jackpot))
+		winPoints += jackpot
+		jackpotWon = true
+		span.SetAttributes(label.Int32("jackpot", jackpot))
+		g.logger.Info("Jackpot is won", zap.String("user_id", string(userID)), zap.Int32("jackpot", jackpot))
+	}
 
 	// only if player won some amount
 	if success && winPoints >= 0 {
-		// add points to player
-		player.points += winPoints
-		g.bankPoints -= winPoints
-
 		go func() {
-			msg := g.getLotteryMessage(player.userID, winPoints)
+			msg := g.getLotteryMessage(player.userID, winPoints, jackpotWon)
 			g.broadcast(msg)
 		}()
 	}
+	g.persist()
 
-	return success, cellValues, winPoints, nil
+	return success, outcome{}, cellValues, winPoints, jackpotWon, nil
 }
 
-func (g *game) doGenerateQuestion(userID userID, bidPoints int32) (questionID, string, []string, error) {
-	questionID := questionID("")
-	question := ""
-	answers := []string{}
+// doGenerateQuestion asks the question to the player,
+// who bids provided amount of points.
+func (g *game) doGenerateQuestion(userID userID, bidPoints int32, question Question) (questionID, error) {
+	// acquiring write lock
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
 
 	player, ok := g.players[userID]
 	if !ok {
-		errMsg := fmt.Sprintf("doGenerateQuestion has been called with user %v, who is not in this game", userID)
-		log.Printf(errMsg)
-		return questionID, question, answers, fmt.Errorf(errMsg)
+		err := fmt.Errorf("doGenerateQuestion has been called with user %v, who is not in this game", userID)
+		g.logger.Info("Question is refused", zap.String("user_id", string(userID)), zap.Error(err))
+		return "", err
 	}
 
-	// acquiring write lock
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-
 	if player.points < bidPoints {
-		return questionID, question, answers, fmt.Errorf("player has less points than bid amount")
+		return "", fmt.Errorf("player has less points than bid amount")
+	}
+
+	if g.config.questionMaxBid > 0 {
+		maxBidPoints := getNumberProportion(player.points, g.config.questionMaxBid)
+		if bidPoints > maxBidPoints {
+			return "", fmt.Errorf(
+				"bid points (%d) cannot exceed %d percent of player's points (%d)",
+				bidPoints,
+				g.config.questionMaxBid,
+				maxBidPoints,
+			)
+		}
 	}
 
-	questionID, question, answers, err := player.generateQuestion(bidPoints)
+	questionID, err := player.generateQuestion(bidPoints, question)
 	if err != nil {
-		return questionID, question, answers, err
+		return "", err
 	}
+	g.askedQuestions[question.key()] = true
 
 	// subtracting bid points from player
-	g.bankPoints += bidPoints
-	player.points -= bidPoints
+	event := newEvent(EventQuestionBid, userID, -bidPoints)
+	event.RefID = string(questionID)
+	g.apply(event)
+	if g.config.questionTime > 0 {
+		player.questions[questionID].deadline = g.clock.Now().Add(time.Duration(g.config.questionTime) * time.Second)
+		player.questions[questionID].timer = g.clock.AfterFunc(
+			time.Duration(g.config.questionTime)*time.Second,
+			func() {
+				g.expireQuestion(userID, questionID)
+			},
+		)
+	}
+	g.persist()
 
 	// we do not broadcast that question was generated
 
-	return questionID, question, answers, nil
+	return questionID, nil
 }
 
 func (g *game) doAnswerQuestion(
@@ -433,33 +1954,41 @@ func (g *game) doAnswerQuestion(
 	bidPoints := int32(0)
 	winPoints := int32(0)
 
-	player, ok := g.players[userID]
-	if !ok {
-		errMsg := fmt.Sprintf("doAnswerQuestion has been called with user %v, who is not in this game", userID)
-		log.Printf(errMsg)
-		return answerIsCorrect, correctAnswer, winPoints, fmt.Errorf(errMsg)
-	}
-
 	// acquiring write lock
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
 
-	answerIsCorrect, correctAnswer, bidPoints, err := player.answerQuestion(questionID, userAnswer)
+	player, ok := g.players[userID]
+	if !ok {
+		err := fmt.Errorf("doAnswerQuestion has been called with user %v, who is not in this game", userID)
+		g.logger.Info("Answer is refused", zap.String("user_id", string(userID)), zap.Error(err))
+		return answerIsCorrect, correctAnswer, winPoints, err
+	}
+
+	answerIsCorrect, correctAnswer, bidPoints, difficulty, err := player.answerQuestion(questionID, userAnswer)
 	if err != nil {
 		return answerIsCorrect, correctAnswer, winPoints, err
 	}
+	if timer := player.questions[questionID].timer; timer != nil {
+		timer.Stop()
+	}
 
 	if answerIsCorrect {
-		floatWinPoints := float64(bidPoints) * float64(g.config.questionWinPercentage) / 100.0
+		// harder questions are rewarded with more points
+		// and the reward grows with the price level
+		floatWinPoints := float64(bidPoints) * float64(g.config.questionWinPercentage) / 100.0 *
+			questionDifficultyMultipliers[difficulty] * float64(g.priceLevel) / 100.0
 		winPoints = int32(math.Ceil(floatWinPoints))
 	} else {
 		winPoints = int32(0)
 	}
 
-	if winPoints >= 0 {
-		g.bankPoints -= winPoints
-		player.points += winPoints
+	event := newEvent(EventQuestionAnswer, userID, winPoints)
+	event.RefID = string(questionID)
+	g.apply(event)
+	g.persist()
 
+	if winPoints >= 0 {
 		go func() {
 			msg := g.getAnswerQuestionMessage(userID, answerIsCorrect, bidPoints, winPoints)
 			g.broadcast(msg)
@@ -469,13 +1998,48 @@ func (g *game) doAnswerQuestion(
 	return answerIsCorrect, correctAnswer, winPoints, nil
 }
 
+// expireQuestion is called, when the player hasn't answered the question
+// in time. Bid points of the question stay in the bank.
+func (g *game) expireQuestion(userID userID, questionID questionID) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state != activeState {
+		return
+	}
+	player, ok := g.players[userID]
+	if !ok {
+		return
+	}
+	qInfo, ok := player.questions[questionID]
+	if !ok || qInfo.expired {
+		return
+	}
+
+	event := newEvent(EventQuestionTimeout, userID, 0)
+	event.RefID = string(questionID)
+	g.apply(event)
+	g.persist()
+	g.logger.Info(
+		"Question has expired",
+		zap.String("user_id", string(userID)),
+		zap.String("question_id", string(questionID)),
+	)
+
+	go func() {
+		msg := g.getQuestionTimeoutMessage(userID, qInfo.bidPoints)
+		g.broadcast(msg)
+	}()
+}
+
 // The calling function has to acquire at least read lock
 // for accurate reading of player points.
-func (g *game) printPlayersPoints(preMsg string) {
-	log.Println(preMsg)
+func (g *game) logPlayersPoints(msg string) {
+	points := make(map[string]int32, len(g.players))
 	for _, player := range g.players {
-		log.Printf("%s: %d points, ", player.userID, player.points)
+		points[string(player.userID)] = player.points
 	}
+	g.logger.Debug(msg, zap.Any("points", points))
 }
 
 func (g *game) doTheft() {
@@ -484,43 +2048,112 @@ func (g *game) doTheft() {
 
 	// this function executes over and over again
 	// so we need to stop it after the game is finished
-	if g.state == finishedState {
+	if g.state == finishedState || g.deferWhilePaused(g.doTheft) {
 		return
 	}
 
-	var userIDs []userID
-	var theftAmounts []int32
+	var reports []theftReport
 
-	g.printPlayersPoints("Players' points BEFORE theft")
+	g.logPlayersPoints("Players' points BEFORE theft")
 	for userID, player := range g.players {
-		floatTheftAmount := float64(player.points) * float64(g.config.theftPercentage) / 100.0
+		// guard lets only part of the points to be stolen
+		protection := g.getTheftProtection(player)
+		floatTheftAmount := float64(player.points) * float64(g.config.theftPercentage) / 100.0 *
+			float64(100-protection) / 100.0
 		theftAmount := int32(math.Ceil(floatTheftAmount))
 
 		// send only if theft amount is positive number
 		// if the theft amount is negative or zero, then we won't do the theft
 		// and we won't send a redundant or meaningless message about it
 		if theftAmount > 0 {
-			player.points -= theftAmount // point deduction from player
-			g.bankPoints += theftAmount  // add them to bank
+			// point deduction from player, which are added to bank
+			g.apply(newEvent(EventTheft, userID, -theftAmount))
+		} else {
+			theftAmount = 0
+		}
 
-			userIDs = append(userIDs, userID)
-			theftAmounts = append(theftAmounts, theftAmount)
+		report := theftReport{
+			userID:     userID,
+			value:      theftAmount,
+			insured:    player.insured,
+			protection: protection,
+		}
+		// insurance covers only the next theft, even if nothing is stolen
+		report.insurancePayout = g.payInsurance(player, theftAmount)
+
+		if theftAmount > 0 {
+			reports = append(reports, report)
 		}
 	}
-	g.printPlayersPoints("Players' points AFTER theft")
+	g.logPlayersPoints("Players' points AFTER theft")
 
 	go func() {
-		msg := g.getTheftMessage(userIDs, theftAmounts)
+		msg := g.getTheftMessage(reports)
 		g.broadcast(msg)
-		log.Printf("Theft happened as follows:\n%v", msg)
+		g.logger.Info("Theft happened", zap.Int("robbed_players", len(reports)))
 	}()
 
-	time.AfterFunc(time.Duration(g.config.theftTime)*time.Second, func() {
+	g.scheduleTheft()
+	g.persist()
+}
+
+// scheduleTheft launches the timer for the next theft.
+// The calling function has to acquire write lock.
+func (g *game) scheduleTheft() {
+	theftDuration := time.Duration(g.config.theftTime) * time.Second
+	g.nextTheftTime = g.clock.Now().Add(theftDuration)
+	g.clock.AfterFunc(theftDuration, func() {
 		g.doTheft()
 	})
 }
 
-func (g *game) setPlayerStream(userID userID, stream pb.Game_StreamServer) error {
+// buyInsurance returns "True", if the player has been insured against the
+// next theft. Otherwise, it will return "False" and the outcome why the
+// insurance has not been bought. The paid premium is returned as well.
+func (g *game) buyInsurance(ctx context.Context, userID userID) (bool, outcome, int32, error) {
+	ctx, span := startSpan(ctx, "game.buyInsurance", g.spanAttrs(userID)...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	player, ok := g.players[userID]
+	if !ok {
+		return false, outcome{}, 0, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if g.config.insurancePremium == 0 {
+		return false, outcome{}, 0, fmt.Errorf("there is no insurance in the game")
+	}
+
+	premium := g.config.insurancePremium
+	if player.insured {
+		return false, deny(ReasonAlreadyInsured, 0, "player is already insured against the next theft"), 0, nil
+	}
+	if player.points < premium {
+		out := deny(ReasonInsufficientPoints, 0, "not enough points to pay the premium (%d required)", premium)
+		return false, out, 0, nil
+	}
+
+	g.apply(newEvent(EventInsurance, userID, -premium))
+	g.persist()
+	span.AddEvent(ctx, "insurance bought")
+
+	go func() {
+		msg := g.getInsuranceMessage(userID, premium)
+		g.broadcast(msg)
+	}()
+
+	return true, outcome{}, premium, nil
+}
+
+// setPlayerStream sets the stream of the player, on which only the events
+// of subscribed categories are sent. If the player has seen the events up
+// to provided sequence number, the missed events are sent on the stream
+// before any other event.
+func (g *game) setPlayerStream(
+	userID userID, stream pb.Game_StreamServer, subscriptions subscriptions, lastSeen int64,
+) error {
 	g.mutex.Lock() /* WRITE lock for player.setStream */
 	defer g.mutex.Unlock()
 
@@ -529,35 +2162,144 @@ func (g *game) setPlayerStream(userID userID, stream pb.Game_StreamServer) error
 		return fmt.Errorf("setPlayerStream: invalid user id %v", userID)
 	}
 
-	player.setStream(stream)
+	player.setStream(stream, subscriptions)
+	g.logger.Info("Stream has been set", zap.String("user_id", string(userID)))
+	if lastSeen == 0 {
+		return nil
+	}
+	g.streamMutex.Lock()
+	defer g.streamMutex.Unlock()
+	g.catchUp(player, lastSeen)
 	return nil
 }
 
+// reattachPlayerStream finds the player with provided session token and
+// replaces his stream with the new one, on which only the events of
+// subscribed categories are sent. The events, which the player has missed
+// since provided sequence number, or the full state of the game are sent
+// on the new stream before any other event.
+func (g *game) reattachPlayerStream(
+	token sessionToken, stream pb.Game_StreamServer, subscriptions subscriptions, lastSeen int64,
+) (userID, error) {
+	g.mutex.Lock() /* WRITE lock for player.setStream */
+	defer g.mutex.Unlock()
+
+	var player *player = nil
+	for _, p := range g.players {
+		if p.sessionToken == token {
+			player = p
+			break
+		}
+	}
+	if player == nil {
+		return "", fmt.Errorf("reattachPlayerStream: invalid session token for game %v", g.gameID)
+	}
+
+	player.setStream(stream, subscriptions)
+	g.logger.Info("Stream has been replaced", zap.String("user_id", string(player.userID)))
+	g.streamMutex.Lock()
+	defer g.streamMutex.Unlock()
+	g.catchUp(player, lastSeen)
+	return player.userID, nil
+}
+
+// getStreamQueue returns the events queued for provided stream of the
+// player and the channel, which is closed, when the stream is replaced
+// or the player leaves the game. The returned channel is already closed,
+// if the stream has been replaced.
+func (g *game) getStreamQueue(
+	userID userID, stream pb.Game_StreamServer,
+) (<-chan *pb.StreamResponse, <-chan struct{}) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	player, ok := g.players[userID]
+	if !ok || player.stream != stream {
+		done := make(chan struct{})
+		close(done)
+		return nil, done
+	}
+	return player.events, player.streamDone
+}
+
+// detachStream forgets provided stream of the player, which cannot be
+// sent to anymore, unless the player has replaced it by reconnecting.
+func (g *game) detachStream(userID userID, stream pb.Game_StreamServer) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if player, ok := g.players[userID]; ok && player.stream == stream {
+		player.closeStream()
+		player.stream = nil
+	}
+}
+
+// queue queues the event for the stream of the player without waiting
+// for the stream, so that a slow client doesn't hold up the game. If the
+// queue is full, the event is dropped, and the client detects the gap by
+// the sequence numbers. The calling function has to acquire streamMutex,
+// so that the events are queued in the order of their sequence numbers.
+func (g *game) queue(player *player, response *pb.StreamResponse) {
+	select {
+	case player.events <- response:
+	default:
+		g.logger.Warn("Stream is full, event is dropped", zap.String("user_id", string(player.userID)))
+	}
+}
+
+// sendTo sends the event only to the player with provided id.
+func (g *game) sendTo(userID userID, response *pb.StreamResponse) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	player, ok := g.players[userID]
+	if !ok || player.stream == nil || !player.subscriptions.includes(response) {
+		return
+	}
+	g.streamMutex.Lock()
+	defer g.streamMutex.Unlock()
+	response.Sequence = g.getStreamSequence()
+	g.queue(player, response)
+}
+
+// getStreamSequence returns the sequence number of the latest event
+// broadcast to all players. Events sent only to some of the players
+// carry this number without increasing it, so that clients detect
+// gaps only, when they miss the broadcast events.
+func (g *game) getStreamSequence() int64 {
+	return atomic.LoadInt64(&g.streamSequence)
+}
+
 // Broadcast sends some event to all users in the game.
 func (g *game) broadcast(response *pb.StreamResponse) {
 	g.mutex.RLock()
 	defer g.mutex.RUnlock()
-	for userID, player := range g.players {
-		stream := player.stream
+	g.streamMutex.Lock()
+	defer g.streamMutex.Unlock()
+	response.Sequence = atomic.AddInt64(&g.streamSequence, 1)
+	g.storeBacklog(response)
+	for _, player := range g.players {
 		// WARNING: this is a dirty workaround around the problem
 		// that start/deposit/etc handlers may be called before
-		if stream == nil {
-			continue
-		}
-		if err := stream.Send(response); err != nil {
-			log.Printf("Could not send event to %v in game %v: %v\n", userID, g.gameID, err)
+		if player.stream == nil || !player.subscriptions.includes(response) {
 			continue
 		}
+		g.queue(player, response)
 
 		// if sent message is Start, then player marked as notified about start
-		if reflect.TypeOf(response.Event) == reflect.TypeOf(pb.StreamResponse_Start_{}) {
+		if _, ok := response.Event.(*pb.StreamResponse_Start_); ok {
 			player.gameStartNotified = true
 		}
 
 		// if game is in active state and the player has not been notified about start,
 		// then notify player about start and mark player as notified
 		if g.state == activeState && !player.gameStartNotified {
-			stream.Send(g.getStartMessage())
+			start := g.getStartMessage()
+			if !player.subscriptions.includes(start) {
+				continue
+			}
+			start.Sequence = response.Sequence
+			g.queue(player, start)
 			player.gameStartNotified = true
 		}
 	}
@@ -585,6 +2327,68 @@ func (g *game) getPBPlayersWithBank() []*pb.Player {
 	return players
 }
 
+// getPBGameStatus returns the state of the game. The calling function
+// has to acquire at least read lock.
+func (g *game) getPBGameStatus() pb.GameStatus {
+	switch g.state {
+	case activeState:
+		return pb.GameStatus_GAME_STATUS_ACTIVE
+	case finishedState:
+		return pb.GameStatus_GAME_STATUS_FINISHED
+	}
+	return pb.GameStatus_GAME_STATUS_WAITING
+}
+
+// getRemainingTime returns seconds until the active game finishes, or 0,
+// if the game is not active. The calling function has to acquire at
+// least read lock.
+func (g *game) getRemainingTime() int32 {
+	if g.state != activeState {
+		return 0
+	}
+	// the clock is frozen, while the game is paused
+	now := g.clock.Now()
+	if !g.pauseTime.IsZero() {
+		now = g.pauseTime
+	}
+	elapsed := int32(now.Sub(g.startTime).Seconds())
+	if elapsed >= g.config.duration {
+		return 0
+	}
+	return g.config.duration - elapsed
+}
+
+// WARNING: This function doesn't use any locks, so make sure that
+// goroutine, which calls this function, uses at least read-lock.
+func (g *game) getPBGameState() *pb.GameState {
+	return &pb.GameState{
+		GameId:        string(g.gameID),
+		Status:        g.getPBGameStatus(),
+		Players:       g.getPBPlayersWithBank(),
+		RemainingTime: g.getRemainingTime(),
+		Jackpot:       g.jackpot,
+		StockPrice:    g.stockPrice,
+		PriceLevel:    g.priceLevel,
+		HostId:        string(g.hostID),
+		Teams:         g.getPBTeams(),
+		CoopProgress:  g.getPBCoopProgress(),
+		Paused:        !g.pauseTime.IsZero(),
+		Sequence:      g.getStreamSequence(),
+	}
+}
+
+// WARNING: This function doesn't use any locks, so make sure that
+// goroutine, which calls this function, uses at least read-lock.
+func (g *game) getStateMessage() *pb.StreamResponse {
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_State{
+			State: g.getPBGameState(),
+		},
+		Sequence: g.getStreamSequence(),
+	}
+	return res
+}
+
 // As this function uses Readlock, it has to be spawned in a separate goroutine.
 func (g *game) getJoinMessage(player *player) *pb.StreamResponse {
 	g.mutex.RLock()
@@ -603,23 +2407,27 @@ func (g *game) getJoinMessage(player *player) *pb.StreamResponse {
 
 // This function can be called from anywhere, as it doesn't
 // refer to the state of the game and does not use any locks.
-func (g *game) getLeaveMessage(userID userID) *pb.StreamResponse {
+func (g *game) getLeaveMessage(userID userID, hostID userID) *pb.StreamResponse {
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Leave_{
 			Leave: &pb.StreamResponse_Leave{
 				UserId: string(userID),
+				HostId: string(hostID),
 			},
 		},
 	}
 	return res
 }
 
-// This function can be called from anywhere, as it doesn't
-// refer to the state of the game and does not use any locks.
+// WARNING: This function doesn't use any locks, so make sure that
+// goroutine, which calls this function, uses at least read-lock.
+// Read-lock is needed since game config is read.
 func (g *game) getStartMessage() *pb.StreamResponse {
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Start_{
-			Start: &pb.StreamResponse_Start{},
+			Start: &pb.StreamResponse_Start{
+				Config: g.config.toPBGameConfig(),
+			},
 		},
 	}
 	return res
@@ -634,8 +2442,12 @@ func (g *game) getFinishMessage(winnerUserID userID) *pb.StreamResponse {
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Finish_{
 			Finish: &pb.StreamResponse_Finish{
-				Players:      players,
-				WinnerUserId: string(winnerUserID),
+				Players:        players,
+				WinnerUserId:   string(winnerUserID),
+				WinnerTeam:     g.findWinnerTeam(),
+				Teams:          g.getPBTeams(),
+				CoopProgress:   g.getPBCoopProgress(),
+				OvertimeRounds: g.getOvertimeRounds(),
 			},
 		},
 	}
@@ -652,6 +2464,7 @@ func (g *game) getUseCreditMessage(userID userID, val int32) *pb.StreamResponse
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
 				Event: &pb.StreamResponse_Transaction_UseCredit_{
 					UseCredit: &pb.StreamResponse_Transaction_UseCredit{
 						UserId: string(userID),
@@ -674,6 +2487,7 @@ func (g *game) getUseDepositMessage(userID userID, val int32) *pb.StreamResponse
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
 				Event: &pb.StreamResponse_Transaction_UseDeposit_{
 					UseDeposit: &pb.StreamResponse_Transaction_UseDeposit{
 						UserId: string(userID),
@@ -696,6 +2510,7 @@ func (g *game) getReturnCreditMessage(userID userID, valWithInterest int32) *pb.
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
 				Event: &pb.StreamResponse_Transaction_ReturnCredit_{
 					ReturnCredit: &pb.StreamResponse_Transaction_ReturnCredit{
 						UserId: string(userID),
@@ -708,6 +2523,30 @@ func (g *game) getReturnCreditMessage(userID userID, valWithInterest int32) *pb.
 	return res
 }
 
+// As this function uses Readlock, it has to be spawned in a separate goroutine.
+func (g *game) getRepayCreditMessage(userID userID, val int32, remainingVal int32) *pb.StreamResponse {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	players := g.getPBPlayersWithBank()
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Transaction_{
+			Transaction: &pb.StreamResponse_Transaction{
+				Players: players,
+				Jackpot: g.jackpot,
+				Event: &pb.StreamResponse_Transaction_RepayCredit_{
+					RepayCredit: &pb.StreamResponse_Transaction_RepayCredit{
+						UserId:         string(userID),
+						Value:          val,
+						RemainingValue: remainingVal,
+					},
+				},
+			},
+		},
+	}
+	return res
+}
+
 // As this function uses Readlock, it has to be spawned in a separate goroutine.
 func (g *game) getReturnDepositMessage(userID userID, valWithInterest int32) *pb.StreamResponse {
 	g.mutex.RLock()
@@ -718,6 +2557,7 @@ func (g *game) getReturnDepositMessage(userID userID, valWithInterest int32) *pb
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
 				Event: &pb.StreamResponse_Transaction_ReturnDeposit_{
 					ReturnDeposit: &pb.StreamResponse_Transaction_ReturnDeposit{
 						UserId: string(userID),
@@ -731,17 +2571,40 @@ func (g *game) getReturnDepositMessage(userID userID, valWithInterest int32) *pb
 }
 
 // As this function uses Readlock, it has to be spawned in a separate goroutine.
-func (g *game) getTheftMessage(userIDs []userID, theftAmounts []int32) *pb.StreamResponse {
+func (g *game) getWithdrawDepositMessage(
+	userID userID, val int32, penalty int32, remainingVal int32,
+) *pb.StreamResponse {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	players := g.getPBPlayersWithBank()
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Transaction_{
+			Transaction: &pb.StreamResponse_Transaction{
+				Players: players,
+				Jackpot: g.jackpot,
+				Event: &pb.StreamResponse_Transaction_WithdrawDeposit_{
+					WithdrawDeposit: &pb.StreamResponse_Transaction_WithdrawDeposit{
+						UserId:         string(userID),
+						Value:          val,
+						Penalty:        penalty,
+						RemainingValue: remainingVal,
+					},
+				},
+			},
+		},
+	}
+	return res
+}
+
+// As this function uses Readlock, it has to be spawned in a separate goroutine.
+func (g *game) getTheftMessage(reports []theftReport) *pb.StreamResponse {
 	g.mutex.RLock()
 	defer g.mutex.RUnlock()
 
 	var robbedPlayers []*pb.StreamResponse_Transaction_Theft_RobbedPlayer
-	for ind := range userIDs {
-		robbedPlayer := &pb.StreamResponse_Transaction_Theft_RobbedPlayer{
-			UserId: string(userIDs[ind]),
-			Value:  theftAmounts[ind],
-		}
-		robbedPlayers = append(robbedPlayers, robbedPlayer)
+	for _, report := range reports {
+		robbedPlayers = append(robbedPlayers, report.toPBRobbedPlayer())
 	}
 
 	players := g.getPBPlayersWithBank()
@@ -749,6 +2612,7 @@ func (g *game) getTheftMessage(userIDs []userID, theftAmounts []int32) *pb.Strea
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
 				Event: &pb.StreamResponse_Transaction_Theft_{
 					Theft: &pb.StreamResponse_Transaction_Theft{
 						RobbedPlayers: robbedPlayers,
@@ -761,7 +2625,30 @@ func (g *game) getTheftMessage(userIDs []userID, theftAmounts []int32) *pb.Strea
 }
 
 // As this function uses Readlock, it has to be spawned in a separate goroutine.
-func (g *game) getLotteryMessage(userID userID, val int32) *pb.StreamResponse {
+func (g *game) getInsuranceMessage(userID userID, premium int32) *pb.StreamResponse {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	players := g.getPBPlayersWithBank()
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Transaction_{
+			Transaction: &pb.StreamResponse_Transaction{
+				Players: players,
+				Jackpot: g.jackpot,
+				Event: &pb.StreamResponse_Transaction_Insurance_{
+					Insurance: &pb.StreamResponse_Transaction_Insurance{
+						UserId:  string(userID),
+						Premium: premium,
+					},
+				},
+			},
+		},
+	}
+	return res
+}
+
+// As this function uses Readlock, it has to be spawned in a separate goroutine.
+func (g *game) getLotteryMessage(userID userID, val int32, jackpotWon bool) *pb.StreamResponse {
 	g.mutex.RLock()
 	defer g.mutex.RUnlock()
 
@@ -770,10 +2657,12 @@ func (g *game) getLotteryMessage(userID userID, val int32) *pb.StreamResponse {
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
 				Event: &pb.StreamResponse_Transaction_Lottery_{
 					Lottery: &pb.StreamResponse_Transaction_Lottery{
-						UserId: string(userID),
-						Value:  val,
+						UserId:     string(userID),
+						Value:      val,
+						JackpotWon: jackpotWon,
 					},
 				},
 			},
@@ -794,6 +2683,7 @@ func (g *game) getAnswerQuestionMessage(
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
 				Event: &pb.StreamResponse_Transaction_Question_{
 					Question: &pb.StreamResponse_Transaction_Question{
 						UserId:          string(userID),
@@ -807,3 +2697,26 @@ func (g *game) getAnswerQuestionMessage(
 	}
 	return res
 }
+
+// As this function uses Readlock, it has to be spawned in a separate goroutine.
+func (g *game) getQuestionTimeoutMessage(userID userID, bidPoints int32) *pb.StreamResponse {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	players := g.getPBPlayersWithBank()
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Transaction_{
+			Transaction: &pb.StreamResponse_Transaction{
+				Players: players,
+				Jackpot: g.jackpot,
+				Event: &pb.StreamResponse_Transaction_QuestionTimeout_{
+					QuestionTimeout: &pb.StreamResponse_Transaction_QuestionTimeout{
+						UserId:    string(userID),
+						BidPoints: bidPoints,
+					},
+				},
+			},
+		},
+	}
+	return res
+}
@@ -3,9 +3,21 @@ module github.com/cs489-team11/server
 go 1.15
 
 require (
-	github.com/golang/protobuf v1.4.2
+	github.com/desertbit/timer v0.0.0-20180107155436-c41aec40b27f // indirect
+	github.com/dgrijalva/jwt-go v3.2.0+incompatible
+	github.com/golang/protobuf v1.4.3
 	github.com/google/uuid v1.1.2
+	github.com/improbable-eng/grpc-web v0.13.0
+	github.com/lib/pq v1.8.0
+	github.com/prometheus/client_golang v1.8.0
+	github.com/rs/cors v1.7.0 // indirect
 	github.com/stretchr/testify v1.6.1
+	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.13.0
+	go.opentelemetry.io/otel v0.13.0
+	go.uber.org/zap v1.16.0
+	golang.org/x/time v0.0.0-20191024005414-555d28b269f0
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013
 	google.golang.org/grpc v1.33.0
 	google.golang.org/protobuf v1.25.0
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c
 )
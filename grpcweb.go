@@ -0,0 +1,75 @@
+package server
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"go.uber.org/zap"
+
+	"google.golang.org/grpc"
+)
+
+// WithGRPCWeb makes server accept gRPC-Web requests over HTTP on provided
+// address, so that browsers can call the services and consume the event
+// stream without a separate proxy. Streams are also available over
+// WebSocket. Requests from any origin are allowed. The endpoint is
+// started by Listen and uses TLS config of the server, if it is set.
+func WithGRPCWeb(addr string) ServerOption {
+	return func(s *Server) {
+		s.grpcWebAddr = addr
+	}
+}
+
+// listenGRPCWeb initializes the listener of gRPC-Web endpoint,
+// if the server has been created with WithGRPCWeb option.
+func (s *Server) listenGRPCWeb() error {
+	if s.grpcWebAddr == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.grpcWebAddr)
+	if err != nil {
+		s.logger.Error("Failed to init gRPC-Web listener", zap.Error(err))
+		return err
+	}
+	s.logger.Info("Initialized gRPC-Web listener", zap.String("addr", listener.Addr().String()))
+
+	s.grpcWebListener = listener
+	return nil
+}
+
+// serveGRPCWeb starts translating gRPC-Web requests
+// to the calls of provided grpc server.
+func (s *Server) serveGRPCWeb(srv *grpc.Server) {
+	if s.grpcWebListener == nil {
+		return
+	}
+
+	allowOrigin := func(string) bool { return true }
+	wrapped := grpcweb.WrapServer(
+		srv,
+		grpcweb.WithOriginFunc(allowOrigin),
+		grpcweb.WithWebsockets(true),
+		grpcweb.WithWebsocketOriginFunc(func(*http.Request) bool { return true }),
+	)
+
+	listener := s.grpcWebListener
+	if s.tlsConfig != nil {
+		listener = tls.NewListener(listener, s.tlsConfig)
+	}
+	s.mutex.Lock()
+	s.grpcWebServer = &http.Server{Handler: wrapped}
+	s.mutex.Unlock()
+	go s.grpcWebServer.Serve(listener)
+}
+
+// GRPCWebAddr returns the address of the gRPC-Web endpoint
+// or empty string, if it is not enabled.
+func (s *Server) GRPCWebAddr() string {
+	if s.grpcWebListener == nil {
+		return ""
+	}
+	return s.grpcWebListener.Addr().String()
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+)
+
+// WithHeartbeat makes server send the Heartbeat event on every player
+// stream with provided interval.
+// Without this option, heartbeats are not sent.
+func WithHeartbeat(interval time.Duration) ServerOption {
+	return func(s *Server) {
+		s.heartbeatInterval = interval
+	}
+}
+
+// sendHeartbeat queues the Heartbeat event for provided stream, unless the
+// player has left, has replaced the stream by reconnecting, or is not
+// subscribed to the system events. Heartbeats
+// carry the number of the latest broadcast event like other events sent
+// only to some players.
+func (g *game) sendHeartbeat(userID userID, stream pb.Game_StreamServer) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	player, ok := g.players[userID]
+	if !ok || player.stream != stream {
+		return
+	}
+	msg := g.getHeartbeatMessage()
+	if !player.subscriptions.includes(msg) {
+		return
+	}
+	g.streamMutex.Lock()
+	defer g.streamMutex.Unlock()
+	msg.Sequence = g.getStreamSequence()
+	g.queue(player, msg)
+}
+
+// getHeartbeatMessage returns the Heartbeat event with the current
+// status of the game. The calling function has to acquire at least
+// read lock.
+func (g *game) getHeartbeatMessage() *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Heartbeat_{
+			Heartbeat: &pb.StreamResponse_Heartbeat{
+				Status:        g.getPBGameStatus(),
+				RemainingTime: g.getRemainingTime(),
+				Paused:        !g.pauseTime.IsZero(),
+				ServerTime:    g.clock.Now().UnixNano() / int64(time.Millisecond),
+			},
+		},
+	}
+}
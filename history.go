@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Default and maximal number of the games returned by ListGameHistory.
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+)
+
+// HistoryRecord is a persisted summary of the finished game,
+// by which players browse their past games.
+type HistoryRecord struct {
+	GameID     string
+	FinishTime time.Time
+	Summary    *pb.GameSummary
+}
+
+// getUsernames returns usernames of the players, who have finished the
+// game, except bots, whose history is not kept.
+func (r HistoryRecord) getUsernames() []string {
+	var usernames []string
+	for _, result := range r.Summary.GetResults().GetRankings() {
+		if !result.Bot {
+			usernames = append(usernames, result.Username)
+		}
+	}
+	return usernames
+}
+
+// HistoryFilter selects the games, which the player has finished.
+type HistoryFilter struct {
+	Username string
+	Since    time.Time // not bounded, if zero
+	Until    time.Time // exclusive, not bounded, if zero
+	Offset   int       // number of the newest games to skip
+	Limit    int
+}
+
+// matches returns true, if the record passes the filter
+// regardless of the offset and the limit.
+func (f HistoryFilter) matches(record HistoryRecord) bool {
+	if !f.Since.IsZero() && record.FinishTime.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !record.FinishTime.Before(f.Until) {
+		return false
+	}
+	for _, username := range record.getUsernames() {
+		if username == f.Username {
+			return true
+		}
+	}
+	return false
+}
+
+// saveHistory records the summary of the finished game, if the
+// game has storage.
+func (g *game) saveHistory(summary *pb.GameSummary) {
+	if g.storage == nil {
+		return
+	}
+	record := HistoryRecord{
+		GameID:     summary.GameId,
+		FinishTime: fromMillis(summary.FinishTime),
+		Summary:    summary,
+	}
+	if err := g.storage.AddHistory(record); err != nil {
+		g.logger.Error("Failed to persist history", zap.Error(err))
+	}
+}
+
+// fromMillis converts milliseconds since epoch to the time,
+// 0 is converted to zero time.
+func fromMillis(millis int64) time.Time {
+	if millis == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, millis*int64(time.Millisecond))
+}
+
+// ListGameHistory returns summaries of the games, which the player has
+// finished, from the newest to the oldest. It requires the server to
+// keep finished games in the storage.
+func (s *Server) ListGameHistory(_ context.Context, req *pb.ListGameHistoryRequest) (*pb.ListGameHistoryResponse, error) {
+	reqUsername := req.GetUsername()
+	offset := req.GetOffset()
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+	since := fromMillis(req.GetSince())
+	until := fromMillis(req.GetUntil())
+
+	if s.storage == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "server doesn't keep finished games")
+	}
+	// one more game is loaded to know, if there is the next page
+	records, err := s.storage.LoadHistory(HistoryFilter{
+		Username: reqUsername,
+		Since:    since,
+		Until:    until,
+		Offset:   int(offset),
+		Limit:    int(limit) + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load history: %v", err)
+	}
+
+	res := &pb.ListGameHistoryResponse{}
+	if len(records) > int(limit) {
+		records = records[:limit]
+		res.NextOffset = offset + limit
+	}
+	for _, record := range records {
+		res.Games = append(res.Games, record.Summary)
+	}
+	return res, nil
+}
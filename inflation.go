@@ -0,0 +1,71 @@
+package server
+
+import (
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+)
+
+// Price level of the game without inflation, as percentage.
+const initialPriceLevel = 100
+
+// getLotteryMaxWin returns the max win of the lottery
+// scaled by the current price level of the game.
+// The calling function has to acquire at least read lock.
+func (g *game) getLotteryMaxWin() int32 {
+	return getNumberProportion(g.config.lotteryMaxWin, g.priceLevel)
+}
+
+// generateLotteryCellValues generates values of the lottery
+// cells, which are scaled by the current price level of the game.
+// The calling function has to acquire write lock.
+func (g *game) generateLotteryCellValues() {
+	g.lotteryCellValues = generateLotteryCellValues(
+		g.getLotteryMaxWin(),
+		g.config.lotteryCellCount(),
+		g.config.getLotteryPayouts(),
+	)
+}
+
+func (g *game) scheduleInflation() {
+	g.clock.AfterFunc(time.Duration(g.config.inflationTime)*time.Second, func() {
+		g.doInflation()
+	})
+}
+
+// doInflation raises the price level by the inflation rate, so that
+// lottery and question payouts grow, while points of the players don't.
+// It is repeated, until the game is finished.
+func (g *game) doInflation() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state == finishedState || g.deferWhilePaused(g.doInflation) {
+		return
+	}
+
+	event := newEvent(EventInflation, "", 0)
+	event.Price = g.priceLevel + getNumberProportion(g.priceLevel, g.config.inflationRate)
+	g.apply(event)
+	g.persist()
+	g.logger.Debug("Price level has changed", zap.Int32("price_level", g.priceLevel))
+
+	msg := getInflationMessage(g.priceLevel, g.getLotteryMaxWin())
+	go func() {
+		g.broadcast(msg)
+	}()
+
+	g.scheduleInflation()
+}
+
+func getInflationMessage(priceLevel int32, lotteryMaxWin int32) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Inflation_{
+			Inflation: &pb.StreamResponse_Inflation{
+				PriceLevel:    priceLevel,
+				LotteryMaxWin: lotteryMaxWin,
+			},
+		},
+	}
+}
@@ -0,0 +1,430 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// JournalEvent is a single state-changing action in the game.
+// Every game keeps the append-only list of its events, applying of
+// which to the new game reproduces the state of the original one.
+type JournalEvent struct {
+	GameID   string
+	Sequence int64 // starts from 1 in every game
+	Time     time.Time
+	Kind     string
+	UserID   string
+	// For events moving money, it is amount of points moved from
+	// the bank to the player (negative, if it is the opposite).
+	// For loan and trade events, points move from the other player
+	// (the lender or the seller) instead of the bank.
+	// For join events, it is the initial amount of player's points.
+	Value int32
+	// id of the credit, deposit, loan, or question the event refers to
+	RefID string
+	// for early repayments and withdrawals, returned part of the value
+	// of the credit or deposit; the whole position is returned, if 0
+	Principal int32
+	// for credit and deposit events, interest decided by the bank strategy;
+	// interest of the default strategy is used, if 0
+	Interest int32
+	// set only for join events, and username for rename events as well
+	Username     string
+	SessionToken string
+	AvatarID     string
+	Color        string
+	// set only for start events
+	Config *GameConfig
+	Seed   int64
+	// set only for loan offer events
+	Loan *LoanTerms
+	// set only for trade events
+	Trade *Trade
+	// for buy and sell shares events, number of shares
+	Shares int32
+	// for buy and sell shares and stock tick events, price of the share
+	Price int32
+	// for steal events, id of the player, from whom the points are
+	// stolen, or to whom the penalty is paid
+	Target string
+	// for join and team events, team of the player,
+	// 0 if the game has no teams
+	Team int32
+	// for join events, true if the player is driven by the server
+	Bot bool
+	// for deposit events, true if the deposit of the AFK player
+	// is rolled over by the server
+	Auto bool
+}
+
+// Kinds of journal events. Kinds of events moving money
+// are the same as the kinds of corresponding transactions.
+const (
+	EventJoin            = "join"
+	EventLeave           = "leave"
+	EventStart           = "start"
+	EventCredit          = TransactionCredit
+	EventDeposit         = TransactionDeposit
+	EventReturnCredit    = TransactionReturnCredit
+	EventRepayCredit     = TransactionRepayCredit
+	EventReturnDeposit   = TransactionReturnDeposit
+	EventWithdrawDeposit = TransactionWithdrawDeposit
+	EventLottery         = TransactionLottery
+	EventJackpot         = TransactionJackpot
+	EventQuestionBid     = TransactionQuestionBid
+	EventQuestionAnswer  = "question_answer"
+	EventQuestionTimeout = "question_timeout"
+	EventTheft           = TransactionTheft
+	EventEvict           = TransactionEvict
+	EventLoanOffer       = "loan_offer"
+	EventLoanAccept      = TransactionLoanAccept
+	EventLoanRepay       = TransactionLoanRepay
+	EventLoanDefault     = TransactionLoanDefault
+	EventLoanCollect     = TransactionLoanCollect
+	EventTrade           = TransactionTrade
+	EventBuyShares       = TransactionBuyShares
+	EventSellShares      = TransactionSellShares
+	EventStockTick       = "stock_tick"
+	EventInsurance       = TransactionInsurance
+	EventInsurancePayout = TransactionInsurancePayout
+	EventTax             = TransactionTax
+	EventInflation       = "inflation"
+	EventSteal           = TransactionSteal
+	EventStealFailure    = TransactionStealFailure
+	EventGuard           = TransactionGuard
+	EventTeam            = "team"
+	EventRename          = "rename"
+	EventForfeit         = "forfeit"
+	EventFinish          = "finish"
+)
+
+// newEvent returns event of provided kind for the player with provided
+// id. It happens, when it is applied to the game, by the clock of the game.
+func newEvent(kind string, userID userID, value int32) JournalEvent {
+	return JournalEvent{
+		Kind:   kind,
+		UserID: string(userID),
+		Value:  value,
+	}
+}
+
+func (e JournalEvent) targetID() userID {
+	return userID(e.Target)
+}
+
+// apply changes the state of the game according to the event and
+// appends the event to the journal. All changes of players' points,
+// bank's points, and positions have to be done through this function.
+// Timers, broadcasting, and snapshots are up to the calling function.
+// The calling function has to acquire write lock.
+func (g *game) apply(event JournalEvent) {
+	if event.Time.IsZero() {
+		event.Time = g.clock.Now()
+	}
+	event.GameID = string(g.gameID)
+	event.Sequence = int64(len(g.journal)) + 1
+	// loans and trades move points between the players instead of
+	// the bank, points of the lender, who has left, are moved to the bank
+	var counterparty *player
+	userID := userID(event.UserID)
+	player := g.players[userID]
+
+	switch event.Kind {
+	case EventJoin:
+		player = newPlayer(username(event.Username), event.Value)
+		player.userID = userID
+		player.sessionToken = sessionToken(event.SessionToken)
+		player.team = event.Team
+		player.bot = event.Bot
+		player.avatarID = event.AvatarID
+		player.color = event.Color
+		g.players[userID] = player
+		if g.hostID == "" && !event.Bot {
+			g.hostID = userID
+		}
+	case EventLeave, EventEvict:
+		player.closeStream()
+		delete(g.players, userID)
+		// host role passes to the player, who has joined next
+		if userID == g.hostID {
+			g.hostID = g.getNextHostID()
+		}
+	case EventTeam:
+		player.team = event.Team
+	case EventRename:
+		player.username = username(event.Username)
+	case EventForfeit:
+		player.forfeited = true
+	case EventStart:
+		config := *event.Config
+		// teams chosen in the lobby are kept, unless they don't
+		// fit the number of teams, which is overridden at start
+		if !g.fitTeams(config.teamCount) {
+			g.assignTeams(config.teamCount)
+		}
+		if config.playerPoints != g.config.playerPoints {
+			for _, player := range g.players {
+				player.points = config.playerPoints
+			}
+		}
+		g.config = config
+		g.seedRand(event.Seed, 0)
+		g.stockPrice = config.stockPrice
+		g.priceLevel = initialPriceLevel
+		g.generateLotteryCellValues()
+
+		g.state = activeState
+		g.startTime = event.Time
+		// bank points are calculated
+		g.bankPoints = int32(len(g.players)) * g.config.bankPointsPerPlayer
+
+		// marking each player as if he has just played the lottery
+		// users can play their first lottery after g.config.lotteryTime seconds.
+		for _, player := range g.players {
+			player.lastLotteryTime = event.Time
+			player.lastActionTime = event.Time
+			for _, asset := range g.config.marketAssets {
+				player.assets[asset] = g.config.marketUnits
+			}
+		}
+	case EventCredit:
+		interest := event.Interest
+		if interest == 0 {
+			interest = DefaultBankStrategy{}.CreditInterest(g.getBankConditions(), player.creditScore)
+		}
+		credit := newPositionAt(positionID(event.RefID), event.Value, interest, event.Time, g.config.creditTime)
+		player.credits[credit.positionID] = credit
+	case EventDeposit:
+		interest := event.Interest
+		if interest == 0 {
+			interest = g.config.depositInterest
+		}
+		deposit := newPositionAt(positionID(event.RefID), -event.Value, interest, event.Time, g.config.depositTime)
+		player.deposits[deposit.positionID] = deposit
+	case EventReturnCredit:
+		delete(player.credits, positionID(event.RefID))
+		// points are taken even if the player cannot afford it, but that is a default
+		if player.points < -event.Value {
+			player.changeCreditScore(creditDefaultScore)
+		} else {
+			player.changeCreditScore(onTimeRepaymentScore)
+		}
+	case EventRepayCredit:
+		returnPosition(player.credits, positionID(event.RefID), event.Principal)
+		if _, ok := player.credits[positionID(event.RefID)]; !ok {
+			player.changeCreditScore(onTimeRepaymentScore)
+		}
+	case EventReturnDeposit:
+		delete(player.deposits, positionID(event.RefID))
+	case EventWithdrawDeposit:
+		returnPosition(player.deposits, positionID(event.RefID), event.Principal)
+	case EventLottery:
+		// record that player have just played lottery
+		player.lastLotteryTime = event.Time
+		if event.Value == 0 {
+			g.jackpot += getNumberProportion(g.getLotteryMaxWin(), g.config.jackpotPercentage)
+		}
+	case EventJackpot:
+		g.jackpot = 0
+	case EventQuestionAnswer:
+		// bid of the lost question stays in the bank
+		if qInfo, ok := player.questions[questionID(event.RefID)]; ok && event.Value == 0 {
+			g.jackpot += getNumberProportion(qInfo.bidPoints, g.config.jackpotPercentage)
+		}
+		// question can be answered only once
+		delete(player.questions, questionID(event.RefID))
+	case EventQuestionTimeout:
+		// expired question is kept, so that its answer can be refused
+		if qInfo, ok := player.questions[questionID(event.RefID)]; ok {
+			qInfo.expired = true
+			g.jackpot += getNumberProportion(qInfo.bidPoints, g.config.jackpotPercentage)
+		}
+	case EventLoanOffer:
+		g.loans[loanID(event.RefID)] = newLoan(loanID(event.RefID), *event.Loan)
+	case EventLoanAccept:
+		loan := g.loans[loanID(event.RefID)]
+		loan.state = activeLoan
+		loan.due = g.config.getValueWithInterest(loan.terms.Value, loan.terms.Interest)
+		loan.endTime = event.Time.Add(time.Duration(loan.terms.Time) * time.Second)
+		counterparty = g.players[loan.lender]
+	case EventLoanRepay:
+		loan := g.loans[loanID(event.RefID)]
+		loan.due += event.Value
+		loan.state = repaidLoan
+		delete(g.loans, loan.loanID)
+		counterparty = g.players[loan.lender]
+		player.changeCreditScore(onTimeRepaymentScore)
+	case EventLoanDefault:
+		loan := g.loans[loanID(event.RefID)]
+		loan.due += event.Value
+		loan.state = collectionsLoan
+		counterparty = g.players[loan.lender]
+		player.changeCreditScore(creditDefaultScore)
+	case EventLoanCollect:
+		loan := g.loans[loanID(event.RefID)]
+		loan.due += event.Value
+		if loan.due <= 0 {
+			loan.state = repaidLoan
+			delete(g.loans, loan.loanID)
+		}
+		counterparty = g.players[loan.lender]
+	case EventTrade:
+		trade := event.Trade
+		counterparty = g.players[trade.sellerID()]
+		player.assets[trade.Asset] += trade.Quantity
+		counterparty.assets[trade.Asset] -= trade.Quantity
+		g.getOrderBook(trade.Asset).lastPrice = trade.Price
+	case EventBuyShares:
+		player.shares += event.Shares
+	case EventSellShares:
+		player.shares -= event.Shares
+	case EventStockTick:
+		g.stockPrice = event.Price
+	case EventInflation:
+		g.priceLevel = event.Price
+		g.generateLotteryCellValues()
+	case EventSteal, EventStealFailure:
+		player.lastStealTime = event.Time
+		counterparty = g.players[event.targetID()]
+	case EventGuard:
+		player.guardEndTime = event.Time.Add(time.Duration(g.config.guardTime) * time.Second)
+	case EventInsurance:
+		player.insured = true
+	case EventInsurancePayout:
+		player.insured = false
+	case EventFinish:
+		g.state = finishedState
+	}
+	if afkActions[event.Kind] && !event.Auto {
+		player.lastActionTime = event.Time
+	}
+
+	if event.Kind != EventJoin && event.Value != 0 {
+		player.points += event.Value
+		transactionKind := event.Kind
+		if event.Kind == EventQuestionAnswer {
+			transactionKind = TransactionQuestionWin
+		}
+		g.recordTransaction(userID, transactionKind, event.Value)
+		if counterparty != nil {
+			counterparty.points -= event.Value
+			g.recordTransaction(counterparty.userID, transactionKind, -event.Value)
+		} else {
+			g.bankPoints -= event.Value
+		}
+	}
+
+	g.journal = append(g.journal, event)
+	// events of waiting games are saved once the game starts
+	if event.Kind == EventStart {
+		g.saveEvents(g.journal...)
+	} else if g.state != waitingState {
+		g.saveEvents(event)
+	}
+	g.checkEventAchievements(event, player, counterparty)
+	g.checkCoopProgress()
+	g.checkWinCondition()
+}
+
+// returnPosition decreases the value of the position by the returned
+// part. Position is deleted, once its whole value is returned.
+func returnPosition(positions map[positionID]*position, positionID positionID, val int32) {
+	pos, ok := positions[positionID]
+	if !ok {
+		return
+	}
+	if val == 0 || val >= pos.value {
+		delete(positions, positionID)
+		return
+	}
+	pos.value -= val
+}
+
+// saveEvents saves journal events, if the game has storage.
+// The calling function has to acquire at least read lock.
+func (g *game) saveEvents(events ...JournalEvent) {
+	if g.storage == nil {
+		return
+	}
+	for _, event := range events {
+		if err := g.storage.AppendEvent(event); err != nil {
+			g.logger.Error("Failed to persist event", zap.Int64("sequence", event.Sequence), zap.Error(err))
+		}
+	}
+}
+
+// replayJournal creates a game by applying journal events one by one.
+// If provided, check is called after each applied event and replaying
+// stops at its first error. Returned game doesn't have timers and storage.
+func replayJournal(events []JournalEvent, check func(*game, JournalEvent) error) (*game, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("journal is empty")
+	}
+
+	g := newGameWithID(gameID(events[0].GameID), GameConfig{}, nil, zap.NewNop())
+	for i, event := range events {
+		if event.Sequence != int64(i)+1 {
+			return nil, fmt.Errorf("event %d has sequence number %d", i+1, event.Sequence)
+		}
+		if event.Kind == EventStart && event.Config == nil {
+			return nil, fmt.Errorf("start event %d doesn't have config", event.Sequence)
+		}
+		if event.Kind != EventJoin && event.Kind != EventStart &&
+			event.Kind != EventFinish && event.Kind != EventStockTick && event.Kind != EventInflation {
+			if _, ok := g.players[userID(event.UserID)]; !ok {
+				return nil, fmt.Errorf("event %d refers to unknown player %v", event.Sequence, event.UserID)
+			}
+		}
+
+		g.apply(event)
+		if check != nil {
+			if err := check(g, event); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return g, nil
+}
+
+// auditJournal replays the journal and checks that the total amount
+// of points of the players and the bank doesn't change after the start.
+// Gains and losses on the stock are included in this check, since shares
+// are traded with the bank, and it is also checked that they are traded
+// at the current price and that players don't sell shares they don't have.
+func auditJournal(events []JournalEvent) error {
+	total := int32(0)
+	_, err := replayJournal(events, func(g *game, event JournalEvent) error {
+		if g.state == waitingState {
+			return nil
+		}
+
+		if event.Kind == EventBuyShares || event.Kind == EventSellShares {
+			if event.Price != g.stockPrice {
+				return fmt.Errorf(
+					"shares are traded at %d instead of the current price %d in event %d",
+					event.Price, g.stockPrice, event.Sequence,
+				)
+			}
+			if g.players[userID(event.UserID)].shares < 0 {
+				return fmt.Errorf("player %v sold shares he doesn't have in event %d", event.UserID, event.Sequence)
+			}
+		}
+
+		currentTotal := g.bankPoints
+		for _, player := range g.players {
+			currentTotal += player.points
+		}
+		if event.Kind == EventStart {
+			total = currentTotal
+		} else if currentTotal != total {
+			return fmt.Errorf(
+				"total amount of points changed from %d to %d after event %d (%s)",
+				total, currentTotal, event.Sequence, event.Kind,
+			)
+		}
+		return nil
+	})
+	return err
+}
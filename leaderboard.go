@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Number of leaderboard entries returned, if the request doesn't limit it.
+const defaultLeaderboardLimit = 10
+
+// Maximal number of leaderboard entries returned by a single request.
+const maxLeaderboardLimit = 100
+
+// ResultRecord is a persisted result of the player in the finished game.
+type ResultRecord struct {
+	GameID   string
+	Username string
+	Winnings int32 // final points minus initial points
+	Won      bool
+	Rating   int32 // rating after the game
+	Time     time.Time
+}
+
+// saveResults records results of the players, who have finished the
+// game, if the game has storage. Results of bots are not recorded. Ratings have to be updated before.
+// The calling function has to acquire at least read lock.
+func (g *game) saveResults() {
+	if g.storage == nil || len(g.players) == 0 {
+		return
+	}
+	now := g.clock.Now()
+	winners := g.findWinners()
+	results := make([]ResultRecord, 0, len(g.players))
+	for _, player := range g.players {
+		if player.bot {
+			continue
+		}
+		results = append(results, ResultRecord{
+			GameID:   string(g.gameID),
+			Username: string(player.username),
+			Winnings: player.points - g.config.playerPoints,
+			Won:      winners[player.userID],
+			Rating:   player.rating,
+			Time:     now,
+		})
+	}
+	if err := g.storage.AddResults(results); err != nil {
+		g.logger.Error("Failed to persist results", zap.Error(err))
+	}
+}
+
+// getLeaderboardSince returns the time, from which results
+// are ranked in provided window.
+func getLeaderboardSince(window pb.LeaderboardWindow, now time.Time) time.Time {
+	switch window {
+	case pb.LeaderboardWindow_LEADERBOARD_WINDOW_DAILY:
+		return now.Add(-24 * time.Hour)
+	case pb.LeaderboardWindow_LEADERBOARD_WINDOW_WEEKLY:
+		return now.Add(-7 * 24 * time.Hour)
+	}
+	return time.Time{}
+}
+
+// getLeaderboard sums up results of every player and ranks the players
+// in provided order. Ties are broken by usernames.
+func getLeaderboard(results []ResultRecord, order pb.LeaderboardOrder) []*pb.LeaderboardEntry {
+	entries := make(map[string]*pb.LeaderboardEntry)
+	latest := make(map[string]time.Time)
+	for _, result := range results {
+		entry, ok := entries[result.Username]
+		if !ok {
+			entry = &pb.LeaderboardEntry{Username: result.Username}
+			entries[result.Username] = entry
+		}
+		entry.TotalWinnings += int64(result.Winnings)
+		entry.Games++
+		if result.Won {
+			entry.Wins++
+		}
+		if !result.Time.Before(latest[result.Username]) {
+			entry.Rating = result.Rating
+			latest[result.Username] = result.Time
+		}
+	}
+
+	res := make([]*pb.LeaderboardEntry, 0, len(entries))
+	for _, entry := range entries {
+		entry.WinRatePercentage = entry.Wins * 100 / entry.Games
+		res = append(res, entry)
+	}
+	key := func(entry *pb.LeaderboardEntry) int64 {
+		switch order {
+		case pb.LeaderboardOrder_LEADERBOARD_ORDER_WINNINGS:
+			return entry.TotalWinnings
+		case pb.LeaderboardOrder_LEADERBOARD_ORDER_WIN_RATE:
+			return int64(entry.WinRatePercentage)
+		}
+		return int64(entry.Rating)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if key(res[i]) != key(res[j]) {
+			return key(res[i]) > key(res[j])
+		}
+		return res[i].Username < res[j].Username
+	})
+	for i, entry := range res {
+		entry.Rank = int32(i + 1)
+	}
+	return res
+}
+
+func (s *Server) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	offset := req.GetOffset()
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+
+	if s.storage == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "server doesn't keep results of finished games")
+	}
+	results, err := s.storage.LoadResults(getLeaderboardSince(req.GetWindow(), s.clock.Now()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load results: %v", err)
+	}
+
+	entries := getLeaderboard(results, req.GetOrder())
+	total := int32(len(entries))
+	if offset > total {
+		offset = total
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return &pb.GetLeaderboardResponse{
+		Entries: entries[offset:end],
+		Total:   total,
+	}, nil
+}
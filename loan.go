@@ -0,0 +1,290 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/label"
+	"go.uber.org/zap"
+)
+
+type loanID string
+
+type loanState int
+
+const (
+	offeredLoan loanState = iota
+	activeLoan
+	repaidLoan
+	// borrower hasn't paid the whole loan, when its time ended
+	collectionsLoan
+)
+
+// Time between attempts to collect the rest of the loan in collections.
+const loanCollectionInterval = 5 * time.Second
+
+// LoanTerms are the terms of the loan, which one player offers to another.
+type LoanTerms struct {
+	Lender   string
+	Borrower string
+	Value    int32
+	Interest int32 // percentage of the value for the whole time
+	Time     int32 // seconds from the acceptance until the loan is collected
+}
+
+// loan is the loan between two players of the game.
+type loan struct {
+	loanID   loanID
+	lender   userID
+	borrower userID
+	terms    LoanTerms
+	state    loanState
+	due      int32 // points, which the borrower still has to pay
+	endTime  time.Time
+	timer    Timer // nil, if the loan is not accepted yet
+}
+
+func newLoanID() loanID {
+	return loanID(uuid.New().String())
+}
+
+func newLoan(loanID loanID, terms LoanTerms) *loan {
+	return &loan{
+		loanID:   loanID,
+		lender:   userID(terms.Lender),
+		borrower: userID(terms.Borrower),
+		terms:    terms,
+		state:    offeredLoan,
+	}
+}
+
+// when game calls this function on loan, make sure to grab
+// READ lock on game
+func (l *loan) toPBLoan(now time.Time) *pb.Loan {
+	status := pb.LoanStatus_LOAN_STATUS_OFFERED
+	remainingTime := int32(0)
+	switch l.state {
+	case activeLoan:
+		status = pb.LoanStatus_LOAN_STATUS_ACTIVE
+		remainingTime = int32(math.Ceil(l.endTime.Sub(now).Seconds()))
+		if remainingTime < 0 {
+			remainingTime = 0
+		}
+	case repaidLoan:
+		status = pb.LoanStatus_LOAN_STATUS_REPAID
+	case collectionsLoan:
+		status = pb.LoanStatus_LOAN_STATUS_COLLECTIONS
+	}
+
+	return &pb.Loan{
+		LoanId:        string(l.loanID),
+		LenderId:      string(l.lender),
+		BorrowerId:    string(l.borrower),
+		Value:         l.terms.Value,
+		Interest:      l.terms.Interest,
+		Time:          l.terms.Time,
+		Status:        status,
+		Due:           l.due,
+		RemainingTime: remainingTime,
+	}
+}
+
+// getPBLoansOfPlayer returns loans, which the player has offered,
+// taken, or given, sorted by their ids.
+// WARNING: This function doesn't use any locks, so make sure that
+// goroutine, which calls this function, uses at least read-lock.
+func (g *game) getPBLoansOfPlayer(userID userID) []*pb.Loan {
+	var loans []*pb.Loan
+	for _, loan := range g.loans {
+		if loan.lender == userID || loan.borrower == userID {
+			loans = append(loans, loan.toPBLoan(g.clock.Now()))
+		}
+	}
+	sort.Slice(loans, func(i, j int) bool {
+		return loans[i].LoanId < loans[j].LoanId
+	})
+	return loans
+}
+
+// offerLoan offers the loan with provided terms from the lender to the
+// borrower. Points are moved only when the borrower accepts the loan.
+// Returns id of the offered loan.
+func (g *game) offerLoan(
+	ctx context.Context, lenderID userID, borrowerID userID, val int32, interest int32, seconds int32,
+) (loanID, error) {
+	ctx, span := startSpan(ctx, "game.offerLoan", append(g.spanAttrs(lenderID), label.Int32("value", val))...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	lender, ok := g.players[lenderID]
+	if !ok {
+		return "", fmt.Errorf("there is no player with id %v in the game", lenderID)
+	}
+	if _, ok := g.players[borrowerID]; !ok {
+		return "", fmt.Errorf("there is no player with id %v in the game", borrowerID)
+	}
+	if lenderID == borrowerID {
+		return "", fmt.Errorf("player cannot lend to himself")
+	}
+	if lender.points < val {
+		return "", fmt.Errorf("lender doesn't have enough points (%d) to lend %d", lender.points, val)
+	}
+
+	event := newEvent(EventLoanOffer, lenderID, 0)
+	event.RefID = string(newLoanID())
+	event.Loan = &LoanTerms{
+		Lender:   string(lenderID),
+		Borrower: string(borrowerID),
+		Value:    val,
+		Interest: interest,
+		Time:     seconds,
+	}
+	g.apply(event)
+	g.persist()
+	span.AddEvent(ctx, "loan offered")
+
+	pbLoan := g.loans[loanID(event.RefID)].toPBLoan(g.clock.Now())
+	go func() {
+		msg := g.getLoanChangeMessage(pbLoan, 0)
+		g.broadcast(msg)
+	}()
+
+	return loanID(event.RefID), nil
+}
+
+// acceptLoan moves points of the loan from the lender to the borrower
+// and schedules its collection. It returns "False" and the outcome, if
+// the lender doesn't have enough points anymore.
+func (g *game) acceptLoan(ctx context.Context, borrowerID userID, loanID loanID) (bool, outcome, error) {
+	ctx, span := startSpan(ctx, "game.acceptLoan", g.spanAttrs(borrowerID)...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	if _, ok := g.players[borrowerID]; !ok {
+		return false, outcome{}, fmt.Errorf("there is no player with id %v in the game", borrowerID)
+	}
+	loan, ok := g.loans[loanID]
+	if !ok || loan.borrower != borrowerID {
+		return false, outcome{}, fmt.Errorf("loan %v has not been offered to player %v", loanID, borrowerID)
+	}
+	if loan.state != offeredLoan {
+		return false, outcome{}, fmt.Errorf("loan %v has already been accepted", loanID)
+	}
+
+	lender, ok := g.players[loan.lender]
+	if !ok {
+		return false, deny(ReasonLenderLeft, 0, "lender has left the game"), nil
+	}
+	if lender.points < loan.terms.Value {
+		return false, deny(ReasonLenderInsufficientPoints, 0, "lender doesn't have enough points anymore"), nil
+	}
+
+	event := newEvent(EventLoanAccept, borrowerID, loan.terms.Value)
+	event.RefID = string(loanID)
+	g.apply(event)
+	loan.timer = g.clock.AfterFunc(loan.endTime.Sub(g.clock.Now()), func() {
+		g.collectLoan(loanID)
+	})
+	g.persist()
+	span.AddEvent(ctx, "loan accepted")
+
+	pbLoan := loan.toPBLoan(g.clock.Now())
+	go func() {
+		msg := g.getLoanChangeMessage(pbLoan, loan.terms.Value)
+		g.broadcast(msg)
+	}()
+
+	return true, outcome{}, nil
+}
+
+// collectLoan is called, when the time of the loan ends, and then
+// periodically, while the loan is in collections. Borrower pays as
+// much of the due points as he has. If it is not enough, the loan
+// moves to collections, and the rest is collected later.
+func (g *game) collectLoan(loanID loanID) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	loan, ok := g.loans[loanID]
+	if !ok {
+		g.logger.Warn("collectLoan has been called with loan, which is not in this game", zap.String("loan_id", string(loanID)))
+		return
+	}
+	// loans are not collected after the end of the game
+	if g.state != activeState {
+		return
+	}
+	borrower, ok := g.players[loan.borrower]
+	if !ok {
+		g.logger.Info("Loan is not collected, since borrower has left", zap.String("loan_id", string(loanID)))
+		return
+	}
+
+	paid := loan.due
+	if borrower.points < paid {
+		paid = borrower.points
+	}
+	if paid < 0 {
+		paid = 0
+	}
+
+	kind := EventLoanCollect
+	if loan.state == activeLoan {
+		kind = EventLoanRepay
+		if paid < loan.due {
+			kind = EventLoanDefault
+		}
+	}
+	if kind != EventLoanCollect || paid > 0 {
+		event := newEvent(kind, loan.borrower, -paid)
+		event.RefID = string(loanID)
+		g.apply(event)
+		g.persist()
+
+		pbLoan := loan.toPBLoan(g.clock.Now())
+		go func() {
+			msg := g.getLoanChangeMessage(pbLoan, -paid)
+			g.broadcast(msg)
+		}()
+	}
+
+	if loan.state == collectionsLoan {
+		loan.timer = g.clock.AfterFunc(loanCollectionInterval, func() {
+			g.collectLoan(loanID)
+		})
+	}
+}
+
+// As this function uses Readlock, it has to be spawned in a separate goroutine.
+func (g *game) getLoanChangeMessage(pbLoan *pb.Loan, val int32) *pb.StreamResponse {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	players := g.getPBPlayersWithBank()
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Transaction_{
+			Transaction: &pb.StreamResponse_Transaction{
+				Players: players,
+				Jackpot: g.jackpot,
+				Event: &pb.StreamResponse_Transaction_LoanChange_{
+					LoanChange: &pb.StreamResponse_Transaction_LoanChange{
+						Loan:  pbLoan,
+						Value: val,
+					},
+				},
+			},
+		},
+	}
+	return res
+}
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewLogger returns logger, which writes JSON lines to stderr.
+// Messages below provided level ("debug", "info", "warn", or "error")
+// are dropped.
+func NewLogger(level string) (*zap.Logger, error) {
+	var zapLevel zapcore.Level
+	if err := zapLevel.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q", level)
+	}
+
+	config := zap.NewProductionConfig()
+	config.Level = zap.NewAtomicLevelAt(zapLevel)
+	return config.Build()
+}
+
+// WithLogger makes server and its games write logs to provided logger.
+// Without this option, logs of "info" level and higher are written to stderr.
+func WithLogger(logger *zap.Logger) ServerOption {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// Players poll these methods all the time, so only
+// some of their successful calls are logged.
+var sampledMethods = []string{
+	"/server.Game/GetGameState",
+	"/server.Game/GetMarket",
+	"/server.Game/GetStandings",
+	"/server.Game/GetLeaderboard",
+	"/server.Game/ListRooms",
+	"/server.Game/ListGames",
+}
+
+// Every 10th successful call of the sampled methods
+// is logged, unless WithLogSampling says otherwise.
+const defaultLogSampling = 10
+
+// WithLogSampling makes server log only every nth successful call of the
+// methods, which players poll, e.g. GetGameState or ListRooms. Failed calls
+// are always logged. Every call is logged, if n is 1 or less.
+func WithLogSampling(n int32) ServerOption {
+	return func(s *Server) {
+		s.logSampler = newLogSampler(n)
+	}
+}
+
+// logSampler counts the calls of the sampled methods.
+type logSampler struct {
+	n      uint64
+	counts map[string]*uint64 // read-only after creation, so the map needs no lock
+}
+
+func newLogSampler(n int32) *logSampler {
+	sampler := &logSampler{n: 1, counts: make(map[string]*uint64, len(sampledMethods))}
+	if n > 1 {
+		sampler.n = uint64(n)
+	}
+	for _, method := range sampledMethods {
+		sampler.counts[method] = new(uint64)
+	}
+	return sampler
+}
+
+// sample returns true, if the successful call of the method has to be logged.
+func (l *logSampler) sample(method string) bool {
+	count, ok := l.counts[method]
+	if !ok {
+		return true
+	}
+	return (atomic.AddUint64(count, 1)-1)%l.n == 0
+}
+
+// getRequestFields returns the ids of the game and the player and the
+// username, which are taken from the request or, if it doesn't carry
+// them, from the response, e.g. JoinResponse.
+func getRequestFields(msgs ...interface{}) []zap.Field {
+	var gameID, userID, username string
+	for _, msg := range msgs {
+		if m, ok := msg.(interface{ GetGameId() string }); ok && gameID == "" {
+			gameID = m.GetGameId()
+		}
+		if m, ok := msg.(interface{ GetUserId() string }); ok && userID == "" {
+			userID = m.GetUserId()
+		}
+		if m, ok := msg.(interface{ GetUsername() string }); ok && username == "" {
+			username = m.GetUsername()
+		}
+	}
+
+	var fields []zap.Field
+	if gameID != "" {
+		fields = append(fields, zap.String("game_id", gameID))
+	}
+	if userID != "" {
+		fields = append(fields, zap.String("user_id", userID))
+	}
+	if username != "" {
+		fields = append(fields, zap.String("username", username))
+	}
+	return fields
+}
+
+// logUnary is a unary interceptor, which logs every RPC together with
+// the ids of the game and the player, its duration, and its status code,
+// so that the actions of the player can be traced. Failed RPCs are logged
+// with "info" level as well, since they are mostly caused by invalid
+// requests of the players. Successful calls of the polled methods are sampled.
+func (s *Server) logUnary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	startTime := time.Now()
+	res, err := handler(ctx, req)
+
+	code := status.Code(err)
+	if code == codes.OK && !s.logSampler.sample(info.FullMethod) {
+		return res, err
+	}
+	fields := []zap.Field{
+		zap.String("rpc", info.FullMethod),
+		zap.String("code", code.String()),
+		zap.Duration("duration", time.Since(startTime)),
+	}
+	fields = append(fields, getRequestFields(req, res)...)
+	if code == codes.OK {
+		s.logger.Info("RPC finished", fields...)
+	} else {
+		s.logger.Info("RPC failed", append(fields, zap.Error(err))...)
+	}
+	return res, err
+}
+
+// loggedStream remembers the ids carried by the request of the stream.
+type loggedStream struct {
+	grpc.ServerStream
+	fields []zap.Field
+}
+
+func (s *loggedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.fields == nil {
+		s.fields = getRequestFields(m)
+	}
+	return err
+}
+
+// logStream is a stream interceptor, which logs opening and closing of
+// streams. Closing is logged together with the ids carried by the request,
+// the duration of the stream, and its status code.
+func (s *Server) logStream(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	startTime := time.Now()
+	logger := s.logger.With(zap.String("rpc", info.FullMethod))
+	logger.Debug("Stream opened")
+	stream := &loggedStream{ServerStream: ss}
+	err := handler(srv, stream)
+	fields := []zap.Field{
+		zap.String("code", status.Code(err).String()),
+		zap.Duration("duration", time.Since(startTime)),
+	}
+	logger.Info("Stream closed", append(fields, stream.fields...)...)
+	return err
+}
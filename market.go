@@ -0,0 +1,337 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cs489-team11/server/pb"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/label"
+)
+
+type orderID string
+
+type orderSide int32
+
+const (
+	buyOrder  orderSide = orderSide(pb.OrderSide_ORDER_SIDE_BUY)
+	sellOrder orderSide = orderSide(pb.OrderSide_ORDER_SIDE_SELL)
+)
+
+// order is an open order of the player on the market.
+// Open orders are not persisted, so they are lost after restart.
+type order struct {
+	orderID  orderID
+	userID   userID
+	asset    string
+	side     orderSide
+	quantity int32 // units, which are not traded yet
+	price    int32 // points per unit
+}
+
+// orderBook keeps open orders of a single asset. Bids are sorted
+// from the highest price, asks are sorted from the lowest price,
+// and orders with the same price are sorted from the oldest.
+type orderBook struct {
+	bids      []*order
+	asks      []*order
+	lastPrice int32 // 0, if the asset has not been traded yet
+}
+
+// Trade is the exchange of asset units for points between two players.
+type Trade struct {
+	Buyer    string
+	Seller   string
+	Asset    string
+	Quantity int32
+	Price    int32 // points per unit
+}
+
+func newOrderID() orderID {
+	return orderID(uuid.New().String())
+}
+
+func (t *Trade) sellerID() userID {
+	return userID(t.Seller)
+}
+
+func (t *Trade) toPBTrade() *pb.Trade {
+	return &pb.Trade{
+		BuyerId:  t.Buyer,
+		SellerId: t.Seller,
+		Asset:    t.Asset,
+		Quantity: t.Quantity,
+		Price:    t.Price,
+	}
+}
+
+func (o *order) toPBOrder() *pb.Order {
+	return &pb.Order{
+		OrderId:  string(o.orderID),
+		UserId:   string(o.userID),
+		Asset:    o.asset,
+		Side:     pb.OrderSide(o.side),
+		Quantity: o.quantity,
+		Price:    o.price,
+	}
+}
+
+func ordersToPB(orders []*order) []*pb.Order {
+	res := make([]*pb.Order, len(orders))
+	for i, order := range orders {
+		res[i] = order.toPBOrder()
+	}
+	return res
+}
+
+// getOrderBook returns order book of the asset and creates it, if
+// the asset has not been used yet. The calling function has to acquire
+// write lock.
+func (g *game) getOrderBook(asset string) *orderBook {
+	book, ok := g.market[asset]
+	if !ok {
+		book = &orderBook{}
+		g.market[asset] = book
+	}
+	return book
+}
+
+// getOpenOrderTotals returns points, which the player has bid in open
+// buy orders, and units of the asset, which the player has listed in
+// open sell orders. The calling function has to acquire write lock.
+func (g *game) getOpenOrderTotals(userID userID, asset string) (int64, int32) {
+	book := g.getOrderBook(asset)
+	points := int64(0)
+	for _, bid := range book.bids {
+		if bid.userID == userID {
+			points += int64(bid.quantity) * int64(bid.price)
+		}
+	}
+	units := int32(0)
+	for _, ask := range book.asks {
+		if ask.userID == userID {
+			units += ask.quantity
+		}
+	}
+	return points, units
+}
+
+// canSettle returns true, if the player has enough points or units
+// to trade provided quantity of the order at provided price.
+// The calling function has to acquire write lock.
+func (g *game) canSettle(o *order, quantity int32, price int32) bool {
+	player, ok := g.players[o.userID]
+	if !ok {
+		return false
+	}
+	if o.side == buyOrder {
+		return int64(player.points) >= int64(quantity)*int64(price)
+	}
+	return player.assets[o.asset] >= quantity
+}
+
+// placeOrder trades the order with the best open orders of the other side,
+// while their prices are not worse than the price of the order. Trades are
+// settled at the prices of the open orders. The rest of the order is added
+// to the order book. Open orders of the same player are cancelled, instead
+// of being traded with the order, and open orders, which cannot be settled
+// anymore, are cancelled as well.
+// Returns id of the order, traded units, and units left in the order book.
+func (g *game) placeOrder(
+	ctx context.Context, userID userID, asset string, side orderSide, quantity int32, price int32,
+) (orderID, int32, int32, error) {
+	ctx, span := startSpan(ctx, "game.placeOrder", append(g.spanAttrs(userID), label.String("asset", asset))...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	player, ok := g.players[userID]
+	if !ok {
+		return "", 0, 0, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if !g.config.hasMarketAsset(asset) {
+		return "", 0, 0, fmt.Errorf("asset %q is not traded in the game", asset)
+	}
+
+	bidPoints, listedUnits := g.getOpenOrderTotals(userID, asset)
+	if side == buyOrder && int64(player.points) < bidPoints+int64(quantity)*int64(price) {
+		return "", 0, 0, fmt.Errorf("player doesn't have enough points for this order and other open bids")
+	}
+	if side == sellOrder && player.assets[asset] < listedUnits+quantity {
+		return "", 0, 0, fmt.Errorf("player doesn't have enough units of %q for this order and other open asks", asset)
+	}
+
+	incoming := &order{
+		orderID:  newOrderID(),
+		userID:   userID,
+		asset:    asset,
+		side:     side,
+		quantity: quantity,
+		price:    price,
+	}
+	book := g.getOrderBook(asset)
+	resting := &book.asks
+	if side == sellOrder {
+		resting = &book.bids
+	}
+
+	var trades []*Trade
+	for incoming.quantity > 0 && len(*resting) > 0 {
+		best := (*resting)[0]
+		if (side == buyOrder && best.price > price) || (side == sellOrder && best.price < price) {
+			break
+		}
+
+		tradeQuantity := incoming.quantity
+		if best.quantity < tradeQuantity {
+			tradeQuantity = best.quantity
+		}
+		if best.userID == userID || !g.canSettle(best, tradeQuantity, best.price) {
+			*resting = (*resting)[1:]
+			continue
+		}
+		if !g.canSettle(incoming, tradeQuantity, best.price) {
+			break
+		}
+
+		buyerID, sellerID := userID, best.userID
+		if side == sellOrder {
+			buyerID, sellerID = sellerID, buyerID
+		}
+		trade := &Trade{
+			Buyer:    string(buyerID),
+			Seller:   string(sellerID),
+			Asset:    asset,
+			Quantity: tradeQuantity,
+			Price:    best.price,
+		}
+		event := newEvent(EventTrade, buyerID, -tradeQuantity*best.price)
+		event.Trade = trade
+		g.apply(event)
+		trades = append(trades, trade)
+
+		incoming.quantity -= tradeQuantity
+		best.quantity -= tradeQuantity
+		if best.quantity == 0 {
+			*resting = (*resting)[1:]
+		}
+	}
+
+	if incoming.quantity > 0 {
+		g.addOrder(book, incoming)
+	}
+	if len(trades) > 0 {
+		g.persist()
+		span.AddEvent(ctx, "order traded")
+	}
+
+	for _, trade := range trades {
+		trade := trade
+		go func() {
+			msg := g.getTradeMessage(trade)
+			g.broadcast(msg)
+		}()
+	}
+
+	return incoming.orderID, quantity - incoming.quantity, incoming.quantity, nil
+}
+
+// addOrder inserts the order after the open orders of the same side,
+// which have the same or a better price.
+// The calling function has to acquire write lock.
+func (g *game) addOrder(book *orderBook, o *order) {
+	orders := &book.bids
+	isWorse := func(other *order) bool { return other.price < o.price }
+	if o.side == sellOrder {
+		orders = &book.asks
+		isWorse = func(other *order) bool { return other.price > o.price }
+	}
+
+	index := sort.Search(len(*orders), func(i int) bool {
+		return isWorse((*orders)[i])
+	})
+	*orders = append(*orders, nil)
+	copy((*orders)[index+1:], (*orders)[index:])
+	(*orders)[index] = o
+}
+
+// cancelOrder removes the open order of the player from the order book.
+func (g *game) cancelOrder(ctx context.Context, userID userID, orderID orderID) error {
+	ctx, span := startSpan(ctx, "game.cancelOrder", g.spanAttrs(userID)...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	for _, book := range g.market {
+		for _, orders := range []*[]*order{&book.bids, &book.asks} {
+			for i, o := range *orders {
+				if o.orderID != orderID {
+					continue
+				}
+				if o.userID != userID {
+					return fmt.Errorf("order %v doesn't belong to player %v", orderID, userID)
+				}
+				*orders = append((*orders)[:i], (*orders)[i+1:]...)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("there is no open order with id %v", orderID)
+}
+
+// getPBMarket returns order books of all assets of the game
+// and assets of the player in the order of the config.
+func (g *game) getPBMarket(userID userID) ([]*pb.OrderBook, []*pb.AssetHolding, error) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	player, ok := g.players[userID]
+	if !ok {
+		return nil, nil, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+
+	books := make([]*pb.OrderBook, 0, len(g.config.marketAssets))
+	holdings := make([]*pb.AssetHolding, 0, len(g.config.marketAssets))
+	for _, asset := range g.config.marketAssets {
+		book, ok := g.market[asset]
+		if !ok {
+			book = &orderBook{}
+		}
+		books = append(books, &pb.OrderBook{
+			Asset:     asset,
+			Bids:      ordersToPB(book.bids),
+			Asks:      ordersToPB(book.asks),
+			LastPrice: book.lastPrice,
+		})
+		holdings = append(holdings, &pb.AssetHolding{
+			Asset:    asset,
+			Quantity: player.assets[asset],
+		})
+	}
+	return books, holdings, nil
+}
+
+// As this function uses Readlock, it has to be spawned in a separate goroutine.
+func (g *game) getTradeMessage(trade *Trade) *pb.StreamResponse {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	players := g.getPBPlayersWithBank()
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Transaction_{
+			Transaction: &pb.StreamResponse_Transaction{
+				Players: players,
+				Jackpot: g.jackpot,
+				Event: &pb.StreamResponse_Transaction_Trade{
+					Trade: trade.toPBTrade(),
+				},
+			},
+		},
+	}
+	return res
+}
@@ -0,0 +1,308 @@
+package server
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Name of the rooms created by matchmaking.
+const matchRoomName = "match"
+
+// Default parameters of matchmaking.
+const (
+	defaultMatchSize   = 2
+	defaultMatchWindow = 100 // rating points
+	defaultMatchGrowth = 50  // rating points per second
+)
+
+// WithMatchmaking makes matchmaking group size players in every game.
+// Players are matched, if their ratings differ by at most window points,
+// and the window of every player grows by growth points for every second
+// the player waits. Without this option, players are matched in pairs with
+// the window of 100 points, which grows by 50 points every second.
+func WithMatchmaking(size int32, window int32, growth int32) ServerOption {
+	return func(s *Server) {
+		s.matchmaker.size = int(size)
+		s.matchmaker.window = window
+		s.matchmaker.growth = growth
+	}
+}
+
+// matchTicket is the player waiting in the matchmaking queue.
+type matchTicket struct {
+	username   username
+	locale     string
+	appearance appearance
+	rating     int32
+	enqueuedAt time.Time
+	match      chan *pb.JoinResponse // receives the response, once the player is matched
+}
+
+// getWindow returns the maximal difference of ratings,
+// with which the player can be matched at provided time.
+func (t *matchTicket) getWindow(m *matchmaker, now time.Time) int32 {
+	waited := int32(now.Sub(t.enqueuedAt).Seconds())
+	return m.window + waited*m.growth
+}
+
+// matchmaker holds the players waiting for the match.
+type matchmaker struct {
+	mutex   sync.Mutex
+	size    int
+	window  int32
+	growth  int32
+	tickets []*matchTicket // ordered by the time of enqueueing
+}
+
+func newMatchmaker() *matchmaker {
+	return &matchmaker{
+		size:   defaultMatchSize,
+		window: defaultMatchWindow,
+		growth: defaultMatchGrowth,
+	}
+}
+
+func (m *matchmaker) enqueue(ticket *matchTicket) int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.tickets = append(m.tickets, ticket)
+	return len(m.tickets)
+}
+
+// dequeue removes the ticket from the queue. Returns
+// false, if the ticket has been already matched.
+func (m *matchmaker) dequeue(ticket *matchTicket) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for i, t := range m.tickets {
+		if t == ticket {
+			m.tickets = append(m.tickets[:i], m.tickets[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (m *matchmaker) getQueueSize() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return len(m.tickets)
+}
+
+// takeMatches removes groups of matched tickets from the queue and
+// returns them. The players, who wait longer, are matched first with
+// the players of the closest ratings, which are within windows of all
+// players of the group at provided time.
+func (m *matchmaker) takeMatches(now time.Time) [][]*matchTicket {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var matches [][]*matchTicket
+	for i := 0; i < len(m.tickets) && len(m.tickets) >= m.size; i++ {
+		ticket := m.tickets[i]
+		window := ticket.getWindow(m, now)
+
+		candidates := make([]*matchTicket, 0, len(m.tickets)-1)
+		for _, t := range m.tickets {
+			if t != ticket && abs(t.rating-ticket.rating) <= window && abs(t.rating-ticket.rating) <= t.getWindow(m, now) {
+				candidates = append(candidates, t)
+			}
+		}
+		if len(candidates) < m.size-1 {
+			continue
+		}
+		sort.SliceStable(candidates, func(a, b int) bool {
+			return abs(candidates[a].rating-ticket.rating) < abs(candidates[b].rating-ticket.rating)
+		})
+
+		match := append([]*matchTicket{ticket}, candidates[:m.size-1]...)
+		matched := make(map[*matchTicket]bool, len(match))
+		for _, t := range match {
+			matched[t] = true
+		}
+		remaining := m.tickets[:0]
+		for _, t := range m.tickets {
+			if !matched[t] {
+				remaining = append(remaining, t)
+			}
+		}
+		m.tickets = remaining
+		matches = append(matches, match)
+		// the ticket at the same index hasn't been checked yet
+		i--
+	}
+	return matches
+}
+
+func abs(x int32) int32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// findMatches creates the room for every group of matched players
+// and adds the players to it. The player, who has waited the longest,
+// becomes the host of the room.
+func (s *Server) findMatches() {
+	for _, match := range s.matchmaker.takeMatches(s.clock.Now()) {
+		s.mutex.Lock()
+		if s.shuttingDown {
+			s.mutex.Unlock()
+			return
+		}
+		room := s.newGame(s.gameConfig)
+		room.name = matchRoomName
+		// other players cannot join the room, since nobody knows its code
+		room.code = uuid.New().String()
+		s.rooms[room.gameID] = room
+		s.mutex.Unlock()
+
+		room.logger.Info("Players are matched", zap.Int("players", len(match)))
+		for _, ticket := range match {
+			userID, err := room.addPlayer(ticket.username, ticket.locale, ticket.appearance)
+			if err != nil {
+				// the room is new, so the error means that it
+				// has been started by its host in the meantime
+				room.logger.Warn("Could not add matched player", zap.Error(err))
+				close(ticket.match)
+				continue
+			}
+			s.metrics.joins.Inc()
+			res, err := s.getJoinResponseMessage(userID, room)
+			if err != nil {
+				room.logger.Warn("Could not add matched player", zap.Error(err))
+				close(ticket.match)
+				continue
+			}
+			ticket.match <- res
+		}
+		s.scheduleAutoStart(room)
+	}
+}
+
+// Enqueue adds the player to the matchmaking queue. The status of the search
+// is sent every second, until the player is matched with other players of
+// similar rating. Then, the player is added to the new room, and the join
+// response is sent as the last event.
+func (s *Server) Enqueue(req *pb.EnqueueRequest, srv pb.Game_EnqueueServer) error {
+	ctx := srv.Context()
+	if err := s.validateRequest(req); err != nil {
+		return newStatusError(codes.InvalidArgument, ReasonInvalidRequest, 0, "%v", err)
+	}
+	reqUsername, err := s.resolveUsername(username(req.GetUsername()), req.GetAccountToken())
+	if err != nil {
+		return err
+	}
+	if err := s.checkBan(ctx, reqUsername); err != nil {
+		return err
+	}
+	game, err := s.getWaitingGame()
+	if err != nil {
+		return err
+	}
+	// matched rooms are created with the default config like the waiting game
+	if err := firstError(game.getConfig().checkUsername(reqUsername), checkUsernameWords(s.wordFilter, reqUsername)); err != nil {
+		return getUsernameError(err)
+	}
+
+	// rating from the request is used only for
+	// the players, who have not finished any game yet
+	rating := s.ratings.get(reqUsername)
+	if rating.Games == 0 && req.GetRating() > 0 {
+		rating.Rating = req.GetRating()
+	}
+	ticket := &matchTicket{
+		username:   reqUsername,
+		locale:     req.GetLocale(),
+		appearance: appearance{avatarID: req.GetAvatarId(), color: req.GetColor()},
+		rating:     rating.Rating,
+		enqueuedAt: s.clock.Now(),
+		match:      make(chan *pb.JoinResponse, 1),
+	}
+	s.matchmaker.enqueue(ticket)
+	s.findMatches()
+
+	ticker := s.clock.NewTicker(time.Second)
+	defer ticker.Stop()
+	if err := srv.Send(s.getSearchingMessage(ticket)); err != nil {
+		s.leaveQueue(ticket)
+		return err
+	}
+	for {
+		select {
+		case res, ok := <-ticket.match:
+			if !ok {
+				return status.Errorf(codes.Internal, "failed to add matched player to the game")
+			}
+			if err := srv.Send(getMatchFoundMessage(res)); err != nil {
+				s.leaveMatch(res)
+				return err
+			}
+			return nil
+		case <-ctx.Done():
+			s.leaveQueue(ticket)
+			return nil
+		case <-ticker.C():
+			if _, err := s.getWaitingGame(); err != nil {
+				s.leaveQueue(ticket)
+				return err
+			}
+			s.findMatches()
+			if err := srv.Send(s.getSearchingMessage(ticket)); err != nil {
+				s.leaveQueue(ticket)
+				return err
+			}
+		}
+	}
+}
+
+// leaveQueue removes the player, who has stopped waiting, from the queue.
+// If he has been matched in the meantime, he leaves the room.
+func (s *Server) leaveQueue(ticket *matchTicket) {
+	if s.matchmaker.dequeue(ticket) {
+		return
+	}
+	if res, ok := <-ticket.match; ok {
+		s.leaveMatch(res)
+	}
+}
+
+// leaveMatch removes the matched player, who has not been
+// notified about the match, from the room.
+func (s *Server) leaveMatch(res *pb.JoinResponse) {
+	if room, err := s.getLobby(gameID(res.GameId)); err == nil {
+		room.deletePlayer(userID(res.UserId))
+		s.pruneRoom(room)
+	}
+}
+
+func (s *Server) getSearchingMessage(ticket *matchTicket) *pb.MatchmakingEvent {
+	return &pb.MatchmakingEvent{
+		Event: &pb.MatchmakingEvent_Searching_{
+			Searching: &pb.MatchmakingEvent_Searching{
+				RatingWindow: ticket.getWindow(s.matchmaker, s.clock.Now()),
+				QueueSize:    int32(s.matchmaker.getQueueSize()),
+				WaitingTime:  int32(s.clock.Now().Sub(ticket.enqueuedAt).Seconds()),
+			},
+		},
+	}
+}
+
+func getMatchFoundMessage(res *pb.JoinResponse) *pb.MatchmakingEvent {
+	return &pb.MatchmakingEvent{
+		Event: &pb.MatchmakingEvent_MatchFound{
+			MatchFound: res,
+		},
+	}
+}
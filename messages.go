@@ -0,0 +1,115 @@
+package server
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// messageCatalog maps locales to the translations of the explanations,
+// which are keyed by their English formats.
+type messageCatalog map[string]map[string]string
+
+// defaultMessages returns the built-in translations.
+func defaultMessages() messageCatalog {
+	return messageCatalog{
+		"ru": {
+			"bank cannot grant the credit due to bank's undisclosed policies":        "банк не может выдать кредит по внутренним правилам банка",
+			"player with no net worth cannot take credit":                            "игрок без собственного капитала не может взять кредит",
+			"asking for too much money: credit limit is %d, %d (%d%%) of it is used": "запрошено слишком много: кредитный лимит %d, использовано %d (%d%%)",
+			"credit limit is %d, %d (%d%%) of it is used":                            "кредитный лимит %d, использовано %d (%d%%)",
+			"not enough points to repay the credit (%d required)":                    "недостаточно очков для погашения кредита (требуется %d)",
+			"not allowed to deposit more than player has":                            "нельзя внести на депозит больше, чем есть у игрока",
+			"player can play the lottery again in %d seconds":                        "сыграть в лотерею снова можно через %d с",
+			"lender has left the game":                                               "кредитор покинул игру",
+			"lender doesn't have enough points anymore":                              "у кредитора уже недостаточно очков",
+			"player doesn't have enough points to buy the shares":                    "у игрока недостаточно очков для покупки акций",
+			"player has only %d shares":                                              "количество акций у игрока: %d",
+			"bank cannot buy the shares due to bank's undisclosed policies":          "банк не может купить акции по внутренним правилам банка",
+			"player can steal again in %d seconds":                                   "украсть снова можно через %d с",
+			"target doesn't have any points to steal":                                "у цели нет очков, которые можно украсть",
+			"player has been caught":                                                 "игрок пойман",
+			"player is already guarded for %d seconds":                               "игрок уже под охраной ещё %d с",
+			"not enough points to hire the guard (%d required)":                      "недостаточно очков, чтобы нанять охрану (требуется %d)",
+			"player is already insured against the next theft":                       "игрок уже застрахован от следующей кражи",
+			"not enough points to pay the premium (%d required)":                     "недостаточно очков для оплаты страховки (требуется %d)",
+		},
+		"es": {
+			"bank cannot grant the credit due to bank's undisclosed policies":        "el banco no puede conceder el crédito debido a sus políticas internas",
+			"player with no net worth cannot take credit":                            "un jugador sin patrimonio neto no puede pedir un crédito",
+			"asking for too much money: credit limit is %d, %d (%d%%) of it is used": "se pide demasiado dinero: el límite de crédito es %d, se usa %d (%d%%)",
+			"credit limit is %d, %d (%d%%) of it is used":                            "el límite de crédito es %d, se usa %d (%d%%)",
+			"not enough points to repay the credit (%d required)":                    "no hay suficientes puntos para devolver el crédito (se requieren %d)",
+			"not allowed to deposit more than player has":                            "no se puede depositar más de lo que tiene el jugador",
+			"player can play the lottery again in %d seconds":                        "el jugador puede volver a jugar a la lotería en %d segundos",
+			"lender has left the game":                                               "el prestamista ha abandonado la partida",
+			"lender doesn't have enough points anymore":                              "el prestamista ya no tiene suficientes puntos",
+			"player doesn't have enough points to buy the shares":                    "el jugador no tiene suficientes puntos para comprar las acciones",
+			"player has only %d shares":                                              "el jugador solo tiene %d acciones",
+			"bank cannot buy the shares due to bank's undisclosed policies":          "el banco no puede comprar las acciones debido a sus políticas internas",
+			"player can steal again in %d seconds":                                   "el jugador puede volver a robar en %d segundos",
+			"target doesn't have any points to steal":                                "el objetivo no tiene puntos que robar",
+			"player has been caught":                                                 "el jugador ha sido atrapado",
+			"player is already guarded for %d seconds":                               "el jugador ya está protegido durante %d segundos",
+			"not enough points to hire the guard (%d required)":                      "no hay suficientes puntos para contratar al guardia (se requieren %d)",
+			"player is already insured against the next theft":                       "el jugador ya está asegurado contra el próximo robo",
+			"not enough points to pay the premium (%d required)":                     "no hay suficientes puntos para pagar la prima (se requieren %d)",
+		},
+	}
+}
+
+// WithMessages registers the translations of the explanations to the
+// locale, e.g. "fr" or "fr-CA". Translations are keyed by the English
+// formats of the explanations, e.g. "player can steal again in %d seconds",
+// and have to keep their verbs in the same order. They replace the built-in
+// translations of the same formats. Players get the explanations in the
+// locale provided on join.
+func WithMessages(locale string, messages map[string]string) ServerOption {
+	return func(s *Server) {
+		locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+		if s.messages[locale] == nil {
+			s.messages[locale] = make(map[string]string, len(messages))
+		}
+		for format, translation := range messages {
+			s.messages[locale][format] = translation
+		}
+	}
+}
+
+// formatVerbPattern matches the verbs of the formats, e.g. %d or %5.2f.
+var formatVerbPattern = regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z%]`)
+
+// CheckMessages returns error, if any translation doesn't have
+// the same verbs in the same order as its English format.
+func CheckMessages(messages map[string]string) error {
+	for format, translation := range messages {
+		verbs := formatVerbPattern.FindAllString(format, -1)
+		translationVerbs := formatVerbPattern.FindAllString(translation, -1)
+		if !reflect.DeepEqual(verbs, translationVerbs) {
+			return fmt.Errorf("translation %q has to have verbs %v of %q, received: %v", translation, verbs, format, translationVerbs)
+		}
+	}
+	return nil
+}
+
+// translate returns the translation of the format to the locale or to its
+// language, e.g. "fr" for "fr-CA", or the format itself, if there is none.
+func (c messageCatalog) translate(locale string, format string) string {
+	for _, locale := range getLocaleFallbacks(locale) {
+		if translation, ok := c[locale][format]; ok {
+			return translation
+		}
+	}
+	return format
+}
+
+// localize returns the outcome with the explanation translated
+// to the locale, which the player has provided on join.
+func (s *Server) localize(game *game, userID userID, out outcome) outcome {
+	if out.format == "" {
+		return out
+	}
+	out.format = s.messages.translate(game.getPlayerLocale(userID), out.format)
+	return out
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// metricsNamespace prefixes the names of all exported metrics.
+const metricsNamespace = "game"
+
+// metrics are collected by every server, but they are exported
+// only if the server is created with WithMetrics option.
+type metrics struct {
+	registry         *prometheus.Registry
+	connectedStreams prometheus.Gauge
+	joins            prometheus.Counter
+	creditVolume     prometheus.Counter
+	depositVolume    prometheus.Counter
+	lotteryWins      prometheus.Counter
+	lotteryWinPoints prometheus.Counter
+	rpcDuration      *prometheus.HistogramVec
+}
+
+// WithMetrics makes server export Prometheus metrics over HTTP
+// on provided address. The endpoint is started by Listen.
+func WithMetrics(addr string) ServerOption {
+	return func(s *Server) {
+		s.metricsAddr = addr
+	}
+}
+
+func newMetrics(s *Server) *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		connectedStreams: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "connected_streams",
+			Help:      "Number of open server streams.",
+		}),
+		joins: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "joins_total",
+			Help:      "Number of players joined the waiting game or the rooms.",
+		}),
+		creditVolume: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "credit_points_total",
+			Help:      "Total amount of points granted as credits.",
+		}),
+		depositVolume: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "deposit_points_total",
+			Help:      "Total amount of points put on deposits.",
+		}),
+		lotteryWins: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "lottery_wins_total",
+			Help:      "Number of lotteries, in which the player won some points.",
+		}),
+		lotteryWinPoints: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "lottery_win_points_total",
+			Help:      "Total amount of points won in lotteries.",
+		}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "rpc_duration_seconds",
+			Help:      "Latency of unary RPCs.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+	}
+
+	activeGames := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "active_games",
+		Help:      "Number of games in active state.",
+	}, func() float64 {
+		return float64(s.activeGameCount())
+	})
+
+	m.registry.MustRegister(
+		activeGames,
+		m.connectedStreams,
+		m.joins,
+		m.creditVolume,
+		m.depositVolume,
+		m.lotteryWins,
+		m.lotteryWinPoints,
+		m.rpcDuration,
+	)
+	return m
+}
+
+// listenMetrics starts the HTTP endpoint with metrics,
+// if the server has been created with WithMetrics option.
+func (s *Server) listenMetrics() error {
+	if s.metricsAddr == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.metricsAddr)
+	if err != nil {
+		s.logger.Error("Failed to init metrics listener", zap.Error(err))
+		return err
+	}
+	s.logger.Info("Initialized metrics listener", zap.String("addr", listener.Addr().String()))
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(s.metrics.registry, promhttp.HandlerOpts{}))
+	s.metricsListener = listener
+	s.metricsServer = &http.Server{Handler: mux}
+	go s.metricsServer.Serve(listener)
+	return nil
+}
+
+// MetricsAddr returns the address of the metrics endpoint
+// or empty string, if metrics are not exported.
+func (s *Server) MetricsAddr() string {
+	if s.metricsListener == nil {
+		return ""
+	}
+	return s.metricsListener.Addr().String()
+}
+
+// observeUnary is a unary interceptor, which records latency of RPCs.
+func (s *Server) observeUnary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	startTime := time.Now()
+	res, err := handler(ctx, req)
+	code := status.Code(err).String()
+	s.metrics.rpcDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(startTime).Seconds())
+	return res, err
+}
+
+// observeStream is a stream interceptor, which tracks number of open streams.
+func (s *Server) observeStream(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	s.metrics.connectedStreams.Inc()
+	defer s.metrics.connectedStreams.Dec()
+	return handler(srv, ss)
+}
@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// openTriviaDBURL is the endpoint of the Open Trivia Database API.
+const openTriviaDBURL = "https://opentdb.com/api.php"
+
+const (
+	// openTriviaDBBatchSize is the number of questions fetched
+	// at once and cached until they are asked.
+	openTriviaDBBatchSize = 20
+	// openTriviaDBAttempts is the number of attempts to fetch questions
+	// before the fallback provider is used.
+	openTriviaDBAttempts = 3
+	// openTriviaDBRetryDelay is the delay before the first retry,
+	// which is doubled after every failed attempt.
+	openTriviaDBRetryDelay = 200 * time.Millisecond
+	// openTriviaDBCooldown defines how long the fallback provider is
+	// used after all attempts have failed, so that every question
+	// doesn't wait for the unavailable API.
+	openTriviaDBCooldown = 30 * time.Second
+)
+
+// openTriviaDBCategories map names of the categories
+// of the Open Trivia Database to their ids.
+var openTriviaDBCategories = map[string]int{
+	"general knowledge":                     9,
+	"entertainment: books":                  10,
+	"entertainment: film":                   11,
+	"entertainment: music":                  12,
+	"entertainment: musicals & theatres":    13,
+	"entertainment: television":             14,
+	"entertainment: video games":            15,
+	"entertainment: board games":            16,
+	"science & nature":                      17,
+	"science: computers":                    18,
+	"science: mathematics":                  19,
+	"mythology":                             20,
+	"sports":                                21,
+	"geography":                             22,
+	"history":                               23,
+	"politics":                              24,
+	"art":                                   25,
+	"celebrities":                           26,
+	"animals":                               27,
+	"vehicles":                              28,
+	"entertainment: comics":                 29,
+	"science: gadgets":                      30,
+	"entertainment: japanese anime & manga": 31,
+	"entertainment: cartoon & animations":   32,
+}
+
+// OpenTriviaDBProvider asks questions fetched from the Open Trivia
+// Database or a compatible API. Questions are fetched in batches for
+// each filter and each of them is asked only once. If the API is
+// unavailable or doesn't have the category, questions are taken from
+// the fallback provider.
+type OpenTriviaDBProvider struct {
+	mutex      sync.Mutex
+	url        string
+	client     *http.Client
+	fallback   QuestionProvider
+	cache      map[openTriviaDBCacheKey][]Question
+	retryAfter time.Time // fallback is used until this time
+	rand       *rand.Rand
+}
+
+// openTriviaDBCacheKey identifies the batch of questions
+// fetched with the same parameters.
+type openTriviaDBCacheKey struct {
+	category   string
+	difficulty string
+}
+
+// openTriviaDBResponse is the response of the API for
+// requests with base64 encoding of the strings.
+type openTriviaDBResponse struct {
+	ResponseCode int `json:"response_code"`
+	Results      []struct {
+		Category         string   `json:"category"`
+		Difficulty       string   `json:"difficulty"`
+		Question         string   `json:"question"`
+		CorrectAnswer    string   `json:"correct_answer"`
+		IncorrectAnswers []string `json:"incorrect_answers"`
+	} `json:"results"`
+}
+
+// NewOpenTriviaDBProvider returns provider fetching questions from the
+// API at provided url (the Open Trivia Database, if empty). Built-in
+// questions are used as fallback, if provided fallback is nil.
+func NewOpenTriviaDBProvider(apiURL string, fallback QuestionProvider) *OpenTriviaDBProvider {
+	if apiURL == "" {
+		apiURL = openTriviaDBURL
+	}
+	if fallback == nil {
+		fallback = newBuiltinQuestionProvider()
+	}
+	return &OpenTriviaDBProvider{
+		url:      apiURL,
+		client:   &http.Client{Timeout: 5 * time.Second},
+		fallback: fallback,
+		cache:    make(map[openTriviaDBCacheKey][]Question),
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (p *OpenTriviaDBProvider) Question(ctx context.Context, filter QuestionFilter) (Question, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	key := openTriviaDBCacheKey{strings.ToLower(filter.Category), filter.Difficulty}
+	categoryID, ok := openTriviaDBCategories[key.category]
+	if key.category != "" && !ok {
+		return p.fallback.Question(ctx, filter)
+	}
+
+	// already asked questions are dropped from the cache
+	if question, ok := p.popCached(key, filter); ok {
+		return question, nil
+	}
+	if time.Now().After(p.retryAfter) {
+		questions, err := p.fetchWithRetry(ctx, categoryID, filter.Difficulty)
+		// cancelled request doesn't mean that the API is unavailable
+		if err != nil && ctx.Err() == nil {
+			p.retryAfter = time.Now().Add(openTriviaDBCooldown)
+		}
+		p.cache[key] = questions
+	}
+	if question, ok := p.popCached(key, filter); ok {
+		return question, nil
+	}
+	return p.fallback.Question(ctx, filter)
+}
+
+// popCached removes cached questions until the one
+// passing the filter is found, which is returned.
+func (p *OpenTriviaDBProvider) popCached(key openTriviaDBCacheKey, filter QuestionFilter) (Question, bool) {
+	cache := p.cache[key]
+	for len(cache) > 0 {
+		question := cache[0]
+		cache = cache[1:]
+		if filter.match(question) {
+			p.cache[key] = cache
+			return question, true
+		}
+	}
+	p.cache[key] = nil
+	return Question{}, false
+}
+
+// fetchWithRetry fetches the batch of questions, retrying
+// with exponential backoff until the attempts run out.
+func (p *OpenTriviaDBProvider) fetchWithRetry(ctx context.Context, categoryID int, difficulty string) ([]Question, error) {
+	delay := openTriviaDBRetryDelay
+	var err error
+	for attempt := 1; attempt <= openTriviaDBAttempts; attempt++ {
+		var questions []Question
+		questions, err = p.fetch(ctx, categoryID, difficulty)
+		if err == nil {
+			return questions, nil
+		}
+		if attempt == openTriviaDBAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return nil, fmt.Errorf("failed to fetch questions after %d attempts: %v", openTriviaDBAttempts, err)
+}
+
+// fetch requests the batch of multiple choice questions from the API.
+// Questions of any category or difficulty are requested, if they are not set.
+func (p *OpenTriviaDBProvider) fetch(ctx context.Context, categoryID int, difficulty string) ([]Question, error) {
+	query := url.Values{}
+	query.Set("amount", strconv.Itoa(openTriviaDBBatchSize))
+	if categoryID != 0 {
+		query.Set("category", strconv.Itoa(categoryID))
+	}
+	if difficulty != "" {
+		query.Set("difficulty", difficulty)
+	}
+	query.Set("type", "multiple")
+	query.Set("encode", "base64")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %v", err)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP request failed with status %v", resp.Status)
+	}
+
+	var data openTriviaDBResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("response decoding failure: %v", err)
+	}
+	if data.ResponseCode != 0 {
+		return nil, fmt.Errorf("API returned response code %d", data.ResponseCode)
+	}
+
+	questions := make([]Question, 0, len(data.Results))
+	for _, result := range data.Results {
+		if len(result.IncorrectAnswers) != questionAnswerCount-1 {
+			continue
+		}
+		correctAnswerIndex := p.rand.Intn(questionAnswerCount)
+		answers := insertToSlice(decodeStringsB64(result.IncorrectAnswers), correctAnswerIndex, decodeB64(result.CorrectAnswer))
+		question := Question{
+			Text:          decodeB64(result.Question),
+			Answers:       answers,
+			CorrectAnswer: int32(correctAnswerIndex + 1),
+			Category:      decodeB64(result.Category),
+			Difficulty:    decodeB64(result.Difficulty),
+		}
+		if question.validate() == nil {
+			questions = append(questions, question)
+		}
+	}
+	if len(questions) == 0 {
+		return nil, fmt.Errorf("API returned no valid questions")
+	}
+	return questions, nil
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the ErrorInfo details of the status errors.
+const ErrorDomain = "cs489-team11.server"
+
+// Reason is the machine-readable reason, why the request has failed. It is
+// sent in the reason field of the responses with success flag and in the
+// ErrorInfo details of the status errors, so that clients can branch on it
+// and localize the messages themselves.
+type Reason string
+
+// Reasons, why the action has been denied by the game rules.
+const (
+	ReasonBankPolicy               Reason = "BANK_POLICY"
+	ReasonNoNetWorth               Reason = "NO_NET_WORTH"
+	ReasonCreditLimitExceeded      Reason = "CREDIT_LIMIT_EXCEEDED"
+	ReasonInsufficientPoints       Reason = "INSUFFICIENT_POINTS"
+	ReasonInsufficientShares       Reason = "INSUFFICIENT_SHARES"
+	ReasonLenderLeft               Reason = "LENDER_LEFT"
+	ReasonLenderInsufficientPoints Reason = "LENDER_INSUFFICIENT_POINTS"
+	ReasonLotteryOnCooldown        Reason = "LOTTERY_ON_COOLDOWN"
+	ReasonStealOnCooldown          Reason = "STEAL_ON_COOLDOWN"
+	ReasonTargetHasNoPoints        Reason = "TARGET_HAS_NO_POINTS"
+	ReasonThiefCaught              Reason = "THIEF_CAUGHT"
+	ReasonAlreadyGuarded           Reason = "ALREADY_GUARDED"
+	ReasonAlreadyInsured           Reason = "ALREADY_INSURED"
+)
+
+// Reasons of the status errors. Errors without a specific reason
+// have the name of their code, e.g. INVALID_ARGUMENT.
+const (
+	ReasonInvalidRequest     Reason = "INVALID_REQUEST"
+	ReasonGameNotFound       Reason = "GAME_NOT_FOUND"
+	ReasonGamePaused         Reason = "GAME_PAUSED"
+	ReasonGameInOvertime     Reason = "GAME_IN_OVERTIME"
+	ReasonRateLimited        Reason = "RATE_LIMITED"
+	ReasonBanned             Reason = "BANNED"
+	ReasonServerShuttingDown Reason = "SERVER_SHUTTING_DOWN"
+	ReasonQuestionExpired    Reason = "QUESTION_EXPIRED"
+	ReasonInvalidUsername    Reason = "INVALID_USERNAME"
+	ReasonUsernameTaken      Reason = "USERNAME_TAKEN"
+)
+
+// outcome describes, why the action of the player has been denied. The
+// explanation is human-readable, and it is kept as English format and its
+// arguments until it is localized. RetryAfter is set, if the action may
+// succeed later. Reason is empty, if the action has succeeded.
+type outcome struct {
+	reason     Reason
+	format     string
+	args       []interface{}
+	retryAfter time.Duration
+}
+
+// deny returns the outcome of the action denied for provided reason.
+func deny(reason Reason, retryAfter time.Duration, format string, args ...interface{}) outcome {
+	return outcome{
+		reason:     reason,
+		format:     format,
+		args:       args,
+		retryAfter: retryAfter,
+	}
+}
+
+// getExplanation returns the explanation formatted with its arguments.
+func (o outcome) getExplanation() string {
+	if o.format == "" {
+		return ""
+	}
+	return fmt.Sprintf(o.format, o.args...)
+}
+
+// getRetryAfter returns the retry delay in milliseconds.
+func (o outcome) getRetryAfter() int64 {
+	return o.retryAfter.Milliseconds()
+}
+
+// newStatusError returns the status error with the ErrorInfo details of
+// provided reason, and the RetryInfo details, if retryAfter is positive.
+func newStatusError(code codes.Code, reason Reason, retryAfter time.Duration, format string, args ...interface{}) error {
+	st := status.Newf(code, format, args...)
+	details := []proto.Message{&errdetails.ErrorInfo{Reason: string(reason), Domain: ErrorDomain}}
+	if retryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(retryAfter)})
+	}
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// withErrorInfo adds the ErrorInfo named after the code of the error,
+// unless the error already has the details.
+func withErrorInfo(err error) error {
+	if err == nil {
+		return nil
+	}
+	st := status.Convert(err)
+	if len(st.Details()) > 0 {
+		return err
+	}
+	return newStatusError(st.Code(), getCodeReason(st.Code()), 0, "%s", st.Message())
+}
+
+// getCodeReason returns the name of the code in upper snake
+// case, e.g. INVALID_ARGUMENT for InvalidArgument.
+func getCodeReason(code codes.Code) Reason {
+	var b strings.Builder
+	for i, r := range code.String() {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return Reason(b.String())
+}
+
+// detailUnary is a unary interceptor, which makes sure that every
+// status error has the ErrorInfo details with its reason.
+func (s *Server) detailUnary(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	res, err := handler(ctx, req)
+	return res, withErrorInfo(err)
+}
+
+// detailStream is a stream interceptor, which makes sure that every
+// status error has the ErrorInfo details with its reason.
+func (s *Server) detailStream(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return withErrorInfo(handler(srv, ss))
+}
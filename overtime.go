@@ -0,0 +1,250 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Seconds, which the tied players have to answer the overtime question.
+const overtimeTime = 15
+
+// Maximal number of overtime rounds, after which the game is a draw.
+const maxOvertimeRounds = 3
+
+// Time to fetch the overtime question, after which the game finishes
+// without overtime.
+const overtimeQuestionTimeout = 5 * time.Second
+
+// overtime is the sudden-death round of questions, which breaks the tie
+// between the players, who have finished the game with the same points.
+type overtime struct {
+	round    int32
+	tied     []userID        // sorted
+	answered map[userID]bool // tied players, who have answered in this round
+	question Question
+	winnerID userID // empty, until the tied player answers correctly
+}
+
+// getTiedPlayers returns the players sharing the best score, if there
+// are at least two of them, and not all of them are bots. Only
+// competitive games without teams have ties. The calling function has to acquire at least read lock.
+func (g *game) getTiedPlayers() []userID {
+	if g.config.mode != CompetitiveMode || g.config.teamCount != 0 {
+		return nil
+	}
+	var tied []userID
+	for userID, player := range g.players {
+		// players, who have forfeited, cannot win
+		if player.forfeited {
+			continue
+		}
+		if len(tied) > 0 && g.getPlayerScore(player) < g.getPlayerScore(g.players[tied[0]]) {
+			continue
+		}
+		if len(tied) > 0 && g.getPlayerScore(player) > g.getPlayerScore(g.players[tied[0]]) {
+			tied = nil
+		}
+		tied = append(tied, userID)
+	}
+	// bots don't answer questions, so they cannot break the tie
+	humans := 0
+	for _, userID := range tied {
+		if !g.players[userID].bot {
+			humans++
+		}
+	}
+	if len(tied) < 2 || humans == 0 {
+		return nil
+	}
+	sort.Slice(tied, func(i, j int) bool { return tied[i] < tied[j] })
+	return tied
+}
+
+// needsOvertime returns the players, who have to play the next round of
+// overtime, or nil, if the game has to finish.
+func (g *game) needsOvertime() []userID {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	if g.state != activeState || g.conditionWinnerID != "" {
+		return nil
+	}
+	if g.overtime == nil {
+		return g.getTiedPlayers()
+	}
+	if g.overtime.winnerID != "" || g.overtime.round >= maxOvertimeRounds {
+		return nil
+	}
+	return g.overtime.tied
+}
+
+// beginOvertimeRound asks the tied players provided question and
+// reschedules the finish of the game after the time to answer it.
+func (g *game) beginOvertimeRound(tied []userID, question Question) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.overtime == nil {
+		g.overtime = &overtime{tied: tied}
+	}
+	g.overtime.round++
+	g.overtime.answered = make(map[userID]bool)
+	g.overtime.question = question
+	g.askedQuestions[question.key()] = true
+	if g.finishTimer != nil {
+		g.finishTimer.Reset(overtimeTime * time.Second)
+	}
+	g.logger.Info("Overtime round has begun", zap.Int32("round", g.overtime.round), zap.Int("tied", len(tied)))
+
+	msg := g.getOvertimeMessage()
+	go func() {
+		g.broadcast(msg)
+	}()
+}
+
+// isInOvertime returns true, if the game is in overtime.
+func (g *game) isInOvertime() bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return g.overtime != nil
+}
+
+// answerOvertime answers the overtime question on behalf of the tied
+// player. The first correct answer wins, and the game finishes right away.
+// The round ends early, once all tied players have answered incorrectly.
+func (g *game) answerOvertime(userID userID, answer int32) (bool, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	ot := g.overtime
+	if g.state != activeState || ot == nil || ot.winnerID != "" {
+		return false, fmt.Errorf("game is not in overtime")
+	}
+	tied := false
+	for _, tiedID := range ot.tied {
+		tied = tied || tiedID == userID
+	}
+	if !tied {
+		return false, fmt.Errorf("only tied players can answer in overtime")
+	}
+	if ot.answered[userID] {
+		return false, fmt.Errorf("player has already answered in this round")
+	}
+
+	ot.answered[userID] = true
+	correct := answer == ot.question.CorrectAnswer
+	if correct {
+		ot.winnerID = userID
+		g.logger.Info("Overtime is won", zap.String("user_id", string(userID)), zap.Int32("round", ot.round))
+	}
+	// bots don't answer, so they are not waited for
+	done := correct
+	if !done {
+		done = true
+		for _, tiedID := range ot.tied {
+			if player, ok := g.players[tiedID]; ok && !player.bot && !ot.answered[tiedID] {
+				done = false
+			}
+		}
+	}
+	if done && g.finishTimer != nil {
+		g.finishTimer.Reset(0)
+	}
+
+	msg := getOvertimeAnswerMessage(userID, correct)
+	go func() {
+		g.broadcast(msg)
+	}()
+
+	return correct, nil
+}
+
+// getOvertimeRounds returns the number of overtime rounds played.
+// The calling function has to acquire at least read lock.
+func (g *game) getOvertimeRounds() int32 {
+	if g.overtime == nil {
+		return 0
+	}
+	return g.overtime.round
+}
+
+// getOvertimeMessage returns the event about the round of overtime.
+// The calling function has to acquire at least read lock.
+func (g *game) getOvertimeMessage() *pb.StreamResponse {
+	tied := make([]string, len(g.overtime.tied))
+	for i, userID := range g.overtime.tied {
+		tied[i] = string(userID)
+	}
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Overtime_{
+			Overtime: &pb.StreamResponse_Overtime{
+				Round:         g.overtime.round,
+				UserIds:       tied,
+				Question:      g.overtime.question.Text,
+				Answers:       g.overtime.question.Answers,
+				RemainingTime: overtimeTime,
+			},
+		},
+	}
+}
+
+func getOvertimeAnswerMessage(userID userID, correct bool) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_OvertimeAnswer_{
+			OvertimeAnswer: &pb.StreamResponse_OvertimeAnswer{
+				UserId:  string(userID),
+				Correct: correct,
+			},
+		},
+	}
+}
+
+// beginOvertime starts the next round of overtime, if the game has ended
+// in a tie, which hasn't been broken yet. Returns false, if the game has
+// to finish. The game finishes without overtime, if the question cannot
+// be fetched.
+func (s *Server) beginOvertime(game *game) bool {
+	tied := game.needsOvertime()
+	if len(tied) == 0 {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), overtimeQuestionTimeout)
+	defer cancel()
+	questionRand := game.getQuestionRand()
+	question, err := s.questions.Question(ctx, QuestionFilter{Exclude: game.getAskedQuestions(), Rand: questionRand})
+	if err != nil {
+		question, err = s.questions.Question(ctx, QuestionFilter{Rand: questionRand})
+	}
+	if err != nil {
+		game.logger.Warn("Overtime is skipped, since question cannot be fetched", zap.Error(err))
+		return false
+	}
+
+	game.beginOvertimeRound(tied, question)
+	return true
+}
+
+// AnswerOvertime answers the overtime question on behalf of the tied player.
+func (s *Server) AnswerOvertime(_ context.Context, req *pb.AnswerOvertimeRequest) (*pb.AnswerOvertimeResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, err := s.getActiveGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+	correct, err := game.answerOvertime(reqUserID, req.GetAnswer())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.AnswerOvertimeResponse{Correct: correct}, nil
+}
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The paused game is resumed automatically after this time,
+// so that abandoned games don't stay active forever.
+const maxPauseTime = 5 * time.Minute
+
+// pausedTick is the periodic action, e.g. the theft or the stock tick,
+// which has been due, while the game was paused.
+type pausedTick struct {
+	remaining time.Duration // until the action, when the game was paused
+	do        func()
+}
+
+// isPaused returns true, if the active game is paused.
+func (g *game) isPaused() bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return !g.pauseTime.IsZero()
+}
+
+// deferWhilePaused returns true, if the game is paused, in which case the
+// periodic action is done after the game resumes, as much later as it
+// would have been done after the pause. The calling function has to
+// acquire write lock.
+func (g *game) deferWhilePaused(do func()) bool {
+	if g.pauseTime.IsZero() {
+		return false
+	}
+	g.pausedTicks = append(g.pausedTicks, pausedTick{remaining: g.clock.Now().Sub(g.pauseTime), do: do})
+	return true
+}
+
+// stopWhilePaused stops the timer, which has to fire at provided time,
+// until the game resumes. Timers, which have already fired, are not
+// restarted. The calling function has to acquire write lock.
+func (g *game) stopWhilePaused(timer Timer, fireTime time.Time) {
+	if timer == nil || !timer.Stop() {
+		return
+	}
+	g.pausedTimers[timer] = fireTime.Sub(g.clock.Now())
+}
+
+// pause freezes the active game. Timers of the game are stopped, and
+// periodic actions are deferred until the game resumes. Other players
+// are notified with the Pause event. User id is empty for admin.
+func (g *game) pause(userID userID) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state != activeState || g.overtime != nil {
+		return fmt.Errorf("only active game can be paused before overtime")
+	}
+	if !g.pauseTime.IsZero() {
+		return fmt.Errorf("game is already paused")
+	}
+
+	g.pauseTime = g.clock.Now()
+	g.pausedTimers = make(map[Timer]time.Duration)
+	g.stopWhilePaused(g.finishTimer, g.startTime.Add(time.Duration(g.config.duration)*time.Second))
+	for _, player := range g.players {
+		for _, pos := range player.credits {
+			g.stopWhilePaused(pos.timer, pos.endTime)
+		}
+		for _, pos := range player.deposits {
+			g.stopWhilePaused(pos.timer, pos.endTime)
+		}
+		for _, question := range player.questions {
+			g.stopWhilePaused(question.timer, question.deadline)
+		}
+	}
+	for _, loan := range g.loans {
+		fireTime := loan.endTime
+		if loan.state == collectionsLoan {
+			fireTime = g.pauseTime.Add(loanCollectionInterval)
+		}
+		g.stopWhilePaused(loan.timer, fireTime)
+	}
+	g.pauseTimer = g.clock.AfterFunc(maxPauseTime, func() {
+		if err := g.resume(""); err == nil {
+			g.logger.Info("Game is resumed after the maximal pause")
+		}
+	})
+	g.logger.Info("Game is paused", zap.String("user_id", string(userID)))
+
+	go func() {
+		g.broadcast(getPauseMessage(userID))
+	}()
+
+	return nil
+}
+
+// resume unfreezes the paused game. All times of the game are shifted
+// by the duration of the pause, and stopped timers are restarted with
+// the time, which they had left. Other players are notified with the
+// Resume event. User id is empty for admin or the automatic resume.
+func (g *game) resume(userID userID) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state != activeState || g.pauseTime.IsZero() {
+		return fmt.Errorf("game is not paused")
+	}
+
+	paused := g.clock.Now().Sub(g.pauseTime)
+	g.pauseTime = time.Time{}
+	g.pauseTimer.Stop()
+	g.shiftTimes(paused)
+	for timer, remaining := range g.pausedTimers {
+		timer.Reset(remaining)
+	}
+	g.pausedTimers = nil
+	for _, tick := range g.pausedTicks {
+		g.clock.AfterFunc(tick.remaining, tick.do)
+	}
+	g.pausedTicks = nil
+	g.persist()
+	g.logger.Info("Game is resumed", zap.String("user_id", string(userID)), zap.Duration("paused", paused))
+
+	msg := getResumeMessage(userID, g.getRemainingTime())
+	go func() {
+		g.broadcast(msg)
+	}()
+
+	return nil
+}
+
+// shiftTimes moves all times of the game by provided duration, so that
+// the pause doesn't count toward the game clock, positions, loans,
+// questions, cooldowns, and AFK time. The calling function has to acquire
+// write lock.
+func (g *game) shiftTimes(d time.Duration) {
+	g.startTime = g.startTime.Add(d)
+	g.nextTheftTime = g.nextTheftTime.Add(d)
+	for _, player := range g.players {
+		player.lastLotteryTime = player.lastLotteryTime.Add(d)
+		player.lastActionTime = player.lastActionTime.Add(d)
+		// zero times mean, that the player has never done it
+		if !player.lastStealTime.IsZero() {
+			player.lastStealTime = player.lastStealTime.Add(d)
+		}
+		if !player.guardEndTime.IsZero() {
+			player.guardEndTime = player.guardEndTime.Add(d)
+		}
+		for _, pos := range player.credits {
+			pos.startTime = pos.startTime.Add(d)
+			pos.endTime = pos.endTime.Add(d)
+		}
+		for _, pos := range player.deposits {
+			pos.startTime = pos.startTime.Add(d)
+			pos.endTime = pos.endTime.Add(d)
+		}
+		for _, question := range player.questions {
+			if !question.deadline.IsZero() {
+				question.deadline = question.deadline.Add(d)
+			}
+		}
+	}
+	for _, loan := range g.loans {
+		loan.endTime = loan.endTime.Add(d)
+	}
+}
+
+func getPauseMessage(userID userID) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Pause_{
+			Pause: &pb.StreamResponse_Pause{
+				UserId: string(userID),
+			},
+		},
+	}
+}
+
+func getResumeMessage(userID userID, remainingTime int32) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Resume_{
+			Resume: &pb.StreamResponse_Resume{
+				UserId:        string(userID),
+				RemainingTime: remainingTime,
+			},
+		},
+	}
+}
+
+// getPlayableGame returns the active game, in which players can act,
+// i.e. which is neither paused nor in overtime.
+func (s *Server) getPlayableGame(gameID gameID) (*game, error) {
+	game, err := s.getActiveGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if game.isPaused() {
+		return nil, newStatusError(codes.FailedPrecondition, ReasonGamePaused, 0, "game with id %v is paused", gameID)
+	}
+	if game.isInOvertime() {
+		return nil, newStatusError(codes.FailedPrecondition, ReasonGameInOvertime, 0, "game with id %v is in overtime", gameID)
+	}
+	return game, nil
+}
+
+// PauseGame pauses the active game on behalf of the host.
+func (s *Server) PauseGame(_ context.Context, req *pb.PauseGameRequest) (*pb.PauseGameResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, err := s.getActiveGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+	if !game.isHost(reqUserID) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the host of the game can pause it")
+	}
+	if err := game.pause(reqUserID); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.PauseGameResponse{}, nil
+}
+
+// ResumeGame resumes the paused game on behalf of the host.
+func (s *Server) ResumeGame(_ context.Context, req *pb.ResumeGameRequest) (*pb.ResumeGameResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, err := s.getActiveGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+	if !game.isHost(reqUserID) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the host of the game can resume it")
+	}
+	if err := game.resume(reqUserID); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.ResumeGameResponse{}, nil
+}
@@ -33,486 +33,783 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
-type Player struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GameMode int32
 
-	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Points   int32  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
-}
+const (
+	// players compete, and the player with the most points wins
+	GameMode_GAME_MODE_COMPETITIVE GameMode = 0
+	// players cooperate to extract the target amount of points
+	// from the bank before the game finishes
+	GameMode_GAME_MODE_COOP GameMode = 1
+)
 
-func (x *Player) Reset() {
-	*x = Player{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GameMode.
+var (
+	GameMode_name = map[int32]string{
+		0: "GAME_MODE_COMPETITIVE",
+		1: "GAME_MODE_COOP",
+	}
+	GameMode_value = map[string]int32{
+		"GAME_MODE_COMPETITIVE": 0,
+		"GAME_MODE_COOP":        1,
 	}
+)
+
+func (x GameMode) Enum() *GameMode {
+	p := new(GameMode)
+	*p = x
+	return p
 }
 
-func (x *Player) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GameMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Player) ProtoMessage() {}
+func (GameMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[0].Descriptor()
+}
 
-func (x *Player) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GameMode) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[0]
 }
 
-// Deprecated: Use Player.ProtoReflect.Descriptor instead.
-func (*Player) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{0}
+func (x GameMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Player) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use GameMode.Descriptor instead.
+func (GameMode) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Player) GetUsername() string {
-	if x != nil {
-		return x.Username
+// How the winner of the competitive game without teams is decided.
+type WinCondition int32
+
+const (
+	// the player with the most points, when the time runs out
+	WinCondition_WIN_CONDITION_POINTS WinCondition = 0
+	// the player with the highest net worth, when the time runs out
+	WinCondition_WIN_CONDITION_NET_WORTH WinCondition = 1
+	// the first player, whose net worth reaches the target; the game
+	// finishes right away, or the highest net worth wins, when the
+	// time runs out
+	WinCondition_WIN_CONDITION_TARGET WinCondition = 2
+	// the last player with positive net worth; the game finishes, once
+	// all other players are insolvent, or the highest net worth wins,
+	// when the time runs out
+	WinCondition_WIN_CONDITION_LAST_SOLVENT WinCondition = 3
+)
+
+// Enum value maps for WinCondition.
+var (
+	WinCondition_name = map[int32]string{
+		0: "WIN_CONDITION_POINTS",
+		1: "WIN_CONDITION_NET_WORTH",
+		2: "WIN_CONDITION_TARGET",
+		3: "WIN_CONDITION_LAST_SOLVENT",
 	}
-	return ""
+	WinCondition_value = map[string]int32{
+		"WIN_CONDITION_POINTS":       0,
+		"WIN_CONDITION_NET_WORTH":    1,
+		"WIN_CONDITION_TARGET":       2,
+		"WIN_CONDITION_LAST_SOLVENT": 3,
+	}
+)
+
+func (x WinCondition) Enum() *WinCondition {
+	p := new(WinCondition)
+	*p = x
+	return p
 }
 
-func (x *Player) GetPoints() int32 {
-	if x != nil {
-		return x.Points
-	}
-	return 0
+func (x WinCondition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type JoinRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (WinCondition) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[1].Descriptor()
+}
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+func (WinCondition) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[1]
 }
 
-func (x *JoinRequest) Reset() {
-	*x = JoinRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x WinCondition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *JoinRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use WinCondition.Descriptor instead.
+func (WinCondition) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{1}
 }
 
-func (*JoinRequest) ProtoMessage() {}
+type InterestMode int32
 
-func (x *JoinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// interest is charged only on the initial value
+	InterestMode_INTEREST_MODE_SIMPLE InterestMode = 0
+	// interest is split between the ticks, and every tick it is charged
+	// on the value together with the interest of the previous ticks
+	InterestMode_INTEREST_MODE_COMPOUND InterestMode = 1
+)
+
+// Enum value maps for InterestMode.
+var (
+	InterestMode_name = map[int32]string{
+		0: "INTEREST_MODE_SIMPLE",
+		1: "INTEREST_MODE_COMPOUND",
 	}
-	return mi.MessageOf(x)
-}
+	InterestMode_value = map[string]int32{
+		"INTEREST_MODE_SIMPLE":   0,
+		"INTEREST_MODE_COMPOUND": 1,
+	}
+)
 
-// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
-func (*JoinRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{1}
+func (x InterestMode) Enum() *InterestMode {
+	p := new(InterestMode)
+	*p = x
+	return p
 }
 
-func (x *JoinRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+func (x InterestMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type JoinResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (InterestMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[2].Descriptor()
+}
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// players who already joined the game
-	Players []*Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
-	// fields related to the game configs
-	Duration              int32 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
-	PlayerPoints          int32 `protobuf:"varint,5,opt,name=player_points,json=playerPoints,proto3" json:"player_points,omitempty"`
-	BankPointsPerPlayer   int32 `protobuf:"varint,6,opt,name=bank_points_per_player,json=bankPointsPerPlayer,proto3" json:"bank_points_per_player,omitempty"`
-	CreditInterest        int32 `protobuf:"varint,7,opt,name=credit_interest,json=creditInterest,proto3" json:"credit_interest,omitempty"`
-	DepositInterest       int32 `protobuf:"varint,8,opt,name=deposit_interest,json=depositInterest,proto3" json:"deposit_interest,omitempty"`
-	CreditTime            int32 `protobuf:"varint,9,opt,name=credit_time,json=creditTime,proto3" json:"credit_time,omitempty"`
-	DepositTime           int32 `protobuf:"varint,10,opt,name=deposit_time,json=depositTime,proto3" json:"deposit_time,omitempty"`
-	TheftTime             int32 `protobuf:"varint,11,opt,name=theft_time,json=theftTime,proto3" json:"theft_time,omitempty"`
-	TheftPercentage       int32 `protobuf:"varint,12,opt,name=theft_percentage,json=theftPercentage,proto3" json:"theft_percentage,omitempty"`
-	LotteryTime           int32 `protobuf:"varint,13,opt,name=lottery_time,json=lotteryTime,proto3" json:"lottery_time,omitempty"`
-	LotteryMaxWin         int32 `protobuf:"varint,14,opt,name=lottery_max_win,json=lotteryMaxWin,proto3" json:"lottery_max_win,omitempty"`
-	QuestionWinPercentage int32 `protobuf:"varint,15,opt,name=question_win_percentage,json=questionWinPercentage,proto3" json:"question_win_percentage,omitempty"`
+func (InterestMode) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[2]
 }
 
-func (x *JoinResponse) Reset() {
-	*x = JoinResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x InterestMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *JoinResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use InterestMode.Descriptor instead.
+func (InterestMode) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{2}
 }
 
-func (*JoinResponse) ProtoMessage() {}
+// UsernamePolicy defines, what happens, when the player joins
+// the game with the username, which is already taken in it.
+type UsernamePolicy int32
 
-func (x *JoinResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// the username is suffixed with the first free number, e.g. "Bob 2"
+	UsernamePolicy_USERNAME_POLICY_SUFFIX UsernamePolicy = 0
+	// the join is rejected with AlreadyExists error
+	UsernamePolicy_USERNAME_POLICY_REJECT UsernamePolicy = 1
+)
+
+// Enum value maps for UsernamePolicy.
+var (
+	UsernamePolicy_name = map[int32]string{
+		0: "USERNAME_POLICY_SUFFIX",
+		1: "USERNAME_POLICY_REJECT",
 	}
-	return mi.MessageOf(x)
-}
+	UsernamePolicy_value = map[string]int32{
+		"USERNAME_POLICY_SUFFIX": 0,
+		"USERNAME_POLICY_REJECT": 1,
+	}
+)
 
-// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
-func (*JoinResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{2}
+func (x UsernamePolicy) Enum() *UsernamePolicy {
+	p := new(UsernamePolicy)
+	*p = x
+	return p
 }
 
-func (x *JoinResponse) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+func (x UsernamePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *JoinResponse) GetGameId() string {
-	if x != nil {
-		return x.GameId
-	}
-	return ""
+func (UsernamePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[3].Descriptor()
 }
 
-func (x *JoinResponse) GetPlayers() []*Player {
-	if x != nil {
-		return x.Players
-	}
-	return nil
+func (UsernamePolicy) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[3]
 }
 
-func (x *JoinResponse) GetDuration() int32 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
+func (x UsernamePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *JoinResponse) GetPlayerPoints() int32 {
-	if x != nil {
-		return x.PlayerPoints
-	}
-	return 0
+// Deprecated: Use UsernamePolicy.Descriptor instead.
+func (UsernamePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *JoinResponse) GetBankPointsPerPlayer() int32 {
-	if x != nil {
-		return x.BankPointsPerPlayer
+// AfkPolicy defines, what happens to the player of the active game,
+// who hasn't acted for the AFK time. The player is flagged as AFK
+// in any case, and the flag is cleared, once the player acts again.
+type AfkPolicy int32
+
+const (
+	// the player is only flagged
+	AfkPolicy_AFK_POLICY_FLAG AfkPolicy = 0
+	// deposits of the player are deposited again, when their time ends
+	AfkPolicy_AFK_POLICY_MANAGE AfkPolicy = 1
+	// the player forfeits the game, so that the player cannot win it and is
+	// ranked after the other players; forfeit is kept, if the player returns
+	AfkPolicy_AFK_POLICY_FORFEIT AfkPolicy = 2
+)
+
+// Enum value maps for AfkPolicy.
+var (
+	AfkPolicy_name = map[int32]string{
+		0: "AFK_POLICY_FLAG",
+		1: "AFK_POLICY_MANAGE",
+		2: "AFK_POLICY_FORFEIT",
 	}
-	return 0
+	AfkPolicy_value = map[string]int32{
+		"AFK_POLICY_FLAG":    0,
+		"AFK_POLICY_MANAGE":  1,
+		"AFK_POLICY_FORFEIT": 2,
+	}
+)
+
+func (x AfkPolicy) Enum() *AfkPolicy {
+	p := new(AfkPolicy)
+	*p = x
+	return p
 }
 
-func (x *JoinResponse) GetCreditInterest() int32 {
-	if x != nil {
-		return x.CreditInterest
-	}
-	return 0
+func (x AfkPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *JoinResponse) GetDepositInterest() int32 {
-	if x != nil {
-		return x.DepositInterest
-	}
-	return 0
+func (AfkPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[4].Descriptor()
 }
 
-func (x *JoinResponse) GetCreditTime() int32 {
-	if x != nil {
-		return x.CreditTime
-	}
-	return 0
+func (AfkPolicy) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[4]
 }
 
-func (x *JoinResponse) GetDepositTime() int32 {
-	if x != nil {
-		return x.DepositTime
-	}
-	return 0
+func (x AfkPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *JoinResponse) GetTheftTime() int32 {
-	if x != nil {
-		return x.TheftTime
-	}
-	return 0
+// Deprecated: Use AfkPolicy.Descriptor instead.
+func (AfkPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *JoinResponse) GetTheftPercentage() int32 {
-	if x != nil {
-		return x.TheftPercentage
+// Predefined emotes, which players can react with.
+type Emote int32
+
+const (
+	Emote_EMOTE_THUMBS_UP Emote = 0
+	Emote_EMOTE_LAUGH     Emote = 1
+	Emote_EMOTE_WOW       Emote = 2
+	Emote_EMOTE_SAD       Emote = 3
+	Emote_EMOTE_ANGRY     Emote = 4
+	Emote_EMOTE_MONEY     Emote = 5
+)
+
+// Enum value maps for Emote.
+var (
+	Emote_name = map[int32]string{
+		0: "EMOTE_THUMBS_UP",
+		1: "EMOTE_LAUGH",
+		2: "EMOTE_WOW",
+		3: "EMOTE_SAD",
+		4: "EMOTE_ANGRY",
+		5: "EMOTE_MONEY",
 	}
-	return 0
+	Emote_value = map[string]int32{
+		"EMOTE_THUMBS_UP": 0,
+		"EMOTE_LAUGH":     1,
+		"EMOTE_WOW":       2,
+		"EMOTE_SAD":       3,
+		"EMOTE_ANGRY":     4,
+		"EMOTE_MONEY":     5,
+	}
+)
+
+func (x Emote) Enum() *Emote {
+	p := new(Emote)
+	*p = x
+	return p
 }
 
-func (x *JoinResponse) GetLotteryTime() int32 {
-	if x != nil {
-		return x.LotteryTime
-	}
-	return 0
+func (x Emote) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *JoinResponse) GetLotteryMaxWin() int32 {
-	if x != nil {
-		return x.LotteryMaxWin
-	}
-	return 0
+func (Emote) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[5].Descriptor()
 }
 
-func (x *JoinResponse) GetQuestionWinPercentage() int32 {
-	if x != nil {
-		return x.QuestionWinPercentage
-	}
-	return 0
+func (Emote) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[5]
 }
 
-type LeaveRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x Emote) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+// Deprecated: Use Emote.Descriptor instead.
+func (Emote) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LeaveRequest) Reset() {
-	*x = LeaveRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Players can be banned either by their username
+// or by the address they connect from.
+type BanKind int32
+
+const (
+	BanKind_BAN_KIND_USERNAME BanKind = 0
+	BanKind_BAN_KIND_ADDRESS  BanKind = 1
+)
+
+// Enum value maps for BanKind.
+var (
+	BanKind_name = map[int32]string{
+		0: "BAN_KIND_USERNAME",
+		1: "BAN_KIND_ADDRESS",
+	}
+	BanKind_value = map[string]int32{
+		"BAN_KIND_USERNAME": 0,
+		"BAN_KIND_ADDRESS":  1,
 	}
+)
+
+func (x BanKind) Enum() *BanKind {
+	p := new(BanKind)
+	*p = x
+	return p
 }
 
-func (x *LeaveRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x BanKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*LeaveRequest) ProtoMessage() {}
+func (BanKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[6].Descriptor()
+}
 
-func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (BanKind) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[6]
 }
 
-// Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
-func (*LeaveRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{3}
+func (x BanKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LeaveRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use BanKind.Descriptor instead.
+func (BanKind) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *LeaveRequest) GetGameId() string {
-	if x != nil {
-		return x.GameId
+type OrderSide int32
+
+const (
+	OrderSide_ORDER_SIDE_BUY  OrderSide = 0
+	OrderSide_ORDER_SIDE_SELL OrderSide = 1
+)
+
+// Enum value maps for OrderSide.
+var (
+	OrderSide_name = map[int32]string{
+		0: "ORDER_SIDE_BUY",
+		1: "ORDER_SIDE_SELL",
 	}
-	return ""
+	OrderSide_value = map[string]int32{
+		"ORDER_SIDE_BUY":  0,
+		"ORDER_SIDE_SELL": 1,
+	}
+)
+
+func (x OrderSide) Enum() *OrderSide {
+	p := new(OrderSide)
+	*p = x
+	return p
 }
 
-type LeaveResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x OrderSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LeaveResponse) Reset() {
-	*x = LeaveResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (OrderSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[7].Descriptor()
 }
 
-func (x *LeaveResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (OrderSide) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[7]
 }
 
-func (*LeaveResponse) ProtoMessage() {}
+func (x OrderSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// Deprecated: Use OrderSide.Descriptor instead.
+func (OrderSide) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{7}
+}
+
+// Categories of the stream events, to which clients can subscribe.
+type EventCategory int32
+
+const (
+	// start and finish of the game, players joining and leaving,
+	// countdown, pause, achievements, heartbeats, etc.
+	EventCategory_EVENT_CATEGORY_SYSTEM EventCategory = 0
+	// transactions other than lottery and questions, stock ticks,
+	// inflation, and co-op progress
+	EventCategory_EVENT_CATEGORY_BALANCE EventCategory = 1
+	// chat messages and reactions
+	EventCategory_EVENT_CATEGORY_CHAT EventCategory = 2
+	// lottery plays
+	EventCategory_EVENT_CATEGORY_LOTTERY EventCategory = 3
+	// answered and timed out questions, and overtime
+	EventCategory_EVENT_CATEGORY_QUESTIONS EventCategory = 4
+)
+
+// Enum value maps for EventCategory.
+var (
+	EventCategory_name = map[int32]string{
+		0: "EVENT_CATEGORY_SYSTEM",
+		1: "EVENT_CATEGORY_BALANCE",
+		2: "EVENT_CATEGORY_CHAT",
+		3: "EVENT_CATEGORY_LOTTERY",
+		4: "EVENT_CATEGORY_QUESTIONS",
 	}
-	return mi.MessageOf(x)
+	EventCategory_value = map[string]int32{
+		"EVENT_CATEGORY_SYSTEM":    0,
+		"EVENT_CATEGORY_BALANCE":   1,
+		"EVENT_CATEGORY_CHAT":      2,
+		"EVENT_CATEGORY_LOTTERY":   3,
+		"EVENT_CATEGORY_QUESTIONS": 4,
+	}
+)
+
+func (x EventCategory) Enum() *EventCategory {
+	p := new(EventCategory)
+	*p = x
+	return p
 }
 
-// Deprecated: Use LeaveResponse.ProtoReflect.Descriptor instead.
-func (*LeaveResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{4}
+func (x EventCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Ideally, we have also to check
-// user_id. But for now, let's simply
-// use game_id. Anyone, even people outside
-// the game can start it.
-type StartRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (EventCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[8].Descriptor()
+}
 
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+func (EventCategory) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[8]
 }
 
-func (x *StartRequest) Reset() {
-	*x = StartRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x EventCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventCategory.Descriptor instead.
+func (EventCategory) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{8}
+}
+
+type GameStatus int32
+
+const (
+	GameStatus_GAME_STATUS_WAITING  GameStatus = 0
+	GameStatus_GAME_STATUS_ACTIVE   GameStatus = 1
+	GameStatus_GAME_STATUS_FINISHED GameStatus = 2
+)
+
+// Enum value maps for GameStatus.
+var (
+	GameStatus_name = map[int32]string{
+		0: "GAME_STATUS_WAITING",
+		1: "GAME_STATUS_ACTIVE",
+		2: "GAME_STATUS_FINISHED",
+	}
+	GameStatus_value = map[string]int32{
+		"GAME_STATUS_WAITING":  0,
+		"GAME_STATUS_ACTIVE":   1,
+		"GAME_STATUS_FINISHED": 2,
 	}
+)
+
+func (x GameStatus) Enum() *GameStatus {
+	p := new(GameStatus)
+	*p = x
+	return p
 }
 
-func (x *StartRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GameStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*StartRequest) ProtoMessage() {}
+func (GameStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[9].Descriptor()
+}
 
-func (x *StartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (GameStatus) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[9]
+}
+
+func (x GameStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameStatus.Descriptor instead.
+func (GameStatus) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{9}
+}
+
+type LoanStatus int32
+
+const (
+	LoanStatus_LOAN_STATUS_OFFERED LoanStatus = 0
+	LoanStatus_LOAN_STATUS_ACTIVE  LoanStatus = 1
+	LoanStatus_LOAN_STATUS_REPAID  LoanStatus = 2
+	// borrower hasn't paid the whole loan, when its time ended;
+	// the rest is collected from the borrower's points over time
+	LoanStatus_LOAN_STATUS_COLLECTIONS LoanStatus = 3
+)
+
+// Enum value maps for LoanStatus.
+var (
+	LoanStatus_name = map[int32]string{
+		0: "LOAN_STATUS_OFFERED",
+		1: "LOAN_STATUS_ACTIVE",
+		2: "LOAN_STATUS_REPAID",
+		3: "LOAN_STATUS_COLLECTIONS",
 	}
-	return mi.MessageOf(x)
+	LoanStatus_value = map[string]int32{
+		"LOAN_STATUS_OFFERED":     0,
+		"LOAN_STATUS_ACTIVE":      1,
+		"LOAN_STATUS_REPAID":      2,
+		"LOAN_STATUS_COLLECTIONS": 3,
+	}
+)
+
+func (x LoanStatus) Enum() *LoanStatus {
+	p := new(LoanStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use StartRequest.ProtoReflect.Descriptor instead.
-func (*StartRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{5}
+func (x LoanStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *StartRequest) GetGameId() string {
-	if x != nil {
-		return x.GameId
+func (LoanStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[10].Descriptor()
+}
+
+func (LoanStatus) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[10]
+}
+
+func (x LoanStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoanStatus.Descriptor instead.
+func (LoanStatus) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{10}
+}
+
+type LeaderboardWindow int32
+
+const (
+	LeaderboardWindow_LEADERBOARD_WINDOW_ALL_TIME LeaderboardWindow = 0
+	LeaderboardWindow_LEADERBOARD_WINDOW_DAILY    LeaderboardWindow = 1 // last 24 hours
+	LeaderboardWindow_LEADERBOARD_WINDOW_WEEKLY   LeaderboardWindow = 2 // last 7 days
+)
+
+// Enum value maps for LeaderboardWindow.
+var (
+	LeaderboardWindow_name = map[int32]string{
+		0: "LEADERBOARD_WINDOW_ALL_TIME",
+		1: "LEADERBOARD_WINDOW_DAILY",
+		2: "LEADERBOARD_WINDOW_WEEKLY",
 	}
-	return ""
+	LeaderboardWindow_value = map[string]int32{
+		"LEADERBOARD_WINDOW_ALL_TIME": 0,
+		"LEADERBOARD_WINDOW_DAILY":    1,
+		"LEADERBOARD_WINDOW_WEEKLY":   2,
+	}
+)
+
+func (x LeaderboardWindow) Enum() *LeaderboardWindow {
+	p := new(LeaderboardWindow)
+	*p = x
+	return p
 }
 
-type StartResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x LeaderboardWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *StartResponse) Reset() {
-	*x = StartResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (LeaderboardWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[11].Descriptor()
+}
+
+func (LeaderboardWindow) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[11]
+}
+
+func (x LeaderboardWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardWindow.Descriptor instead.
+func (LeaderboardWindow) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{11}
+}
+
+type LeaderboardOrder int32
+
+const (
+	LeaderboardOrder_LEADERBOARD_ORDER_RATING   LeaderboardOrder = 0
+	LeaderboardOrder_LEADERBOARD_ORDER_WINNINGS LeaderboardOrder = 1
+	LeaderboardOrder_LEADERBOARD_ORDER_WIN_RATE LeaderboardOrder = 2
+)
+
+// Enum value maps for LeaderboardOrder.
+var (
+	LeaderboardOrder_name = map[int32]string{
+		0: "LEADERBOARD_ORDER_RATING",
+		1: "LEADERBOARD_ORDER_WINNINGS",
+		2: "LEADERBOARD_ORDER_WIN_RATE",
 	}
+	LeaderboardOrder_value = map[string]int32{
+		"LEADERBOARD_ORDER_RATING":   0,
+		"LEADERBOARD_ORDER_WINNINGS": 1,
+		"LEADERBOARD_ORDER_WIN_RATE": 2,
+	}
+)
+
+func (x LeaderboardOrder) Enum() *LeaderboardOrder {
+	p := new(LeaderboardOrder)
+	*p = x
+	return p
 }
 
-func (x *StartResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x LeaderboardOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*StartResponse) ProtoMessage() {}
+func (LeaderboardOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[12].Descriptor()
+}
 
-func (x *StartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (LeaderboardOrder) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[12]
+}
+
+func (x LeaderboardOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardOrder.Descriptor instead.
+func (LeaderboardOrder) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{12}
+}
+
+type StreamResponse_TimeTick_Marker int32
+
+const (
+	StreamResponse_TimeTick_MARKER_NONE        StreamResponse_TimeTick_Marker = 0
+	StreamResponse_TimeTick_MARKER_ONE_MINUTE  StreamResponse_TimeTick_Marker = 1
+	StreamResponse_TimeTick_MARKER_TEN_SECONDS StreamResponse_TimeTick_Marker = 2
+)
+
+// Enum value maps for StreamResponse_TimeTick_Marker.
+var (
+	StreamResponse_TimeTick_Marker_name = map[int32]string{
+		0: "MARKER_NONE",
+		1: "MARKER_ONE_MINUTE",
+		2: "MARKER_TEN_SECONDS",
 	}
-	return mi.MessageOf(x)
+	StreamResponse_TimeTick_Marker_value = map[string]int32{
+		"MARKER_NONE":        0,
+		"MARKER_ONE_MINUTE":  1,
+		"MARKER_TEN_SECONDS": 2,
+	}
+)
+
+func (x StreamResponse_TimeTick_Marker) Enum() *StreamResponse_TimeTick_Marker {
+	p := new(StreamResponse_TimeTick_Marker)
+	*p = x
+	return p
 }
 
-// Deprecated: Use StartResponse.ProtoReflect.Descriptor instead.
-func (*StartResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{6}
+func (x StreamResponse_TimeTick_Marker) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type CreditRequest struct {
+func (StreamResponse_TimeTick_Marker) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[13].Descriptor()
+}
+
+func (StreamResponse_TimeTick_Marker) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[13]
+}
+
+func (x StreamResponse_TimeTick_Marker) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StreamResponse_TimeTick_Marker.Descriptor instead.
+func (StreamResponse_TimeTick_Marker) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 3, 0}
+}
+
+type Player struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Value  int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Points   int32  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	// from 0 to 100, it goes up when the player returns credits and down
+	// when the player cannot afford to return them; higher score lowers
+	// interest of new credits and raises the credit limit
+	CreditScore int32 `protobuf:"varint,4,opt,name=credit_score,json=creditScore,proto3" json:"credit_score,omitempty"`
+	// ELO rating, which is updated, when the game finishes
+	Rating int32 `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	// from 1 to the number of teams, 0 if the game has no teams
+	Team int32 `protobuf:"varint,6,opt,name=team,proto3" json:"team,omitempty"`
+	// true, if the player is driven by the server
+	Bot bool `protobuf:"varint,7,opt,name=bot,proto3" json:"bot,omitempty"`
+	// avatar chosen by the client, empty if it hasn't chosen any
+	AvatarId string `protobuf:"bytes,8,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`
+	// color of the player as "#rrggbb", which is distinguishable
+	// from the colors of other players of the game
+	Color string `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+	// true, if the player hasn't acted for the AFK time of the game
+	Afk bool `protobuf:"varint,10,opt,name=afk,proto3" json:"afk,omitempty"`
+	// true, if the player has forfeited the game for being AFK
+	Forfeited bool `protobuf:"varint,11,opt,name=forfeited,proto3" json:"forfeited,omitempty"`
 }
 
-func (x *CreditRequest) Reset() {
-	*x = CreditRequest{}
+func (x *Player) Reset() {
+	*x = Player{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[7]
+		mi := &file_game_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreditRequest) String() string {
+func (x *Player) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreditRequest) ProtoMessage() {}
+func (*Player) ProtoMessage() {}
 
-func (x *CreditRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[7]
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,119 +820,129 @@ func (x *CreditRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreditRequest.ProtoReflect.Descriptor instead.
-func (*CreditRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *CreditRequest) GetUserId() string {
+func (x *Player) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreditRequest) GetGameId() string {
+func (x *Player) GetUsername() string {
 	if x != nil {
-		return x.GameId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *CreditRequest) GetValue() int32 {
+func (x *Player) GetPoints() int32 {
 	if x != nil {
-		return x.Value
+		return x.Points
 	}
 	return 0
 }
 
-// In case of credit, it cannot be granted
-// if the bank doesn't have enough money or
-// if the user asks too much. These reasons
-// will be stated in "explanation" field if
-// "success" is false.
-type CreditResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+func (x *Player) GetCreditScore() int32 {
+	if x != nil {
+		return x.CreditScore
+	}
+	return 0
 }
 
-func (x *CreditResponse) Reset() {
-	*x = CreditResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Player) GetRating() int32 {
+	if x != nil {
+		return x.Rating
 	}
+	return 0
 }
 
-func (x *CreditResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Player) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
 }
 
-func (*CreditResponse) ProtoMessage() {}
+func (x *Player) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
 
-func (x *CreditResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Player) GetAvatarId() string {
+	if x != nil {
+		return x.AvatarId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreditResponse.ProtoReflect.Descriptor instead.
-func (*CreditResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{8}
+func (x *Player) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
 }
 
-func (x *CreditResponse) GetSuccess() bool {
+func (x *Player) GetAfk() bool {
 	if x != nil {
-		return x.Success
+		return x.Afk
 	}
 	return false
 }
 
-func (x *CreditResponse) GetExplanation() string {
+func (x *Player) GetForfeited() bool {
 	if x != nil {
-		return x.Explanation
+		return x.Forfeited
 	}
-	return ""
+	return false
 }
 
-type DepositRequest struct {
+type JoinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Value  int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// language of the questions asked to the player as BCP 47 tag, e.g. "fr"
+	// or "fr-CA"; default language is used, if question is not translated
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// token returned by Register or Login, if set, the player joins with
+	// the username of the account instead of the requested one
+	AccountToken string `protobuf:"bytes,3,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"`
+	// if true, the player joins a new practice game, which starts right away
+	Practice bool `protobuf:"varint,4,opt,name=practice,proto3" json:"practice,omitempty"`
+	// number of bots (up to 10) added to the practice game
+	PracticeBots int32 `protobuf:"varint,5,opt,name=practice_bots,json=practiceBots,proto3" json:"practice_bots,omitempty"`
+	// id of the avatar, which clients know how to render, up to 64
+	// letters, digits, "_", or "-" characters, e.g. "fox"; optional
+	AvatarId string `protobuf:"bytes,6,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`
+	// preferred color as "#rrggbb"; if it is empty or too close to the color
+	// of another player, the player gets the first free color of the palette
+	Color string `protobuf:"bytes,7,opt,name=color,proto3" json:"color,omitempty"`
 }
 
-func (x *DepositRequest) Reset() {
-	*x = DepositRequest{}
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[9]
+		mi := &file_game_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositRequest) String() string {
+func (x *JoinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositRequest) ProtoMessage() {}
+func (*JoinRequest) ProtoMessage() {}
 
-func (x *DepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[9]
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,119 +953,212 @@ func (x *DepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
-func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *DepositRequest) GetUserId() string {
+func (x *JoinRequest) GetUsername() string {
 	if x != nil {
-		return x.UserId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *DepositRequest) GetGameId() string {
+func (x *JoinRequest) GetLocale() string {
 	if x != nil {
-		return x.GameId
+		return x.Locale
 	}
 	return ""
 }
 
-func (x *DepositRequest) GetValue() int32 {
+func (x *JoinRequest) GetAccountToken() string {
 	if x != nil {
-		return x.Value
+		return x.AccountToken
 	}
-	return 0
-}
-
-// In case of deposit, it cannot be granted
-// if the user doesn't have enough money or
-// the user puts too much. These reasons
-// will be stated in "explanation" field if
-// "success" is false.
-type DepositResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	return ""
 }
 
-func (x *DepositResponse) Reset() {
-	*x = DepositResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinRequest) GetPractice() bool {
+	if x != nil {
+		return x.Practice
 	}
+	return false
 }
 
-func (x *DepositResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DepositResponse) ProtoMessage() {}
-
-func (x *DepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinRequest) GetPracticeBots() int32 {
+	if x != nil {
+		return x.PracticeBots
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
-func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{10}
+	return 0
 }
 
-func (x *DepositResponse) GetSuccess() bool {
+func (x *JoinRequest) GetAvatarId() string {
 	if x != nil {
-		return x.Success
+		return x.AvatarId
 	}
-	return false
+	return ""
 }
 
-func (x *DepositResponse) GetExplanation() string {
+func (x *JoinRequest) GetColor() string {
 	if x != nil {
-		return x.Explanation
+		return x.Color
 	}
 	return ""
 }
 
-type LotteryRequest struct {
+type JoinResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	CellIndex int32  `protobuf:"varint,3,opt,name=cell_index,json=cellIndex,proto3" json:"cell_index,omitempty"` // has to be from 1 to 9
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// players who already joined the game
+	Players []*Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	// fields related to the game configs
+	Duration              int32 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	PlayerPoints          int32 `protobuf:"varint,5,opt,name=player_points,json=playerPoints,proto3" json:"player_points,omitempty"`
+	BankPointsPerPlayer   int32 `protobuf:"varint,6,opt,name=bank_points_per_player,json=bankPointsPerPlayer,proto3" json:"bank_points_per_player,omitempty"`
+	CreditInterest        int32 `protobuf:"varint,7,opt,name=credit_interest,json=creditInterest,proto3" json:"credit_interest,omitempty"`
+	DepositInterest       int32 `protobuf:"varint,8,opt,name=deposit_interest,json=depositInterest,proto3" json:"deposit_interest,omitempty"`
+	CreditTime            int32 `protobuf:"varint,9,opt,name=credit_time,json=creditTime,proto3" json:"credit_time,omitempty"`
+	DepositTime           int32 `protobuf:"varint,10,opt,name=deposit_time,json=depositTime,proto3" json:"deposit_time,omitempty"`
+	TheftTime             int32 `protobuf:"varint,11,opt,name=theft_time,json=theftTime,proto3" json:"theft_time,omitempty"`
+	TheftPercentage       int32 `protobuf:"varint,12,opt,name=theft_percentage,json=theftPercentage,proto3" json:"theft_percentage,omitempty"`
+	LotteryTime           int32 `protobuf:"varint,13,opt,name=lottery_time,json=lotteryTime,proto3" json:"lottery_time,omitempty"`
+	LotteryMaxWin         int32 `protobuf:"varint,14,opt,name=lottery_max_win,json=lotteryMaxWin,proto3" json:"lottery_max_win,omitempty"`
+	QuestionWinPercentage int32 `protobuf:"varint,15,opt,name=question_win_percentage,json=questionWinPercentage,proto3" json:"question_win_percentage,omitempty"`
+	// token, which has to be provided in order
+	// to reconnect to the game after losing connection
+	SessionToken string `protobuf:"bytes,16,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// signed token, which has to be provided in "authorization"
+	// metadata as "Bearer <token>" with all requests of the player
+	AuthToken string `protobuf:"bytes,17,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// seconds to answer the generated question, after which
+	// the bid is forfeited; there is no deadline, if 0
+	QuestionTime int32 `protobuf:"varint,18,opt,name=question_time,json=questionTime,proto3" json:"question_time,omitempty"`
+	// maximum bid as percentage of the current points of the player;
+	// player can bid all of the points, if 0
+	QuestionMaxBidPercentage int32 `protobuf:"varint,19,opt,name=question_max_bid_percentage,json=questionMaxBidPercentage,proto3" json:"question_max_bid_percentage,omitempty"`
+	// dimensions of the lottery grid
+	LotteryRows    int32 `protobuf:"varint,20,opt,name=lottery_rows,json=lotteryRows,proto3" json:"lottery_rows,omitempty"`
+	LotteryColumns int32 `protobuf:"varint,21,opt,name=lottery_columns,json=lotteryColumns,proto3" json:"lottery_columns,omitempty"`
+	// payout table of the lottery as percentages of lottery_max_win, which
+	// are distributed over the cells in order, each taking about the same
+	// number of cells; first and last payouts are always present
+	LotteryPayouts []int32 `protobuf:"varint,22,rep,packed,name=lottery_payouts,json=lotteryPayouts,proto3" json:"lottery_payouts,omitempty"`
+	// percentage of the lottery max win, which is put into the jackpot every
+	// time the lottery is played without winning, and of the bid of every
+	// lost question; there is no jackpot, if 0
+	JackpotPercentage int32 `protobuf:"varint,23,opt,name=jackpot_percentage,json=jackpotPercentage,proto3" json:"jackpot_percentage,omitempty"`
+	// chance of winning the jackpot in every lottery play as percentage
+	JackpotChance int32 `protobuf:"varint,24,opt,name=jackpot_chance,json=jackpotChance,proto3" json:"jackpot_chance,omitempty"`
+	// percentage of the deposit, which is kept by the bank,
+	// if the deposit is withdrawn before its time ends
+	DepositPenaltyPercentage int32 `protobuf:"varint,25,opt,name=deposit_penalty_percentage,json=depositPenaltyPercentage,proto3" json:"deposit_penalty_percentage,omitempty"`
+	// how the interest of credits and deposits is charged
+	InterestMode InterestMode `protobuf:"varint,26,opt,name=interest_mode,json=interestMode,proto3,enum=server.InterestMode" json:"interest_mode,omitempty"`
+	// number of ticks during the credit or deposit time,
+	// at which the interest is compounded
+	InterestTicks int32 `protobuf:"varint,27,opt,name=interest_ticks,json=interestTicks,proto3" json:"interest_ticks,omitempty"`
+	// maximum value of outstanding credits as percentage of the net
+	// worth of the player, i.e. his points together with his deposits
+	// without his credits
+	CreditLimitPercentage int32 `protobuf:"varint,28,opt,name=credit_limit_percentage,json=creditLimitPercentage,proto3" json:"credit_limit_percentage,omitempty"`
+	// assets traded on the market of the game
+	MarketAssets []string `protobuf:"bytes,29,rep,name=market_assets,json=marketAssets,proto3" json:"market_assets,omitempty"`
+	// units of every asset, which each player has at the start
+	MarketUnits int32 `protobuf:"varint,30,opt,name=market_units,json=marketUnits,proto3" json:"market_units,omitempty"`
+	// initial price of the share of the stock, there is no stock, if 0
+	StockPrice int32 `protobuf:"varint,31,opt,name=stock_price,json=stockPrice,proto3" json:"stock_price,omitempty"`
+	// maximum change of the stock price in one tick as percentage
+	StockVolatilityPercentage int32 `protobuf:"varint,32,opt,name=stock_volatility_percentage,json=stockVolatilityPercentage,proto3" json:"stock_volatility_percentage,omitempty"`
+	// seconds between the changes of the stock price
+	StockTickTime int32 `protobuf:"varint,33,opt,name=stock_tick_time,json=stockTickTime,proto3" json:"stock_tick_time,omitempty"`
+	// points paid for the insurance against the next theft,
+	// there is no insurance, if 0
+	InsurancePremium int32 `protobuf:"varint,34,opt,name=insurance_premium,json=insurancePremium,proto3" json:"insurance_premium,omitempty"`
+	// percentage of the stolen points, which the insurance pays back
+	InsuranceCoveragePercentage int32 `protobuf:"varint,35,opt,name=insurance_coverage_percentage,json=insuranceCoveragePercentage,proto3" json:"insurance_coverage_percentage,omitempty"`
+	// seconds between the collections of the wealth tax,
+	// there is no tax, if 0
+	TaxTime     int32         `protobuf:"varint,36,opt,name=tax_time,json=taxTime,proto3" json:"tax_time,omitempty"`
+	TaxBrackets []*TaxBracket `protobuf:"bytes,37,rep,name=tax_brackets,json=taxBrackets,proto3" json:"tax_brackets,omitempty"`
+	// percentage, by which the price level rises every inflation tick,
+	// there is no inflation, if 0
+	InflationRate int32 `protobuf:"varint,38,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
+	// seconds between the inflation ticks
+	InflationTime int32 `protobuf:"varint,39,opt,name=inflation_time,json=inflationTime,proto3" json:"inflation_time,omitempty"`
+	// chance of the successful steal as percentage,
+	// players cannot steal, if 0
+	StealChance int32 `protobuf:"varint,40,opt,name=steal_chance,json=stealChance,proto3" json:"steal_chance,omitempty"`
+	// percentage of the points, which the thief tried to steal,
+	// paid to the target, if the thief is caught
+	StealPenaltyPercentage int32 `protobuf:"varint,41,opt,name=steal_penalty_percentage,json=stealPenaltyPercentage,proto3" json:"steal_penalty_percentage,omitempty"`
+	// points paid for the guard, there are no guards, if 0
+	GuardPrice int32 `protobuf:"varint,42,opt,name=guard_price,json=guardPrice,proto3" json:"guard_price,omitempty"`
+	// seconds, for which the guard protects the player
+	GuardTime int32 `protobuf:"varint,43,opt,name=guard_time,json=guardTime,proto3" json:"guard_time,omitempty"`
+	// percentage, by which the guard reduces points stolen
+	// in thefts and the chance to steal from the player
+	GuardProtectionPercentage int32 `protobuf:"varint,44,opt,name=guard_protection_percentage,json=guardProtectionPercentage,proto3" json:"guard_protection_percentage,omitempty"`
+	// true, if thieves, who are not caught, are not revealed in steal events
+	MaskThieves bool `protobuf:"varint,45,opt,name=mask_thieves,json=maskThieves,proto3" json:"mask_thieves,omitempty"`
+	// player, who can start the game and kick players
+	HostId string `protobuf:"bytes,46,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	// the game cannot be started with fewer players
+	MinPlayers int32 `protobuf:"varint,47,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	// players cannot join the game, when it has that many
+	// players, 0 if the number of players is not limited
+	MaxPlayers int32 `protobuf:"varint,48,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	// true, if the game starts automatically, once max players have joined it
+	AutoStart bool `protobuf:"varint,49,opt,name=auto_start,json=autoStart,proto3" json:"auto_start,omitempty"`
+	// seconds between Start request and the start of the game,
+	// 0 if the game starts right after the request
+	StartCountdown int32 `protobuf:"varint,50,opt,name=start_countdown,json=startCountdown,proto3" json:"start_countdown,omitempty"`
+	// players are split into that many teams, 0 if the game has no teams
+	TeamCount int32    `protobuf:"varint,51,opt,name=team_count,json=teamCount,proto3" json:"team_count,omitempty"`
+	Mode      GameMode `protobuf:"varint,52,opt,name=mode,proto3,enum=server.GameMode" json:"mode,omitempty"`
+	// in co-op mode, points, which the players have to extract
+	// from the bank together, 0 in competitive mode
+	CoopTarget int32 `protobuf:"varint,53,opt,name=coop_target,json=coopTarget,proto3" json:"coop_target,omitempty"`
+	// true, if the game is a practice, which doesn't count
+	// toward ratings, leaderboards, stats, and achievements
+	Practice     bool         `protobuf:"varint,54,opt,name=practice,proto3" json:"practice,omitempty"`
+	WinCondition WinCondition `protobuf:"varint,55,opt,name=win_condition,json=winCondition,proto3,enum=server.WinCondition" json:"win_condition,omitempty"`
+	// net worth, which wins the game with the target win condition
+	WinTarget int32 `protobuf:"varint,56,opt,name=win_target,json=winTarget,proto3" json:"win_target,omitempty"`
+	// seconds between the TimeTick events, which aren't sent, if 0
+	TimeTickTime int32 `protobuf:"varint,57,opt,name=time_tick_time,json=timeTickTime,proto3" json:"time_tick_time,omitempty"`
+	// username of the player, which has a number suffix,
+	// if the requested one is already taken in the game
+	Username string `protobuf:"bytes,58,opt,name=username,proto3" json:"username,omitempty"`
+	// color of the player, which differs from the requested
+	// one, if that is too close to the color of another player
+	Color string `protobuf:"bytes,59,opt,name=color,proto3" json:"color,omitempty"`
+	// seconds without actions, after which the player is AFK, not detected, if 0
+	AfkTime   int32     `protobuf:"varint,60,opt,name=afk_time,json=afkTime,proto3" json:"afk_time,omitempty"`
+	AfkPolicy AfkPolicy `protobuf:"varint,61,opt,name=afk_policy,json=afkPolicy,proto3,enum=server.AfkPolicy" json:"afk_policy,omitempty"`
 }
 
-func (x *LotteryRequest) Reset() {
-	*x = LotteryRequest{}
+func (x *JoinResponse) Reset() {
+	*x = JoinResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[11]
+		mi := &file_game_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LotteryRequest) String() string {
+func (x *JoinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LotteryRequest) ProtoMessage() {}
+func (*JoinResponse) ProtoMessage() {}
 
-func (x *LotteryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[11]
+func (x *JoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,561 +1169,516 @@ func (x *LotteryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LotteryRequest.ProtoReflect.Descriptor instead.
-func (*LotteryRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
+func (*JoinResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *LotteryRequest) GetUserId() string {
+func (x *JoinResponse) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *LotteryRequest) GetGameId() string {
+func (x *JoinResponse) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-func (x *LotteryRequest) GetCellIndex() int32 {
+func (x *JoinResponse) GetPlayers() []*Player {
 	if x != nil {
-		return x.CellIndex
+		return x.Players
 	}
-	return 0
-}
-
-type LotteryResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success    bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	CellValues []int32 `protobuf:"varint,2,rep,packed,name=cell_values,json=cellValues,proto3" json:"cell_values,omitempty"` // 9 values for each cell
-	WinPoints  int32   `protobuf:"varint,3,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`
+	return nil
 }
 
-func (x *LotteryResponse) Reset() {
-	*x = LotteryResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinResponse) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
 	}
+	return 0
 }
 
-func (x *LotteryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LotteryResponse) ProtoMessage() {}
-
-func (x *LotteryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinResponse) GetPlayerPoints() int32 {
+	if x != nil {
+		return x.PlayerPoints
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LotteryResponse.ProtoReflect.Descriptor instead.
-func (*LotteryResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{12}
+	return 0
 }
 
-func (x *LotteryResponse) GetSuccess() bool {
+func (x *JoinResponse) GetBankPointsPerPlayer() int32 {
 	if x != nil {
-		return x.Success
+		return x.BankPointsPerPlayer
 	}
-	return false
+	return 0
 }
 
-func (x *LotteryResponse) GetCellValues() []int32 {
+func (x *JoinResponse) GetCreditInterest() int32 {
 	if x != nil {
-		return x.CellValues
+		return x.CreditInterest
 	}
-	return nil
+	return 0
 }
 
-func (x *LotteryResponse) GetWinPoints() int32 {
+func (x *JoinResponse) GetDepositInterest() int32 {
 	if x != nil {
-		return x.WinPoints
+		return x.DepositInterest
 	}
 	return 0
 }
 
-type GenerateQuestionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *JoinResponse) GetCreditTime() int32 {
+	if x != nil {
+		return x.CreditTime
+	}
+	return 0
+}
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// These points will be withdrawn during this request
-	// even if player does not answer the question
-	BidPoints int32 `protobuf:"varint,3,opt,name=bid_points,json=bidPoints,proto3" json:"bid_points,omitempty"`
+func (x *JoinResponse) GetDepositTime() int32 {
+	if x != nil {
+		return x.DepositTime
+	}
+	return 0
 }
 
-func (x *GenerateQuestionRequest) Reset() {
-	*x = GenerateQuestionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinResponse) GetTheftTime() int32 {
+	if x != nil {
+		return x.TheftTime
 	}
+	return 0
 }
 
-func (x *GenerateQuestionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JoinResponse) GetTheftPercentage() int32 {
+	if x != nil {
+		return x.TheftPercentage
+	}
+	return 0
 }
 
-func (*GenerateQuestionRequest) ProtoMessage() {}
+func (x *JoinResponse) GetLotteryTime() int32 {
+	if x != nil {
+		return x.LotteryTime
+	}
+	return 0
+}
 
-func (x *GenerateQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinResponse) GetLotteryMaxWin() int32 {
+	if x != nil {
+		return x.LotteryMaxWin
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GenerateQuestionRequest.ProtoReflect.Descriptor instead.
-func (*GenerateQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{13}
+func (x *JoinResponse) GetQuestionWinPercentage() int32 {
+	if x != nil {
+		return x.QuestionWinPercentage
+	}
+	return 0
 }
 
-func (x *GenerateQuestionRequest) GetUserId() string {
+func (x *JoinResponse) GetSessionToken() string {
 	if x != nil {
-		return x.UserId
+		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *GenerateQuestionRequest) GetGameId() string {
+func (x *JoinResponse) GetAuthToken() string {
 	if x != nil {
-		return x.GameId
+		return x.AuthToken
 	}
 	return ""
 }
 
-func (x *GenerateQuestionRequest) GetBidPoints() int32 {
+func (x *JoinResponse) GetQuestionTime() int32 {
 	if x != nil {
-		return x.BidPoints
+		return x.QuestionTime
 	}
 	return 0
 }
 
-type GenerateQuestionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	QuestionId string   `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Question   string   `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"` // 1 question
-	Answers    []string `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`   // 4 answers
+func (x *JoinResponse) GetQuestionMaxBidPercentage() int32 {
+	if x != nil {
+		return x.QuestionMaxBidPercentage
+	}
+	return 0
 }
 
-func (x *GenerateQuestionResponse) Reset() {
-	*x = GenerateQuestionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinResponse) GetLotteryRows() int32 {
+	if x != nil {
+		return x.LotteryRows
 	}
+	return 0
 }
 
-func (x *GenerateQuestionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JoinResponse) GetLotteryColumns() int32 {
+	if x != nil {
+		return x.LotteryColumns
+	}
+	return 0
 }
 
-func (*GenerateQuestionResponse) ProtoMessage() {}
-
-func (x *GenerateQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinResponse) GetLotteryPayouts() []int32 {
+	if x != nil {
+		return x.LotteryPayouts
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GenerateQuestionResponse.ProtoReflect.Descriptor instead.
-func (*GenerateQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{14}
+func (x *JoinResponse) GetJackpotPercentage() int32 {
+	if x != nil {
+		return x.JackpotPercentage
+	}
+	return 0
 }
 
-func (x *GenerateQuestionResponse) GetQuestionId() string {
+func (x *JoinResponse) GetJackpotChance() int32 {
 	if x != nil {
-		return x.QuestionId
+		return x.JackpotChance
 	}
-	return ""
+	return 0
 }
 
-func (x *GenerateQuestionResponse) GetQuestion() string {
+func (x *JoinResponse) GetDepositPenaltyPercentage() int32 {
 	if x != nil {
-		return x.Question
+		return x.DepositPenaltyPercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *GenerateQuestionResponse) GetAnswers() []string {
+func (x *JoinResponse) GetInterestMode() InterestMode {
 	if x != nil {
-		return x.Answers
+		return x.InterestMode
 	}
-	return nil
+	return InterestMode_INTEREST_MODE_SIMPLE
 }
 
-type AnswerQuestionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId     string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	QuestionId string `protobuf:"bytes,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Answer     int32  `protobuf:"varint,4,opt,name=answer,proto3" json:"answer,omitempty"` // index from 1 to 4
+func (x *JoinResponse) GetInterestTicks() int32 {
+	if x != nil {
+		return x.InterestTicks
+	}
+	return 0
 }
 
-func (x *AnswerQuestionRequest) Reset() {
-	*x = AnswerQuestionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinResponse) GetCreditLimitPercentage() int32 {
+	if x != nil {
+		return x.CreditLimitPercentage
 	}
+	return 0
 }
 
-func (x *AnswerQuestionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JoinResponse) GetMarketAssets() []string {
+	if x != nil {
+		return x.MarketAssets
+	}
+	return nil
 }
 
-func (*AnswerQuestionRequest) ProtoMessage() {}
-
-func (x *AnswerQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinResponse) GetMarketUnits() int32 {
+	if x != nil {
+		return x.MarketUnits
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AnswerQuestionRequest.ProtoReflect.Descriptor instead.
-func (*AnswerQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15}
+func (x *JoinResponse) GetStockPrice() int32 {
+	if x != nil {
+		return x.StockPrice
+	}
+	return 0
 }
 
-func (x *AnswerQuestionRequest) GetUserId() string {
+func (x *JoinResponse) GetStockVolatilityPercentage() int32 {
 	if x != nil {
-		return x.UserId
+		return x.StockVolatilityPercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *AnswerQuestionRequest) GetGameId() string {
+func (x *JoinResponse) GetStockTickTime() int32 {
 	if x != nil {
-		return x.GameId
+		return x.StockTickTime
 	}
-	return ""
+	return 0
 }
 
-func (x *AnswerQuestionRequest) GetQuestionId() string {
+func (x *JoinResponse) GetInsurancePremium() int32 {
 	if x != nil {
-		return x.QuestionId
+		return x.InsurancePremium
 	}
-	return ""
+	return 0
 }
 
-func (x *AnswerQuestionRequest) GetAnswer() int32 {
+func (x *JoinResponse) GetInsuranceCoveragePercentage() int32 {
 	if x != nil {
-		return x.Answer
+		return x.InsuranceCoveragePercentage
 	}
 	return 0
 }
 
-type AnswerQuestionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AnswerIsCorrect bool  `protobuf:"varint,1,opt,name=answer_is_correct,json=answerIsCorrect,proto3" json:"answer_is_correct,omitempty"`
-	CorrectAnswer   int32 `protobuf:"varint,2,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"` // index from 1 to 4
-	WinPoints       int32 `protobuf:"varint,3,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`             // 0 if !answer_is_correct, otherwise (bid_points * question_win_percentage / 100)
+func (x *JoinResponse) GetTaxTime() int32 {
+	if x != nil {
+		return x.TaxTime
+	}
+	return 0
 }
 
-func (x *AnswerQuestionResponse) Reset() {
-	*x = AnswerQuestionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinResponse) GetTaxBrackets() []*TaxBracket {
+	if x != nil {
+		return x.TaxBrackets
 	}
+	return nil
 }
 
-func (x *AnswerQuestionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JoinResponse) GetInflationRate() int32 {
+	if x != nil {
+		return x.InflationRate
+	}
+	return 0
 }
 
-func (*AnswerQuestionResponse) ProtoMessage() {}
-
-func (x *AnswerQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinResponse) GetInflationTime() int32 {
+	if x != nil {
+		return x.InflationTime
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AnswerQuestionResponse.ProtoReflect.Descriptor instead.
-func (*AnswerQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{16}
+func (x *JoinResponse) GetStealChance() int32 {
+	if x != nil {
+		return x.StealChance
+	}
+	return 0
 }
 
-func (x *AnswerQuestionResponse) GetAnswerIsCorrect() bool {
+func (x *JoinResponse) GetStealPenaltyPercentage() int32 {
 	if x != nil {
-		return x.AnswerIsCorrect
+		return x.StealPenaltyPercentage
 	}
-	return false
+	return 0
 }
 
-func (x *AnswerQuestionResponse) GetCorrectAnswer() int32 {
+func (x *JoinResponse) GetGuardPrice() int32 {
 	if x != nil {
-		return x.CorrectAnswer
+		return x.GuardPrice
 	}
 	return 0
 }
 
-func (x *AnswerQuestionResponse) GetWinPoints() int32 {
+func (x *JoinResponse) GetGuardTime() int32 {
 	if x != nil {
-		return x.WinPoints
+		return x.GuardTime
 	}
 	return 0
 }
 
-type StreamRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *JoinResponse) GetGuardProtectionPercentage() int32 {
+	if x != nil {
+		return x.GuardProtectionPercentage
+	}
+	return 0
+}
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+func (x *JoinResponse) GetMaskThieves() bool {
+	if x != nil {
+		return x.MaskThieves
+	}
+	return false
 }
 
-func (x *StreamRequest) Reset() {
-	*x = StreamRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinResponse) GetHostId() string {
+	if x != nil {
+		return x.HostId
 	}
+	return ""
 }
 
-func (x *StreamRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StreamRequest) ProtoMessage() {}
-
-func (x *StreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinResponse) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use StreamRequest.ProtoReflect.Descriptor instead.
-func (*StreamRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{17}
+func (x *JoinResponse) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
 }
 
-func (x *StreamRequest) GetUserId() string {
+func (x *JoinResponse) GetAutoStart() bool {
 	if x != nil {
-		return x.UserId
+		return x.AutoStart
 	}
-	return ""
+	return false
 }
 
-func (x *StreamRequest) GetGameId() string {
+func (x *JoinResponse) GetStartCountdown() int32 {
 	if x != nil {
-		return x.GameId
+		return x.StartCountdown
 	}
-	return ""
+	return 0
 }
 
-type StreamResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Event:
-	//	*StreamResponse_Join_
-	//	*StreamResponse_Leave_
-	//	*StreamResponse_Start_
-	//	*StreamResponse_Finish_
-	//	*StreamResponse_Transaction_
-	Event isStreamResponse_Event `protobuf_oneof:"event"`
+func (x *JoinResponse) GetTeamCount() int32 {
+	if x != nil {
+		return x.TeamCount
+	}
+	return 0
 }
 
-func (x *StreamResponse) Reset() {
-	*x = StreamResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinResponse) GetMode() GameMode {
+	if x != nil {
+		return x.Mode
 	}
+	return GameMode_GAME_MODE_COMPETITIVE
 }
 
-func (x *StreamResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JoinResponse) GetCoopTarget() int32 {
+	if x != nil {
+		return x.CoopTarget
+	}
+	return 0
 }
 
-func (*StreamResponse) ProtoMessage() {}
-
-func (x *StreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinResponse) GetPractice() bool {
+	if x != nil {
+		return x.Practice
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use StreamResponse.ProtoReflect.Descriptor instead.
-func (*StreamResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18}
+func (x *JoinResponse) GetWinCondition() WinCondition {
+	if x != nil {
+		return x.WinCondition
+	}
+	return WinCondition_WIN_CONDITION_POINTS
 }
 
-func (m *StreamResponse) GetEvent() isStreamResponse_Event {
-	if m != nil {
-		return m.Event
+func (x *JoinResponse) GetWinTarget() int32 {
+	if x != nil {
+		return x.WinTarget
 	}
-	return nil
+	return 0
 }
 
-func (x *StreamResponse) GetJoin() *StreamResponse_Join {
-	if x, ok := x.GetEvent().(*StreamResponse_Join_); ok {
-		return x.Join
+func (x *JoinResponse) GetTimeTickTime() int32 {
+	if x != nil {
+		return x.TimeTickTime
 	}
-	return nil
+	return 0
 }
 
-func (x *StreamResponse) GetLeave() *StreamResponse_Leave {
-	if x, ok := x.GetEvent().(*StreamResponse_Leave_); ok {
-		return x.Leave
+func (x *JoinResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamResponse) GetStart() *StreamResponse_Start {
-	if x, ok := x.GetEvent().(*StreamResponse_Start_); ok {
-		return x.Start
+func (x *JoinResponse) GetColor() string {
+	if x != nil {
+		return x.Color
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamResponse) GetFinish() *StreamResponse_Finish {
-	if x, ok := x.GetEvent().(*StreamResponse_Finish_); ok {
-		return x.Finish
+func (x *JoinResponse) GetAfkTime() int32 {
+	if x != nil {
+		return x.AfkTime
 	}
-	return nil
+	return 0
 }
 
-func (x *StreamResponse) GetTransaction() *StreamResponse_Transaction {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_); ok {
-		return x.Transaction
+func (x *JoinResponse) GetAfkPolicy() AfkPolicy {
+	if x != nil {
+		return x.AfkPolicy
 	}
-	return nil
+	return AfkPolicy_AFK_POLICY_FLAG
 }
 
-type isStreamResponse_Event interface {
-	isStreamResponse_Event()
-}
+type LeaveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type StreamResponse_Join_ struct {
-	// Events for game in "Waiting" state.
-	Join *StreamResponse_Join `protobuf:"bytes,1,opt,name=join,proto3,oneof"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-type StreamResponse_Leave_ struct {
-	Leave *StreamResponse_Leave `protobuf:"bytes,2,opt,name=leave,proto3,oneof"`
+func (x *LeaveRequest) Reset() {
+	*x = LeaveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type StreamResponse_Start_ struct {
-	// Events for game in "Active" state.
-	Start *StreamResponse_Start `protobuf:"bytes,3,opt,name=start,proto3,oneof"`
+func (x *LeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type StreamResponse_Finish_ struct {
-	Finish *StreamResponse_Finish `protobuf:"bytes,4,opt,name=finish,proto3,oneof"`
-}
+func (*LeaveRequest) ProtoMessage() {}
 
-type StreamResponse_Transaction_ struct {
-	Transaction *StreamResponse_Transaction `protobuf:"bytes,5,opt,name=transaction,proto3,oneof"`
+func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*StreamResponse_Join_) isStreamResponse_Event() {}
-
-func (*StreamResponse_Leave_) isStreamResponse_Event() {}
-
-func (*StreamResponse_Start_) isStreamResponse_Event() {}
+// Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
+func (*LeaveRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{3}
+}
 
-func (*StreamResponse_Finish_) isStreamResponse_Event() {}
+func (x *LeaveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
 
-func (*StreamResponse_Transaction_) isStreamResponse_Event() {}
+func (x *LeaveRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
 
-type StreamResponse_Join struct {
+type LeaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
 }
 
-func (x *StreamResponse_Join) Reset() {
-	*x = StreamResponse_Join{}
+func (x *LeaveResponse) Reset() {
+	*x = LeaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[19]
+		mi := &file_game_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Join) String() string {
+func (x *LeaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Join) ProtoMessage() {}
+func (*LeaveResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Join) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[19]
+func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,43 +1689,39 @@ func (x *StreamResponse_Join) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Join.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Join) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 0}
-}
-
-func (x *StreamResponse_Join) GetPlayer() *Player {
-	if x != nil {
-		return x.Player
-	}
-	return nil
+// Deprecated: Use LeaveResponse.ProtoReflect.Descriptor instead.
+func (*LeaveResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{4}
 }
 
-type StreamResponse_Leave struct {
+type KickPlayerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// id of the host, who kicks the player
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId   string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	TargetId string `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
 }
 
-func (x *StreamResponse_Leave) Reset() {
-	*x = StreamResponse_Leave{}
+func (x *KickPlayerRequest) Reset() {
+	*x = KickPlayerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[20]
+		mi := &file_game_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Leave) String() string {
+func (x *KickPlayerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Leave) ProtoMessage() {}
+func (*KickPlayerRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Leave) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[20]
+func (x *KickPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,44 +1732,55 @@ func (x *StreamResponse_Leave) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Leave.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Leave) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 1}
+// Deprecated: Use KickPlayerRequest.ProtoReflect.Descriptor instead.
+func (*KickPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StreamResponse_Leave) GetUserId() string {
+func (x *KickPlayerRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-// It might have contained time so that client
-// can precisely estimate the remaining time.
-// However, we will ignore it for now.
-type StreamResponse_Start struct {
+func (x *KickPlayerRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *KickPlayerRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type KickPlayerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *StreamResponse_Start) Reset() {
-	*x = StreamResponse_Start{}
+func (x *KickPlayerResponse) Reset() {
+	*x = KickPlayerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[21]
+		mi := &file_game_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Start) String() string {
+func (x *KickPlayerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Start) ProtoMessage() {}
+func (*KickPlayerResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Start) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[21]
+func (x *KickPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,37 +1791,39 @@ func (x *StreamResponse_Start) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Start.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Start) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 2}
+// Deprecated: Use KickPlayerResponse.ProtoReflect.Descriptor instead.
+func (*KickPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{6}
 }
 
-type StreamResponse_Finish struct {
+type ChooseTeamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Players      []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
-	WinnerUserId string    `protobuf:"bytes,2,opt,name=winner_user_id,json=winnerUserId,proto3" json:"winner_user_id,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// from 1 to the number of teams
+	Team int32 `protobuf:"varint,3,opt,name=team,proto3" json:"team,omitempty"`
 }
 
-func (x *StreamResponse_Finish) Reset() {
-	*x = StreamResponse_Finish{}
+func (x *ChooseTeamRequest) Reset() {
+	*x = ChooseTeamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[22]
+		mi := &file_game_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Finish) String() string {
+func (x *ChooseTeamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Finish) ProtoMessage() {}
+func (*ChooseTeamRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Finish) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[22]
+func (x *ChooseTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,65 +1834,55 @@ func (x *StreamResponse_Finish) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Finish.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Finish) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 3}
+// Deprecated: Use ChooseTeamRequest.ProtoReflect.Descriptor instead.
+func (*ChooseTeamRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *StreamResponse_Finish) GetPlayers() []*Player {
+func (x *ChooseTeamRequest) GetUserId() string {
 	if x != nil {
-		return x.Players
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamResponse_Finish) GetWinnerUserId() string {
+func (x *ChooseTeamRequest) GetGameId() string {
 	if x != nil {
-		return x.WinnerUserId
+		return x.GameId
 	}
 	return ""
 }
 
-type StreamResponse_Transaction struct {
+func (x *ChooseTeamRequest) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+type ChooseTeamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Server will recalculate the amount
-	// of money for each player and send it
-	// to client. This way, client won't have
-	// to do calculations. It can just update
-	// the fields showing amounts of "points"
-	// for each player.
-	Players []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
-	// Types that are assignable to Event:
-	//	*StreamResponse_Transaction_UseCredit_
-	//	*StreamResponse_Transaction_UseDeposit_
-	//	*StreamResponse_Transaction_ReturnCredit_
-	//	*StreamResponse_Transaction_ReturnDeposit_
-	//	*StreamResponse_Transaction_Theft_
-	//	*StreamResponse_Transaction_Lottery_
-	//	*StreamResponse_Transaction_Question_
-	Event isStreamResponse_Transaction_Event `protobuf_oneof:"event"`
 }
 
-func (x *StreamResponse_Transaction) Reset() {
-	*x = StreamResponse_Transaction{}
+func (x *ChooseTeamResponse) Reset() {
+	*x = ChooseTeamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[23]
+		mi := &file_game_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction) String() string {
+func (x *ChooseTeamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction) ProtoMessage() {}
+func (*ChooseTeamResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[23]
+func (x *ChooseTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,146 +1893,156 @@ func (x *StreamResponse_Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4}
+// Deprecated: Use ChooseTeamResponse.ProtoReflect.Descriptor instead.
+func (*ChooseTeamResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *StreamResponse_Transaction) GetPlayers() []*Player {
-	if x != nil {
-		return x.Players
-	}
-	return nil
-}
+type RenameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *StreamResponse_Transaction) GetEvent() isStreamResponse_Transaction_Event {
-	if m != nil {
-		return m.Event
-	}
-	return nil
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// new username, which follows the same rules as the one sent on join
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *StreamResponse_Transaction) GetUseCredit() *StreamResponse_Transaction_UseCredit {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_UseCredit_); ok {
-		return x.UseCredit
+func (x *RenameRequest) Reset() {
+	*x = RenameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *StreamResponse_Transaction) GetUseDeposit() *StreamResponse_Transaction_UseDeposit {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_UseDeposit_); ok {
-		return x.UseDeposit
-	}
-	return nil
+func (x *RenameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StreamResponse_Transaction) GetReturnCredit() *StreamResponse_Transaction_ReturnCredit {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_ReturnCredit_); ok {
-		return x.ReturnCredit
+func (*RenameRequest) ProtoMessage() {}
+
+func (x *RenameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *StreamResponse_Transaction) GetReturnDeposit() *StreamResponse_Transaction_ReturnDeposit {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_ReturnDeposit_); ok {
-		return x.ReturnDeposit
-	}
-	return nil
+// Deprecated: Use RenameRequest.ProtoReflect.Descriptor instead.
+func (*RenameRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *StreamResponse_Transaction) GetTheft() *StreamResponse_Transaction_Theft {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Theft_); ok {
-		return x.Theft
+func (x *RenameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamResponse_Transaction) GetLottery() *StreamResponse_Transaction_Lottery {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Lottery_); ok {
-		return x.Lottery
+func (x *RenameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamResponse_Transaction) GetQuestion() *StreamResponse_Transaction_Question {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Question_); ok {
-		return x.Question
+func (x *RenameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-type isStreamResponse_Transaction_Event interface {
-	isStreamResponse_Transaction_Event()
-}
+type RenameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type StreamResponse_Transaction_UseCredit_ struct {
-	UseCredit *StreamResponse_Transaction_UseCredit `protobuf:"bytes,2,opt,name=use_credit,json=useCredit,proto3,oneof"`
+	// final username, which may have a number suffix, if the
+	// requested one is taken in the game
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-type StreamResponse_Transaction_UseDeposit_ struct {
-	UseDeposit *StreamResponse_Transaction_UseDeposit `protobuf:"bytes,3,opt,name=use_deposit,json=useDeposit,proto3,oneof"`
+func (x *RenameResponse) Reset() {
+	*x = RenameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type StreamResponse_Transaction_ReturnCredit_ struct {
-	ReturnCredit *StreamResponse_Transaction_ReturnCredit `protobuf:"bytes,4,opt,name=return_credit,json=returnCredit,proto3,oneof"`
+func (x *RenameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type StreamResponse_Transaction_ReturnDeposit_ struct {
-	ReturnDeposit *StreamResponse_Transaction_ReturnDeposit `protobuf:"bytes,5,opt,name=return_deposit,json=returnDeposit,proto3,oneof"`
-}
+func (*RenameResponse) ProtoMessage() {}
 
-type StreamResponse_Transaction_Theft_ struct {
-	Theft *StreamResponse_Transaction_Theft `protobuf:"bytes,6,opt,name=theft,proto3,oneof"`
+func (x *RenameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type StreamResponse_Transaction_Lottery_ struct {
-	Lottery *StreamResponse_Transaction_Lottery `protobuf:"bytes,7,opt,name=lottery,proto3,oneof"`
+// Deprecated: Use RenameResponse.ProtoReflect.Descriptor instead.
+func (*RenameResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{10}
 }
 
-type StreamResponse_Transaction_Question_ struct {
-	Question *StreamResponse_Transaction_Question `protobuf:"bytes,8,opt,name=question,proto3,oneof"`
+func (x *RenameResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (*StreamResponse_Transaction_UseCredit_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_UseDeposit_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_ReturnCredit_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_ReturnDeposit_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_Theft_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_Lottery_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_Question_) isStreamResponse_Transaction_Event() {}
-
-type StreamResponse_Transaction_UseCredit struct {
+// Only the host of the game can add bots.
+type AddBotsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// from 1 to 10
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// strategy of the bots, e.g. "conservative", "aggressive", or
+	// "gambler"; bots take profiles of the server in turn, if empty
+	Profile string `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_UseCredit) Reset() {
-	*x = StreamResponse_Transaction_UseCredit{}
+func (x *AddBotsRequest) Reset() {
+	*x = AddBotsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[24]
+		mi := &file_game_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_UseCredit) String() string {
+func (x *AddBotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_UseCredit) ProtoMessage() {}
+func (*AddBotsRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_UseCredit) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[24]
+func (x *AddBotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,51 +2053,65 @@ func (x *StreamResponse_Transaction_UseCredit) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_UseCredit.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_UseCredit) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 0}
+// Deprecated: Use AddBotsRequest.ProtoReflect.Descriptor instead.
+func (*AddBotsRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *StreamResponse_Transaction_UseCredit) GetUserId() string {
+func (x *AddBotsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamResponse_Transaction_UseCredit) GetValue() int32 {
+func (x *AddBotsRequest) GetGameId() string {
 	if x != nil {
-		return x.Value
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AddBotsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-type StreamResponse_Transaction_UseDeposit struct {
+func (x *AddBotsRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type AddBotsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// ids of the added bots, fewer than requested, if the game is full
+	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_UseDeposit) Reset() {
-	*x = StreamResponse_Transaction_UseDeposit{}
+func (x *AddBotsResponse) Reset() {
+	*x = AddBotsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[25]
+		mi := &file_game_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_UseDeposit) String() string {
+func (x *AddBotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_UseDeposit) ProtoMessage() {}
+func (*AddBotsResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_UseDeposit) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[25]
+func (x *AddBotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,51 +2122,46 @@ func (x *StreamResponse_Transaction_UseDeposit) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_UseDeposit.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_UseDeposit) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 1}
-}
-
-func (x *StreamResponse_Transaction_UseDeposit) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use AddBotsResponse.ProtoReflect.Descriptor instead.
+func (*AddBotsResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *StreamResponse_Transaction_UseDeposit) GetValue() int32 {
+func (x *AddBotsResponse) GetUserIds() []string {
 	if x != nil {
-		return x.Value
+		return x.UserIds
 	}
-	return 0
+	return nil
 }
 
-type StreamResponse_Transaction_ReturnCredit struct {
+// Only the host of the game can pause and resume it.
+// User id is ignored by the Admin service.
+type PauseGameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_ReturnCredit) Reset() {
-	*x = StreamResponse_Transaction_ReturnCredit{}
+func (x *PauseGameRequest) Reset() {
+	*x = PauseGameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[26]
+		mi := &file_game_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_ReturnCredit) String() string {
+func (x *PauseGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_ReturnCredit) ProtoMessage() {}
+func (*PauseGameRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_ReturnCredit) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[26]
+func (x *PauseGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,51 +2172,89 @@ func (x *StreamResponse_Transaction_ReturnCredit) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_ReturnCredit.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_ReturnCredit) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 2}
+// Deprecated: Use PauseGameRequest.ProtoReflect.Descriptor instead.
+func (*PauseGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *StreamResponse_Transaction_ReturnCredit) GetUserId() string {
+func (x *PauseGameRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamResponse_Transaction_ReturnCredit) GetValue() int32 {
+func (x *PauseGameRequest) GetGameId() string {
 	if x != nil {
-		return x.Value
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-type StreamResponse_Transaction_ReturnDeposit struct {
+type PauseGameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+func (x *PauseGameResponse) Reset() {
+	*x = PauseGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *StreamResponse_Transaction_ReturnDeposit) Reset() {
-	*x = StreamResponse_Transaction_ReturnDeposit{}
+func (x *PauseGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseGameResponse) ProtoMessage() {}
+
+func (x *PauseGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseGameResponse.ProtoReflect.Descriptor instead.
+func (*PauseGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{14}
+}
+
+type ResumeGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *ResumeGameRequest) Reset() {
+	*x = ResumeGameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[27]
+		mi := &file_game_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_ReturnDeposit) String() string {
+func (x *ResumeGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_ReturnDeposit) ProtoMessage() {}
+func (*ResumeGameRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_ReturnDeposit) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[27]
+func (x *ResumeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1854,50 +2265,48 @@ func (x *StreamResponse_Transaction_ReturnDeposit) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_ReturnDeposit.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_ReturnDeposit) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 3}
+// Deprecated: Use ResumeGameRequest.ProtoReflect.Descriptor instead.
+func (*ResumeGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *StreamResponse_Transaction_ReturnDeposit) GetUserId() string {
+func (x *ResumeGameRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamResponse_Transaction_ReturnDeposit) GetValue() int32 {
+func (x *ResumeGameRequest) GetGameId() string {
 	if x != nil {
-		return x.Value
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-type StreamResponse_Transaction_Theft struct {
+type ResumeGameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	RobbedPlayers []*StreamResponse_Transaction_Theft_RobbedPlayer `protobuf:"bytes,1,rep,name=robbed_players,json=robbedPlayers,proto3" json:"robbed_players,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_Theft) Reset() {
-	*x = StreamResponse_Transaction_Theft{}
+func (x *ResumeGameResponse) Reset() {
+	*x = ResumeGameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[28]
+		mi := &file_game_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_Theft) String() string {
+func (x *ResumeGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_Theft) ProtoMessage() {}
+func (*ResumeGameResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_Theft) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[28]
+func (x *ResumeGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,44 +2317,37 @@ func (x *StreamResponse_Transaction_Theft) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_Theft.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_Theft) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 4}
-}
-
-func (x *StreamResponse_Transaction_Theft) GetRobbedPlayers() []*StreamResponse_Transaction_Theft_RobbedPlayer {
-	if x != nil {
-		return x.RobbedPlayers
-	}
-	return nil
+// Deprecated: Use ResumeGameResponse.ProtoReflect.Descriptor instead.
+func (*ResumeGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{16}
 }
 
-type StreamResponse_Transaction_Lottery struct {
+type ExtendGameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_Lottery) Reset() {
-	*x = StreamResponse_Transaction_Lottery{}
+func (x *ExtendGameRequest) Reset() {
+	*x = ExtendGameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[29]
+		mi := &file_game_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_Lottery) String() string {
+func (x *ExtendGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_Lottery) ProtoMessage() {}
+func (*ExtendGameRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_Lottery) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[29]
+func (x *ExtendGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,53 +2358,53 @@ func (x *StreamResponse_Transaction_Lottery) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_Lottery.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_Lottery) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 5}
+// Deprecated: Use ExtendGameRequest.ProtoReflect.Descriptor instead.
+func (*ExtendGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *StreamResponse_Transaction_Lottery) GetUserId() string {
+func (x *ExtendGameRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamResponse_Transaction_Lottery) GetValue() int32 {
+func (x *ExtendGameRequest) GetGameId() string {
 	if x != nil {
-		return x.Value
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-type StreamResponse_Transaction_Question struct {
+type ExtendGameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId          string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AnswerIsCorrect bool   `protobuf:"varint,2,opt,name=answer_is_correct,json=answerIsCorrect,proto3" json:"answer_is_correct,omitempty"`
-	BidPoints       int32  `protobuf:"varint,3,opt,name=bid_points,json=bidPoints,proto3" json:"bid_points,omitempty"`
-	WinPoints       int32  `protobuf:"varint,4,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`
+	Votes  int32 `protobuf:"varint,1,opt,name=votes,proto3" json:"votes,omitempty"`
+	Needed int32 `protobuf:"varint,2,opt,name=needed,proto3" json:"needed,omitempty"`
+	// true, if the vote of the player has extended the game
+	Extended bool `protobuf:"varint,3,opt,name=extended,proto3" json:"extended,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_Question) Reset() {
-	*x = StreamResponse_Transaction_Question{}
+func (x *ExtendGameResponse) Reset() {
+	*x = ExtendGameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[30]
+		mi := &file_game_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_Question) String() string {
+func (x *ExtendGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_Question) ProtoMessage() {}
+func (*ExtendGameResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_Question) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[30]
+func (x *ExtendGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2013,66 +2415,60 @@ func (x *StreamResponse_Transaction_Question) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_Question.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_Question) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 6}
-}
-
-func (x *StreamResponse_Transaction_Question) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use ExtendGameResponse.ProtoReflect.Descriptor instead.
+func (*ExtendGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *StreamResponse_Transaction_Question) GetAnswerIsCorrect() bool {
+func (x *ExtendGameResponse) GetVotes() int32 {
 	if x != nil {
-		return x.AnswerIsCorrect
+		return x.Votes
 	}
-	return false
+	return 0
 }
 
-func (x *StreamResponse_Transaction_Question) GetBidPoints() int32 {
+func (x *ExtendGameResponse) GetNeeded() int32 {
 	if x != nil {
-		return x.BidPoints
+		return x.Needed
 	}
 	return 0
 }
 
-func (x *StreamResponse_Transaction_Question) GetWinPoints() int32 {
+func (x *ExtendGameResponse) GetExtended() bool {
 	if x != nil {
-		return x.WinPoints
+		return x.Extended
 	}
-	return 0
+	return false
 }
 
-type StreamResponse_Transaction_Theft_RobbedPlayer struct {
+type AnswerOvertimeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// value shows how much money has been stolen from the player.
-	Value int32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// index from 1 to 4
+	Answer int32 `protobuf:"varint,3,opt,name=answer,proto3" json:"answer,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_Theft_RobbedPlayer) Reset() {
-	*x = StreamResponse_Transaction_Theft_RobbedPlayer{}
+func (x *AnswerOvertimeRequest) Reset() {
+	*x = AnswerOvertimeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[31]
+		mi := &file_game_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_Theft_RobbedPlayer) String() string {
+func (x *AnswerOvertimeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_Theft_RobbedPlayer) ProtoMessage() {}
+func (*AnswerOvertimeRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_Theft_RobbedPlayer) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[31]
+func (x *AnswerOvertimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2083,1238 +2479,21000 @@ func (x *StreamResponse_Transaction_Theft_RobbedPlayer) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_Theft_RobbedPlayer.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_Theft_RobbedPlayer) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 4, 0}
+// Deprecated: Use AnswerOvertimeRequest.ProtoReflect.Descriptor instead.
+func (*AnswerOvertimeRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetUserId() string {
+func (x *AnswerOvertimeRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetValue() int32 {
+func (x *AnswerOvertimeRequest) GetGameId() string {
 	if x != nil {
-		return x.Value
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AnswerOvertimeRequest) GetAnswer() int32 {
+	if x != nil {
+		return x.Answer
 	}
 	return 0
 }
 
-var File_game_proto protoreflect.FileDescriptor
+type AnswerOvertimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_game_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x0b, 0x4a,
-	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc5, 0x04, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x62, 0x61, 0x6e, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x50, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
-	0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x65, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x29, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x68, 0x65, 0x66,
-	0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
-	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26,
-	0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69,
-	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
-	0x4d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
-	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x57, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x40,
-	0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
-	0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x27, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x4c, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4d, 0x0a, 0x0f,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70,
-	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x0e, 0x4c,
-	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x6b,
-	0x0a, 0x0f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x65, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05,
-	0x52, 0x0a, 0x63, 0x65, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x77, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x77, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x6a, 0x0a, 0x17, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x69,
-	0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x71, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22,
-	0x8a, 0x01, 0x0a, 0x16, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x49, 0x73, 0x43,
-	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
-	0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
-	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a,
-	0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x41, 0x0a, 0x0d,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22,
-	0xc3, 0x0d, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x48, 0x00, 0x52,
-	0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x2e, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x1a, 0x20, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x1a, 0x07, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x1a, 0x58, 0x0a,
-	0x06, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0xd2, 0x09, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x73, 0x12, 0x4d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x09, 0x75, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x12, 0x50, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x12, 0x56, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x40, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x66, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x68, 0x65, 0x66, 0x74, 0x48, 0x00,
-	0x52, 0x05, 0x74, 0x68, 0x65, 0x66, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x6c, 0x6f, 0x74, 0x74, 0x65,
-	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x74,
-	0x74, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12,
-	0x49, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3a, 0x0a, 0x09, 0x55, 0x73,
-	0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x3b, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x3d, 0x0a, 0x0c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x1a, 0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x1a, 0xa4, 0x01, 0x0a, 0x05, 0x54, 0x68, 0x65, 0x66, 0x74, 0x12, 0x5c, 0x0a, 0x0e,
-	0x72, 0x6f, 0x62, 0x62, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x68, 0x65, 0x66, 0x74, 0x2e, 0x52,
-	0x6f, 0x62, 0x62, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x0d, 0x72, 0x6f, 0x62,
-	0x62, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0c, 0x52, 0x6f,
-	0x62, 0x62, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x38, 0x0a, 0x07, 0x4c, 0x6f, 0x74,
-	0x74, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x8d, 0x01, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x49, 0x73, 0x43, 0x6f,
-	0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x69, 0x64, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x32, 0xcb, 0x04, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x33,
-	0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x14, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x05, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x15, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c,
-	0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x07,
-	0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	Correct bool `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
 }
 
-var (
-	file_game_proto_rawDescOnce sync.Once
-	file_game_proto_rawDescData = file_game_proto_rawDesc
-)
+func (x *AnswerOvertimeResponse) Reset() {
+	*x = AnswerOvertimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_game_proto_rawDescGZIP() []byte {
-	file_game_proto_rawDescOnce.Do(func() {
-		file_game_proto_rawDescData = protoimpl.X.CompressGZIP(file_game_proto_rawDescData)
-	})
-	return file_game_proto_rawDescData
+func (x *AnswerOvertimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_game_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
-var file_game_proto_goTypes = []interface{}{
-	(*Player)(nil),                                        // 0: server.Player
-	(*JoinRequest)(nil),                                   // 1: server.JoinRequest
-	(*JoinResponse)(nil),                                  // 2: server.JoinResponse
-	(*LeaveRequest)(nil),                                  // 3: server.LeaveRequest
-	(*LeaveResponse)(nil),                                 // 4: server.LeaveResponse
-	(*StartRequest)(nil),                                  // 5: server.StartRequest
-	(*StartResponse)(nil),                                 // 6: server.StartResponse
-	(*CreditRequest)(nil),                                 // 7: server.CreditRequest
-	(*CreditResponse)(nil),                                // 8: server.CreditResponse
-	(*DepositRequest)(nil),                                // 9: server.DepositRequest
-	(*DepositResponse)(nil),                               // 10: server.DepositResponse
-	(*LotteryRequest)(nil),                                // 11: server.LotteryRequest
-	(*LotteryResponse)(nil),                               // 12: server.LotteryResponse
-	(*GenerateQuestionRequest)(nil),                       // 13: server.GenerateQuestionRequest
-	(*GenerateQuestionResponse)(nil),                      // 14: server.GenerateQuestionResponse
-	(*AnswerQuestionRequest)(nil),                         // 15: server.AnswerQuestionRequest
-	(*AnswerQuestionResponse)(nil),                        // 16: server.AnswerQuestionResponse
-	(*StreamRequest)(nil),                                 // 17: server.StreamRequest
-	(*StreamResponse)(nil),                                // 18: server.StreamResponse
-	(*StreamResponse_Join)(nil),                           // 19: server.StreamResponse.Join
-	(*StreamResponse_Leave)(nil),                          // 20: server.StreamResponse.Leave
-	(*StreamResponse_Start)(nil),                          // 21: server.StreamResponse.Start
-	(*StreamResponse_Finish)(nil),                         // 22: server.StreamResponse.Finish
-	(*StreamResponse_Transaction)(nil),                    // 23: server.StreamResponse.Transaction
-	(*StreamResponse_Transaction_UseCredit)(nil),          // 24: server.StreamResponse.Transaction.UseCredit
-	(*StreamResponse_Transaction_UseDeposit)(nil),         // 25: server.StreamResponse.Transaction.UseDeposit
-	(*StreamResponse_Transaction_ReturnCredit)(nil),       // 26: server.StreamResponse.Transaction.ReturnCredit
-	(*StreamResponse_Transaction_ReturnDeposit)(nil),      // 27: server.StreamResponse.Transaction.ReturnDeposit
-	(*StreamResponse_Transaction_Theft)(nil),              // 28: server.StreamResponse.Transaction.Theft
-	(*StreamResponse_Transaction_Lottery)(nil),            // 29: server.StreamResponse.Transaction.Lottery
-	(*StreamResponse_Transaction_Question)(nil),           // 30: server.StreamResponse.Transaction.Question
-	(*StreamResponse_Transaction_Theft_RobbedPlayer)(nil), // 31: server.StreamResponse.Transaction.Theft.RobbedPlayer
+func (*AnswerOvertimeResponse) ProtoMessage() {}
+
+func (x *AnswerOvertimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_game_proto_depIdxs = []int32{
-	0,  // 0: server.JoinResponse.players:type_name -> server.Player
-	19, // 1: server.StreamResponse.join:type_name -> server.StreamResponse.Join
-	20, // 2: server.StreamResponse.leave:type_name -> server.StreamResponse.Leave
-	21, // 3: server.StreamResponse.start:type_name -> server.StreamResponse.Start
-	22, // 4: server.StreamResponse.finish:type_name -> server.StreamResponse.Finish
-	23, // 5: server.StreamResponse.transaction:type_name -> server.StreamResponse.Transaction
-	0,  // 6: server.StreamResponse.Join.player:type_name -> server.Player
-	0,  // 7: server.StreamResponse.Finish.players:type_name -> server.Player
-	0,  // 8: server.StreamResponse.Transaction.players:type_name -> server.Player
-	24, // 9: server.StreamResponse.Transaction.use_credit:type_name -> server.StreamResponse.Transaction.UseCredit
-	25, // 10: server.StreamResponse.Transaction.use_deposit:type_name -> server.StreamResponse.Transaction.UseDeposit
-	26, // 11: server.StreamResponse.Transaction.return_credit:type_name -> server.StreamResponse.Transaction.ReturnCredit
-	27, // 12: server.StreamResponse.Transaction.return_deposit:type_name -> server.StreamResponse.Transaction.ReturnDeposit
-	28, // 13: server.StreamResponse.Transaction.theft:type_name -> server.StreamResponse.Transaction.Theft
-	29, // 14: server.StreamResponse.Transaction.lottery:type_name -> server.StreamResponse.Transaction.Lottery
-	30, // 15: server.StreamResponse.Transaction.question:type_name -> server.StreamResponse.Transaction.Question
-	31, // 16: server.StreamResponse.Transaction.Theft.robbed_players:type_name -> server.StreamResponse.Transaction.Theft.RobbedPlayer
-	1,  // 17: server.Game.Join:input_type -> server.JoinRequest
-	3,  // 18: server.Game.Leave:input_type -> server.LeaveRequest
-	5,  // 19: server.Game.Start:input_type -> server.StartRequest
-	7,  // 20: server.Game.Credit:input_type -> server.CreditRequest
-	9,  // 21: server.Game.Deposit:input_type -> server.DepositRequest
-	11, // 22: server.Game.Lottery:input_type -> server.LotteryRequest
-	13, // 23: server.Game.GenerateQuestion:input_type -> server.GenerateQuestionRequest
-	15, // 24: server.Game.AnswerQuestion:input_type -> server.AnswerQuestionRequest
-	17, // 25: server.Game.Stream:input_type -> server.StreamRequest
-	2,  // 26: server.Game.Join:output_type -> server.JoinResponse
-	4,  // 27: server.Game.Leave:output_type -> server.LeaveResponse
-	6,  // 28: server.Game.Start:output_type -> server.StartResponse
-	8,  // 29: server.Game.Credit:output_type -> server.CreditResponse
-	10, // 30: server.Game.Deposit:output_type -> server.DepositResponse
-	12, // 31: server.Game.Lottery:output_type -> server.LotteryResponse
-	14, // 32: server.Game.GenerateQuestion:output_type -> server.GenerateQuestionResponse
-	16, // 33: server.Game.AnswerQuestion:output_type -> server.AnswerQuestionResponse
-	18, // 34: server.Game.Stream:output_type -> server.StreamResponse
-	26, // [26:35] is the sub-list for method output_type
-	17, // [17:26] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+
+// Deprecated: Use AnswerOvertimeResponse.ProtoReflect.Descriptor instead.
+func (*AnswerOvertimeResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{20}
 }
 
-func init() { file_game_proto_init() }
-func file_game_proto_init() {
-	if File_game_proto != nil {
-		return
+func (x *AnswerOvertimeResponse) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_game_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Player); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_game_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return false
+}
+
+// Only the host of the game can start it.
+type StartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// overrides of the game config, which
+	// the game has been created with
+	Config *GameConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	UserId string      `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *StartRequest) Reset() {
+	*x = StartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRequest) ProtoMessage() {}
+
+func (x *StartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRequest.ProtoReflect.Descriptor instead.
+func (*StartRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StartRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *StartRequest) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *StartRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type StartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartResponse) Reset() {
+	*x = StartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartResponse) ProtoMessage() {}
+
+func (x *StartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartResponse.ProtoReflect.Descriptor instead.
+func (*StartResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{22}
+}
+
+type CancelStartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *CancelStartRequest) Reset() {
+	*x = CancelStartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelStartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelStartRequest) ProtoMessage() {}
+
+func (x *CancelStartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelStartRequest.ProtoReflect.Descriptor instead.
+func (*CancelStartRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelStartRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelStartRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type CancelStartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelStartResponse) Reset() {
+	*x = CancelStartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelStartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelStartResponse) ProtoMessage() {}
+
+func (x *CancelStartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelStartResponse.ProtoReflect.Descriptor instead.
+func (*CancelStartResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{24}
+}
+
+type CreditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Value  int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *CreditRequest) Reset() {
+	*x = CreditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditRequest) ProtoMessage() {}
+
+func (x *CreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_game_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreditRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_game_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreditResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_game_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_game_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditRequest.ProtoReflect.Descriptor instead.
+func (*CreditRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreditRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreditRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *CreditRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// In case of credit, it cannot be granted
+// if the bank doesn't have enough money or
+// if the user asks too much. These reasons
+// will be stated in "explanation" field if
+// "success" is false. Otherwise, "explanation"
+// describes the credit limit and its used part.
+type CreditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// maximum value of outstanding credits of the player
+	CreditLimit int32 `protobuf:"varint,3,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"`
+	// value of outstanding credits of the player
+	// including the granted one
+	CreditUsed int32 `protobuf:"varint,4,opt,name=credit_used,json=creditUsed,proto3" json:"credit_used,omitempty"`
+	// machine-readable reason of the failure, e.g. CREDIT_LIMIT_EXCEEDED;
+	// empty, if success is true
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// milliseconds, after which the request may succeed, if the
+	// reason is temporary, e.g. LOTTERY_ON_COOLDOWN; 0 otherwise
+	RetryAfter int64 `protobuf:"varint,6,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *CreditResponse) Reset() {
+	*x = CreditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditResponse) ProtoMessage() {}
+
+func (x *CreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LotteryRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditResponse.ProtoReflect.Descriptor instead.
+func (*CreditResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreditResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreditResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *CreditResponse) GetCreditLimit() int32 {
+	if x != nil {
+		return x.CreditLimit
+	}
+	return 0
+}
+
+func (x *CreditResponse) GetCreditUsed() int32 {
+	if x != nil {
+		return x.CreditUsed
+	}
+	return 0
+}
+
+func (x *CreditResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreditResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type RepayCreditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// id of the outstanding credit, which is returned in GetGameStateResponse
+	PositionId string `protobuf:"bytes,3,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	// part of the credit value to repay; the whole credit is repaid, if 0
+	Value int32 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RepayCreditRequest) Reset() {
+	*x = RepayCreditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepayCreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepayCreditRequest) ProtoMessage() {}
+
+func (x *RepayCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LotteryResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepayCreditRequest.ProtoReflect.Descriptor instead.
+func (*RepayCreditRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RepayCreditRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RepayCreditRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RepayCreditRequest) GetPositionId() string {
+	if x != nil {
+		return x.PositionId
+	}
+	return ""
+}
+
+func (x *RepayCreditRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Credit cannot be repaid, if the player doesn't have
+// enough points. The reason will be stated in "explanation"
+// field if "success" is false.
+type RepayCreditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// points paid to the bank, which include the interest on the repaid
+	// part only for the time passed since the credit was taken
+	Value int32 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// value of the credit, which is still outstanding; interest on it
+	// is charged, when the credit time ends
+	RemainingValue int32 `protobuf:"varint,4,opt,name=remaining_value,json=remainingValue,proto3" json:"remaining_value,omitempty"`
+	// machine-readable reason of the failure, e.g. CREDIT_LIMIT_EXCEEDED;
+	// empty, if success is true
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// milliseconds, after which the request may succeed, if the
+	// reason is temporary, e.g. LOTTERY_ON_COOLDOWN; 0 otherwise
+	RetryAfter int64 `protobuf:"varint,6,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *RepayCreditResponse) Reset() {
+	*x = RepayCreditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepayCreditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepayCreditResponse) ProtoMessage() {}
+
+func (x *RepayCreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateQuestionRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepayCreditResponse.ProtoReflect.Descriptor instead.
+func (*RepayCreditResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RepayCreditResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RepayCreditResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *RepayCreditResponse) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *RepayCreditResponse) GetRemainingValue() int32 {
+	if x != nil {
+		return x.RemainingValue
+	}
+	return 0
+}
+
+func (x *RepayCreditResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RepayCreditResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type OfferLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// id of the player, to whom the loan is offered
+	BorrowerId string `protobuf:"bytes,3,opt,name=borrower_id,json=borrowerId,proto3" json:"borrower_id,omitempty"`
+	Value      int32  `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	// percentage of the value, which the borrower pays back on top of it
+	Interest int32 `protobuf:"varint,5,opt,name=interest,proto3" json:"interest,omitempty"`
+	// seconds from the acceptance of the loan until it is collected
+	Time int32 `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *OfferLoanRequest) Reset() {
+	*x = OfferLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferLoanRequest) ProtoMessage() {}
+
+func (x *OfferLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateQuestionResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferLoanRequest.ProtoReflect.Descriptor instead.
+func (*OfferLoanRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OfferLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OfferLoanRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *OfferLoanRequest) GetBorrowerId() string {
+	if x != nil {
+		return x.BorrowerId
+	}
+	return ""
+}
+
+func (x *OfferLoanRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *OfferLoanRequest) GetInterest() int32 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *OfferLoanRequest) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type OfferLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *OfferLoanResponse) Reset() {
+	*x = OfferLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferLoanResponse) ProtoMessage() {}
+
+func (x *OfferLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnswerQuestionRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferLoanResponse.ProtoReflect.Descriptor instead.
+func (*OfferLoanResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *OfferLoanResponse) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type AcceptLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// id of the loan offered to the player
+	LoanId string `protobuf:"bytes,3,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *AcceptLoanRequest) Reset() {
+	*x = AcceptLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptLoanRequest) ProtoMessage() {}
+
+func (x *AcceptLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnswerQuestionResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptLoanRequest.ProtoReflect.Descriptor instead.
+func (*AcceptLoanRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AcceptLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptLoanRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AcceptLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// Loan cannot be accepted, if the lender doesn't have
+// enough points anymore. The reason will be stated in
+// "explanation" field if "success" is false.
+type AcceptLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// machine-readable reason of the failure, e.g. CREDIT_LIMIT_EXCEEDED;
+	// empty, if success is true
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// milliseconds, after which the request may succeed, if the
+	// reason is temporary, e.g. LOTTERY_ON_COOLDOWN; 0 otherwise
+	RetryAfter int64 `protobuf:"varint,4,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *AcceptLoanResponse) Reset() {
+	*x = AcceptLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptLoanResponse) ProtoMessage() {}
+
+func (x *AcceptLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptLoanResponse.ProtoReflect.Descriptor instead.
+func (*AcceptLoanResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AcceptLoanResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AcceptLoanResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *AcceptLoanResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AcceptLoanResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+// Order to buy or sell units of the asset on the market.
+type Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string    `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId  string    `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Asset   string    `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Side    OrderSide `protobuf:"varint,4,opt,name=side,proto3,enum=server.OrderSide" json:"side,omitempty"`
+	// units, which are not traded yet
+	Quantity int32 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// points per unit
+	Price int32 `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Order) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Order) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Order) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *Order) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_BUY
+}
+
+func (x *Order) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Order) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Trade settled between two players on the market.
+type Trade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuyerId  string `protobuf:"bytes,1,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId string `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Asset    string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Quantity int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// points per unit
+	Price int32 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *Trade) Reset() {
+	*x = Trade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trade) ProtoMessage() {}
+
+func (x *Trade) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Join); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trade.ProtoReflect.Descriptor instead.
+func (*Trade) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Trade) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *Trade) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *Trade) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *Trade) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Trade) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type PlaceOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId   string    `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Asset    string    `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Side     OrderSide `protobuf:"varint,4,opt,name=side,proto3,enum=server.OrderSide" json:"side,omitempty"`
+	Quantity int32     `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// highest price per unit for buy orders,
+	// lowest price per unit for sell orders
+	Price int32 `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *PlaceOrderRequest) Reset() {
+	*x = PlaceOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderRequest) ProtoMessage() {}
+
+func (x *PlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Leave); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PlaceOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_BUY
+}
+
+func (x *PlaceOrderRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Order is traded with the best orders of the other side right
+// away, and its rest is kept in the order book, until it is traded
+// or cancelled.
+type PlaceOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId        string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	FilledQuantity int32  `protobuf:"varint,2,opt,name=filled_quantity,json=filledQuantity,proto3" json:"filled_quantity,omitempty"`
+	// units left in the order book
+	RemainingQuantity int32 `protobuf:"varint,3,opt,name=remaining_quantity,json=remainingQuantity,proto3" json:"remaining_quantity,omitempty"`
+}
+
+func (x *PlaceOrderResponse) Reset() {
+	*x = PlaceOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderResponse) ProtoMessage() {}
+
+func (x *PlaceOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Start); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderResponse.ProtoReflect.Descriptor instead.
+func (*PlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PlaceOrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PlaceOrderResponse) GetFilledQuantity() int32 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *PlaceOrderResponse) GetRemainingQuantity() int32 {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return 0
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId  string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	OrderId string `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Finish); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CancelOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Transaction); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{38}
+}
+
+type GetMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetMarketRequest) Reset() {
+	*x = GetMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketRequest) ProtoMessage() {}
+
+func (x *GetMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Transaction_UseCredit); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetMarketRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMarketRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type OrderBook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// buy orders sorted from the highest price
+	Bids []*Order `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
+	// sell orders sorted from the lowest price
+	Asks []*Order `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks,omitempty"`
+	// price of the last trade, 0 if the asset has not been traded yet
+	LastPrice int32 `protobuf:"varint,4,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+}
+
+func (x *OrderBook) Reset() {
+	*x = OrderBook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBook) ProtoMessage() {}
+
+func (x *OrderBook) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Transaction_UseDeposit); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBook.ProtoReflect.Descriptor instead.
+func (*OrderBook) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *OrderBook) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *OrderBook) GetBids() []*Order {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *OrderBook) GetAsks() []*Order {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *OrderBook) GetLastPrice() int32 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+// Points of the player above the threshold (and below the threshold
+// of the next bracket) are taxed at the percentage of the bracket.
+type TaxBracket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Threshold  int32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Percentage int32 `protobuf:"varint,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *TaxBracket) Reset() {
+	*x = TaxBracket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaxBracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxBracket) ProtoMessage() {}
+
+func (x *TaxBracket) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Transaction_ReturnCredit); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_game_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Transaction_ReturnDeposit); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_game_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Transaction_Theft); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxBracket.ProtoReflect.Descriptor instead.
+func (*TaxBracket) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TaxBracket) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *TaxBracket) GetPercentage() int32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+type AssetHolding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset    string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Quantity int32  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *AssetHolding) Reset() {
+	*x = AssetHolding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetHolding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetHolding) ProtoMessage() {}
+
+func (x *AssetHolding) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Transaction_Lottery); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetHolding.ProtoReflect.Descriptor instead.
+func (*AssetHolding) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AssetHolding) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *AssetHolding) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GetMarketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// order books of all assets of the game
+	Books []*OrderBook `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	// assets of the requesting player
+	Holdings []*AssetHolding `protobuf:"bytes,2,rep,name=holdings,proto3" json:"holdings,omitempty"`
+}
+
+func (x *GetMarketResponse) Reset() {
+	*x = GetMarketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketResponse) ProtoMessage() {}
+
+func (x *GetMarketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Transaction_Question); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetMarketResponse) GetBooks() []*OrderBook {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+func (x *GetMarketResponse) GetHoldings() []*AssetHolding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+type BuySharesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Shares int32  `protobuf:"varint,3,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *BuySharesRequest) Reset() {
+	*x = BuySharesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuySharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuySharesRequest) ProtoMessage() {}
+
+func (x *BuySharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_game_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse_Transaction_Theft_RobbedPlayer); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuySharesRequest.ProtoReflect.Descriptor instead.
+func (*BuySharesRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BuySharesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BuySharesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *BuySharesRequest) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+// Shares are bought from the bank at the current price. They
+// cannot be bought, if the player doesn't have enough points.
+// The reason will be stated in "explanation" field if "success"
+// is false.
+type BuySharesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// price of a single share
+	Price int32 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// points paid to the bank
+	Value int32 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	// machine-readable reason of the failure, e.g. CREDIT_LIMIT_EXCEEDED;
+	// empty, if success is true
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// milliseconds, after which the request may succeed, if the
+	// reason is temporary, e.g. LOTTERY_ON_COOLDOWN; 0 otherwise
+	RetryAfter int64 `protobuf:"varint,6,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *BuySharesResponse) Reset() {
+	*x = BuySharesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuySharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuySharesResponse) ProtoMessage() {}
+
+func (x *BuySharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuySharesResponse.ProtoReflect.Descriptor instead.
+func (*BuySharesResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BuySharesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BuySharesResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *BuySharesResponse) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *BuySharesResponse) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *BuySharesResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BuySharesResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type SellSharesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Shares int32  `protobuf:"varint,3,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *SellSharesRequest) Reset() {
+	*x = SellSharesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SellSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellSharesRequest) ProtoMessage() {}
+
+func (x *SellSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellSharesRequest.ProtoReflect.Descriptor instead.
+func (*SellSharesRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SellSharesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SellSharesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SellSharesRequest) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+// Shares are sold to the bank at the current price. They cannot
+// be sold, if the player doesn't have them or if the bank doesn't
+// have enough points. The reason will be stated in "explanation"
+// field if "success" is false.
+type SellSharesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// price of a single share
+	Price int32 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// points paid by the bank
+	Value int32 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	// machine-readable reason of the failure, e.g. CREDIT_LIMIT_EXCEEDED;
+	// empty, if success is true
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// milliseconds, after which the request may succeed, if the
+	// reason is temporary, e.g. LOTTERY_ON_COOLDOWN; 0 otherwise
+	RetryAfter int64 `protobuf:"varint,6,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *SellSharesResponse) Reset() {
+	*x = SellSharesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SellSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellSharesResponse) ProtoMessage() {}
+
+func (x *SellSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellSharesResponse.ProtoReflect.Descriptor instead.
+func (*SellSharesResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SellSharesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SellSharesResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *SellSharesResponse) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SellSharesResponse) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SellSharesResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SellSharesResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type BuyInsuranceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *BuyInsuranceRequest) Reset() {
+	*x = BuyInsuranceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyInsuranceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyInsuranceRequest) ProtoMessage() {}
+
+func (x *BuyInsuranceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyInsuranceRequest.ProtoReflect.Descriptor instead.
+func (*BuyInsuranceRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BuyInsuranceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BuyInsuranceRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// Insurance cannot be bought, if the player doesn't have enough
+// points for the premium or if the player is already insured.
+// The reason will be stated in "explanation" field if "success"
+// is false.
+type BuyInsuranceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// points paid to the bank
+	Premium int32 `protobuf:"varint,3,opt,name=premium,proto3" json:"premium,omitempty"`
+	// machine-readable reason of the failure, e.g. CREDIT_LIMIT_EXCEEDED;
+	// empty, if success is true
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// milliseconds, after which the request may succeed, if the
+	// reason is temporary, e.g. LOTTERY_ON_COOLDOWN; 0 otherwise
+	RetryAfter int64 `protobuf:"varint,5,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *BuyInsuranceResponse) Reset() {
+	*x = BuyInsuranceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyInsuranceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyInsuranceResponse) ProtoMessage() {}
+
+func (x *BuyInsuranceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyInsuranceResponse.ProtoReflect.Descriptor instead.
+func (*BuyInsuranceResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BuyInsuranceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BuyInsuranceResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *BuyInsuranceResponse) GetPremium() int32 {
+	if x != nil {
+		return x.Premium
+	}
+	return 0
+}
+
+func (x *BuyInsuranceResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BuyInsuranceResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type DepositRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Value  int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositRequest) ProtoMessage() {}
+
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DepositRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// In case of deposit, it cannot be granted
+// if the user doesn't have enough money or
+// the user puts too much. These reasons
+// will be stated in "explanation" field if
+// "success" is false.
+type DepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// machine-readable reason of the failure, e.g. CREDIT_LIMIT_EXCEEDED;
+	// empty, if success is true
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// milliseconds, after which the request may succeed, if the
+	// reason is temporary, e.g. LOTTERY_ON_COOLDOWN; 0 otherwise
+	RetryAfter int64 `protobuf:"varint,4,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositResponse) ProtoMessage() {}
+
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DepositResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DepositResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *DepositResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DepositResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type WithdrawDepositRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// id of the deposit, which is returned in GetGameStateResponse
+	PositionId string `protobuf:"bytes,3,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	// part of the deposit value to withdraw; the whole deposit is withdrawn, if 0
+	Value int32 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *WithdrawDepositRequest) Reset() {
+	*x = WithdrawDepositRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawDepositRequest) ProtoMessage() {}
+
+func (x *WithdrawDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawDepositRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawDepositRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WithdrawDepositRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WithdrawDepositRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *WithdrawDepositRequest) GetPositionId() string {
+	if x != nil {
+		return x.PositionId
+	}
+	return ""
+}
+
+func (x *WithdrawDepositRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type WithdrawDepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// points returned to the player, i.e. the withdrawn
+	// part without interest and without the penalty
+	Value   int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Penalty int32 `protobuf:"varint,2,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	// value of the deposit, which stays in the bank; interest
+	// on it is paid, when the deposit time ends
+	RemainingValue int32 `protobuf:"varint,3,opt,name=remaining_value,json=remainingValue,proto3" json:"remaining_value,omitempty"`
+}
+
+func (x *WithdrawDepositResponse) Reset() {
+	*x = WithdrawDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawDepositResponse) ProtoMessage() {}
+
+func (x *WithdrawDepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawDepositResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawDepositResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WithdrawDepositResponse) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *WithdrawDepositResponse) GetPenalty() int32 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *WithdrawDepositResponse) GetRemainingValue() int32 {
+	if x != nil {
+		return x.RemainingValue
+	}
+	return 0
+}
+
+type HireGuardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *HireGuardRequest) Reset() {
+	*x = HireGuardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HireGuardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HireGuardRequest) ProtoMessage() {}
+
+func (x *HireGuardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HireGuardRequest.ProtoReflect.Descriptor instead.
+func (*HireGuardRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *HireGuardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HireGuardRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// Guard cannot be hired, if the player doesn't have enough points
+// or if the player is already guarded.
+// The reason will be stated in "explanation" field if "success"
+// is false.
+type HireGuardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// points paid to the bank
+	Price int32 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// machine-readable reason of the failure, e.g. CREDIT_LIMIT_EXCEEDED;
+	// empty, if success is true
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// milliseconds, after which the request may succeed, if the
+	// reason is temporary, e.g. LOTTERY_ON_COOLDOWN; 0 otherwise
+	RetryAfter int64 `protobuf:"varint,5,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *HireGuardResponse) Reset() {
+	*x = HireGuardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HireGuardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HireGuardResponse) ProtoMessage() {}
+
+func (x *HireGuardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HireGuardResponse.ProtoReflect.Descriptor instead.
+func (*HireGuardResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *HireGuardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HireGuardResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *HireGuardResponse) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *HireGuardResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HireGuardResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type StealRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// player, from whom the points are stolen
+	TargetId string `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+}
+
+func (x *StealRequest) Reset() {
+	*x = StealRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StealRequest) ProtoMessage() {}
+
+func (x *StealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StealRequest.ProtoReflect.Descriptor instead.
+func (*StealRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *StealRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StealRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *StealRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+// Steal is not tried, if the player has stolen less than theft_time
+// seconds ago or if the target doesn't have any points. If the thief is
+// caught, "success" is false as well, and the penalty is paid to the target.
+// The reason will be stated in "explanation" field if "success" is false.
+type StealResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// points stolen from the target
+	Stolen int32 `protobuf:"varint,3,opt,name=stolen,proto3" json:"stolen,omitempty"`
+	// points paid to the target, if the thief is caught
+	Penalty int32 `protobuf:"varint,4,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	// machine-readable reason of the failure, e.g. CREDIT_LIMIT_EXCEEDED;
+	// empty, if success is true
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// milliseconds, after which the request may succeed, if the
+	// reason is temporary, e.g. LOTTERY_ON_COOLDOWN; 0 otherwise
+	RetryAfter int64 `protobuf:"varint,6,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *StealResponse) Reset() {
+	*x = StealResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StealResponse) ProtoMessage() {}
+
+func (x *StealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StealResponse.ProtoReflect.Descriptor instead.
+func (*StealResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *StealResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StealResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *StealResponse) GetStolen() int32 {
+	if x != nil {
+		return x.Stolen
+	}
+	return 0
+}
+
+func (x *StealResponse) GetPenalty() int32 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *StealResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StealResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type LotteryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// has to be from 1 to lottery_rows * lottery_columns,
+	// cells are numbered row by row
+	CellIndex int32 `protobuf:"varint,3,opt,name=cell_index,json=cellIndex,proto3" json:"cell_index,omitempty"`
+}
+
+func (x *LotteryRequest) Reset() {
+	*x = LotteryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LotteryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LotteryRequest) ProtoMessage() {}
+
+func (x *LotteryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LotteryRequest.ProtoReflect.Descriptor instead.
+func (*LotteryRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LotteryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LotteryRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *LotteryRequest) GetCellIndex() int32 {
+	if x != nil {
+		return x.CellIndex
+	}
+	return 0
+}
+
+type LotteryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	CellValues []int32 `protobuf:"varint,2,rep,packed,name=cell_values,json=cellValues,proto3" json:"cell_values,omitempty"` // values of all cells row by row
+	WinPoints  int32   `protobuf:"varint,3,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`           // includes the jackpot, if it is won
+	JackpotWon bool    `protobuf:"varint,4,opt,name=jackpot_won,json=jackpotWon,proto3" json:"jackpot_won,omitempty"`
+	// human-readable description of the failure
+	Explanation string `protobuf:"bytes,5,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// machine-readable reason of the failure, e.g. CREDIT_LIMIT_EXCEEDED;
+	// empty, if success is true
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// milliseconds, after which the request may succeed, if the
+	// reason is temporary, e.g. LOTTERY_ON_COOLDOWN; 0 otherwise
+	RetryAfter int64 `protobuf:"varint,7,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *LotteryResponse) Reset() {
+	*x = LotteryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LotteryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LotteryResponse) ProtoMessage() {}
+
+func (x *LotteryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LotteryResponse.ProtoReflect.Descriptor instead.
+func (*LotteryResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *LotteryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LotteryResponse) GetCellValues() []int32 {
+	if x != nil {
+		return x.CellValues
+	}
+	return nil
+}
+
+func (x *LotteryResponse) GetWinPoints() int32 {
+	if x != nil {
+		return x.WinPoints
+	}
+	return 0
+}
+
+func (x *LotteryResponse) GetJackpotWon() bool {
+	if x != nil {
+		return x.JackpotWon
+	}
+	return false
+}
+
+func (x *LotteryResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *LotteryResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LotteryResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type GenerateQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// These points will be withdrawn during this request
+	// even if player does not answer the question.
+	// Bid cannot exceed question_max_bid_percentage of player's points.
+	BidPoints int32 `protobuf:"varint,3,opt,name=bid_points,json=bidPoints,proto3" json:"bid_points,omitempty"`
+	// question of any category is asked, if empty
+	Category string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	// "easy", "medium", or "hard"; question of any difficulty is asked, if empty.
+	// Win points of harder questions are multiplied (by 1.5 for medium, 2 for hard).
+	Difficulty string `protobuf:"bytes,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// overrides the locale provided on join for this question
+	Locale string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *GenerateQuestionRequest) Reset() {
+	*x = GenerateQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateQuestionRequest) ProtoMessage() {}
+
+func (x *GenerateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*GenerateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GenerateQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GenerateQuestionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GenerateQuestionRequest) GetBidPoints() int32 {
+	if x != nil {
+		return x.BidPoints
+	}
+	return 0
+}
+
+func (x *GenerateQuestionRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GenerateQuestionRequest) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *GenerateQuestionRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GenerateQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string   `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Question   string   `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"` // 1 question
+	Answers    []string `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`   // 4 answers
+	Category   string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Difficulty string   `protobuf:"bytes,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+}
+
+func (x *GenerateQuestionResponse) Reset() {
+	*x = GenerateQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateQuestionResponse) ProtoMessage() {}
+
+func (x *GenerateQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateQuestionResponse.ProtoReflect.Descriptor instead.
+func (*GenerateQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GenerateQuestionResponse) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *GenerateQuestionResponse) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *GenerateQuestionResponse) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *GenerateQuestionResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GenerateQuestionResponse) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+type AnswerQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId     string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	QuestionId string `protobuf:"bytes,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Answer     int32  `protobuf:"varint,4,opt,name=answer,proto3" json:"answer,omitempty"` // index from 1 to 4
+}
+
+func (x *AnswerQuestionRequest) Reset() {
+	*x = AnswerQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerQuestionRequest) ProtoMessage() {}
+
+func (x *AnswerQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerQuestionRequest.ProtoReflect.Descriptor instead.
+func (*AnswerQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AnswerQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AnswerQuestionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AnswerQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AnswerQuestionRequest) GetAnswer() int32 {
+	if x != nil {
+		return x.Answer
+	}
+	return 0
+}
+
+type AnswerQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnswerIsCorrect bool  `protobuf:"varint,1,opt,name=answer_is_correct,json=answerIsCorrect,proto3" json:"answer_is_correct,omitempty"`
+	CorrectAnswer   int32 `protobuf:"varint,2,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"` // index from 1 to 4
+	// 0 if !answer_is_correct, otherwise (bid_points * question_win_percentage / 100)
+	// multiplied by the difficulty multiplier of the question
+	WinPoints int32 `protobuf:"varint,3,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`
+}
+
+func (x *AnswerQuestionResponse) Reset() {
+	*x = AnswerQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerQuestionResponse) ProtoMessage() {}
+
+func (x *AnswerQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerQuestionResponse.ProtoReflect.Descriptor instead.
+func (*AnswerQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AnswerQuestionResponse) GetAnswerIsCorrect() bool {
+	if x != nil {
+		return x.AnswerIsCorrect
+	}
+	return false
+}
+
+func (x *AnswerQuestionResponse) GetCorrectAnswer() int32 {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return 0
+}
+
+func (x *AnswerQuestionResponse) GetWinPoints() int32 {
+	if x != nil {
+		return x.WinPoints
+	}
+	return 0
+}
+
+// Game configuration variables. When used as overrides
+// of the server defaults, fields with zero value are ignored.
+type GameConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration                    int32         `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	PlayerPoints                int32         `protobuf:"varint,2,opt,name=player_points,json=playerPoints,proto3" json:"player_points,omitempty"`
+	BankPointsPerPlayer         int32         `protobuf:"varint,3,opt,name=bank_points_per_player,json=bankPointsPerPlayer,proto3" json:"bank_points_per_player,omitempty"`
+	CreditInterest              int32         `protobuf:"varint,4,opt,name=credit_interest,json=creditInterest,proto3" json:"credit_interest,omitempty"`
+	DepositInterest             int32         `protobuf:"varint,5,opt,name=deposit_interest,json=depositInterest,proto3" json:"deposit_interest,omitempty"`
+	CreditTime                  int32         `protobuf:"varint,6,opt,name=credit_time,json=creditTime,proto3" json:"credit_time,omitempty"`
+	DepositTime                 int32         `protobuf:"varint,7,opt,name=deposit_time,json=depositTime,proto3" json:"deposit_time,omitempty"`
+	TheftTime                   int32         `protobuf:"varint,8,opt,name=theft_time,json=theftTime,proto3" json:"theft_time,omitempty"`
+	TheftPercentage             int32         `protobuf:"varint,9,opt,name=theft_percentage,json=theftPercentage,proto3" json:"theft_percentage,omitempty"`
+	LotteryTime                 int32         `protobuf:"varint,10,opt,name=lottery_time,json=lotteryTime,proto3" json:"lottery_time,omitempty"`
+	LotteryMaxWin               int32         `protobuf:"varint,11,opt,name=lottery_max_win,json=lotteryMaxWin,proto3" json:"lottery_max_win,omitempty"`
+	QuestionWinPercentage       int32         `protobuf:"varint,12,opt,name=question_win_percentage,json=questionWinPercentage,proto3" json:"question_win_percentage,omitempty"`
+	QuestionTime                int32         `protobuf:"varint,13,opt,name=question_time,json=questionTime,proto3" json:"question_time,omitempty"`
+	QuestionMaxBidPercentage    int32         `protobuf:"varint,14,opt,name=question_max_bid_percentage,json=questionMaxBidPercentage,proto3" json:"question_max_bid_percentage,omitempty"`
+	LotteryRows                 int32         `protobuf:"varint,15,opt,name=lottery_rows,json=lotteryRows,proto3" json:"lottery_rows,omitempty"`
+	LotteryColumns              int32         `protobuf:"varint,16,opt,name=lottery_columns,json=lotteryColumns,proto3" json:"lottery_columns,omitempty"`
+	LotteryPayouts              []int32       `protobuf:"varint,17,rep,packed,name=lottery_payouts,json=lotteryPayouts,proto3" json:"lottery_payouts,omitempty"`
+	JackpotPercentage           int32         `protobuf:"varint,18,opt,name=jackpot_percentage,json=jackpotPercentage,proto3" json:"jackpot_percentage,omitempty"`
+	JackpotChance               int32         `protobuf:"varint,19,opt,name=jackpot_chance,json=jackpotChance,proto3" json:"jackpot_chance,omitempty"`
+	DepositPenaltyPercentage    int32         `protobuf:"varint,20,opt,name=deposit_penalty_percentage,json=depositPenaltyPercentage,proto3" json:"deposit_penalty_percentage,omitempty"`
+	InterestMode                InterestMode  `protobuf:"varint,21,opt,name=interest_mode,json=interestMode,proto3,enum=server.InterestMode" json:"interest_mode,omitempty"`
+	InterestTicks               int32         `protobuf:"varint,22,opt,name=interest_ticks,json=interestTicks,proto3" json:"interest_ticks,omitempty"`
+	CreditLimitPercentage       int32         `protobuf:"varint,23,opt,name=credit_limit_percentage,json=creditLimitPercentage,proto3" json:"credit_limit_percentage,omitempty"`
+	MarketAssets                []string      `protobuf:"bytes,24,rep,name=market_assets,json=marketAssets,proto3" json:"market_assets,omitempty"`
+	MarketUnits                 int32         `protobuf:"varint,25,opt,name=market_units,json=marketUnits,proto3" json:"market_units,omitempty"`
+	StockPrice                  int32         `protobuf:"varint,26,opt,name=stock_price,json=stockPrice,proto3" json:"stock_price,omitempty"`
+	StockVolatilityPercentage   int32         `protobuf:"varint,27,opt,name=stock_volatility_percentage,json=stockVolatilityPercentage,proto3" json:"stock_volatility_percentage,omitempty"`
+	StockTickTime               int32         `protobuf:"varint,28,opt,name=stock_tick_time,json=stockTickTime,proto3" json:"stock_tick_time,omitempty"`
+	InsurancePremium            int32         `protobuf:"varint,29,opt,name=insurance_premium,json=insurancePremium,proto3" json:"insurance_premium,omitempty"`
+	InsuranceCoveragePercentage int32         `protobuf:"varint,30,opt,name=insurance_coverage_percentage,json=insuranceCoveragePercentage,proto3" json:"insurance_coverage_percentage,omitempty"`
+	TaxTime                     int32         `protobuf:"varint,31,opt,name=tax_time,json=taxTime,proto3" json:"tax_time,omitempty"`
+	TaxBrackets                 []*TaxBracket `protobuf:"bytes,32,rep,name=tax_brackets,json=taxBrackets,proto3" json:"tax_brackets,omitempty"`
+	InflationRate               int32         `protobuf:"varint,33,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
+	InflationTime               int32         `protobuf:"varint,34,opt,name=inflation_time,json=inflationTime,proto3" json:"inflation_time,omitempty"`
+	StealChance                 int32         `protobuf:"varint,35,opt,name=steal_chance,json=stealChance,proto3" json:"steal_chance,omitempty"`
+	StealPenaltyPercentage      int32         `protobuf:"varint,36,opt,name=steal_penalty_percentage,json=stealPenaltyPercentage,proto3" json:"steal_penalty_percentage,omitempty"`
+	GuardPrice                  int32         `protobuf:"varint,37,opt,name=guard_price,json=guardPrice,proto3" json:"guard_price,omitempty"`
+	GuardTime                   int32         `protobuf:"varint,38,opt,name=guard_time,json=guardTime,proto3" json:"guard_time,omitempty"`
+	GuardProtectionPercentage   int32         `protobuf:"varint,39,opt,name=guard_protection_percentage,json=guardProtectionPercentage,proto3" json:"guard_protection_percentage,omitempty"`
+	MaskThieves                 bool          `protobuf:"varint,40,opt,name=mask_thieves,json=maskThieves,proto3" json:"mask_thieves,omitempty"`
+	MinPlayers                  int32         `protobuf:"varint,41,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	MaxPlayers                  int32         `protobuf:"varint,42,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	AutoStart                   bool          `protobuf:"varint,43,opt,name=auto_start,json=autoStart,proto3" json:"auto_start,omitempty"`
+	StartCountdown              int32         `protobuf:"varint,44,opt,name=start_countdown,json=startCountdown,proto3" json:"start_countdown,omitempty"`
+	TeamCount                   int32         `protobuf:"varint,45,opt,name=team_count,json=teamCount,proto3" json:"team_count,omitempty"`
+	Mode                        GameMode      `protobuf:"varint,46,opt,name=mode,proto3,enum=server.GameMode" json:"mode,omitempty"`
+	CoopTarget                  int32         `protobuf:"varint,47,opt,name=coop_target,json=coopTarget,proto3" json:"coop_target,omitempty"`
+	// practice can be started with fewer than min players,
+	// and it doesn't count toward ratings and leaderboards
+	Practice     bool         `protobuf:"varint,48,opt,name=practice,proto3" json:"practice,omitempty"`
+	WinCondition WinCondition `protobuf:"varint,49,opt,name=win_condition,json=winCondition,proto3,enum=server.WinCondition" json:"win_condition,omitempty"`
+	WinTarget    int32        `protobuf:"varint,50,opt,name=win_target,json=winTarget,proto3" json:"win_target,omitempty"`
+	TimeTickTime int32        `protobuf:"varint,51,opt,name=time_tick_time,json=timeTickTime,proto3" json:"time_tick_time,omitempty"`
+	// name of the preset, e.g. "quick", "classroom", or "marathon", which
+	// replaces the game rules before the other overrides are applied;
+	// used only in the overrides, lobby settings are not replaced
+	Preset string `protobuf:"bytes,52,opt,name=preset,proto3" json:"preset,omitempty"`
+	// bounds of the length of the usernames in characters
+	UsernameMinLength int32          `protobuf:"varint,53,opt,name=username_min_length,json=usernameMinLength,proto3" json:"username_min_length,omitempty"`
+	UsernameMaxLength int32          `protobuf:"varint,54,opt,name=username_max_length,json=usernameMaxLength,proto3" json:"username_max_length,omitempty"`
+	UsernamePolicy    UsernamePolicy `protobuf:"varint,55,opt,name=username_policy,json=usernamePolicy,proto3,enum=server.UsernamePolicy" json:"username_policy,omitempty"`
+	AfkTime           int32          `protobuf:"varint,56,opt,name=afk_time,json=afkTime,proto3" json:"afk_time,omitempty"`
+	AfkPolicy         AfkPolicy      `protobuf:"varint,57,opt,name=afk_policy,json=afkPolicy,proto3,enum=server.AfkPolicy" json:"afk_policy,omitempty"`
+}
+
+func (x *GameConfig) Reset() {
+	*x = GameConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameConfig) ProtoMessage() {}
+
+func (x *GameConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameConfig.ProtoReflect.Descriptor instead.
+func (*GameConfig) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GameConfig) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *GameConfig) GetPlayerPoints() int32 {
+	if x != nil {
+		return x.PlayerPoints
+	}
+	return 0
+}
+
+func (x *GameConfig) GetBankPointsPerPlayer() int32 {
+	if x != nil {
+		return x.BankPointsPerPlayer
+	}
+	return 0
+}
+
+func (x *GameConfig) GetCreditInterest() int32 {
+	if x != nil {
+		return x.CreditInterest
+	}
+	return 0
+}
+
+func (x *GameConfig) GetDepositInterest() int32 {
+	if x != nil {
+		return x.DepositInterest
+	}
+	return 0
+}
+
+func (x *GameConfig) GetCreditTime() int32 {
+	if x != nil {
+		return x.CreditTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetDepositTime() int32 {
+	if x != nil {
+		return x.DepositTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetTheftTime() int32 {
+	if x != nil {
+		return x.TheftTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetTheftPercentage() int32 {
+	if x != nil {
+		return x.TheftPercentage
+	}
+	return 0
+}
+
+func (x *GameConfig) GetLotteryTime() int32 {
+	if x != nil {
+		return x.LotteryTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetLotteryMaxWin() int32 {
+	if x != nil {
+		return x.LotteryMaxWin
+	}
+	return 0
+}
+
+func (x *GameConfig) GetQuestionWinPercentage() int32 {
+	if x != nil {
+		return x.QuestionWinPercentage
+	}
+	return 0
+}
+
+func (x *GameConfig) GetQuestionTime() int32 {
+	if x != nil {
+		return x.QuestionTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetQuestionMaxBidPercentage() int32 {
+	if x != nil {
+		return x.QuestionMaxBidPercentage
+	}
+	return 0
+}
+
+func (x *GameConfig) GetLotteryRows() int32 {
+	if x != nil {
+		return x.LotteryRows
+	}
+	return 0
+}
+
+func (x *GameConfig) GetLotteryColumns() int32 {
+	if x != nil {
+		return x.LotteryColumns
+	}
+	return 0
+}
+
+func (x *GameConfig) GetLotteryPayouts() []int32 {
+	if x != nil {
+		return x.LotteryPayouts
+	}
+	return nil
+}
+
+func (x *GameConfig) GetJackpotPercentage() int32 {
+	if x != nil {
+		return x.JackpotPercentage
+	}
+	return 0
+}
+
+func (x *GameConfig) GetJackpotChance() int32 {
+	if x != nil {
+		return x.JackpotChance
+	}
+	return 0
+}
+
+func (x *GameConfig) GetDepositPenaltyPercentage() int32 {
+	if x != nil {
+		return x.DepositPenaltyPercentage
+	}
+	return 0
+}
+
+func (x *GameConfig) GetInterestMode() InterestMode {
+	if x != nil {
+		return x.InterestMode
+	}
+	return InterestMode_INTEREST_MODE_SIMPLE
+}
+
+func (x *GameConfig) GetInterestTicks() int32 {
+	if x != nil {
+		return x.InterestTicks
+	}
+	return 0
+}
+
+func (x *GameConfig) GetCreditLimitPercentage() int32 {
+	if x != nil {
+		return x.CreditLimitPercentage
+	}
+	return 0
+}
+
+func (x *GameConfig) GetMarketAssets() []string {
+	if x != nil {
+		return x.MarketAssets
+	}
+	return nil
+}
+
+func (x *GameConfig) GetMarketUnits() int32 {
+	if x != nil {
+		return x.MarketUnits
+	}
+	return 0
+}
+
+func (x *GameConfig) GetStockPrice() int32 {
+	if x != nil {
+		return x.StockPrice
+	}
+	return 0
+}
+
+func (x *GameConfig) GetStockVolatilityPercentage() int32 {
+	if x != nil {
+		return x.StockVolatilityPercentage
+	}
+	return 0
+}
+
+func (x *GameConfig) GetStockTickTime() int32 {
+	if x != nil {
+		return x.StockTickTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetInsurancePremium() int32 {
+	if x != nil {
+		return x.InsurancePremium
+	}
+	return 0
+}
+
+func (x *GameConfig) GetInsuranceCoveragePercentage() int32 {
+	if x != nil {
+		return x.InsuranceCoveragePercentage
+	}
+	return 0
+}
+
+func (x *GameConfig) GetTaxTime() int32 {
+	if x != nil {
+		return x.TaxTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetTaxBrackets() []*TaxBracket {
+	if x != nil {
+		return x.TaxBrackets
+	}
+	return nil
+}
+
+func (x *GameConfig) GetInflationRate() int32 {
+	if x != nil {
+		return x.InflationRate
+	}
+	return 0
+}
+
+func (x *GameConfig) GetInflationTime() int32 {
+	if x != nil {
+		return x.InflationTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetStealChance() int32 {
+	if x != nil {
+		return x.StealChance
+	}
+	return 0
+}
+
+func (x *GameConfig) GetStealPenaltyPercentage() int32 {
+	if x != nil {
+		return x.StealPenaltyPercentage
+	}
+	return 0
+}
+
+func (x *GameConfig) GetGuardPrice() int32 {
+	if x != nil {
+		return x.GuardPrice
+	}
+	return 0
+}
+
+func (x *GameConfig) GetGuardTime() int32 {
+	if x != nil {
+		return x.GuardTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetGuardProtectionPercentage() int32 {
+	if x != nil {
+		return x.GuardProtectionPercentage
+	}
+	return 0
+}
+
+func (x *GameConfig) GetMaskThieves() bool {
+	if x != nil {
+		return x.MaskThieves
+	}
+	return false
+}
+
+func (x *GameConfig) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *GameConfig) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *GameConfig) GetAutoStart() bool {
+	if x != nil {
+		return x.AutoStart
+	}
+	return false
+}
+
+func (x *GameConfig) GetStartCountdown() int32 {
+	if x != nil {
+		return x.StartCountdown
+	}
+	return 0
+}
+
+func (x *GameConfig) GetTeamCount() int32 {
+	if x != nil {
+		return x.TeamCount
+	}
+	return 0
+}
+
+func (x *GameConfig) GetMode() GameMode {
+	if x != nil {
+		return x.Mode
+	}
+	return GameMode_GAME_MODE_COMPETITIVE
+}
+
+func (x *GameConfig) GetCoopTarget() int32 {
+	if x != nil {
+		return x.CoopTarget
+	}
+	return 0
+}
+
+func (x *GameConfig) GetPractice() bool {
+	if x != nil {
+		return x.Practice
+	}
+	return false
+}
+
+func (x *GameConfig) GetWinCondition() WinCondition {
+	if x != nil {
+		return x.WinCondition
+	}
+	return WinCondition_WIN_CONDITION_POINTS
+}
+
+func (x *GameConfig) GetWinTarget() int32 {
+	if x != nil {
+		return x.WinTarget
+	}
+	return 0
+}
+
+func (x *GameConfig) GetTimeTickTime() int32 {
+	if x != nil {
+		return x.TimeTickTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+func (x *GameConfig) GetUsernameMinLength() int32 {
+	if x != nil {
+		return x.UsernameMinLength
+	}
+	return 0
+}
+
+func (x *GameConfig) GetUsernameMaxLength() int32 {
+	if x != nil {
+		return x.UsernameMaxLength
+	}
+	return 0
+}
+
+func (x *GameConfig) GetUsernamePolicy() UsernamePolicy {
+	if x != nil {
+		return x.UsernamePolicy
+	}
+	return UsernamePolicy_USERNAME_POLICY_SUFFIX
+}
+
+func (x *GameConfig) GetAfkTime() int32 {
+	if x != nil {
+		return x.AfkTime
+	}
+	return 0
+}
+
+func (x *GameConfig) GetAfkPolicy() AfkPolicy {
+	if x != nil {
+		return x.AfkPolicy
+	}
+	return AfkPolicy_AFK_POLICY_FLAG
+}
+
+type Room struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId      string      `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Name        string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PlayerCount int32       `protobuf:"varint,3,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	Config      *GameConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Room) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Room) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Room) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *Room) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// overrides of the default game config
+	Config *GameConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// if set, JoinRoom requests have to carry the same code
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CreateRoomRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CreateRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *CreateRoomResponse) Reset() {
+	*x = CreateRoomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomResponse) ProtoMessage() {}
+
+func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateRoomResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ListRoomsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRoomsRequest) Reset() {
+	*x = ListRoomsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsRequest) ProtoMessage() {}
+
+func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{68}
+}
+
+type ListRoomsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rooms []*Room `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+}
+
+func (x *ListRoomsResponse) Reset() {
+	*x = ListRoomsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsResponse) ProtoMessage() {}
+
+func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListRoomsResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type JoinRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Locale   string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"` // same as in JoinRequest
+	// code of the room, if it has been created with one
+	Code         string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	AccountToken string `protobuf:"bytes,5,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"` // same as in JoinRequest
+	AvatarId     string `protobuf:"bytes,6,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`             // same as in JoinRequest
+	Color        string `protobuf:"bytes,7,opt,name=color,proto3" json:"color,omitempty"`                                   // same as in JoinRequest
+}
+
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequest) ProtoMessage() {}
+
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *JoinRoomRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetAvatarId() string {
+	if x != nil {
+		return x.AvatarId
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+// Waiting game or room, which can be joined.
+type GameListing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// "default" for the waiting game joined with Join
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PlayerCount int32  `protobuf:"varint,3,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	MinPlayers  int32  `protobuf:"varint,4,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	// 0 if the number of players is not limited
+	MaxPlayers int32 `protobuf:"varint,5,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	// true, if JoinRoom has to carry the code of the room
+	CodeRequired bool `protobuf:"varint,6,opt,name=code_required,json=codeRequired,proto3" json:"code_required,omitempty"`
+	// true, if the game is counting down to the start
+	Starting     bool   `protobuf:"varint,7,opt,name=starting,proto3" json:"starting,omitempty"`
+	HostUsername string `protobuf:"bytes,8,opt,name=host_username,json=hostUsername,proto3" json:"host_username,omitempty"`
+	Duration     int32  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	PlayerPoints int32  `protobuf:"varint,10,opt,name=player_points,json=playerPoints,proto3" json:"player_points,omitempty"`
+}
+
+func (x *GameListing) Reset() {
+	*x = GameListing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameListing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameListing) ProtoMessage() {}
+
+func (x *GameListing) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameListing.ProtoReflect.Descriptor instead.
+func (*GameListing) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GameListing) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameListing) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameListing) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *GameListing) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *GameListing) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *GameListing) GetCodeRequired() bool {
+	if x != nil {
+		return x.CodeRequired
+	}
+	return false
+}
+
+func (x *GameListing) GetStarting() bool {
+	if x != nil {
+		return x.Starting
+	}
+	return false
+}
+
+func (x *GameListing) GetHostUsername() string {
+	if x != nil {
+		return x.HostUsername
+	}
+	return ""
+}
+
+func (x *GameListing) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *GameListing) GetPlayerPoints() int32 {
+	if x != nil {
+		return x.PlayerPoints
+	}
+	return 0
+}
+
+type EnqueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// used only for players, who have not finished any
+	// game yet, otherwise the rating of the player is used
+	Rating       int32  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Locale       string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                 // same as in JoinRequest
+	AccountToken string `protobuf:"bytes,4,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"` // same as in JoinRequest
+	AvatarId     string `protobuf:"bytes,5,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`             // same as in JoinRequest
+	Color        string `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`                                   // same as in JoinRequest
+}
+
+func (x *EnqueueRequest) Reset() {
+	*x = EnqueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueRequest) ProtoMessage() {}
+
+func (x *EnqueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueRequest.ProtoReflect.Descriptor instead.
+func (*EnqueueRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *EnqueueRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EnqueueRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *EnqueueRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *EnqueueRequest) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
+	}
+	return ""
+}
+
+func (x *EnqueueRequest) GetAvatarId() string {
+	if x != nil {
+		return x.AvatarId
+	}
+	return ""
+}
+
+func (x *EnqueueRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type MatchmakingEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*MatchmakingEvent_Searching_
+	//	*MatchmakingEvent_MatchFound
+	Event isMatchmakingEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *MatchmakingEvent) Reset() {
+	*x = MatchmakingEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchmakingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchmakingEvent) ProtoMessage() {}
+
+func (x *MatchmakingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchmakingEvent.ProtoReflect.Descriptor instead.
+func (*MatchmakingEvent) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{73}
+}
+
+func (m *MatchmakingEvent) GetEvent() isMatchmakingEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *MatchmakingEvent) GetSearching() *MatchmakingEvent_Searching {
+	if x, ok := x.GetEvent().(*MatchmakingEvent_Searching_); ok {
+		return x.Searching
+	}
+	return nil
+}
+
+func (x *MatchmakingEvent) GetMatchFound() *JoinResponse {
+	if x, ok := x.GetEvent().(*MatchmakingEvent_MatchFound); ok {
+		return x.MatchFound
+	}
+	return nil
+}
+
+type isMatchmakingEvent_Event interface {
+	isMatchmakingEvent_Event()
+}
+
+type MatchmakingEvent_Searching_ struct {
+	// Sent every second, while the player is waiting.
+	Searching *MatchmakingEvent_Searching `protobuf:"bytes,1,opt,name=searching,proto3,oneof"`
+}
+
+type MatchmakingEvent_MatchFound struct {
+	// Sent as the last event, once the player is matched and added to the
+	// new room. The player, who has waited the longest, is its host.
+	MatchFound *JoinResponse `protobuf:"bytes,2,opt,name=match_found,json=matchFound,proto3,oneof"`
+}
+
+func (*MatchmakingEvent_Searching_) isMatchmakingEvent_Event() {}
+
+func (*MatchmakingEvent_MatchFound) isMatchmakingEvent_Event() {}
+
+type ListLobbiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLobbiesRequest) Reset() {
+	*x = ListLobbiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLobbiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLobbiesRequest) ProtoMessage() {}
+
+func (x *ListLobbiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLobbiesRequest.ProtoReflect.Descriptor instead.
+func (*ListLobbiesRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{74}
+}
+
+type ListLobbiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the default waiting game first, then the rooms
+	Games []*GameListing `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+}
+
+func (x *ListLobbiesResponse) Reset() {
+	*x = ListLobbiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLobbiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLobbiesResponse) ProtoMessage() {}
+
+func (x *ListLobbiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLobbiesResponse.ProtoReflect.Descriptor instead.
+func (*ListLobbiesResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListLobbiesResponse) GetGames() []*GameListing {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type StreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// sequence number of the latest event, which the player has received;
+	// if set, the events broadcast since then are sent first, or the game
+	// state, if some of them aren't kept anymore
+	LastSeenSequence int64 `protobuf:"varint,3,opt,name=last_seen_sequence,json=lastSeenSequence,proto3" json:"last_seen_sequence,omitempty"`
+	// categories of the events sent on the stream, all events are sent, if
+	// empty; the game state sent on (re)attach and the Kicked event are
+	// always sent, and the sequence numbers of skipped events are missing
+	Categories []EventCategory `protobuf:"varint,4,rep,packed,name=categories,proto3,enum=server.EventCategory" json:"categories,omitempty"`
+	// milliseconds, for which the events are collected into the Batch event
+	// before they are sent, up to 1000; events are sent right away, if 0
+	BatchInterval int32 `protobuf:"varint,5,opt,name=batch_interval,json=batchInterval,proto3" json:"batch_interval,omitempty"`
+	// number of events, after which the batch is sent before the interval
+	// ends, up to 100; 100, if 0
+	BatchSize int32 `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *StreamRequest) Reset() {
+	*x = StreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRequest) ProtoMessage() {}
+
+func (x *StreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRequest.ProtoReflect.Descriptor instead.
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *StreamRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *StreamRequest) GetLastSeenSequence() int64 {
+	if x != nil {
+		return x.LastSeenSequence
+	}
+	return 0
+}
+
+func (x *StreamRequest) GetCategories() []EventCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *StreamRequest) GetBatchInterval() int32 {
+	if x != nil {
+		return x.BatchInterval
+	}
+	return 0
+}
+
+func (x *StreamRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type ReconnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// token received in JoinResponse
+	SessionToken string `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// the same as in StreamRequest, but the game state
+	// is sent, if it is not set
+	LastSeenSequence int64 `protobuf:"varint,3,opt,name=last_seen_sequence,json=lastSeenSequence,proto3" json:"last_seen_sequence,omitempty"`
+	// the same as in StreamRequest
+	Categories    []EventCategory `protobuf:"varint,4,rep,packed,name=categories,proto3,enum=server.EventCategory" json:"categories,omitempty"`
+	BatchInterval int32           `protobuf:"varint,5,opt,name=batch_interval,json=batchInterval,proto3" json:"batch_interval,omitempty"`
+	BatchSize     int32           `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *ReconnectRequest) Reset() {
+	*x = ReconnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconnectRequest) ProtoMessage() {}
+
+func (x *ReconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconnectRequest.ProtoReflect.Descriptor instead.
+func (*ReconnectRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ReconnectRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ReconnectRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ReconnectRequest) GetLastSeenSequence() int64 {
+	if x != nil {
+		return x.LastSeenSequence
+	}
+	return 0
+}
+
+func (x *ReconnectRequest) GetCategories() []EventCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ReconnectRequest) GetBatchInterval() int32 {
+	if x != nil {
+		return x.BatchInterval
+	}
+	return 0
+}
+
+func (x *ReconnectRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// Full current state of the game, so that
+// client can resync after reconnecting.
+type GameState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string     `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Status GameStatus `protobuf:"varint,2,opt,name=status,proto3,enum=server.GameStatus" json:"status,omitempty"`
+	// players together with the bank
+	Players []*Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	// seconds until the game finishes, 0 for
+	// games which are not in "Active" state
+	RemainingTime int32 `protobuf:"varint,4,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+	// current value of the jackpot
+	Jackpot int32 `protobuf:"varint,5,opt,name=jackpot,proto3" json:"jackpot,omitempty"`
+	// current price of the share of the stock, 0 if there is no stock
+	StockPrice int32 `protobuf:"varint,6,opt,name=stock_price,json=stockPrice,proto3" json:"stock_price,omitempty"`
+	// percentage, by which lottery and question payouts are scaled,
+	// 100 if there has been no inflation
+	PriceLevel int32 `protobuf:"varint,7,opt,name=price_level,json=priceLevel,proto3" json:"price_level,omitempty"`
+	// player, who can start the game and kick players; the host role passes
+	// to the player, who has joined next, if the host leaves the game
+	HostId string `protobuf:"bytes,8,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	// empty, if the game has no teams
+	Teams []*Team `protobuf:"bytes,9,rep,name=teams,proto3" json:"teams,omitempty"`
+	// set only in co-op mode
+	CoopProgress *CoopProgress `protobuf:"bytes,10,opt,name=coop_progress,json=coopProgress,proto3" json:"coop_progress,omitempty"`
+	// true, if the active game is paused; remaining time
+	// doesn't change, while the game is paused
+	Paused bool `protobuf:"varint,11,opt,name=paused,proto3" json:"paused,omitempty"`
+	// sequence number of the latest event broadcast on the stream
+	Sequence int64 `protobuf:"varint,12,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *GameState) Reset() {
+	*x = GameState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState) ProtoMessage() {}
+
+func (x *GameState) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GameState) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameState) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_WAITING
+}
+
+func (x *GameState) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameState) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+func (x *GameState) GetJackpot() int32 {
+	if x != nil {
+		return x.Jackpot
+	}
+	return 0
+}
+
+func (x *GameState) GetStockPrice() int32 {
+	if x != nil {
+		return x.StockPrice
+	}
+	return 0
+}
+
+func (x *GameState) GetPriceLevel() int32 {
+	if x != nil {
+		return x.PriceLevel
+	}
+	return 0
+}
+
+func (x *GameState) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *GameState) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *GameState) GetCoopProgress() *CoopProgress {
+	if x != nil {
+		return x.CoopProgress
+	}
+	return nil
+}
+
+func (x *GameState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GameState) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// Collective progress of the players in co-op mode.
+type CoopProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// net worth of all players, i.e. their points together with their
+	// deposits without their credits, minus their initial points
+	Progress int32 `protobuf:"varint,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	Target   int32 `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	// true, if the players have extracted the target, which finishes the game
+	Reached bool `protobuf:"varint,3,opt,name=reached,proto3" json:"reached,omitempty"`
+}
+
+func (x *CoopProgress) Reset() {
+	*x = CoopProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoopProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoopProgress) ProtoMessage() {}
+
+func (x *CoopProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoopProgress.ProtoReflect.Descriptor instead.
+func (*CoopProgress) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CoopProgress) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *CoopProgress) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *CoopProgress) GetReached() bool {
+	if x != nil {
+		return x.Reached
+	}
+	return false
+}
+
+// Team of the players, who pool their points.
+type Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from 1 to the number of teams
+	Team int32 `protobuf:"varint,1,opt,name=team,proto3" json:"team,omitempty"`
+	// sum of the points of the players in the team
+	Points  int32    `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	UserIds []string `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Team) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *Team) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Team) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// Final result of the player in the finished game.
+type PlayerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// from 1, players with the same score and outcome share the rank
+	Rank int32 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Won  bool  `protobuf:"varint,4,opt,name=won,proto3" json:"won,omitempty"`
+	// points, net worth, or points of the team, by which the player is ranked
+	Score int32 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	// breakdown of the net worth, interest is not included
+	Cash              int32 `protobuf:"varint,6,opt,name=cash,proto3" json:"cash,omitempty"`
+	Deposits          int32 `protobuf:"varint,7,opt,name=deposits,proto3" json:"deposits,omitempty"`
+	OutstandingCredit int32 `protobuf:"varint,8,opt,name=outstanding_credit,json=outstandingCredit,proto3" json:"outstanding_credit,omitempty"`
+	NetWorth          int32 `protobuf:"varint,9,opt,name=net_worth,json=netWorth,proto3" json:"net_worth,omitempty"`
+	// points won in a single lottery play including the jackpot
+	BiggestLotteryWin int32 `protobuf:"varint,10,opt,name=biggest_lottery_win,json=biggestLotteryWin,proto3" json:"biggest_lottery_win,omitempty"`
+	QuestionsAnswered int32 `protobuf:"varint,11,opt,name=questions_answered,json=questionsAnswered,proto3" json:"questions_answered,omitempty"`
+	QuestionsCorrect  int32 `protobuf:"varint,12,opt,name=questions_correct,json=questionsCorrect,proto3" json:"questions_correct,omitempty"`
+	// from 1 to the number of teams, 0 if the game has no teams
+	Team int32 `protobuf:"varint,13,opt,name=team,proto3" json:"team,omitempty"`
+	Bot  bool  `protobuf:"varint,14,opt,name=bot,proto3" json:"bot,omitempty"`
+	// true, if the player has forfeited the game for being AFK
+	Forfeited bool `protobuf:"varint,15,opt,name=forfeited,proto3" json:"forfeited,omitempty"`
+}
+
+func (x *PlayerResult) Reset() {
+	*x = PlayerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerResult) ProtoMessage() {}
+
+func (x *PlayerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerResult.ProtoReflect.Descriptor instead.
+func (*PlayerResult) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PlayerResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PlayerResult) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PlayerResult) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetWon() bool {
+	if x != nil {
+		return x.Won
+	}
+	return false
+}
+
+func (x *PlayerResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetCash() int32 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetDeposits() int32 {
+	if x != nil {
+		return x.Deposits
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetOutstandingCredit() int32 {
+	if x != nil {
+		return x.OutstandingCredit
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetNetWorth() int32 {
+	if x != nil {
+		return x.NetWorth
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetBiggestLotteryWin() int32 {
+	if x != nil {
+		return x.BiggestLotteryWin
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetQuestionsAnswered() int32 {
+	if x != nil {
+		return x.QuestionsAnswered
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetQuestionsCorrect() int32 {
+	if x != nil {
+		return x.QuestionsCorrect
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
+
+func (x *PlayerResult) GetForfeited() bool {
+	if x != nil {
+		return x.Forfeited
+	}
+	return false
+}
+
+// Final results of the game, which clients show on the results screen.
+type GameResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ordered by rank, ties are broken by usernames
+	Rankings []*PlayerResult `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`
+	// player with the biggest lottery win, empty if nobody has won the lottery
+	BiggestLotteryUserId string `protobuf:"bytes,2,opt,name=biggest_lottery_user_id,json=biggestLotteryUserId,proto3" json:"biggest_lottery_user_id,omitempty"`
+	BiggestLotteryWin    int32  `protobuf:"varint,3,opt,name=biggest_lottery_win,json=biggestLotteryWin,proto3" json:"biggest_lottery_win,omitempty"`
+	// player, who has answered the most questions correctly, empty if nobody has
+	MostQuestionsUserId string `protobuf:"bytes,4,opt,name=most_questions_user_id,json=mostQuestionsUserId,proto3" json:"most_questions_user_id,omitempty"`
+	MostQuestions       int32  `protobuf:"varint,5,opt,name=most_questions,json=mostQuestions,proto3" json:"most_questions,omitempty"`
+}
+
+func (x *GameResults) Reset() {
+	*x = GameResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameResults) ProtoMessage() {}
+
+func (x *GameResults) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameResults.ProtoReflect.Descriptor instead.
+func (*GameResults) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GameResults) GetRankings() []*PlayerResult {
+	if x != nil {
+		return x.Rankings
+	}
+	return nil
+}
+
+func (x *GameResults) GetBiggestLotteryUserId() string {
+	if x != nil {
+		return x.BiggestLotteryUserId
+	}
+	return ""
+}
+
+func (x *GameResults) GetBiggestLotteryWin() int32 {
+	if x != nil {
+		return x.BiggestLotteryWin
+	}
+	return 0
+}
+
+func (x *GameResults) GetMostQuestionsUserId() string {
+	if x != nil {
+		return x.MostQuestionsUserId
+	}
+	return ""
+}
+
+func (x *GameResults) GetMostQuestions() int32 {
+	if x != nil {
+		return x.MostQuestions
+	}
+	return 0
+}
+
+// Outstanding credit or deposit of the player.
+type Position struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PositionId string `protobuf:"bytes,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Value      int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// value, which will be returned when the time ends
+	ValueWithInterest int32 `protobuf:"varint,3,opt,name=value_with_interest,json=valueWithInterest,proto3" json:"value_with_interest,omitempty"`
+	// seconds until the position is returned
+	RemainingTime int32 `protobuf:"varint,4,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+	// percentage for the whole time of the position
+	Interest int32 `protobuf:"varint,5,opt,name=interest,proto3" json:"interest,omitempty"`
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Position) GetPositionId() string {
+	if x != nil {
+		return x.PositionId
+	}
+	return ""
+}
+
+func (x *Position) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Position) GetValueWithInterest() int32 {
+	if x != nil {
+		return x.ValueWithInterest
+	}
+	return 0
+}
+
+func (x *Position) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+func (x *Position) GetInterest() int32 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+// Message sent to the chat of the game.
+type ChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Text     string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// milliseconds since the Unix epoch, when the message has been sent
+	Time int64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	// team, to which the message has been sent,
+	// 0 if it has been sent to all players
+	Team int32 `protobuf:"varint,5,opt,name=team,proto3" json:"team,omitempty"`
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ChatMessage) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+type SendChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// up to 280 characters, surrounding whitespace is removed
+	Text string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// if true, the message is sent only to the team of the player
+	TeamOnly bool `protobuf:"varint,4,opt,name=team_only,json=teamOnly,proto3" json:"team_only,omitempty"`
+}
+
+func (x *SendChatRequest) Reset() {
+	*x = SendChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatRequest) ProtoMessage() {}
+
+func (x *SendChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatRequest.ProtoReflect.Descriptor instead.
+func (*SendChatRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SendChatRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendChatRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SendChatRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SendChatRequest) GetTeamOnly() bool {
+	if x != nil {
+		return x.TeamOnly
+	}
+	return false
+}
+
+type SendChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SendChatResponse) Reset() {
+	*x = SendChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatResponse) ProtoMessage() {}
+
+func (x *SendChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatResponse.ProtoReflect.Descriptor instead.
+func (*SendChatResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SendChatResponse) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ReactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Emote  Emote  `protobuf:"varint,3,opt,name=emote,proto3,enum=server.Emote" json:"emote,omitempty"`
+}
+
+func (x *ReactRequest) Reset() {
+	*x = ReactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactRequest) ProtoMessage() {}
+
+func (x *ReactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactRequest.ProtoReflect.Descriptor instead.
+func (*ReactRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ReactRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReactRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ReactRequest) GetEmote() Emote {
+	if x != nil {
+		return x.Emote
+	}
+	return Emote_EMOTE_THUMBS_UP
+}
+
+type ReactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReactResponse) Reset() {
+	*x = ReactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactResponse) ProtoMessage() {}
+
+func (x *ReactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactResponse.ProtoReflect.Descriptor instead.
+func (*ReactResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{88}
+}
+
+type GetTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// number of the oldest entries to skip
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// maximal number of the returned entries, 0 means 50,
+	// and it cannot be greater than 200
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetTransactionsRequest) Reset() {
+	*x = GetTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsRequest) ProtoMessage() {}
+
+func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Single change of the points of the player.
+type LedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence number of the event in the journal of the game
+	Sequence int64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// milliseconds since epoch
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// "join" for the initial points, otherwise the same kinds as
+	// of ReplayEvent except "question_win" for answered questions
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// change of the points, negative if points are taken from the player
+	Value int32 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	// points of the player after the change
+	Balance int32 `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	// id of the credit, deposit, question, or loan the entry refers to
+	RefId string `protobuf:"bytes,6,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *LedgerEntry) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetBalance() int32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+type GetTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from the oldest to the newest
+	Entries []*LedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// number of all entries of the player
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetTransactionsResponse) Reset() {
+	*x = GetTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsResponse) ProtoMessage() {}
+
+func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetTransactionsResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetTransactionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetGameStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetGameStateRequest) Reset() {
+	*x = GetGameStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStateRequest) ProtoMessage() {}
+
+func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
+func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetGameStateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetGameStateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetGameStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *GameState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// positions of the requesting player sorted by remaining time
+	Credits  []*Position `protobuf:"bytes,2,rep,name=credits,proto3" json:"credits,omitempty"`
+	Deposits []*Position `protobuf:"bytes,3,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	// seconds until the player can play the lottery again
+	LotteryRemainingTime int32 `protobuf:"varint,4,opt,name=lottery_remaining_time,json=lotteryRemainingTime,proto3" json:"lottery_remaining_time,omitempty"`
+	// seconds until the next theft, 0 for
+	// games which are not in "Active" state
+	TheftRemainingTime int32 `protobuf:"varint,5,opt,name=theft_remaining_time,json=theftRemainingTime,proto3" json:"theft_remaining_time,omitempty"`
+	// loans, which the player has offered, taken, or given
+	Loans []*Loan `protobuf:"bytes,6,rep,name=loans,proto3" json:"loans,omitempty"`
+	// shares of the stock, which the player owns
+	Shares int32 `protobuf:"varint,7,opt,name=shares,proto3" json:"shares,omitempty"`
+	// true, if the player is insured against the next theft
+	Insured bool `protobuf:"varint,8,opt,name=insured,proto3" json:"insured,omitempty"`
+	// interest of the credit and the deposit, which the
+	// bank would grant to the player now
+	CreditInterest  int32 `protobuf:"varint,9,opt,name=credit_interest,json=creditInterest,proto3" json:"credit_interest,omitempty"`
+	DepositInterest int32 `protobuf:"varint,10,opt,name=deposit_interest,json=depositInterest,proto3" json:"deposit_interest,omitempty"`
+	// seconds until the guard of the player leaves
+	GuardRemainingTime int32 `protobuf:"varint,11,opt,name=guard_remaining_time,json=guardRemainingTime,proto3" json:"guard_remaining_time,omitempty"`
+	// latest messages of the chat from the oldest
+	Chat []*ChatMessage `protobuf:"bytes,12,rep,name=chat,proto3" json:"chat,omitempty"`
+}
+
+func (x *GetGameStateResponse) Reset() {
+	*x = GetGameStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStateResponse) ProtoMessage() {}
+
+func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
+func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetGameStateResponse) GetState() *GameState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *GetGameStateResponse) GetCredits() []*Position {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *GetGameStateResponse) GetDeposits() []*Position {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+func (x *GetGameStateResponse) GetLotteryRemainingTime() int32 {
+	if x != nil {
+		return x.LotteryRemainingTime
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetTheftRemainingTime() int32 {
+	if x != nil {
+		return x.TheftRemainingTime
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetLoans() []*Loan {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+func (x *GetGameStateResponse) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetInsured() bool {
+	if x != nil {
+		return x.Insured
+	}
+	return false
+}
+
+func (x *GetGameStateResponse) GetCreditInterest() int32 {
+	if x != nil {
+		return x.CreditInterest
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetDepositInterest() int32 {
+	if x != nil {
+		return x.DepositInterest
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetGuardRemainingTime() int32 {
+	if x != nil {
+		return x.GuardRemainingTime
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetChat() []*ChatMessage {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+// Loan from one player to another.
+type Loan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId     string     `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	LenderId   string     `protobuf:"bytes,2,opt,name=lender_id,json=lenderId,proto3" json:"lender_id,omitempty"`
+	BorrowerId string     `protobuf:"bytes,3,opt,name=borrower_id,json=borrowerId,proto3" json:"borrower_id,omitempty"`
+	Value      int32      `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Interest   int32      `protobuf:"varint,5,opt,name=interest,proto3" json:"interest,omitempty"`
+	Time       int32      `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	Status     LoanStatus `protobuf:"varint,7,opt,name=status,proto3,enum=server.LoanStatus" json:"status,omitempty"`
+	// points, which the borrower still has to pay to the lender,
+	// 0 for loans, which have not been accepted yet
+	Due int32 `protobuf:"varint,8,opt,name=due,proto3" json:"due,omitempty"`
+	// seconds until the loan is collected, 0 for loans,
+	// which are not in "Active" status
+	RemainingTime int32 `protobuf:"varint,9,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+}
+
+func (x *Loan) Reset() {
+	*x = Loan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Loan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loan) ProtoMessage() {}
+
+func (x *Loan) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
+func (*Loan) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Loan) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *Loan) GetLenderId() string {
+	if x != nil {
+		return x.LenderId
+	}
+	return ""
+}
+
+func (x *Loan) GetBorrowerId() string {
+	if x != nil {
+		return x.BorrowerId
+	}
+	return ""
+}
+
+func (x *Loan) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Loan) GetInterest() int32 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *Loan) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Loan) GetStatus() LoanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LoanStatus_LOAN_STATUS_OFFERED
+}
+
+func (x *Loan) GetDue() int32 {
+	if x != nil {
+		return x.Due
+	}
+	return 0
+}
+
+func (x *Loan) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+// Statistics of the player over all finished games.
+type PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rating            int32 `protobuf:"varint,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Games             int32 `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	Wins              int32 `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	TotalWinnings     int64 `protobuf:"varint,4,opt,name=total_winnings,json=totalWinnings,proto3" json:"total_winnings,omitempty"`
+	QuestionsAnswered int32 `protobuf:"varint,5,opt,name=questions_answered,json=questionsAnswered,proto3" json:"questions_answered,omitempty"`
+	QuestionsCorrect  int32 `protobuf:"varint,6,opt,name=questions_correct,json=questionsCorrect,proto3" json:"questions_correct,omitempty"`
+	CreditsTaken      int32 `protobuf:"varint,7,opt,name=credits_taken,json=creditsTaken,proto3" json:"credits_taken,omitempty"`
+	// credits, which the player couldn't afford to return,
+	// and defaulted loans of other players
+	CreditsDefaulted int32 `protobuf:"varint,8,opt,name=credits_defaulted,json=creditsDefaulted,proto3" json:"credits_defaulted,omitempty"`
+	LotteryWins      int32 `protobuf:"varint,9,opt,name=lottery_wins,json=lotteryWins,proto3" json:"lottery_wins,omitempty"`
+	// successful steals from other players
+	Steals int32 `protobuf:"varint,10,opt,name=steals,proto3" json:"steals,omitempty"`
+	// by thefts and by steals of other players
+	TimesRobbed int32 `protobuf:"varint,11,opt,name=times_robbed,json=timesRobbed,proto3" json:"times_robbed,omitempty"`
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PlayerStats) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTotalWinnings() int64 {
+	if x != nil {
+		return x.TotalWinnings
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetQuestionsAnswered() int32 {
+	if x != nil {
+		return x.QuestionsAnswered
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetQuestionsCorrect() int32 {
+	if x != nil {
+		return x.QuestionsCorrect
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetCreditsTaken() int32 {
+	if x != nil {
+		return x.CreditsTaken
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetCreditsDefaulted() int32 {
+	if x != nil {
+		return x.CreditsDefaulted
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetLotteryWins() int32 {
+	if x != nil {
+		return x.LotteryWins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetSteals() int32 {
+	if x != nil {
+		return x.Steals
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTimesRobbed() int32 {
+	if x != nil {
+		return x.TimesRobbed
+	}
+	return 0
+}
+
+type Achievement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "first_million", "question_streak", or "debt_free_win"
+	AchievementId string `protobuf:"bytes,1,opt,name=achievement_id,json=achievementId,proto3" json:"achievement_id,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// milliseconds since epoch
+	UnlockTime int64 `protobuf:"varint,4,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+}
+
+func (x *Achievement) Reset() {
+	*x = Achievement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Achievement) ProtoMessage() {}
+
+func (x *Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Achievement.ProtoReflect.Descriptor instead.
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *Achievement) GetAchievementId() string {
+	if x != nil {
+		return x.AchievementId
+	}
+	return ""
+}
+
+func (x *Achievement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Achievement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Achievement) GetUnlockTime() int64 {
+	if x != nil {
+		return x.UnlockTime
+	}
+	return 0
+}
+
+type GetAchievementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *GetAchievementsRequest) Reset() {
+	*x = GetAchievementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAchievementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAchievementsRequest) ProtoMessage() {}
+
+func (x *GetAchievementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAchievementsRequest.ProtoReflect.Descriptor instead.
+func (*GetAchievementsRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetAchievementsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetAchievementsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ordered by unlock time
+	Achievements []*Achievement `protobuf:"bytes,1,rep,name=achievements,proto3" json:"achievements,omitempty"`
+}
+
+func (x *GetAchievementsResponse) Reset() {
+	*x = GetAchievementsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAchievementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAchievementsResponse) ProtoMessage() {}
+
+func (x *GetAchievementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAchievementsResponse.ProtoReflect.Descriptor instead.
+func (*GetAchievementsResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetAchievementsResponse) GetAchievements() []*Achievement {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+type Challenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "play_games", "win_games", "deposit_only_win",
+	// "answer_questions", "lottery_wins", or "steals"
+	ChallengeId string `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Target      int32  `protobuf:"varint,3,opt,name=target,proto3" json:"target,omitempty"`
+	Progress    int32  `protobuf:"varint,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	Completed   bool   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	// coins for completing the challenge
+	Reward int32 `protobuf:"varint,6,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (x *Challenge) Reset() {
+	*x = Challenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenge) ProtoMessage() {}
+
+func (x *Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Challenge) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *Challenge) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Challenge) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *Challenge) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Challenge) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *Challenge) GetReward() int32 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+type GetChallengesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *GetChallengesRequest) Reset() {
+	*x = GetChallengesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChallengesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengesRequest) ProtoMessage() {}
+
+func (x *GetChallengesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengesRequest.ProtoReflect.Descriptor instead.
+func (*GetChallengesRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetChallengesRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetChallengesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// challenges of the current day with the progress of the player
+	Challenges []*Challenge `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	// coins earned by completing challenges on all days
+	Coins int32 `protobuf:"varint,2,opt,name=coins,proto3" json:"coins,omitempty"`
+	// milliseconds since epoch, when the challenges are rotated
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *GetChallengesResponse) Reset() {
+	*x = GetChallengesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChallengesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengesResponse) ProtoMessage() {}
+
+func (x *GetChallengesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengesResponse.ProtoReflect.Descriptor instead.
+func (*GetChallengesResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetChallengesResponse) GetChallenges() []*Challenge {
+	if x != nil {
+		return x.Challenges
+	}
+	return nil
+}
+
+func (x *GetChallengesResponse) GetCoins() int32 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
+}
+
+func (x *GetChallengesResponse) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type CreateTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// number of games played one after another, from 1 to 10
+	Rounds int32 `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// overrides of the default game config for every round
+	Config *GameConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// seconds, during which players can register,
+	// until the first round starts
+	StartDelay int32 `protobuf:"varint,4,opt,name=start_delay,json=startDelay,proto3" json:"start_delay,omitempty"`
+	// seconds between the end of the round and the start of the next one
+	BreakTime int32 `protobuf:"varint,5,opt,name=break_time,json=breakTime,proto3" json:"break_time,omitempty"`
+}
+
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CreateTournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetStartDelay() int32 {
+	if x != nil {
+		return x.StartDelay
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetBreakTime() int32 {
+	if x != nil {
+		return x.BreakTime
+	}
+	return 0
+}
+
+type CreateTournamentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *CreateTournamentResponse) Reset() {
+	*x = CreateTournamentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentResponse) ProtoMessage() {}
+
+func (x *CreateTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CreateTournamentResponse) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type JoinTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Username     string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Locale       string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                 // same as in JoinRequest
+	AccountToken string `protobuf:"bytes,4,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"` // same as in JoinRequest
+	AvatarId     string `protobuf:"bytes,5,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`             // same as in JoinRequest
+	Color        string `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`                                   // same as in JoinRequest
+}
+
+func (x *JoinTournamentRequest) Reset() {
+	*x = JoinTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTournamentRequest) ProtoMessage() {}
+
+func (x *JoinTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTournamentRequest.ProtoReflect.Descriptor instead.
+func (*JoinTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *JoinTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *JoinTournamentRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *JoinTournamentRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *JoinTournamentRequest) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
+	}
+	return ""
+}
+
+func (x *JoinTournamentRequest) GetAvatarId() string {
+	if x != nil {
+		return x.AvatarId
+	}
+	return ""
+}
+
+func (x *JoinTournamentRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+// Final points of every round are summed up, and
+// the player with the most points wins the tournament.
+type TournamentStandings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// last started round, 0 before the start
+	Round    int32 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Rounds   int32 `protobuf:"varint,4,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	Finished bool  `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	// milliseconds since epoch, when the first round starts
+	StartTime int64 `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// ordered by points
+	Entries []*TournamentStandings_Entry `protobuf:"bytes,7,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *TournamentStandings) Reset() {
+	*x = TournamentStandings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentStandings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentStandings) ProtoMessage() {}
+
+func (x *TournamentStandings) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentStandings.ProtoReflect.Descriptor instead.
+func (*TournamentStandings) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *TournamentStandings) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *TournamentStandings) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentStandings) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentStandings) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *TournamentStandings) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *TournamentStandings) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *TournamentStandings) GetEntries() []*TournamentStandings_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type TournamentEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*TournamentEvent_Standings
+	//	*TournamentEvent_RoundStarted_
+	Event isTournamentEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *TournamentEvent) Reset() {
+	*x = TournamentEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentEvent) ProtoMessage() {}
+
+func (x *TournamentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentEvent.ProtoReflect.Descriptor instead.
+func (*TournamentEvent) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{106}
+}
+
+func (m *TournamentEvent) GetEvent() isTournamentEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *TournamentEvent) GetStandings() *TournamentStandings {
+	if x, ok := x.GetEvent().(*TournamentEvent_Standings); ok {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *TournamentEvent) GetRoundStarted() *TournamentEvent_RoundStarted {
+	if x, ok := x.GetEvent().(*TournamentEvent_RoundStarted_); ok {
+		return x.RoundStarted
+	}
+	return nil
+}
+
+type isTournamentEvent_Event interface {
+	isTournamentEvent_Event()
+}
+
+type TournamentEvent_Standings struct {
+	// Sent after the registration and after every round. The stream is
+	// closed after the standings of the finished tournament are sent.
+	Standings *TournamentStandings `protobuf:"bytes,1,opt,name=standings,proto3,oneof"`
+}
+
+type TournamentEvent_RoundStarted_ struct {
+	// Sent at the start of every round, the player has to open the
+	// stream of the game, which starts after a countdown.
+	RoundStarted *TournamentEvent_RoundStarted `protobuf:"bytes,2,opt,name=round_started,json=roundStarted,proto3,oneof"`
+}
+
+func (*TournamentEvent_Standings) isTournamentEvent_Event() {}
+
+func (*TournamentEvent_RoundStarted_) isTournamentEvent_Event() {}
+
+type GetStandingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *GetStandingsRequest) Reset() {
+	*x = GetStandingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsRequest) ProtoMessage() {}
+
+func (x *GetStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetStandingsRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type GetStandingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Standings *TournamentStandings `protobuf:"bytes,1,opt,name=standings,proto3" json:"standings,omitempty"`
+}
+
+func (x *GetStandingsResponse) Reset() {
+	*x = GetStandingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsResponse) ProtoMessage() {}
+
+func (x *GetStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsResponse.ProtoReflect.Descriptor instead.
+func (*GetStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetStandingsResponse) GetStandings() *TournamentStandings {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type GetPlayerStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *GetPlayerStatsRequest) Reset() {
+	*x = GetPlayerStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatsRequest) ProtoMessage() {}
+
+func (x *GetPlayerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetPlayerStatsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetPlayerStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats *PlayerStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetPlayerStatsResponse) Reset() {
+	*x = GetPlayerStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatsResponse) ProtoMessage() {}
+
+func (x *GetPlayerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetPlayerStatsResponse) GetStats() *PlayerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username    string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// identifier or URL of the avatar, which is chosen by the client
+	Avatar string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// milliseconds since epoch
+	CreatedTime int64        `protobuf:"varint,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	Stats       *PlayerStats `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Profile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Profile) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *Profile) GetCreatedTime() int64 {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return 0
+}
+
+func (x *Profile) GetStats() *PlayerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// username is used, if it is empty
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Avatar      string `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RegisterRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+type RegisterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountToken string   `protobuf:"bytes,1,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"`
+	Profile      *Profile `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RegisterResponse) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
+	}
+	return ""
+}
+
+func (x *RegisterResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountToken string   `protobuf:"bytes,1,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"`
+	Profile      *Profile `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *LoginResponse) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetProfileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Empty fields are left unchanged.
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountToken string `protobuf:"bytes,1,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"`
+	DisplayName  string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Avatar       string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *UpdateProfileRequest) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window LeaderboardWindow `protobuf:"varint,1,opt,name=window,proto3,enum=server.LeaderboardWindow" json:"window,omitempty"`
+	Order  LeaderboardOrder  `protobuf:"varint,2,opt,name=order,proto3,enum=server.LeaderboardOrder" json:"order,omitempty"`
+	// number of the entries to skip from the top
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// maximal number of the returned entries, 0 means 10,
+	// and it cannot be greater than 100
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetLeaderboardRequest) GetWindow() LeaderboardWindow {
+	if x != nil {
+		return x.Window
+	}
+	return LeaderboardWindow_LEADERBOARD_WINDOW_ALL_TIME
+}
+
+func (x *GetLeaderboardRequest) GetOrder() LeaderboardOrder {
+	if x != nil {
+		return x.Order
+	}
+	return LeaderboardOrder_LEADERBOARD_ORDER_RATING
+}
+
+func (x *GetLeaderboardRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank     int32  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"` // starts from 1
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// rating after the latest game in the window
+	Rating int32 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	// sum of the final points minus the initial points
+	// over all games in the window
+	TotalWinnings     int64 `protobuf:"varint,4,opt,name=total_winnings,json=totalWinnings,proto3" json:"total_winnings,omitempty"`
+	Games             int32 `protobuf:"varint,5,opt,name=games,proto3" json:"games,omitempty"`
+	Wins              int32 `protobuf:"varint,6,opt,name=wins,proto3" json:"wins,omitempty"`
+	WinRatePercentage int32 `protobuf:"varint,7,opt,name=win_rate_percentage,json=winRatePercentage,proto3" json:"win_rate_percentage,omitempty"`
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetTotalWinnings() int64 {
+	if x != nil {
+		return x.TotalWinnings
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetWinRatePercentage() int32 {
+	if x != nil {
+		return x.WinRatePercentage
+	}
+	return 0
+}
+
+type GetLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// number of all ranked players in the window
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GameSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// milliseconds since epoch, when the game has finished
+	FinishTime int64 `protobuf:"varint,2,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	// the same as the Finish event sent to the players
+	Finish  *StreamResponse_Finish `protobuf:"bytes,3,opt,name=finish,proto3" json:"finish,omitempty"`
+	Results *GameResults           `protobuf:"bytes,4,opt,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GameSummary) Reset() {
+	*x = GameSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSummary) ProtoMessage() {}
+
+func (x *GameSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSummary.ProtoReflect.Descriptor instead.
+func (*GameSummary) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GameSummary) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameSummary) GetFinishTime() int64 {
+	if x != nil {
+		return x.FinishTime
+	}
+	return 0
+}
+
+func (x *GameSummary) GetFinish() *StreamResponse_Finish {
+	if x != nil {
+		return x.Finish
+	}
+	return nil
+}
+
+func (x *GameSummary) GetResults() *GameResults {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetGameSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetGameSummaryRequest) Reset() {
+	*x = GetGameSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameSummaryRequest) ProtoMessage() {}
+
+func (x *GetGameSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetGameSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetGameSummaryRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetGameSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Summary *GameSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *GetGameSummaryResponse) Reset() {
+	*x = GetGameSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameSummaryResponse) ProtoMessage() {}
+
+func (x *GetGameSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetGameSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetGameSummaryResponse) GetSummary() *GameSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type ListGameHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// milliseconds since epoch, games, which have finished in
+	// [since, until), are returned; not bounded, if 0
+	Since int64 `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	Until int64 `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`
+	// number of the newest games to skip
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// maximal number of the returned games, 0 means 20,
+	// and it cannot be greater than 100
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListGameHistoryRequest) Reset() {
+	*x = ListGameHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGameHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameHistoryRequest) ProtoMessage() {}
+
+func (x *ListGameHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListGameHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListGameHistoryRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListGameHistoryRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListGameHistoryRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListGameHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListGameHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListGameHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from the newest to the oldest
+	Games []*GameSummary `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	// offset of the next page, 0 if there are no more games
+	NextOffset int32 `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+}
+
+func (x *ListGameHistoryResponse) Reset() {
+	*x = ListGameHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGameHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameHistoryResponse) ProtoMessage() {}
+
+func (x *ListGameHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListGameHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListGameHistoryResponse) GetGames() []*GameSummary {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *ListGameHistoryResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+type ReplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// how many times faster than the original game events are sent,
+	// 0 means the original speed
+	Speed float64 `protobuf:"fixed64,2,opt,name=speed,proto3" json:"speed,omitempty"`
+}
+
+func (x *ReplayRequest) Reset() {
+	*x = ReplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayRequest) ProtoMessage() {}
+
+func (x *ReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayRequest.ProtoReflect.Descriptor instead.
+func (*ReplayRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ReplayRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ReplayRequest) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+// Single action from the journal of the finished game.
+type ReplayEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence int64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// milliseconds since the first event of the game
+	TimeOffset int64 `protobuf:"varint,2,opt,name=time_offset,json=timeOffset,proto3" json:"time_offset,omitempty"`
+	// "join", "leave", "start", "credit", "deposit", "return_credit",
+	// "repay_credit", "return_deposit", "withdraw_deposit", "lottery", "jackpot", "question_bid", "question_answer",
+	// "question_timeout", "theft", "evict", "loan_offer", "loan_accept", "loan_repay", "loan_default",
+	// "loan_collect", "trade", "buy_shares", "sell_shares", "stock_tick", "insurance",
+	// "insurance_payout", "tax", "inflation", "steal", "steal_failure", "guard", "team", "rename", "forfeit",
+	// or "finish"
+	Kind   string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// points moved from the bank to the player (negative, if it is
+	// the opposite), for loan events, points move from the lender
+	// instead of the bank; for "join" events, initial points of the player
+	Value int32 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	// id of the credit, deposit, or question the event refers to
+	RefId string `protobuf:"bytes,6,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	// set only for "join" and "rename" events
+	Username string `protobuf:"bytes,7,opt,name=username,proto3" json:"username,omitempty"`
+	// set only for "start" events
+	Config *GameConfig `protobuf:"bytes,8,opt,name=config,proto3" json:"config,omitempty"`
+	// for "repay_credit" and "withdraw_deposit" events, returned part
+	// of the credit or deposit; the whole position is returned, if 0
+	Principal int32 `protobuf:"varint,9,opt,name=principal,proto3" json:"principal,omitempty"`
+	// set only for "loan_offer" events
+	Loan *Loan `protobuf:"bytes,10,opt,name=loan,proto3" json:"loan,omitempty"`
+	// set only for "trade" events
+	Trade *Trade `protobuf:"bytes,11,opt,name=trade,proto3" json:"trade,omitempty"`
+	// for "buy_shares" and "sell_shares" events, number of shares
+	Shares int32 `protobuf:"varint,12,opt,name=shares,proto3" json:"shares,omitempty"`
+	// for "buy_shares", "sell_shares", and "stock_tick" events,
+	// price of a single share; for "inflation" events, new price level
+	Price int32 `protobuf:"varint,13,opt,name=price,proto3" json:"price,omitempty"`
+	// for "credit" and "deposit" events, interest decided by the bank
+	Interest int32 `protobuf:"varint,14,opt,name=interest,proto3" json:"interest,omitempty"`
+	// for "steal" and "steal_failure" events, the player, from whom the
+	// points are stolen, or to whom the penalty is paid
+	TargetId string `protobuf:"bytes,15,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// for "join" and "team" events, team of the player
+	Team int32 `protobuf:"varint,16,opt,name=team,proto3" json:"team,omitempty"`
+	// for "join" events, true, if the player is the bot
+	Bot bool `protobuf:"varint,17,opt,name=bot,proto3" json:"bot,omitempty"`
+	// for "join" events, avatar and final color of the player
+	AvatarId string `protobuf:"bytes,18,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`
+	Color    string `protobuf:"bytes,19,opt,name=color,proto3" json:"color,omitempty"`
+	// for "deposit" events, true, if the deposit of the AFK
+	// player is rolled over by the server
+	Auto bool `protobuf:"varint,20,opt,name=auto,proto3" json:"auto,omitempty"`
+}
+
+func (x *ReplayEvent) Reset() {
+	*x = ReplayEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEvent) ProtoMessage() {}
+
+func (x *ReplayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEvent.ProtoReflect.Descriptor instead.
+func (*ReplayEvent) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ReplayEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetTimeOffset() int64 {
+	if x != nil {
+		return x.TimeOffset
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ReplayEvent) GetPrincipal() int32 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *ReplayEvent) GetTrade() *Trade {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+func (x *ReplayEvent) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetInterest() int32 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
+
+func (x *ReplayEvent) GetAvatarId() string {
+	if x != nil {
+		return x.AvatarId
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
+// Summary of the game for the administrators.
+type AdminGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// empty for games, which have not been created as rooms
+	Name          string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status        GameStatus `protobuf:"varint,3,opt,name=status,proto3,enum=server.GameStatus" json:"status,omitempty"`
+	PlayerCount   int32      `protobuf:"varint,4,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	RemainingTime int32      `protobuf:"varint,5,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+}
+
+func (x *AdminGame) Reset() {
+	*x = AdminGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGame) ProtoMessage() {}
+
+func (x *AdminGame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGame.ProtoReflect.Descriptor instead.
+func (*AdminGame) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *AdminGame) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AdminGame) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminGame) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_WAITING
+}
+
+func (x *AdminGame) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *AdminGame) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+type AdminPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player   *Player     `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Credits  []*Position `protobuf:"bytes,2,rep,name=credits,proto3" json:"credits,omitempty"`
+	Deposits []*Position `protobuf:"bytes,3,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	// whether the player has opened the stream
+	Connected bool `protobuf:"varint,4,opt,name=connected,proto3" json:"connected,omitempty"`
+}
+
+func (x *AdminPlayer) Reset() {
+	*x = AdminPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPlayer) ProtoMessage() {}
+
+func (x *AdminPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPlayer.ProtoReflect.Descriptor instead.
+func (*AdminPlayer) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *AdminPlayer) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *AdminPlayer) GetCredits() []*Position {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *AdminPlayer) GetDeposits() []*Position {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+func (x *AdminPlayer) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+type ListGamesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListGamesRequest) Reset() {
+	*x = ListGamesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesRequest) ProtoMessage() {}
+
+func (x *ListGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListGamesRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{132}
+}
+
+type ListGamesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// waiting game, rooms, and active games
+	Games []*AdminGame `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+}
+
+func (x *ListGamesResponse) Reset() {
+	*x = ListGamesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesResponse) ProtoMessage() {}
+
+func (x *ListGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListGamesResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListGamesResponse) GetGames() []*AdminGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type InspectGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *InspectGameRequest) Reset() {
+	*x = InspectGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectGameRequest) ProtoMessage() {}
+
+func (x *InspectGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectGameRequest.ProtoReflect.Descriptor instead.
+func (*InspectGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *InspectGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type InspectGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State   *GameState     `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Name    string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Config  *GameConfig    `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Players []*AdminPlayer `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *InspectGameResponse) Reset() {
+	*x = InspectGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectGameResponse) ProtoMessage() {}
+
+func (x *InspectGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectGameResponse.ProtoReflect.Descriptor instead.
+func (*InspectGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *InspectGameResponse) GetState() *GameState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *InspectGameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InspectGameResponse) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *InspectGameResponse) GetPlayers() []*AdminPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type FinishGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *FinishGameRequest) Reset() {
+	*x = FinishGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishGameRequest) ProtoMessage() {}
+
+func (x *FinishGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishGameRequest.ProtoReflect.Descriptor instead.
+func (*FinishGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *FinishGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type FinishGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FinishGameResponse) Reset() {
+	*x = FinishGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishGameResponse) ProtoMessage() {}
+
+func (x *FinishGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishGameResponse.ProtoReflect.Descriptor instead.
+func (*FinishGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{137}
+}
+
+type EvictPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *EvictPlayerRequest) Reset() {
+	*x = EvictPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvictPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictPlayerRequest) ProtoMessage() {}
+
+func (x *EvictPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictPlayerRequest.ProtoReflect.Descriptor instead.
+func (*EvictPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *EvictPlayerRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *EvictPlayerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type EvictPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EvictPlayerResponse) Reset() {
+	*x = EvictPlayerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvictPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictPlayerResponse) ProtoMessage() {}
+
+func (x *EvictPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictPlayerResponse.ProtoReflect.Descriptor instead.
+func (*EvictPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{139}
+}
+
+type Ban struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind BanKind `protobuf:"varint,1,opt,name=kind,proto3,enum=server.BanKind" json:"kind,omitempty"`
+	// banned username or address (without port)
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// unix time in milliseconds, when the ban ends
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *Ban) Reset() {
+	*x = Ban{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ban) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ban) ProtoMessage() {}
+
+func (x *Ban) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ban.ProtoReflect.Descriptor instead.
+func (*Ban) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *Ban) GetKind() BanKind {
+	if x != nil {
+		return x.Kind
+	}
+	return BanKind_BAN_KIND_USERNAME
+}
+
+func (x *Ban) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Ban) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type BanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind  BanKind `protobuf:"varint,1,opt,name=kind,proto3,enum=server.BanKind" json:"kind,omitempty"`
+	Value string  `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// seconds, for which the ban lasts; the default
+	// duration of the server is used, if 0
+	Duration int32 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *BanRequest) Reset() {
+	*x = BanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanRequest) ProtoMessage() {}
+
+func (x *BanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanRequest.ProtoReflect.Descriptor instead.
+func (*BanRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *BanRequest) GetKind() BanKind {
+	if x != nil {
+		return x.Kind
+	}
+	return BanKind_BAN_KIND_USERNAME
+}
+
+func (x *BanRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *BanRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type BanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ban *Ban `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
+}
+
+func (x *BanResponse) Reset() {
+	*x = BanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanResponse) ProtoMessage() {}
+
+func (x *BanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanResponse.ProtoReflect.Descriptor instead.
+func (*BanResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *BanResponse) GetBan() *Ban {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+type UnbanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind  BanKind `protobuf:"varint,1,opt,name=kind,proto3,enum=server.BanKind" json:"kind,omitempty"`
+	Value string  `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *UnbanRequest) Reset() {
+	*x = UnbanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanRequest) ProtoMessage() {}
+
+func (x *UnbanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanRequest.ProtoReflect.Descriptor instead.
+func (*UnbanRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *UnbanRequest) GetKind() BanKind {
+	if x != nil {
+		return x.Kind
+	}
+	return BanKind_BAN_KIND_USERNAME
+}
+
+func (x *UnbanRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type UnbanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnbanResponse) Reset() {
+	*x = UnbanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanResponse) ProtoMessage() {}
+
+func (x *UnbanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanResponse.ProtoReflect.Descriptor instead.
+func (*UnbanResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{144}
+}
+
+type ListBansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBansRequest) Reset() {
+	*x = ListBansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansRequest) ProtoMessage() {}
+
+func (x *ListBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansRequest.ProtoReflect.Descriptor instead.
+func (*ListBansRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{145}
+}
+
+type ListBansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bans []*Ban `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+}
+
+func (x *ListBansResponse) Reset() {
+	*x = ListBansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansResponse) ProtoMessage() {}
+
+func (x *ListBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansResponse.ProtoReflect.Descriptor instead.
+func (*ListBansResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ListBansResponse) GetBans() []*Ban {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+// Finished game exported for offline analysis. ExportGame encodes it as
+// JSON, in which fields are named in lowerCamelCase, e.g. "gameId", enums
+// are written by their names, and 64-bit integers are written as strings.
+type GameExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version of the schema, it is incremented on incompatible changes
+	Version int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	GameId  string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// milliseconds since epoch, when the first and the last events of
+	// the journal have happened
+	CreateTime int64 `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	FinishTime int64 `protobuf:"varint,4,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	// config, with which the game has been started,
+	// not set, if it has been finished before the start
+	Config *GameConfig `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	// full journal, time offsets are counted from the first event
+	Events []*ReplayEvent `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	// final state of the players ordered by their ids,
+	// and the bank with "bank" id as the last one
+	Players []*Player `protobuf:"bytes,7,rep,name=players,proto3" json:"players,omitempty"`
+	// final results rebuilt from the journal, the tie broken
+	// by overtime is not known
+	Results *GameResults `protobuf:"bytes,8,opt,name=results,proto3" json:"results,omitempty"`
+	// seed of the random numbers of the game, 0 if it hasn't started
+	Seed int64 `protobuf:"varint,9,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *GameExport) Reset() {
+	*x = GameExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameExport) ProtoMessage() {}
+
+func (x *GameExport) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameExport.ProtoReflect.Descriptor instead.
+func (*GameExport) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GameExport) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GameExport) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameExport) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *GameExport) GetFinishTime() int64 {
+	if x != nil {
+		return x.FinishTime
+	}
+	return 0
+}
+
+func (x *GameExport) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GameExport) GetEvents() []*ReplayEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GameExport) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameExport) GetResults() *GameResults {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GameExport) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type ExportGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *ExportGameRequest) Reset() {
+	*x = ExportGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameRequest) ProtoMessage() {}
+
+func (x *ExportGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameRequest.ProtoReflect.Descriptor instead.
+func (*ExportGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ExportGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ExportGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// GameExport encoded as JSON
+	Json string `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *ExportGameResponse) Reset() {
+	*x = ExportGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameResponse) ProtoMessage() {}
+
+func (x *ExportGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameResponse.ProtoReflect.Descriptor instead.
+func (*ExportGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ExportGameResponse) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+type SetDefaultConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *GameConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetDefaultConfigRequest) Reset() {
+	*x = SetDefaultConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDefaultConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultConfigRequest) ProtoMessage() {}
+
+func (x *SetDefaultConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultConfigRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SetDefaultConfigRequest) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetDefaultConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// default config after the overrides
+	Config *GameConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetDefaultConfigResponse) Reset() {
+	*x = SetDefaultConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDefaultConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultConfigResponse) ProtoMessage() {}
+
+func (x *SetDefaultConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultConfigResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultConfigResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *SetDefaultConfigResponse) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type StreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*StreamResponse_Join_
+	//	*StreamResponse_Leave_
+	//	*StreamResponse_Start_
+	//	*StreamResponse_Finish_
+	//	*StreamResponse_Transaction_
+	//	*StreamResponse_State
+	//	*StreamResponse_StockTick_
+	//	*StreamResponse_Inflation_
+	//	*StreamResponse_Chat
+	//	*StreamResponse_Reaction_
+	//	*StreamResponse_Kicked_
+	//	*StreamResponse_Countdown_
+	//	*StreamResponse_Achievement
+	//	*StreamResponse_TeamChange_
+	//	*StreamResponse_CoopProgress
+	//	*StreamResponse_Pause_
+	//	*StreamResponse_Resume_
+	//	*StreamResponse_Extension_
+	//	*StreamResponse_Overtime_
+	//	*StreamResponse_OvertimeAnswer_
+	//	*StreamResponse_Results
+	//	*StreamResponse_Heartbeat_
+	//	*StreamResponse_Batch_
+	//	*StreamResponse_TimeTick_
+	//	*StreamResponse_Rename_
+	//	*StreamResponse_Afk_
+	Event isStreamResponse_Event `protobuf_oneof:"event"`
+	// Events broadcast to all players of the game are numbered from 1
+	// without gaps, so that clients, which have missed some of them, can
+	// resync with GetGameState. Other events, e.g. achievements or team
+	// chat, carry the number of the latest broadcast event.
+	Sequence int64 `protobuf:"varint,22,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *StreamResponse) Reset() {
+	*x = StreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse) ProtoMessage() {}
+
+func (x *StreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse.ProtoReflect.Descriptor instead.
+func (*StreamResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152}
+}
+
+func (m *StreamResponse) GetEvent() isStreamResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetJoin() *StreamResponse_Join {
+	if x, ok := x.GetEvent().(*StreamResponse_Join_); ok {
+		return x.Join
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetLeave() *StreamResponse_Leave {
+	if x, ok := x.GetEvent().(*StreamResponse_Leave_); ok {
+		return x.Leave
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetStart() *StreamResponse_Start {
+	if x, ok := x.GetEvent().(*StreamResponse_Start_); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetFinish() *StreamResponse_Finish {
+	if x, ok := x.GetEvent().(*StreamResponse_Finish_); ok {
+		return x.Finish
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetTransaction() *StreamResponse_Transaction {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetState() *GameState {
+	if x, ok := x.GetEvent().(*StreamResponse_State); ok {
+		return x.State
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetStockTick() *StreamResponse_StockTick {
+	if x, ok := x.GetEvent().(*StreamResponse_StockTick_); ok {
+		return x.StockTick
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetInflation() *StreamResponse_Inflation {
+	if x, ok := x.GetEvent().(*StreamResponse_Inflation_); ok {
+		return x.Inflation
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetChat() *ChatMessage {
+	if x, ok := x.GetEvent().(*StreamResponse_Chat); ok {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetReaction() *StreamResponse_Reaction {
+	if x, ok := x.GetEvent().(*StreamResponse_Reaction_); ok {
+		return x.Reaction
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetKicked() *StreamResponse_Kicked {
+	if x, ok := x.GetEvent().(*StreamResponse_Kicked_); ok {
+		return x.Kicked
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetCountdown() *StreamResponse_Countdown {
+	if x, ok := x.GetEvent().(*StreamResponse_Countdown_); ok {
+		return x.Countdown
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetAchievement() *Achievement {
+	if x, ok := x.GetEvent().(*StreamResponse_Achievement); ok {
+		return x.Achievement
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetTeamChange() *StreamResponse_TeamChange {
+	if x, ok := x.GetEvent().(*StreamResponse_TeamChange_); ok {
+		return x.TeamChange
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetCoopProgress() *CoopProgress {
+	if x, ok := x.GetEvent().(*StreamResponse_CoopProgress); ok {
+		return x.CoopProgress
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetPause() *StreamResponse_Pause {
+	if x, ok := x.GetEvent().(*StreamResponse_Pause_); ok {
+		return x.Pause
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetResume() *StreamResponse_Resume {
+	if x, ok := x.GetEvent().(*StreamResponse_Resume_); ok {
+		return x.Resume
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetExtension() *StreamResponse_Extension {
+	if x, ok := x.GetEvent().(*StreamResponse_Extension_); ok {
+		return x.Extension
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetOvertime() *StreamResponse_Overtime {
+	if x, ok := x.GetEvent().(*StreamResponse_Overtime_); ok {
+		return x.Overtime
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetOvertimeAnswer() *StreamResponse_OvertimeAnswer {
+	if x, ok := x.GetEvent().(*StreamResponse_OvertimeAnswer_); ok {
+		return x.OvertimeAnswer
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetResults() *GameResults {
+	if x, ok := x.GetEvent().(*StreamResponse_Results); ok {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetHeartbeat() *StreamResponse_Heartbeat {
+	if x, ok := x.GetEvent().(*StreamResponse_Heartbeat_); ok {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetBatch() *StreamResponse_Batch {
+	if x, ok := x.GetEvent().(*StreamResponse_Batch_); ok {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetTimeTick() *StreamResponse_TimeTick {
+	if x, ok := x.GetEvent().(*StreamResponse_TimeTick_); ok {
+		return x.TimeTick
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetRename() *StreamResponse_Rename {
+	if x, ok := x.GetEvent().(*StreamResponse_Rename_); ok {
+		return x.Rename
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetAfk() *StreamResponse_Afk {
+	if x, ok := x.GetEvent().(*StreamResponse_Afk_); ok {
+		return x.Afk
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type isStreamResponse_Event interface {
+	isStreamResponse_Event()
+}
+
+type StreamResponse_Join_ struct {
+	// Events for game in "Waiting" state.
+	Join *StreamResponse_Join `protobuf:"bytes,1,opt,name=join,proto3,oneof"`
+}
+
+type StreamResponse_Leave_ struct {
+	Leave *StreamResponse_Leave `protobuf:"bytes,2,opt,name=leave,proto3,oneof"`
+}
+
+type StreamResponse_Start_ struct {
+	// Events for game in "Active" state.
+	Start *StreamResponse_Start `protobuf:"bytes,3,opt,name=start,proto3,oneof"`
+}
+
+type StreamResponse_Finish_ struct {
+	Finish *StreamResponse_Finish `protobuf:"bytes,4,opt,name=finish,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_ struct {
+	Transaction *StreamResponse_Transaction `protobuf:"bytes,5,opt,name=transaction,proto3,oneof"`
+}
+
+type StreamResponse_State struct {
+	// Sent as the first event of the reconnected stream.
+	State *GameState `protobuf:"bytes,6,opt,name=state,proto3,oneof"`
+}
+
+type StreamResponse_StockTick_ struct {
+	StockTick *StreamResponse_StockTick `protobuf:"bytes,7,opt,name=stock_tick,json=stockTick,proto3,oneof"`
+}
+
+type StreamResponse_Inflation_ struct {
+	Inflation *StreamResponse_Inflation `protobuf:"bytes,8,opt,name=inflation,proto3,oneof"`
+}
+
+type StreamResponse_Chat struct {
+	// Sent in both "Waiting" and "Active" states.
+	Chat *ChatMessage `protobuf:"bytes,9,opt,name=chat,proto3,oneof"`
+}
+
+type StreamResponse_Reaction_ struct {
+	Reaction *StreamResponse_Reaction `protobuf:"bytes,10,opt,name=reaction,proto3,oneof"`
+}
+
+type StreamResponse_Kicked_ struct {
+	// Sent only to the player, who has been kicked from the waiting game.
+	// The stream is closed afterwards.
+	Kicked *StreamResponse_Kicked `protobuf:"bytes,11,opt,name=kicked,proto3,oneof"`
+}
+
+type StreamResponse_Countdown_ struct {
+	// Sent every second before the start of the game, which has start
+	// countdown or has been started automatically.
+	Countdown *StreamResponse_Countdown `protobuf:"bytes,12,opt,name=countdown,proto3,oneof"`
+}
+
+type StreamResponse_Achievement struct {
+	// Sent only to the player, who has unlocked the achievement.
+	Achievement *Achievement `protobuf:"bytes,13,opt,name=achievement,proto3,oneof"`
+}
+
+type StreamResponse_TeamChange_ struct {
+	// Sent, when the player changes the team in the waiting game.
+	TeamChange *StreamResponse_TeamChange `protobuf:"bytes,14,opt,name=team_change,json=teamChange,proto3,oneof"`
+}
+
+type StreamResponse_CoopProgress struct {
+	// Sent in co-op mode every time the collective progress changes.
+	CoopProgress *CoopProgress `protobuf:"bytes,15,opt,name=coop_progress,json=coopProgress,proto3,oneof"`
+}
+
+type StreamResponse_Pause_ struct {
+	// Sent, when the active game is paused.
+	Pause *StreamResponse_Pause `protobuf:"bytes,16,opt,name=pause,proto3,oneof"`
+}
+
+type StreamResponse_Resume_ struct {
+	// Sent, when the paused game is resumed.
+	Resume *StreamResponse_Resume `protobuf:"bytes,17,opt,name=resume,proto3,oneof"`
+}
+
+type StreamResponse_Extension_ struct {
+	// Sent, when the player votes to extend the game,
+	// and when the game is extended.
+	Extension *StreamResponse_Extension `protobuf:"bytes,18,opt,name=extension,proto3,oneof"`
+}
+
+type StreamResponse_Overtime_ struct {
+	// Sent, when the round of overtime starts.
+	Overtime *StreamResponse_Overtime `protobuf:"bytes,19,opt,name=overtime,proto3,oneof"`
+}
+
+type StreamResponse_OvertimeAnswer_ struct {
+	// Sent, when the tied player answers the overtime question.
+	OvertimeAnswer *StreamResponse_OvertimeAnswer `protobuf:"bytes,20,opt,name=overtime_answer,json=overtimeAnswer,proto3,oneof"`
+}
+
+type StreamResponse_Results struct {
+	// Sent right before Finish with the final results of the game.
+	Results *GameResults `protobuf:"bytes,21,opt,name=results,proto3,oneof"`
+}
+
+type StreamResponse_Heartbeat_ struct {
+	// Sent only to the player periodically, if the server has heartbeats
+	// enabled, so that quiet game can be told apart from dead connection.
+	Heartbeat *StreamResponse_Heartbeat `protobuf:"bytes,23,opt,name=heartbeat,proto3,oneof"`
+}
+
+type StreamResponse_Batch_ struct {
+	// Sent instead of the events collected during the batch interval, if
+	// the client has requested batching.
+	Batch *StreamResponse_Batch `protobuf:"bytes,24,opt,name=batch,proto3,oneof"`
+}
+
+type StreamResponse_TimeTick_ struct {
+	// Sent periodically during the active game, if the game has time
+	// ticks, and when one minute and ten seconds are left.
+	TimeTick *StreamResponse_TimeTick `protobuf:"bytes,25,opt,name=time_tick,json=timeTick,proto3,oneof"`
+}
+
+type StreamResponse_Rename_ struct {
+	// Sent, when the player changes the username in the waiting game.
+	Rename *StreamResponse_Rename `protobuf:"bytes,26,opt,name=rename,proto3,oneof"`
+}
+
+type StreamResponse_Afk_ struct {
+	// Sent, when the player of the active game becomes AFK,
+	// returns, or forfeits the game for being AFK.
+	Afk *StreamResponse_Afk `protobuf:"bytes,27,opt,name=afk,proto3,oneof"`
+}
+
+func (*StreamResponse_Join_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Leave_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Start_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Finish_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Transaction_) isStreamResponse_Event() {}
+
+func (*StreamResponse_State) isStreamResponse_Event() {}
+
+func (*StreamResponse_StockTick_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Inflation_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Chat) isStreamResponse_Event() {}
+
+func (*StreamResponse_Reaction_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Kicked_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Countdown_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Achievement) isStreamResponse_Event() {}
+
+func (*StreamResponse_TeamChange_) isStreamResponse_Event() {}
+
+func (*StreamResponse_CoopProgress) isStreamResponse_Event() {}
+
+func (*StreamResponse_Pause_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Resume_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Extension_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Overtime_) isStreamResponse_Event() {}
+
+func (*StreamResponse_OvertimeAnswer_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Results) isStreamResponse_Event() {}
+
+func (*StreamResponse_Heartbeat_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Batch_) isStreamResponse_Event() {}
+
+func (*StreamResponse_TimeTick_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Rename_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Afk_) isStreamResponse_Event() {}
+
+type MatchmakingEvent_Searching struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// maximal difference of ratings of the matched players,
+	// which grows while the player is waiting
+	RatingWindow int32 `protobuf:"varint,1,opt,name=rating_window,json=ratingWindow,proto3" json:"rating_window,omitempty"`
+	// number of waiting players
+	QueueSize int32 `protobuf:"varint,2,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// seconds since the player has been enqueued
+	WaitingTime int32 `protobuf:"varint,3,opt,name=waiting_time,json=waitingTime,proto3" json:"waiting_time,omitempty"`
+}
+
+func (x *MatchmakingEvent_Searching) Reset() {
+	*x = MatchmakingEvent_Searching{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchmakingEvent_Searching) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchmakingEvent_Searching) ProtoMessage() {}
+
+func (x *MatchmakingEvent_Searching) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchmakingEvent_Searching.ProtoReflect.Descriptor instead.
+func (*MatchmakingEvent_Searching) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{73, 0}
+}
+
+func (x *MatchmakingEvent_Searching) GetRatingWindow() int32 {
+	if x != nil {
+		return x.RatingWindow
+	}
+	return 0
+}
+
+func (x *MatchmakingEvent_Searching) GetQueueSize() int32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+func (x *MatchmakingEvent_Searching) GetWaitingTime() int32 {
+	if x != nil {
+		return x.WaitingTime
+	}
+	return 0
+}
+
+type TournamentStandings_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank     int32  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"` // starts from 1
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Points   int32  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	// final points in every finished round, 0 for the rounds,
+	// which the player hasn't finished
+	RoundPoints []int32 `protobuf:"varint,4,rep,packed,name=round_points,json=roundPoints,proto3" json:"round_points,omitempty"`
+}
+
+func (x *TournamentStandings_Entry) Reset() {
+	*x = TournamentStandings_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentStandings_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentStandings_Entry) ProtoMessage() {}
+
+func (x *TournamentStandings_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentStandings_Entry.ProtoReflect.Descriptor instead.
+func (*TournamentStandings_Entry) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{105, 0}
+}
+
+func (x *TournamentStandings_Entry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TournamentStandings_Entry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TournamentStandings_Entry) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *TournamentStandings_Entry) GetRoundPoints() []int32 {
+	if x != nil {
+		return x.RoundPoints
+	}
+	return nil
+}
+
+type TournamentEvent_RoundStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round int32         `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Join  *JoinResponse `protobuf:"bytes,2,opt,name=join,proto3" json:"join,omitempty"`
+}
+
+func (x *TournamentEvent_RoundStarted) Reset() {
+	*x = TournamentEvent_RoundStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentEvent_RoundStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentEvent_RoundStarted) ProtoMessage() {}
+
+func (x *TournamentEvent_RoundStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentEvent_RoundStarted.ProtoReflect.Descriptor instead.
+func (*TournamentEvent_RoundStarted) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{106, 0}
+}
+
+func (x *TournamentEvent_RoundStarted) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentEvent_RoundStarted) GetJoin() *JoinResponse {
+	if x != nil {
+		return x.Join
+	}
+	return nil
+}
+
+type StreamResponse_Overtime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from 1 to 3
+	Round int32 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// tied players, who can answer the question
+	UserIds  []string `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Question string   `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	Answers  []string `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`
+	// seconds to answer the question
+	RemainingTime int32 `protobuf:"varint,5,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+}
+
+func (x *StreamResponse_Overtime) Reset() {
+	*x = StreamResponse_Overtime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Overtime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Overtime) ProtoMessage() {}
+
+func (x *StreamResponse_Overtime) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Overtime.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Overtime) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 0}
+}
+
+func (x *StreamResponse_Overtime) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *StreamResponse_Overtime) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *StreamResponse_Overtime) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *StreamResponse_Overtime) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *StreamResponse_Overtime) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+type StreamResponse_OvertimeAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// the first correct answer wins the game
+	Correct bool `protobuf:"varint,2,opt,name=correct,proto3" json:"correct,omitempty"`
+}
+
+func (x *StreamResponse_OvertimeAnswer) Reset() {
+	*x = StreamResponse_OvertimeAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_OvertimeAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_OvertimeAnswer) ProtoMessage() {}
+
+func (x *StreamResponse_OvertimeAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_OvertimeAnswer.ProtoReflect.Descriptor instead.
+func (*StreamResponse_OvertimeAnswer) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 1}
+}
+
+func (x *StreamResponse_OvertimeAnswer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_OvertimeAnswer) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+type StreamResponse_Extension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// player, who has voted
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// number of players, who have voted since the last extension
+	Votes int32 `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	// number of votes, with which the game is extended
+	Needed int32 `protobuf:"varint,3,opt,name=needed,proto3" json:"needed,omitempty"`
+	// true, if the game has been extended by this vote
+	Extended bool `protobuf:"varint,4,opt,name=extended,proto3" json:"extended,omitempty"`
+	// time, when the game finishes, in milliseconds since epoch
+	EndTime int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// seconds until the game finishes
+	RemainingTime int32 `protobuf:"varint,6,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+}
+
+func (x *StreamResponse_Extension) Reset() {
+	*x = StreamResponse_Extension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Extension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Extension) ProtoMessage() {}
+
+func (x *StreamResponse_Extension) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Extension.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Extension) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 2}
+}
+
+func (x *StreamResponse_Extension) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Extension) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *StreamResponse_Extension) GetNeeded() int32 {
+	if x != nil {
+		return x.Needed
+	}
+	return 0
+}
+
+func (x *StreamResponse_Extension) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *StreamResponse_Extension) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *StreamResponse_Extension) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+type StreamResponse_TimeTick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seconds until the game finishes by the server clock
+	RemainingTime int32 `protobuf:"varint,1,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+	// time, when the game finishes, in milliseconds since epoch
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// set, if the tick marks one minute or ten seconds left
+	Marker StreamResponse_TimeTick_Marker `protobuf:"varint,3,opt,name=marker,proto3,enum=server.StreamResponse_TimeTick_Marker" json:"marker,omitempty"`
+}
+
+func (x *StreamResponse_TimeTick) Reset() {
+	*x = StreamResponse_TimeTick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_TimeTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_TimeTick) ProtoMessage() {}
+
+func (x *StreamResponse_TimeTick) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_TimeTick.ProtoReflect.Descriptor instead.
+func (*StreamResponse_TimeTick) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 3}
+}
+
+func (x *StreamResponse_TimeTick) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+func (x *StreamResponse_TimeTick) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *StreamResponse_TimeTick) GetMarker() StreamResponse_TimeTick_Marker {
+	if x != nil {
+		return x.Marker
+	}
+	return StreamResponse_TimeTick_MARKER_NONE
+}
+
+type StreamResponse_Batch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// events in the order, in which they have been sent; transactions
+	// are coalesced, so that every player is listed only in the latest
+	// transaction of the batch
+	Events []*StreamResponse `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *StreamResponse_Batch) Reset() {
+	*x = StreamResponse_Batch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Batch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Batch) ProtoMessage() {}
+
+func (x *StreamResponse_Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Batch.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Batch) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 4}
+}
+
+func (x *StreamResponse_Batch) GetEvents() []*StreamResponse {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type StreamResponse_Heartbeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status of the game, when the heartbeat is sent
+	Status GameStatus `protobuf:"varint,1,opt,name=status,proto3,enum=server.GameStatus" json:"status,omitempty"`
+	// seconds until the game finishes, 0, if it is not active
+	RemainingTime int32 `protobuf:"varint,2,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+	// true, if the active game is paused
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	// server time in milliseconds since epoch
+	ServerTime int64 `protobuf:"varint,4,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+}
+
+func (x *StreamResponse_Heartbeat) Reset() {
+	*x = StreamResponse_Heartbeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Heartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Heartbeat) ProtoMessage() {}
+
+func (x *StreamResponse_Heartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Heartbeat.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Heartbeat) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 5}
+}
+
+func (x *StreamResponse_Heartbeat) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_WAITING
+}
+
+func (x *StreamResponse_Heartbeat) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+func (x *StreamResponse_Heartbeat) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *StreamResponse_Heartbeat) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+type StreamResponse_Pause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// host, who has paused the game, empty, if it is paused by admin
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *StreamResponse_Pause) Reset() {
+	*x = StreamResponse_Pause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Pause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Pause) ProtoMessage() {}
+
+func (x *StreamResponse_Pause) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Pause.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Pause) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 6}
+}
+
+func (x *StreamResponse_Pause) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type StreamResponse_Resume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// host, who has resumed the game, empty, if it is resumed
+	// by admin or automatically after the maximal pause
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// seconds until the game finishes
+	RemainingTime int32 `protobuf:"varint,2,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+}
+
+func (x *StreamResponse_Resume) Reset() {
+	*x = StreamResponse_Resume{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Resume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Resume) ProtoMessage() {}
+
+func (x *StreamResponse_Resume) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Resume.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Resume) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 7}
+}
+
+func (x *StreamResponse_Resume) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Resume) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+type StreamResponse_TeamChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Team   int32  `protobuf:"varint,2,opt,name=team,proto3" json:"team,omitempty"`
+}
+
+func (x *StreamResponse_TeamChange) Reset() {
+	*x = StreamResponse_TeamChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_TeamChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_TeamChange) ProtoMessage() {}
+
+func (x *StreamResponse_TeamChange) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_TeamChange.ProtoReflect.Descriptor instead.
+func (*StreamResponse_TeamChange) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 8}
+}
+
+func (x *StreamResponse_TeamChange) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_TeamChange) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+type StreamResponse_Rename struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *StreamResponse_Rename) Reset() {
+	*x = StreamResponse_Rename{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Rename) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Rename) ProtoMessage() {}
+
+func (x *StreamResponse_Rename) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Rename.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Rename) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 9}
+}
+
+func (x *StreamResponse_Rename) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Rename) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type StreamResponse_Afk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Afk       bool   `protobuf:"varint,2,opt,name=afk,proto3" json:"afk,omitempty"`
+	Forfeited bool   `protobuf:"varint,3,opt,name=forfeited,proto3" json:"forfeited,omitempty"`
+}
+
+func (x *StreamResponse_Afk) Reset() {
+	*x = StreamResponse_Afk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Afk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Afk) ProtoMessage() {}
+
+func (x *StreamResponse_Afk) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Afk.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Afk) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 10}
+}
+
+func (x *StreamResponse_Afk) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Afk) GetAfk() bool {
+	if x != nil {
+		return x.Afk
+	}
+	return false
+}
+
+func (x *StreamResponse_Afk) GetForfeited() bool {
+	if x != nil {
+		return x.Forfeited
+	}
+	return false
+}
+
+type StreamResponse_Countdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seconds until the start of the game
+	RemainingTime int32 `protobuf:"varint,1,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+	// true, if the game won't start, since the host has cancelled
+	// the start or players have left the game
+	Cancelled bool `protobuf:"varint,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (x *StreamResponse_Countdown) Reset() {
+	*x = StreamResponse_Countdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Countdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Countdown) ProtoMessage() {}
+
+func (x *StreamResponse_Countdown) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Countdown.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Countdown) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 11}
+}
+
+func (x *StreamResponse_Countdown) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+func (x *StreamResponse_Countdown) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+// Sent every time the price of the stock changes.
+type StreamResponse_StockTick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price int32 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	// difference from the previous price
+	Change int32 `protobuf:"varint,2,opt,name=change,proto3" json:"change,omitempty"`
+}
+
+func (x *StreamResponse_StockTick) Reset() {
+	*x = StreamResponse_StockTick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_StockTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_StockTick) ProtoMessage() {}
+
+func (x *StreamResponse_StockTick) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_StockTick.ProtoReflect.Descriptor instead.
+func (*StreamResponse_StockTick) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 12}
+}
+
+func (x *StreamResponse_StockTick) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StreamResponse_StockTick) GetChange() int32 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+// Sent when the player reacts with the emote.
+type StreamResponse_Reaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Emote  Emote  `protobuf:"varint,2,opt,name=emote,proto3,enum=server.Emote" json:"emote,omitempty"`
+}
+
+func (x *StreamResponse_Reaction) Reset() {
+	*x = StreamResponse_Reaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Reaction) ProtoMessage() {}
+
+func (x *StreamResponse_Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Reaction.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Reaction) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 13}
+}
+
+func (x *StreamResponse_Reaction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Reaction) GetEmote() Emote {
+	if x != nil {
+		return x.Emote
+	}
+	return Emote_EMOTE_THUMBS_UP
+}
+
+// Sent every time the price level rises due to inflation.
+type StreamResponse_Inflation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// percentage, by which lottery and question payouts are scaled
+	PriceLevel int32 `protobuf:"varint,1,opt,name=price_level,json=priceLevel,proto3" json:"price_level,omitempty"`
+	// max win of the lottery at the new price level
+	LotteryMaxWin int32 `protobuf:"varint,2,opt,name=lottery_max_win,json=lotteryMaxWin,proto3" json:"lottery_max_win,omitempty"`
+}
+
+func (x *StreamResponse_Inflation) Reset() {
+	*x = StreamResponse_Inflation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Inflation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Inflation) ProtoMessage() {}
+
+func (x *StreamResponse_Inflation) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Inflation.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Inflation) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 14}
+}
+
+func (x *StreamResponse_Inflation) GetPriceLevel() int32 {
+	if x != nil {
+		return x.PriceLevel
+	}
+	return 0
+}
+
+func (x *StreamResponse_Inflation) GetLotteryMaxWin() int32 {
+	if x != nil {
+		return x.LotteryMaxWin
+	}
+	return 0
+}
+
+type StreamResponse_Join struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *StreamResponse_Join) Reset() {
+	*x = StreamResponse_Join{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Join) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Join) ProtoMessage() {}
+
+func (x *StreamResponse_Join) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Join.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Join) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 15}
+}
+
+func (x *StreamResponse_Join) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type StreamResponse_Kicked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the host, who has kicked the player
+	HostId string `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+}
+
+func (x *StreamResponse_Kicked) Reset() {
+	*x = StreamResponse_Kicked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Kicked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Kicked) ProtoMessage() {}
+
+func (x *StreamResponse_Kicked) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Kicked.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Kicked) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 16}
+}
+
+func (x *StreamResponse_Kicked) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+type StreamResponse_Leave struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// host of the game after the player has left, which
+	// differs from the previous one, if the host has left
+	HostId string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+}
+
+func (x *StreamResponse_Leave) Reset() {
+	*x = StreamResponse_Leave{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Leave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Leave) ProtoMessage() {}
+
+func (x *StreamResponse_Leave) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Leave.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Leave) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 17}
+}
+
+func (x *StreamResponse_Leave) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Leave) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+// It might have contained time so that client
+// can precisely estimate the remaining time.
+// However, we will ignore it for now.
+type StreamResponse_Start struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// config of the game, which may differ from the
+	// one in JoinResponse, if it was overridden at start
+	Config *GameConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *StreamResponse_Start) Reset() {
+	*x = StreamResponse_Start{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Start) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Start) ProtoMessage() {}
+
+func (x *StreamResponse_Start) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Start.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Start) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 18}
+}
+
+func (x *StreamResponse_Start) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type StreamResponse_Finish struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// in team games, the player with the most points in the winning
+	// team; empty in co-op mode
+	WinnerUserId string `protobuf:"bytes,2,opt,name=winner_user_id,json=winnerUserId,proto3" json:"winner_user_id,omitempty"`
+	// team with the most points, 0 if the game has no teams
+	WinnerTeam int32 `protobuf:"varint,3,opt,name=winner_team,json=winnerTeam,proto3" json:"winner_team,omitempty"`
+	// empty, if the game has no teams
+	Teams []*Team `protobuf:"bytes,4,rep,name=teams,proto3" json:"teams,omitempty"`
+	// set only in co-op mode, all players win, if the target is reached
+	CoopProgress *CoopProgress `protobuf:"bytes,5,opt,name=coop_progress,json=coopProgress,proto3" json:"coop_progress,omitempty"`
+	// number of overtime rounds played to break the tie, winner is
+	// empty, if none of the tied players has answered correctly
+	OvertimeRounds int32 `protobuf:"varint,6,opt,name=overtime_rounds,json=overtimeRounds,proto3" json:"overtime_rounds,omitempty"`
+}
+
+func (x *StreamResponse_Finish) Reset() {
+	*x = StreamResponse_Finish{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Finish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Finish) ProtoMessage() {}
+
+func (x *StreamResponse_Finish) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Finish.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Finish) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 19}
+}
+
+func (x *StreamResponse_Finish) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *StreamResponse_Finish) GetWinnerUserId() string {
+	if x != nil {
+		return x.WinnerUserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Finish) GetWinnerTeam() int32 {
+	if x != nil {
+		return x.WinnerTeam
+	}
+	return 0
+}
+
+func (x *StreamResponse_Finish) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *StreamResponse_Finish) GetCoopProgress() *CoopProgress {
+	if x != nil {
+		return x.CoopProgress
+	}
+	return nil
+}
+
+func (x *StreamResponse_Finish) GetOvertimeRounds() int32 {
+	if x != nil {
+		return x.OvertimeRounds
+	}
+	return 0
+}
+
+type StreamResponse_Transaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Server will recalculate the amount
+	// of money for each player and send it
+	// to client. This way, client won't have
+	// to do calculations. It can just update
+	// the fields showing amounts of "points"
+	// for each player.
+	Players []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// Types that are assignable to Event:
+	//	*StreamResponse_Transaction_UseCredit_
+	//	*StreamResponse_Transaction_UseDeposit_
+	//	*StreamResponse_Transaction_ReturnCredit_
+	//	*StreamResponse_Transaction_ReturnDeposit_
+	//	*StreamResponse_Transaction_Theft_
+	//	*StreamResponse_Transaction_Lottery_
+	//	*StreamResponse_Transaction_Question_
+	//	*StreamResponse_Transaction_QuestionTimeout_
+	//	*StreamResponse_Transaction_RepayCredit_
+	//	*StreamResponse_Transaction_WithdrawDeposit_
+	//	*StreamResponse_Transaction_LoanChange_
+	//	*StreamResponse_Transaction_Trade
+	//	*StreamResponse_Transaction_Shares_
+	//	*StreamResponse_Transaction_Insurance_
+	//	*StreamResponse_Transaction_Tax_
+	//	*StreamResponse_Transaction_Steal_
+	//	*StreamResponse_Transaction_Guard_
+	Event isStreamResponse_Transaction_Event `protobuf_oneof:"event"`
+	// current value of the jackpot after the transaction
+	Jackpot int32 `protobuf:"varint,10,opt,name=jackpot,proto3" json:"jackpot,omitempty"`
+}
+
+func (x *StreamResponse_Transaction) Reset() {
+	*x = StreamResponse_Transaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20}
+}
+
+func (x *StreamResponse_Transaction) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (m *StreamResponse_Transaction) GetEvent() isStreamResponse_Transaction_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetUseCredit() *StreamResponse_Transaction_UseCredit {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_UseCredit_); ok {
+		return x.UseCredit
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetUseDeposit() *StreamResponse_Transaction_UseDeposit {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_UseDeposit_); ok {
+		return x.UseDeposit
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetReturnCredit() *StreamResponse_Transaction_ReturnCredit {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_ReturnCredit_); ok {
+		return x.ReturnCredit
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetReturnDeposit() *StreamResponse_Transaction_ReturnDeposit {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_ReturnDeposit_); ok {
+		return x.ReturnDeposit
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetTheft() *StreamResponse_Transaction_Theft {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Theft_); ok {
+		return x.Theft
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetLottery() *StreamResponse_Transaction_Lottery {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Lottery_); ok {
+		return x.Lottery
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetQuestion() *StreamResponse_Transaction_Question {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Question_); ok {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetQuestionTimeout() *StreamResponse_Transaction_QuestionTimeout {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_QuestionTimeout_); ok {
+		return x.QuestionTimeout
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetRepayCredit() *StreamResponse_Transaction_RepayCredit {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_RepayCredit_); ok {
+		return x.RepayCredit
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetWithdrawDeposit() *StreamResponse_Transaction_WithdrawDeposit {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_WithdrawDeposit_); ok {
+		return x.WithdrawDeposit
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetLoanChange() *StreamResponse_Transaction_LoanChange {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_LoanChange_); ok {
+		return x.LoanChange
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetTrade() *Trade {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Trade); ok {
+		return x.Trade
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetShares() *StreamResponse_Transaction_Shares {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Shares_); ok {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetInsurance() *StreamResponse_Transaction_Insurance {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Insurance_); ok {
+		return x.Insurance
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetTax() *StreamResponse_Transaction_Tax {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Tax_); ok {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetSteal() *StreamResponse_Transaction_Steal {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Steal_); ok {
+		return x.Steal
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetGuard() *StreamResponse_Transaction_Guard {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Guard_); ok {
+		return x.Guard
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetJackpot() int32 {
+	if x != nil {
+		return x.Jackpot
+	}
+	return 0
+}
+
+type isStreamResponse_Transaction_Event interface {
+	isStreamResponse_Transaction_Event()
+}
+
+type StreamResponse_Transaction_UseCredit_ struct {
+	UseCredit *StreamResponse_Transaction_UseCredit `protobuf:"bytes,2,opt,name=use_credit,json=useCredit,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_UseDeposit_ struct {
+	UseDeposit *StreamResponse_Transaction_UseDeposit `protobuf:"bytes,3,opt,name=use_deposit,json=useDeposit,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_ReturnCredit_ struct {
+	ReturnCredit *StreamResponse_Transaction_ReturnCredit `protobuf:"bytes,4,opt,name=return_credit,json=returnCredit,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_ReturnDeposit_ struct {
+	ReturnDeposit *StreamResponse_Transaction_ReturnDeposit `protobuf:"bytes,5,opt,name=return_deposit,json=returnDeposit,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Theft_ struct {
+	Theft *StreamResponse_Transaction_Theft `protobuf:"bytes,6,opt,name=theft,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Lottery_ struct {
+	Lottery *StreamResponse_Transaction_Lottery `protobuf:"bytes,7,opt,name=lottery,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Question_ struct {
+	Question *StreamResponse_Transaction_Question `protobuf:"bytes,8,opt,name=question,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_QuestionTimeout_ struct {
+	QuestionTimeout *StreamResponse_Transaction_QuestionTimeout `protobuf:"bytes,9,opt,name=question_timeout,json=questionTimeout,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_RepayCredit_ struct {
+	RepayCredit *StreamResponse_Transaction_RepayCredit `protobuf:"bytes,11,opt,name=repay_credit,json=repayCredit,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_WithdrawDeposit_ struct {
+	WithdrawDeposit *StreamResponse_Transaction_WithdrawDeposit `protobuf:"bytes,12,opt,name=withdraw_deposit,json=withdrawDeposit,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_LoanChange_ struct {
+	LoanChange *StreamResponse_Transaction_LoanChange `protobuf:"bytes,13,opt,name=loan_change,json=loanChange,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Trade struct {
+	Trade *Trade `protobuf:"bytes,14,opt,name=trade,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Shares_ struct {
+	Shares *StreamResponse_Transaction_Shares `protobuf:"bytes,15,opt,name=shares,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Insurance_ struct {
+	Insurance *StreamResponse_Transaction_Insurance `protobuf:"bytes,16,opt,name=insurance,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Tax_ struct {
+	Tax *StreamResponse_Transaction_Tax `protobuf:"bytes,17,opt,name=tax,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Steal_ struct {
+	Steal *StreamResponse_Transaction_Steal `protobuf:"bytes,18,opt,name=steal,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Guard_ struct {
+	Guard *StreamResponse_Transaction_Guard `protobuf:"bytes,19,opt,name=guard,proto3,oneof"`
+}
+
+func (*StreamResponse_Transaction_UseCredit_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_UseDeposit_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_ReturnCredit_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_ReturnDeposit_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Theft_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Lottery_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Question_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_QuestionTimeout_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_RepayCredit_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_WithdrawDeposit_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_LoanChange_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Trade) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Shares_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Insurance_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Tax_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Steal_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Guard_) isStreamResponse_Transaction_Event() {}
+
+type StreamResponse_Transaction_UseCredit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_UseCredit) Reset() {
+	*x = StreamResponse_Transaction_UseCredit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_UseCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_UseCredit) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_UseCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_UseCredit.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_UseCredit) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 0}
+}
+
+func (x *StreamResponse_Transaction_UseCredit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_UseCredit) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type StreamResponse_Transaction_UseDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_UseDeposit) Reset() {
+	*x = StreamResponse_Transaction_UseDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_UseDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_UseDeposit) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_UseDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_UseDeposit.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_UseDeposit) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 1}
+}
+
+func (x *StreamResponse_Transaction_UseDeposit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_UseDeposit) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type StreamResponse_Transaction_ReturnCredit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_ReturnCredit) Reset() {
+	*x = StreamResponse_Transaction_ReturnCredit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_ReturnCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_ReturnCredit) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_ReturnCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_ReturnCredit.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_ReturnCredit) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 2}
+}
+
+func (x *StreamResponse_Transaction_ReturnCredit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_ReturnCredit) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Sent when the player repays the credit before its time ends.
+type StreamResponse_Transaction_RepayCredit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value          int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	RemainingValue int32  `protobuf:"varint,3,opt,name=remaining_value,json=remainingValue,proto3" json:"remaining_value,omitempty"` // value of the credit, which is still outstanding
+}
+
+func (x *StreamResponse_Transaction_RepayCredit) Reset() {
+	*x = StreamResponse_Transaction_RepayCredit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_RepayCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_RepayCredit) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_RepayCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_RepayCredit.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_RepayCredit) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 3}
+}
+
+func (x *StreamResponse_Transaction_RepayCredit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_RepayCredit) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_RepayCredit) GetRemainingValue() int32 {
+	if x != nil {
+		return x.RemainingValue
+	}
+	return 0
+}
+
+type StreamResponse_Transaction_ReturnDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_ReturnDeposit) Reset() {
+	*x = StreamResponse_Transaction_ReturnDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_ReturnDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_ReturnDeposit) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_ReturnDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_ReturnDeposit.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_ReturnDeposit) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 4}
+}
+
+func (x *StreamResponse_Transaction_ReturnDeposit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_ReturnDeposit) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Sent when the player withdraws the deposit before its time ends.
+type StreamResponse_Transaction_WithdrawDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value          int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // withdrawn value without the penalty
+	Penalty        int32  `protobuf:"varint,3,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	RemainingValue int32  `protobuf:"varint,4,opt,name=remaining_value,json=remainingValue,proto3" json:"remaining_value,omitempty"` // value of the deposit, which stays in the bank
+}
+
+func (x *StreamResponse_Transaction_WithdrawDeposit) Reset() {
+	*x = StreamResponse_Transaction_WithdrawDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_WithdrawDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_WithdrawDeposit) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_WithdrawDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_WithdrawDeposit.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_WithdrawDeposit) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 5}
+}
+
+func (x *StreamResponse_Transaction_WithdrawDeposit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_WithdrawDeposit) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_WithdrawDeposit) GetPenalty() int32 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_WithdrawDeposit) GetRemainingValue() int32 {
+	if x != nil {
+		return x.RemainingValue
+	}
+	return 0
+}
+
+// Sent when the loan is offered, accepted, repaid, moved to
+// collections, or when its part is collected from the borrower.
+type StreamResponse_Transaction_LoanChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	// points moved from the lender to the borrower
+	// (negative, if it is the opposite)
+	Value int32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_LoanChange) Reset() {
+	*x = StreamResponse_Transaction_LoanChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_LoanChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_LoanChange) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_LoanChange) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_LoanChange.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_LoanChange) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 6}
+}
+
+func (x *StreamResponse_Transaction_LoanChange) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction_LoanChange) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Sent when the player buys or sells shares of the stock.
+type StreamResponse_Transaction_Shares struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// bought shares (negative, if they are sold)
+	Shares int32 `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	// price of a single share
+	Price int32 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Shares) Reset() {
+	*x = StreamResponse_Transaction_Shares{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Shares) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Shares) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Shares) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Shares.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Shares) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 7}
+}
+
+func (x *StreamResponse_Transaction_Shares) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Shares) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Shares) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Sent when the player buys the insurance against the next theft.
+type StreamResponse_Transaction_Insurance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Premium int32  `protobuf:"varint,2,opt,name=premium,proto3" json:"premium,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Insurance) Reset() {
+	*x = StreamResponse_Transaction_Insurance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Insurance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Insurance) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Insurance) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Insurance.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Insurance) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 8}
+}
+
+func (x *StreamResponse_Transaction_Insurance) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Insurance) GetPremium() int32 {
+	if x != nil {
+		return x.Premium
+	}
+	return 0
+}
+
+// Sent when the player hires the guard.
+type StreamResponse_Transaction_Guard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Price  int32  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	// seconds, for which the player is guarded
+	Time int32 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Guard) Reset() {
+	*x = StreamResponse_Transaction_Guard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Guard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Guard) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Guard) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Guard.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Guard) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 9}
+}
+
+func (x *StreamResponse_Transaction_Guard) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Guard) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Guard) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// Sent when the player tries to steal from another player.
+// Thief is empty, if thieves are masked and he has not been caught.
+type StreamResponse_Transaction_Steal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThiefId  string `protobuf:"bytes,1,opt,name=thief_id,json=thiefId,proto3" json:"thief_id,omitempty"`
+	TargetId string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// points stolen from the target, or points paid
+	// to the target, if the thief has been caught
+	Value  int32 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Caught bool  `protobuf:"varint,4,opt,name=caught,proto3" json:"caught,omitempty"`
+	// true, if the insurance of the target has been used
+	Insured bool `protobuf:"varint,5,opt,name=insured,proto3" json:"insured,omitempty"`
+	// points paid back to the insured target, which
+	// are already included in the points of the target
+	InsurancePayout int32 `protobuf:"varint,6,opt,name=insurance_payout,json=insurancePayout,proto3" json:"insurance_payout,omitempty"`
+	// percentage, by which the guard of the target
+	// has reduced the chance of the steal
+	GuardProtectionPercentage int32 `protobuf:"varint,7,opt,name=guard_protection_percentage,json=guardProtectionPercentage,proto3" json:"guard_protection_percentage,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Steal) Reset() {
+	*x = StreamResponse_Transaction_Steal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Steal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Steal) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Steal) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Steal.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Steal) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 10}
+}
+
+func (x *StreamResponse_Transaction_Steal) GetThiefId() string {
+	if x != nil {
+		return x.ThiefId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Steal) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Steal) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Steal) GetCaught() bool {
+	if x != nil {
+		return x.Caught
+	}
+	return false
+}
+
+func (x *StreamResponse_Transaction_Steal) GetInsured() bool {
+	if x != nil {
+		return x.Insured
+	}
+	return false
+}
+
+func (x *StreamResponse_Transaction_Steal) GetInsurancePayout() int32 {
+	if x != nil {
+		return x.InsurancePayout
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Steal) GetGuardProtectionPercentage() int32 {
+	if x != nil {
+		return x.GuardProtectionPercentage
+	}
+	return 0
+}
+
+// Sent when the wealth tax is collected from the players.
+type StreamResponse_Transaction_Tax struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaxedPlayers []*StreamResponse_Transaction_Tax_TaxedPlayer `protobuf:"bytes,1,rep,name=taxed_players,json=taxedPlayers,proto3" json:"taxed_players,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Tax) Reset() {
+	*x = StreamResponse_Transaction_Tax{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Tax) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Tax) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Tax) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Tax.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Tax) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 11}
+}
+
+func (x *StreamResponse_Transaction_Tax) GetTaxedPlayers() []*StreamResponse_Transaction_Tax_TaxedPlayer {
+	if x != nil {
+		return x.TaxedPlayers
+	}
+	return nil
+}
+
+type StreamResponse_Transaction_Theft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RobbedPlayers []*StreamResponse_Transaction_Theft_RobbedPlayer `protobuf:"bytes,1,rep,name=robbed_players,json=robbedPlayers,proto3" json:"robbed_players,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Theft) Reset() {
+	*x = StreamResponse_Transaction_Theft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Theft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Theft) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Theft) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Theft.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Theft) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 12}
+}
+
+func (x *StreamResponse_Transaction_Theft) GetRobbedPlayers() []*StreamResponse_Transaction_Theft_RobbedPlayer {
+	if x != nil {
+		return x.RobbedPlayers
+	}
+	return nil
+}
+
+type StreamResponse_Transaction_Lottery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value      int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // includes the jackpot, if it is won
+	JackpotWon bool   `protobuf:"varint,3,opt,name=jackpot_won,json=jackpotWon,proto3" json:"jackpot_won,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Lottery) Reset() {
+	*x = StreamResponse_Transaction_Lottery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Lottery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Lottery) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Lottery) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Lottery.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Lottery) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 13}
+}
+
+func (x *StreamResponse_Transaction_Lottery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Lottery) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Lottery) GetJackpotWon() bool {
+	if x != nil {
+		return x.JackpotWon
+	}
+	return false
+}
+
+type StreamResponse_Transaction_Question struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AnswerIsCorrect bool   `protobuf:"varint,2,opt,name=answer_is_correct,json=answerIsCorrect,proto3" json:"answer_is_correct,omitempty"`
+	BidPoints       int32  `protobuf:"varint,3,opt,name=bid_points,json=bidPoints,proto3" json:"bid_points,omitempty"`
+	WinPoints       int32  `protobuf:"varint,4,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Question) Reset() {
+	*x = StreamResponse_Transaction_Question{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Question) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Question) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Question.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Question) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 14}
+}
+
+func (x *StreamResponse_Transaction_Question) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Question) GetAnswerIsCorrect() bool {
+	if x != nil {
+		return x.AnswerIsCorrect
+	}
+	return false
+}
+
+func (x *StreamResponse_Transaction_Question) GetBidPoints() int32 {
+	if x != nil {
+		return x.BidPoints
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Question) GetWinPoints() int32 {
+	if x != nil {
+		return x.WinPoints
+	}
+	return 0
+}
+
+// Sent when the player hasn't answered the question in time.
+// Bid points of the question stay in the bank.
+type StreamResponse_Transaction_QuestionTimeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BidPoints int32  `protobuf:"varint,2,opt,name=bid_points,json=bidPoints,proto3" json:"bid_points,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_QuestionTimeout) Reset() {
+	*x = StreamResponse_Transaction_QuestionTimeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_QuestionTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_QuestionTimeout) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_QuestionTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_QuestionTimeout.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_QuestionTimeout) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 15}
+}
+
+func (x *StreamResponse_Transaction_QuestionTimeout) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_QuestionTimeout) GetBidPoints() int32 {
+	if x != nil {
+		return x.BidPoints
+	}
+	return 0
+}
+
+type StreamResponse_Transaction_Tax_TaxedPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// value shows how much money has been paid to the bank.
+	Value int32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Tax_TaxedPlayer) Reset() {
+	*x = StreamResponse_Transaction_Tax_TaxedPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Tax_TaxedPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Tax_TaxedPlayer) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Tax_TaxedPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Tax_TaxedPlayer.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Tax_TaxedPlayer) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 11, 0}
+}
+
+func (x *StreamResponse_Transaction_Tax_TaxedPlayer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Tax_TaxedPlayer) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type StreamResponse_Transaction_Theft_RobbedPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// value shows how much money has been stolen from the player.
+	Value int32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// points paid back to the insured player, which
+	// are already included in the points of the player
+	InsurancePayout int32 `protobuf:"varint,3,opt,name=insurance_payout,json=insurancePayout,proto3" json:"insurance_payout,omitempty"`
+	// true, if the insurance of the player has been used
+	Insured bool `protobuf:"varint,4,opt,name=insured,proto3" json:"insured,omitempty"`
+	// percentage, by which the guard of the player
+	// has reduced the stolen points
+	GuardProtectionPercentage int32 `protobuf:"varint,5,opt,name=guard_protection_percentage,json=guardProtectionPercentage,proto3" json:"guard_protection_percentage,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) Reset() {
+	*x = StreamResponse_Transaction_Theft_RobbedPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Theft_RobbedPlayer) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Theft_RobbedPlayer.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Theft_RobbedPlayer) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{152, 20, 12, 0}
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetInsurancePayout() int32 {
+	if x != nil {
+		return x.InsurancePayout
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetInsured() bool {
+	if x != nil {
+		return x.Insured
+	}
+	return false
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetGuardProtectionPercentage() int32 {
+	if x != nil {
+		return x.GuardProtectionPercentage
+	}
+	return 0
+}
+
+var File_game_proto protoreflect.FileDescriptor
+
+var file_game_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x22, 0x99, 0x02, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x62,
+	0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x6f, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x66, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61,
+	0x66, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64,
+	0x22, 0xda, 0x01, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63,
+	0x65, 0x5f, 0x62, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x42, 0x6f, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x96, 0x13,
+	0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62,
+	0x61, 0x6e, 0x6b, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x62, 0x61, 0x6e,
+	0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x66,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68,
+	0x65, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x66, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x74, 0x68, 0x65, 0x66, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x12, 0x36, 0x0a,
+	0x17, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d,
+	0x0a, 0x1b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62,
+	0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x18, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78,
+	0x42, 0x69, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x77, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6c, 0x6f, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x74,
+	0x74, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x16, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0e, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6a, 0x61, 0x63, 0x6b, 0x70,
+	0x6f, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x63,
+	0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x1b, 0x73, 0x74, 0x6f,
+	0x63, 0x6b, 0x5f, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x6f,
+	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70,
+	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x22, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x69, 0x6e,
+	0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x42,
+	0x0a, 0x1d, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x23, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1b, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x24,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x0c, 0x74, 0x61, 0x78, 0x5f, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x25, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x78,
+	0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x78, 0x42, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x27, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x43,
+	0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x5f, 0x70,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x2a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x75, 0x61, 0x72, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x2b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x75, 0x61, 0x72, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x3e, 0x0a, 0x1b, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x2c,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x67, 0x75, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x68, 0x69, 0x65, 0x76, 0x65, 0x73, 0x18,
+	0x2d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x73, 0x6b, 0x54, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x2e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x30, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x31, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18,
+	0x32, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x33, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x34, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6f, 0x70, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x63, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x18, 0x36, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x38, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x39, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x3b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x66, 0x6b,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x66, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x66, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x41, 0x66, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x61, 0x66, 0x6b,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x40, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x11, 0x4b, 0x69, 0x63,
+	0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a,
+	0x12, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x11, 0x43, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x22, 0x14,
+	0x0a, 0x12, 0x43, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x72, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x22, 0x44, 0x0a, 0x10, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x22, 0x5e, 0x0a, 0x12, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x22, 0x61, 0x0a, 0x15, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x32, 0x0a, 0x16, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4f,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x22, 0x6c, 0x0a, 0x0c, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x22, 0x15, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0xc9, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55,
+	0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x7d, 0x0a, 0x12,
+	0x52, 0x65, 0x70, 0x61, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x13,
+	0x52, 0x65, 0x70, 0x61, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61,
+	0x6e, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x61,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61,
+	0x6e, 0x49, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0xaa, 0x01, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x87, 0x01, 0x0a,
+	0x05, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x87, 0x01,
+	0x0a, 0x12, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x61, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x62,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x21,
+	0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x61, 0x73, 0x6b,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x4a, 0x0a, 0x0a, 0x54, 0x61, 0x78, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x40, 0x0a, 0x0c,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x6e,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x08,
+	0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x6f, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x5c,
+	0x0a, 0x10, 0x42, 0x75, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0xb4, 0x01, 0x0a,
+	0x11, 0x42, 0x75, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x11, 0x53, 0x65, 0x6c, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x13, 0x42, 0x75,
+	0x79, 0x49, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x14, 0x42, 0x75, 0x79, 0x49, 0x6e, 0x73, 0x75, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6d,
+	0x69, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6d, 0x69,
+	0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x58, 0x0a, 0x0e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x81,
+	0x01, 0x0a, 0x16, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x72, 0x0a, 0x17, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x48, 0x69, 0x72, 0x65, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x9e, 0x01, 0x0a,
+	0x11, 0x48, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x5d, 0x0a,
+	0x0c, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0xb6, 0x01, 0x0a,
+	0x0d, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x6f, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x6c,
+	0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x61, 0x0a, 0x0e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x65, 0x6c,
+	0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63,
+	0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe7, 0x01, 0x0a, 0x0f, 0x4c, 0x6f, 0x74,
+	0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x65, 0x6c,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f,
+	0x74, 0x5f, 0x77, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6a, 0x61, 0x63,
+	0x6b, 0x70, 0x6f, 0x74, 0x57, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x22, 0xbe, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x69, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x16, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x73,
+	0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x49, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xe2, 0x12, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x62, 0x61, 0x6e, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x65, 0x66, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x68, 0x65,
+	0x66, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77,
+	0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x4d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x42, 0x69, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x72,
+	0x6f, 0x77, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x6f, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6a, 0x61, 0x63, 0x6b,
+	0x70, 0x6f, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6a, 0x61, 0x63, 0x6b, 0x70,
+	0x6f, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3c,
+	0x0a, 0x1a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x18, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x36,
+	0x0a, 0x17, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x15, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x3e, 0x0a, 0x1b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x56, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x75, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x12, 0x42, 0x0a, 0x1d, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1b, 0x69, 0x6e, 0x73,
+	0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x78, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x61, 0x78, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x74, 0x61, 0x78, 0x5f, 0x62, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x54, 0x61, 0x78, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0b, 0x74,
+	0x61, 0x78, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x65, 0x61,
+	0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x73, 0x74, 0x65, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x73,
+	0x74, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x73,
+	0x74, 0x65, 0x61, 0x6c, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x1b, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x68,
+	0x69, 0x65, 0x76, 0x65, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x73,
+	0x6b, 0x54, 0x68, 0x69, 0x65, 0x76, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x29, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d,
+	0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x2c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x2d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x70, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f,
+	0x6f, 0x70, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x65, 0x18, 0x30, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x32, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x33, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x34,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x35, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x13,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x36, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x4d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0f,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x37, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x66, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x38, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x61, 0x66, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x66, 0x6b, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x66, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x09, 0x61, 0x66, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x04, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x67, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x22, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72,
+	0x6f, 0x6f, 0x6d, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x22, 0xc6, 0x02, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0e, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x22, 0x8c, 0x02, 0x0a, 0x10, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52,
+	0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x0b, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x1a, 0x72, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f,
+	0x62, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65,
+	0x65, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xa9, 0x03, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6a, 0x61, 0x63,
+	0x6b, 0x70, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x61, 0x63, 0x6b,
+	0x70, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x6f, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x0c, 0x63, 0x6f, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0x5c, 0x0a, 0x0c, 0x43, 0x6f, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x22,
+	0x4d, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xcb,
+	0x03, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x77, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x77, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6f, 0x75,
+	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x13,
+	0x62, 0x69, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f,
+	0x77, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x62, 0x69, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x57, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x12,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03,
+	0x62, 0x6f, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x6f, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x22, 0x82, 0x02, 0x0a,
+	0x0b, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x08,
+	0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x35,
+	0x0a, 0x17, 0x62, 0x69, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x62, 0x69, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x69, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x5f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x62, 0x69, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x57, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6d, 0x6f, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f,
+	0x73, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x6d, 0x6f, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xb4, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x22, 0x7e, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x22, 0x74, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x41,
+	0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x65, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x6f, 0x74,
+	0x65, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x22, 0x5e,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x47,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x86, 0x04, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x14, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x68, 0x65,
+	0x66, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x74, 0x68, 0x65, 0x66, 0x74, 0x52, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x6c,
+	0x6f, 0x61, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x73, 0x75, 0x72,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x65,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x12, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74,
+	0x22, 0x88, 0x02, 0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x64, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x82, 0x03, 0x0a, 0x0b,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x5f, 0x74, 0x61, 0x6b, 0x65,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x77, 0x69,
+	0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x62, 0x62, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x6f, 0x62, 0x62, 0x65, 0x64,
+	0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x34,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65,
+	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x0c, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41,
+	0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x63, 0x68, 0x69,
+	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x32, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7b, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2a,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x18, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xc8, 0x01, 0x0a, 0x15,
+	0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0xe8, 0x02, 0x0a, 0x13, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x3b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x72, 0x0a,
+	0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0xf4, 0x01, 0x0a, 0x0f, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x1a,
+	0x4e, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x42,
+	0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x3a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x22, 0xae, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x22, 0x62, 0x0a, 0x10, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x46, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5f, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x2f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x76, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x22, 0x42, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xdb, 0x01, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x77, 0x69, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x62, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x8e, 0x01, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x65, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73,
+	0x70, 0x65, 0x65, 0x64, 0x22, 0xa5, 0x04, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c,
+	0x6f, 0x61, 0x6e, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
+	0x65, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x62, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x22, 0xae, 0x01, 0x0a,
+	0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xad, 0x01,
+	0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x26, 0x0a,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x12, 0x2c, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x12, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x3c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x22,
+	0x2d, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xad,
+	0x01, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x2d, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x2c,
+	0x0a, 0x11, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x46, 0x0a, 0x12, 0x45, 0x76, 0x69, 0x63, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x45, 0x76,
+	0x69, 0x63, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x5b, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x23, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x42, 0x61, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x63,
+	0x0a, 0x0a, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x0b, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x62, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x03, 0x62, 0x61,
+	0x6e, 0x22, 0x49, 0x0a, 0x0c, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x0f, 0x0a, 0x0d,
+	0x55, 0x6e, 0x62, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x33, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x52,
+	0x04, 0x62, 0x61, 0x6e, 0x73, 0x22, 0xc7, 0x02, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22,
+	0x2c, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x28, 0x0a,
+	0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x46,
+	0x0a, 0x18, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x8b, 0x34, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x6a, 0x6f, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x05,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x40, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x04, 0x63, 0x68, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x04,
+	0x63, 0x68, 0x61, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4b, 0x69, 0x63, 0x6b,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77,
+	0x6e, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x37,
+	0x0a, 0x0b, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x68,
+	0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x68, 0x69,
+	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3b, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6f, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f,
+	0x6f, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x08, 0x6f,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x00,
+	0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x6f, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x6d, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x40, 0x0a,
+	0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12,
+	0x34, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x05,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3e, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e,
+	0x0a, 0x03, 0x61, 0x66, 0x6b, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x41, 0x66, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x66, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x98, 0x01, 0x0a, 0x08, 0x4f,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x43, 0x0a, 0x0e, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d,
+	0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x1a, 0xb0, 0x01, 0x0a, 0x09, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xd6, 0x01,
+	0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x06,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x41, 0x52, 0x4b, 0x45,
+	0x52, 0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x54, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x43,
+	0x4f, 0x4e, 0x44, 0x53, 0x10, 0x02, 0x1a, 0x37, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x2e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a,
+	0x97, 0x01, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x2a, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x20, 0x0a, 0x05, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x48, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x39, 0x0a, 0x0a, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d,
+	0x1a, 0x3d, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x1a,
+	0x4e, 0x0a, 0x03, 0x41, 0x66, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x66, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x66,
+	0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x1a,
+	0x50, 0x0a, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x1a, 0x39, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x48, 0x0a, 0x08,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x52,
+	0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x1a, 0x54, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c,
+	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x1a, 0x2e, 0x0a, 0x04,
+	0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x1a, 0x21, 0x0a, 0x06,
+	0x4b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x1a,
+	0x39, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x1a, 0x33, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a,
+	0x81, 0x02, 0x0a, 0x06, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x22, 0x0a, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12,
+	0x39, 0x0a, 0x0d, 0x63, 0x6f, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x63, 0x6f,
+	0x6f, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x1a, 0xe5, 0x18, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x4d, 0x0a,
+	0x0a, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x48,
+	0x00, 0x52, 0x09, 0x75, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x50, 0x0a, 0x0b,
+	0x75, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x56,
+	0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x12, 0x40, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x68, 0x65, 0x66, 0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x68,
+	0x65, 0x66, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x48, 0x00, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x08, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x53, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52,
+	0x0b, 0x72, 0x65, 0x70, 0x61, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x5f, 0x0a, 0x10,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x50, 0x0a,
+	0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x09, 0x69,
+	0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09,
+	0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x03, 0x74, 0x61, 0x78,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x61, 0x78, 0x48, 0x00,
+	0x52, 0x03, 0x74, 0x61, 0x78, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x48, 0x00,
+	0x52, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x12, 0x40, 0x0a, 0x05, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64,
+	0x48, 0x00, 0x52, 0x05, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6a, 0x61, 0x63,
+	0x6b, 0x70, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x61, 0x63, 0x6b,
+	0x70, 0x6f, 0x74, 0x1a, 0x3a, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a,
+	0x3b, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x3d, 0x0a, 0x0c,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x65, 0x0a, 0x0b, 0x52,
+	0x65, 0x70, 0x61, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x1a, 0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x1a, 0x83, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x44, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x4f,
+	0x0a, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x1a,
+	0x3e, 0x0a, 0x09, 0x49, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x1a,
+	0x4a, 0x0a, 0x05, 0x47, 0x75, 0x61, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0xf2, 0x01, 0x0a, 0x05,
+	0x53, 0x74, 0x65, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x68, 0x69, 0x65, 0x66, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x68, 0x69, 0x65, 0x66, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x75, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x75, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69,
+	0x6e, 0x73, 0x75, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e,
+	0x73, 0x75, 0x72, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x12, 0x3e, 0x0a, 0x1b, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x67, 0x75, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x1a, 0x9c, 0x01, 0x0a, 0x03, 0x54, 0x61, 0x78, 0x12, 0x57, 0x0a, 0x0d, 0x74, 0x61, 0x78, 0x65,
+	0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x61, 0x78, 0x2e, 0x54, 0x61, 0x78, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x61, 0x78, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x1a, 0x3c, 0x0a, 0x0b, 0x54, 0x61, 0x78, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a,
+	0xaa, 0x02, 0x0a, 0x05, 0x54, 0x68, 0x65, 0x66, 0x74, 0x12, 0x5c, 0x0a, 0x0e, 0x72, 0x6f, 0x62,
+	0x62, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x35, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x68, 0x65, 0x66, 0x74, 0x2e, 0x52, 0x6f, 0x62, 0x62,
+	0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x0d, 0x72, 0x6f, 0x62, 0x62, 0x65, 0x64,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x1a, 0xc2, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x62, 0x62,
+	0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x75, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x1b,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x19, 0x67, 0x75, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0x59, 0x0a, 0x07,
+	0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f,
+	0x74, 0x5f, 0x77, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6a, 0x61, 0x63,
+	0x6b, 0x70, 0x6f, 0x74, 0x57, 0x6f, 0x6e, 0x1a, 0x8d, 0x01, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x49, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62,
+	0x69, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69,
+	0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x49, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x69, 0x64, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x2a, 0x39, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x19, 0x0a, 0x15, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f,
+	0x4d, 0x50, 0x45, 0x54, 0x49, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x47,
+	0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4f, 0x50, 0x10, 0x01, 0x2a,
+	0x7f, 0x0a, 0x0c, 0x57, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x14, 0x57, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x49, 0x4e,
+	0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x54, 0x5f, 0x57,
+	0x4f, 0x52, 0x54, 0x48, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x49, 0x4e, 0x5f, 0x43, 0x4f,
+	0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x10, 0x02,
+	0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x03,
+	0x2a, 0x44, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x45, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x45, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x2a, 0x48, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x53, 0x45, 0x52,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x55, 0x46, 0x46,
+	0x49, 0x58, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x01,
+	0x2a, 0x4f, 0x0a, 0x09, 0x41, 0x66, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x13, 0x0a,
+	0x0f, 0x41, 0x46, 0x4b, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x4c, 0x41, 0x47,
+	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x46, 0x4b, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x46, 0x4b,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x10,
+	0x02, 0x2a, 0x6d, 0x0a, 0x05, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x4d,
+	0x4f, 0x54, 0x45, 0x5f, 0x54, 0x48, 0x55, 0x4d, 0x42, 0x53, 0x5f, 0x55, 0x50, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x4c, 0x41, 0x55, 0x47, 0x48, 0x10, 0x01,
+	0x12, 0x0d, 0x0a, 0x09, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x57, 0x4f, 0x57, 0x10, 0x02, 0x12,
+	0x0d, 0x0a, 0x09, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x53, 0x41, 0x44, 0x10, 0x03, 0x12, 0x0f,
+	0x0a, 0x0b, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x4e, 0x47, 0x52, 0x59, 0x10, 0x04, 0x12,
+	0x0f, 0x0a, 0x0b, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x4e, 0x45, 0x59, 0x10, 0x05,
+	0x2a, 0x36, 0x0a, 0x07, 0x42, 0x61, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x15, 0x0a, 0x11, 0x42,
+	0x41, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x41, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41,
+	0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x2a, 0x34, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x69, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53,
+	0x49, 0x44, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x01, 0x2a, 0x99,
+	0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f,
+	0x52, 0x59, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x42, 0x41,
+	0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x54, 0x10, 0x02,
+	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f,
+	0x52, 0x59, 0x5f, 0x4c, 0x4f, 0x54, 0x54, 0x45, 0x52, 0x59, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x04, 0x2a, 0x57, 0x0a, 0x0a, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x41, 0x4d, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45,
+	0x44, 0x10, 0x02, 0x2a, 0x72, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f,
+	0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x45, 0x50, 0x41, 0x49, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x4f,
+	0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x03, 0x2a, 0x71, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x1b,
+	0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x44,
+	0x4f, 0x57, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x1c, 0x0a,
+	0x18, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4c,
+	0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02, 0x2a, 0x70, 0x0a, 0x10, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x18, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a,
+	0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x57, 0x49, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a,
+	0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x57, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x02, 0x32, 0xf8, 0x1d, 0x0a,
+	0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x05, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x68, 0x6f,
+	0x6f, 0x73, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x43, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x6f, 0x6f,
+	0x73, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x39, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x07, 0x41,
+	0x64, 0x64, 0x42, 0x6f, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x41, 0x64, 0x64, 0x42, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4f, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x19, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a,
+	0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x62,
+	0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x07, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x57, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
+	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x61, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
+	0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x79, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45,
+	0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x19, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x42, 0x75,
+	0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x42, 0x75, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x79, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45,
+	0x0a, 0x0a, 0x53, 0x65, 0x6c, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x42, 0x75, 0x79, 0x49, 0x6e, 0x73, 0x75,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42,
+	0x75, 0x79, 0x49, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x79, 0x49,
+	0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x05, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x12, 0x14, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x65, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x48, 0x69,
+	0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x48, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x72, 0x65, 0x47,
+	0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x07, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c,
+	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08,
+	0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a,
+	0x05, 0x52, 0x65, 0x61, 0x63, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e,
+	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xfc, 0x05, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x18,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x45, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x45, 0x76, 0x69, 0x63, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45,
+	0x76, 0x69, 0x63, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x69, 0x63, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x09, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x30, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x05, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x12, 0x14, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x62,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x10, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_game_proto_rawDescOnce sync.Once
+	file_game_proto_rawDescData = file_game_proto_rawDesc
+)
+
+func file_game_proto_rawDescGZIP() []byte {
+	file_game_proto_rawDescOnce.Do(func() {
+		file_game_proto_rawDescData = protoimpl.X.CompressGZIP(file_game_proto_rawDescData)
+	})
+	return file_game_proto_rawDescData
+}
+
+var file_game_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_game_proto_msgTypes = make([]protoimpl.MessageInfo, 195)
+var file_game_proto_goTypes = []interface{}{
+	(GameMode)(0),                                         // 0: server.GameMode
+	(WinCondition)(0),                                     // 1: server.WinCondition
+	(InterestMode)(0),                                     // 2: server.InterestMode
+	(UsernamePolicy)(0),                                   // 3: server.UsernamePolicy
+	(AfkPolicy)(0),                                        // 4: server.AfkPolicy
+	(Emote)(0),                                            // 5: server.Emote
+	(BanKind)(0),                                          // 6: server.BanKind
+	(OrderSide)(0),                                        // 7: server.OrderSide
+	(EventCategory)(0),                                    // 8: server.EventCategory
+	(GameStatus)(0),                                       // 9: server.GameStatus
+	(LoanStatus)(0),                                       // 10: server.LoanStatus
+	(LeaderboardWindow)(0),                                // 11: server.LeaderboardWindow
+	(LeaderboardOrder)(0),                                 // 12: server.LeaderboardOrder
+	(StreamResponse_TimeTick_Marker)(0),                   // 13: server.StreamResponse.TimeTick.Marker
+	(*Player)(nil),                                        // 14: server.Player
+	(*JoinRequest)(nil),                                   // 15: server.JoinRequest
+	(*JoinResponse)(nil),                                  // 16: server.JoinResponse
+	(*LeaveRequest)(nil),                                  // 17: server.LeaveRequest
+	(*LeaveResponse)(nil),                                 // 18: server.LeaveResponse
+	(*KickPlayerRequest)(nil),                             // 19: server.KickPlayerRequest
+	(*KickPlayerResponse)(nil),                            // 20: server.KickPlayerResponse
+	(*ChooseTeamRequest)(nil),                             // 21: server.ChooseTeamRequest
+	(*ChooseTeamResponse)(nil),                            // 22: server.ChooseTeamResponse
+	(*RenameRequest)(nil),                                 // 23: server.RenameRequest
+	(*RenameResponse)(nil),                                // 24: server.RenameResponse
+	(*AddBotsRequest)(nil),                                // 25: server.AddBotsRequest
+	(*AddBotsResponse)(nil),                               // 26: server.AddBotsResponse
+	(*PauseGameRequest)(nil),                              // 27: server.PauseGameRequest
+	(*PauseGameResponse)(nil),                             // 28: server.PauseGameResponse
+	(*ResumeGameRequest)(nil),                             // 29: server.ResumeGameRequest
+	(*ResumeGameResponse)(nil),                            // 30: server.ResumeGameResponse
+	(*ExtendGameRequest)(nil),                             // 31: server.ExtendGameRequest
+	(*ExtendGameResponse)(nil),                            // 32: server.ExtendGameResponse
+	(*AnswerOvertimeRequest)(nil),                         // 33: server.AnswerOvertimeRequest
+	(*AnswerOvertimeResponse)(nil),                        // 34: server.AnswerOvertimeResponse
+	(*StartRequest)(nil),                                  // 35: server.StartRequest
+	(*StartResponse)(nil),                                 // 36: server.StartResponse
+	(*CancelStartRequest)(nil),                            // 37: server.CancelStartRequest
+	(*CancelStartResponse)(nil),                           // 38: server.CancelStartResponse
+	(*CreditRequest)(nil),                                 // 39: server.CreditRequest
+	(*CreditResponse)(nil),                                // 40: server.CreditResponse
+	(*RepayCreditRequest)(nil),                            // 41: server.RepayCreditRequest
+	(*RepayCreditResponse)(nil),                           // 42: server.RepayCreditResponse
+	(*OfferLoanRequest)(nil),                              // 43: server.OfferLoanRequest
+	(*OfferLoanResponse)(nil),                             // 44: server.OfferLoanResponse
+	(*AcceptLoanRequest)(nil),                             // 45: server.AcceptLoanRequest
+	(*AcceptLoanResponse)(nil),                            // 46: server.AcceptLoanResponse
+	(*Order)(nil),                                         // 47: server.Order
+	(*Trade)(nil),                                         // 48: server.Trade
+	(*PlaceOrderRequest)(nil),                             // 49: server.PlaceOrderRequest
+	(*PlaceOrderResponse)(nil),                            // 50: server.PlaceOrderResponse
+	(*CancelOrderRequest)(nil),                            // 51: server.CancelOrderRequest
+	(*CancelOrderResponse)(nil),                           // 52: server.CancelOrderResponse
+	(*GetMarketRequest)(nil),                              // 53: server.GetMarketRequest
+	(*OrderBook)(nil),                                     // 54: server.OrderBook
+	(*TaxBracket)(nil),                                    // 55: server.TaxBracket
+	(*AssetHolding)(nil),                                  // 56: server.AssetHolding
+	(*GetMarketResponse)(nil),                             // 57: server.GetMarketResponse
+	(*BuySharesRequest)(nil),                              // 58: server.BuySharesRequest
+	(*BuySharesResponse)(nil),                             // 59: server.BuySharesResponse
+	(*SellSharesRequest)(nil),                             // 60: server.SellSharesRequest
+	(*SellSharesResponse)(nil),                            // 61: server.SellSharesResponse
+	(*BuyInsuranceRequest)(nil),                           // 62: server.BuyInsuranceRequest
+	(*BuyInsuranceResponse)(nil),                          // 63: server.BuyInsuranceResponse
+	(*DepositRequest)(nil),                                // 64: server.DepositRequest
+	(*DepositResponse)(nil),                               // 65: server.DepositResponse
+	(*WithdrawDepositRequest)(nil),                        // 66: server.WithdrawDepositRequest
+	(*WithdrawDepositResponse)(nil),                       // 67: server.WithdrawDepositResponse
+	(*HireGuardRequest)(nil),                              // 68: server.HireGuardRequest
+	(*HireGuardResponse)(nil),                             // 69: server.HireGuardResponse
+	(*StealRequest)(nil),                                  // 70: server.StealRequest
+	(*StealResponse)(nil),                                 // 71: server.StealResponse
+	(*LotteryRequest)(nil),                                // 72: server.LotteryRequest
+	(*LotteryResponse)(nil),                               // 73: server.LotteryResponse
+	(*GenerateQuestionRequest)(nil),                       // 74: server.GenerateQuestionRequest
+	(*GenerateQuestionResponse)(nil),                      // 75: server.GenerateQuestionResponse
+	(*AnswerQuestionRequest)(nil),                         // 76: server.AnswerQuestionRequest
+	(*AnswerQuestionResponse)(nil),                        // 77: server.AnswerQuestionResponse
+	(*GameConfig)(nil),                                    // 78: server.GameConfig
+	(*Room)(nil),                                          // 79: server.Room
+	(*CreateRoomRequest)(nil),                             // 80: server.CreateRoomRequest
+	(*CreateRoomResponse)(nil),                            // 81: server.CreateRoomResponse
+	(*ListRoomsRequest)(nil),                              // 82: server.ListRoomsRequest
+	(*ListRoomsResponse)(nil),                             // 83: server.ListRoomsResponse
+	(*JoinRoomRequest)(nil),                               // 84: server.JoinRoomRequest
+	(*GameListing)(nil),                                   // 85: server.GameListing
+	(*EnqueueRequest)(nil),                                // 86: server.EnqueueRequest
+	(*MatchmakingEvent)(nil),                              // 87: server.MatchmakingEvent
+	(*ListLobbiesRequest)(nil),                            // 88: server.ListLobbiesRequest
+	(*ListLobbiesResponse)(nil),                           // 89: server.ListLobbiesResponse
+	(*StreamRequest)(nil),                                 // 90: server.StreamRequest
+	(*ReconnectRequest)(nil),                              // 91: server.ReconnectRequest
+	(*GameState)(nil),                                     // 92: server.GameState
+	(*CoopProgress)(nil),                                  // 93: server.CoopProgress
+	(*Team)(nil),                                          // 94: server.Team
+	(*PlayerResult)(nil),                                  // 95: server.PlayerResult
+	(*GameResults)(nil),                                   // 96: server.GameResults
+	(*Position)(nil),                                      // 97: server.Position
+	(*ChatMessage)(nil),                                   // 98: server.ChatMessage
+	(*SendChatRequest)(nil),                               // 99: server.SendChatRequest
+	(*SendChatResponse)(nil),                              // 100: server.SendChatResponse
+	(*ReactRequest)(nil),                                  // 101: server.ReactRequest
+	(*ReactResponse)(nil),                                 // 102: server.ReactResponse
+	(*GetTransactionsRequest)(nil),                        // 103: server.GetTransactionsRequest
+	(*LedgerEntry)(nil),                                   // 104: server.LedgerEntry
+	(*GetTransactionsResponse)(nil),                       // 105: server.GetTransactionsResponse
+	(*GetGameStateRequest)(nil),                           // 106: server.GetGameStateRequest
+	(*GetGameStateResponse)(nil),                          // 107: server.GetGameStateResponse
+	(*Loan)(nil),                                          // 108: server.Loan
+	(*PlayerStats)(nil),                                   // 109: server.PlayerStats
+	(*Achievement)(nil),                                   // 110: server.Achievement
+	(*GetAchievementsRequest)(nil),                        // 111: server.GetAchievementsRequest
+	(*GetAchievementsResponse)(nil),                       // 112: server.GetAchievementsResponse
+	(*Challenge)(nil),                                     // 113: server.Challenge
+	(*GetChallengesRequest)(nil),                          // 114: server.GetChallengesRequest
+	(*GetChallengesResponse)(nil),                         // 115: server.GetChallengesResponse
+	(*CreateTournamentRequest)(nil),                       // 116: server.CreateTournamentRequest
+	(*CreateTournamentResponse)(nil),                      // 117: server.CreateTournamentResponse
+	(*JoinTournamentRequest)(nil),                         // 118: server.JoinTournamentRequest
+	(*TournamentStandings)(nil),                           // 119: server.TournamentStandings
+	(*TournamentEvent)(nil),                               // 120: server.TournamentEvent
+	(*GetStandingsRequest)(nil),                           // 121: server.GetStandingsRequest
+	(*GetStandingsResponse)(nil),                          // 122: server.GetStandingsResponse
+	(*GetPlayerStatsRequest)(nil),                         // 123: server.GetPlayerStatsRequest
+	(*GetPlayerStatsResponse)(nil),                        // 124: server.GetPlayerStatsResponse
+	(*Profile)(nil),                                       // 125: server.Profile
+	(*RegisterRequest)(nil),                               // 126: server.RegisterRequest
+	(*RegisterResponse)(nil),                              // 127: server.RegisterResponse
+	(*LoginRequest)(nil),                                  // 128: server.LoginRequest
+	(*LoginResponse)(nil),                                 // 129: server.LoginResponse
+	(*GetProfileRequest)(nil),                             // 130: server.GetProfileRequest
+	(*GetProfileResponse)(nil),                            // 131: server.GetProfileResponse
+	(*UpdateProfileRequest)(nil),                          // 132: server.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),                         // 133: server.UpdateProfileResponse
+	(*GetLeaderboardRequest)(nil),                         // 134: server.GetLeaderboardRequest
+	(*LeaderboardEntry)(nil),                              // 135: server.LeaderboardEntry
+	(*GetLeaderboardResponse)(nil),                        // 136: server.GetLeaderboardResponse
+	(*GameSummary)(nil),                                   // 137: server.GameSummary
+	(*GetGameSummaryRequest)(nil),                         // 138: server.GetGameSummaryRequest
+	(*GetGameSummaryResponse)(nil),                        // 139: server.GetGameSummaryResponse
+	(*ListGameHistoryRequest)(nil),                        // 140: server.ListGameHistoryRequest
+	(*ListGameHistoryResponse)(nil),                       // 141: server.ListGameHistoryResponse
+	(*ReplayRequest)(nil),                                 // 142: server.ReplayRequest
+	(*ReplayEvent)(nil),                                   // 143: server.ReplayEvent
+	(*AdminGame)(nil),                                     // 144: server.AdminGame
+	(*AdminPlayer)(nil),                                   // 145: server.AdminPlayer
+	(*ListGamesRequest)(nil),                              // 146: server.ListGamesRequest
+	(*ListGamesResponse)(nil),                             // 147: server.ListGamesResponse
+	(*InspectGameRequest)(nil),                            // 148: server.InspectGameRequest
+	(*InspectGameResponse)(nil),                           // 149: server.InspectGameResponse
+	(*FinishGameRequest)(nil),                             // 150: server.FinishGameRequest
+	(*FinishGameResponse)(nil),                            // 151: server.FinishGameResponse
+	(*EvictPlayerRequest)(nil),                            // 152: server.EvictPlayerRequest
+	(*EvictPlayerResponse)(nil),                           // 153: server.EvictPlayerResponse
+	(*Ban)(nil),                                           // 154: server.Ban
+	(*BanRequest)(nil),                                    // 155: server.BanRequest
+	(*BanResponse)(nil),                                   // 156: server.BanResponse
+	(*UnbanRequest)(nil),                                  // 157: server.UnbanRequest
+	(*UnbanResponse)(nil),                                 // 158: server.UnbanResponse
+	(*ListBansRequest)(nil),                               // 159: server.ListBansRequest
+	(*ListBansResponse)(nil),                              // 160: server.ListBansResponse
+	(*GameExport)(nil),                                    // 161: server.GameExport
+	(*ExportGameRequest)(nil),                             // 162: server.ExportGameRequest
+	(*ExportGameResponse)(nil),                            // 163: server.ExportGameResponse
+	(*SetDefaultConfigRequest)(nil),                       // 164: server.SetDefaultConfigRequest
+	(*SetDefaultConfigResponse)(nil),                      // 165: server.SetDefaultConfigResponse
+	(*StreamResponse)(nil),                                // 166: server.StreamResponse
+	(*MatchmakingEvent_Searching)(nil),                    // 167: server.MatchmakingEvent.Searching
+	(*TournamentStandings_Entry)(nil),                     // 168: server.TournamentStandings.Entry
+	(*TournamentEvent_RoundStarted)(nil),                  // 169: server.TournamentEvent.RoundStarted
+	(*StreamResponse_Overtime)(nil),                       // 170: server.StreamResponse.Overtime
+	(*StreamResponse_OvertimeAnswer)(nil),                 // 171: server.StreamResponse.OvertimeAnswer
+	(*StreamResponse_Extension)(nil),                      // 172: server.StreamResponse.Extension
+	(*StreamResponse_TimeTick)(nil),                       // 173: server.StreamResponse.TimeTick
+	(*StreamResponse_Batch)(nil),                          // 174: server.StreamResponse.Batch
+	(*StreamResponse_Heartbeat)(nil),                      // 175: server.StreamResponse.Heartbeat
+	(*StreamResponse_Pause)(nil),                          // 176: server.StreamResponse.Pause
+	(*StreamResponse_Resume)(nil),                         // 177: server.StreamResponse.Resume
+	(*StreamResponse_TeamChange)(nil),                     // 178: server.StreamResponse.TeamChange
+	(*StreamResponse_Rename)(nil),                         // 179: server.StreamResponse.Rename
+	(*StreamResponse_Afk)(nil),                            // 180: server.StreamResponse.Afk
+	(*StreamResponse_Countdown)(nil),                      // 181: server.StreamResponse.Countdown
+	(*StreamResponse_StockTick)(nil),                      // 182: server.StreamResponse.StockTick
+	(*StreamResponse_Reaction)(nil),                       // 183: server.StreamResponse.Reaction
+	(*StreamResponse_Inflation)(nil),                      // 184: server.StreamResponse.Inflation
+	(*StreamResponse_Join)(nil),                           // 185: server.StreamResponse.Join
+	(*StreamResponse_Kicked)(nil),                         // 186: server.StreamResponse.Kicked
+	(*StreamResponse_Leave)(nil),                          // 187: server.StreamResponse.Leave
+	(*StreamResponse_Start)(nil),                          // 188: server.StreamResponse.Start
+	(*StreamResponse_Finish)(nil),                         // 189: server.StreamResponse.Finish
+	(*StreamResponse_Transaction)(nil),                    // 190: server.StreamResponse.Transaction
+	(*StreamResponse_Transaction_UseCredit)(nil),          // 191: server.StreamResponse.Transaction.UseCredit
+	(*StreamResponse_Transaction_UseDeposit)(nil),         // 192: server.StreamResponse.Transaction.UseDeposit
+	(*StreamResponse_Transaction_ReturnCredit)(nil),       // 193: server.StreamResponse.Transaction.ReturnCredit
+	(*StreamResponse_Transaction_RepayCredit)(nil),        // 194: server.StreamResponse.Transaction.RepayCredit
+	(*StreamResponse_Transaction_ReturnDeposit)(nil),      // 195: server.StreamResponse.Transaction.ReturnDeposit
+	(*StreamResponse_Transaction_WithdrawDeposit)(nil),    // 196: server.StreamResponse.Transaction.WithdrawDeposit
+	(*StreamResponse_Transaction_LoanChange)(nil),         // 197: server.StreamResponse.Transaction.LoanChange
+	(*StreamResponse_Transaction_Shares)(nil),             // 198: server.StreamResponse.Transaction.Shares
+	(*StreamResponse_Transaction_Insurance)(nil),          // 199: server.StreamResponse.Transaction.Insurance
+	(*StreamResponse_Transaction_Guard)(nil),              // 200: server.StreamResponse.Transaction.Guard
+	(*StreamResponse_Transaction_Steal)(nil),              // 201: server.StreamResponse.Transaction.Steal
+	(*StreamResponse_Transaction_Tax)(nil),                // 202: server.StreamResponse.Transaction.Tax
+	(*StreamResponse_Transaction_Theft)(nil),              // 203: server.StreamResponse.Transaction.Theft
+	(*StreamResponse_Transaction_Lottery)(nil),            // 204: server.StreamResponse.Transaction.Lottery
+	(*StreamResponse_Transaction_Question)(nil),           // 205: server.StreamResponse.Transaction.Question
+	(*StreamResponse_Transaction_QuestionTimeout)(nil),    // 206: server.StreamResponse.Transaction.QuestionTimeout
+	(*StreamResponse_Transaction_Tax_TaxedPlayer)(nil),    // 207: server.StreamResponse.Transaction.Tax.TaxedPlayer
+	(*StreamResponse_Transaction_Theft_RobbedPlayer)(nil), // 208: server.StreamResponse.Transaction.Theft.RobbedPlayer
+}
+var file_game_proto_depIdxs = []int32{
+	14,  // 0: server.JoinResponse.players:type_name -> server.Player
+	2,   // 1: server.JoinResponse.interest_mode:type_name -> server.InterestMode
+	55,  // 2: server.JoinResponse.tax_brackets:type_name -> server.TaxBracket
+	0,   // 3: server.JoinResponse.mode:type_name -> server.GameMode
+	1,   // 4: server.JoinResponse.win_condition:type_name -> server.WinCondition
+	4,   // 5: server.JoinResponse.afk_policy:type_name -> server.AfkPolicy
+	78,  // 6: server.StartRequest.config:type_name -> server.GameConfig
+	7,   // 7: server.Order.side:type_name -> server.OrderSide
+	7,   // 8: server.PlaceOrderRequest.side:type_name -> server.OrderSide
+	47,  // 9: server.OrderBook.bids:type_name -> server.Order
+	47,  // 10: server.OrderBook.asks:type_name -> server.Order
+	54,  // 11: server.GetMarketResponse.books:type_name -> server.OrderBook
+	56,  // 12: server.GetMarketResponse.holdings:type_name -> server.AssetHolding
+	2,   // 13: server.GameConfig.interest_mode:type_name -> server.InterestMode
+	55,  // 14: server.GameConfig.tax_brackets:type_name -> server.TaxBracket
+	0,   // 15: server.GameConfig.mode:type_name -> server.GameMode
+	1,   // 16: server.GameConfig.win_condition:type_name -> server.WinCondition
+	3,   // 17: server.GameConfig.username_policy:type_name -> server.UsernamePolicy
+	4,   // 18: server.GameConfig.afk_policy:type_name -> server.AfkPolicy
+	78,  // 19: server.Room.config:type_name -> server.GameConfig
+	78,  // 20: server.CreateRoomRequest.config:type_name -> server.GameConfig
+	79,  // 21: server.ListRoomsResponse.rooms:type_name -> server.Room
+	167, // 22: server.MatchmakingEvent.searching:type_name -> server.MatchmakingEvent.Searching
+	16,  // 23: server.MatchmakingEvent.match_found:type_name -> server.JoinResponse
+	85,  // 24: server.ListLobbiesResponse.games:type_name -> server.GameListing
+	8,   // 25: server.StreamRequest.categories:type_name -> server.EventCategory
+	8,   // 26: server.ReconnectRequest.categories:type_name -> server.EventCategory
+	9,   // 27: server.GameState.status:type_name -> server.GameStatus
+	14,  // 28: server.GameState.players:type_name -> server.Player
+	94,  // 29: server.GameState.teams:type_name -> server.Team
+	93,  // 30: server.GameState.coop_progress:type_name -> server.CoopProgress
+	95,  // 31: server.GameResults.rankings:type_name -> server.PlayerResult
+	98,  // 32: server.SendChatResponse.message:type_name -> server.ChatMessage
+	5,   // 33: server.ReactRequest.emote:type_name -> server.Emote
+	104, // 34: server.GetTransactionsResponse.entries:type_name -> server.LedgerEntry
+	92,  // 35: server.GetGameStateResponse.state:type_name -> server.GameState
+	97,  // 36: server.GetGameStateResponse.credits:type_name -> server.Position
+	97,  // 37: server.GetGameStateResponse.deposits:type_name -> server.Position
+	108, // 38: server.GetGameStateResponse.loans:type_name -> server.Loan
+	98,  // 39: server.GetGameStateResponse.chat:type_name -> server.ChatMessage
+	10,  // 40: server.Loan.status:type_name -> server.LoanStatus
+	110, // 41: server.GetAchievementsResponse.achievements:type_name -> server.Achievement
+	113, // 42: server.GetChallengesResponse.challenges:type_name -> server.Challenge
+	78,  // 43: server.CreateTournamentRequest.config:type_name -> server.GameConfig
+	168, // 44: server.TournamentStandings.entries:type_name -> server.TournamentStandings.Entry
+	119, // 45: server.TournamentEvent.standings:type_name -> server.TournamentStandings
+	169, // 46: server.TournamentEvent.round_started:type_name -> server.TournamentEvent.RoundStarted
+	119, // 47: server.GetStandingsResponse.standings:type_name -> server.TournamentStandings
+	109, // 48: server.GetPlayerStatsResponse.stats:type_name -> server.PlayerStats
+	109, // 49: server.Profile.stats:type_name -> server.PlayerStats
+	125, // 50: server.RegisterResponse.profile:type_name -> server.Profile
+	125, // 51: server.LoginResponse.profile:type_name -> server.Profile
+	125, // 52: server.GetProfileResponse.profile:type_name -> server.Profile
+	125, // 53: server.UpdateProfileResponse.profile:type_name -> server.Profile
+	11,  // 54: server.GetLeaderboardRequest.window:type_name -> server.LeaderboardWindow
+	12,  // 55: server.GetLeaderboardRequest.order:type_name -> server.LeaderboardOrder
+	135, // 56: server.GetLeaderboardResponse.entries:type_name -> server.LeaderboardEntry
+	189, // 57: server.GameSummary.finish:type_name -> server.StreamResponse.Finish
+	96,  // 58: server.GameSummary.results:type_name -> server.GameResults
+	137, // 59: server.GetGameSummaryResponse.summary:type_name -> server.GameSummary
+	137, // 60: server.ListGameHistoryResponse.games:type_name -> server.GameSummary
+	78,  // 61: server.ReplayEvent.config:type_name -> server.GameConfig
+	108, // 62: server.ReplayEvent.loan:type_name -> server.Loan
+	48,  // 63: server.ReplayEvent.trade:type_name -> server.Trade
+	9,   // 64: server.AdminGame.status:type_name -> server.GameStatus
+	14,  // 65: server.AdminPlayer.player:type_name -> server.Player
+	97,  // 66: server.AdminPlayer.credits:type_name -> server.Position
+	97,  // 67: server.AdminPlayer.deposits:type_name -> server.Position
+	144, // 68: server.ListGamesResponse.games:type_name -> server.AdminGame
+	92,  // 69: server.InspectGameResponse.state:type_name -> server.GameState
+	78,  // 70: server.InspectGameResponse.config:type_name -> server.GameConfig
+	145, // 71: server.InspectGameResponse.players:type_name -> server.AdminPlayer
+	6,   // 72: server.Ban.kind:type_name -> server.BanKind
+	6,   // 73: server.BanRequest.kind:type_name -> server.BanKind
+	154, // 74: server.BanResponse.ban:type_name -> server.Ban
+	6,   // 75: server.UnbanRequest.kind:type_name -> server.BanKind
+	154, // 76: server.ListBansResponse.bans:type_name -> server.Ban
+	78,  // 77: server.GameExport.config:type_name -> server.GameConfig
+	143, // 78: server.GameExport.events:type_name -> server.ReplayEvent
+	14,  // 79: server.GameExport.players:type_name -> server.Player
+	96,  // 80: server.GameExport.results:type_name -> server.GameResults
+	78,  // 81: server.SetDefaultConfigRequest.config:type_name -> server.GameConfig
+	78,  // 82: server.SetDefaultConfigResponse.config:type_name -> server.GameConfig
+	185, // 83: server.StreamResponse.join:type_name -> server.StreamResponse.Join
+	187, // 84: server.StreamResponse.leave:type_name -> server.StreamResponse.Leave
+	188, // 85: server.StreamResponse.start:type_name -> server.StreamResponse.Start
+	189, // 86: server.StreamResponse.finish:type_name -> server.StreamResponse.Finish
+	190, // 87: server.StreamResponse.transaction:type_name -> server.StreamResponse.Transaction
+	92,  // 88: server.StreamResponse.state:type_name -> server.GameState
+	182, // 89: server.StreamResponse.stock_tick:type_name -> server.StreamResponse.StockTick
+	184, // 90: server.StreamResponse.inflation:type_name -> server.StreamResponse.Inflation
+	98,  // 91: server.StreamResponse.chat:type_name -> server.ChatMessage
+	183, // 92: server.StreamResponse.reaction:type_name -> server.StreamResponse.Reaction
+	186, // 93: server.StreamResponse.kicked:type_name -> server.StreamResponse.Kicked
+	181, // 94: server.StreamResponse.countdown:type_name -> server.StreamResponse.Countdown
+	110, // 95: server.StreamResponse.achievement:type_name -> server.Achievement
+	178, // 96: server.StreamResponse.team_change:type_name -> server.StreamResponse.TeamChange
+	93,  // 97: server.StreamResponse.coop_progress:type_name -> server.CoopProgress
+	176, // 98: server.StreamResponse.pause:type_name -> server.StreamResponse.Pause
+	177, // 99: server.StreamResponse.resume:type_name -> server.StreamResponse.Resume
+	172, // 100: server.StreamResponse.extension:type_name -> server.StreamResponse.Extension
+	170, // 101: server.StreamResponse.overtime:type_name -> server.StreamResponse.Overtime
+	171, // 102: server.StreamResponse.overtime_answer:type_name -> server.StreamResponse.OvertimeAnswer
+	96,  // 103: server.StreamResponse.results:type_name -> server.GameResults
+	175, // 104: server.StreamResponse.heartbeat:type_name -> server.StreamResponse.Heartbeat
+	174, // 105: server.StreamResponse.batch:type_name -> server.StreamResponse.Batch
+	173, // 106: server.StreamResponse.time_tick:type_name -> server.StreamResponse.TimeTick
+	179, // 107: server.StreamResponse.rename:type_name -> server.StreamResponse.Rename
+	180, // 108: server.StreamResponse.afk:type_name -> server.StreamResponse.Afk
+	16,  // 109: server.TournamentEvent.RoundStarted.join:type_name -> server.JoinResponse
+	13,  // 110: server.StreamResponse.TimeTick.marker:type_name -> server.StreamResponse.TimeTick.Marker
+	166, // 111: server.StreamResponse.Batch.events:type_name -> server.StreamResponse
+	9,   // 112: server.StreamResponse.Heartbeat.status:type_name -> server.GameStatus
+	5,   // 113: server.StreamResponse.Reaction.emote:type_name -> server.Emote
+	14,  // 114: server.StreamResponse.Join.player:type_name -> server.Player
+	78,  // 115: server.StreamResponse.Start.config:type_name -> server.GameConfig
+	14,  // 116: server.StreamResponse.Finish.players:type_name -> server.Player
+	94,  // 117: server.StreamResponse.Finish.teams:type_name -> server.Team
+	93,  // 118: server.StreamResponse.Finish.coop_progress:type_name -> server.CoopProgress
+	14,  // 119: server.StreamResponse.Transaction.players:type_name -> server.Player
+	191, // 120: server.StreamResponse.Transaction.use_credit:type_name -> server.StreamResponse.Transaction.UseCredit
+	192, // 121: server.StreamResponse.Transaction.use_deposit:type_name -> server.StreamResponse.Transaction.UseDeposit
+	193, // 122: server.StreamResponse.Transaction.return_credit:type_name -> server.StreamResponse.Transaction.ReturnCredit
+	195, // 123: server.StreamResponse.Transaction.return_deposit:type_name -> server.StreamResponse.Transaction.ReturnDeposit
+	203, // 124: server.StreamResponse.Transaction.theft:type_name -> server.StreamResponse.Transaction.Theft
+	204, // 125: server.StreamResponse.Transaction.lottery:type_name -> server.StreamResponse.Transaction.Lottery
+	205, // 126: server.StreamResponse.Transaction.question:type_name -> server.StreamResponse.Transaction.Question
+	206, // 127: server.StreamResponse.Transaction.question_timeout:type_name -> server.StreamResponse.Transaction.QuestionTimeout
+	194, // 128: server.StreamResponse.Transaction.repay_credit:type_name -> server.StreamResponse.Transaction.RepayCredit
+	196, // 129: server.StreamResponse.Transaction.withdraw_deposit:type_name -> server.StreamResponse.Transaction.WithdrawDeposit
+	197, // 130: server.StreamResponse.Transaction.loan_change:type_name -> server.StreamResponse.Transaction.LoanChange
+	48,  // 131: server.StreamResponse.Transaction.trade:type_name -> server.Trade
+	198, // 132: server.StreamResponse.Transaction.shares:type_name -> server.StreamResponse.Transaction.Shares
+	199, // 133: server.StreamResponse.Transaction.insurance:type_name -> server.StreamResponse.Transaction.Insurance
+	202, // 134: server.StreamResponse.Transaction.tax:type_name -> server.StreamResponse.Transaction.Tax
+	201, // 135: server.StreamResponse.Transaction.steal:type_name -> server.StreamResponse.Transaction.Steal
+	200, // 136: server.StreamResponse.Transaction.guard:type_name -> server.StreamResponse.Transaction.Guard
+	108, // 137: server.StreamResponse.Transaction.LoanChange.loan:type_name -> server.Loan
+	207, // 138: server.StreamResponse.Transaction.Tax.taxed_players:type_name -> server.StreamResponse.Transaction.Tax.TaxedPlayer
+	208, // 139: server.StreamResponse.Transaction.Theft.robbed_players:type_name -> server.StreamResponse.Transaction.Theft.RobbedPlayer
+	15,  // 140: server.Game.Join:input_type -> server.JoinRequest
+	17,  // 141: server.Game.Leave:input_type -> server.LeaveRequest
+	19,  // 142: server.Game.KickPlayer:input_type -> server.KickPlayerRequest
+	21,  // 143: server.Game.ChooseTeam:input_type -> server.ChooseTeamRequest
+	23,  // 144: server.Game.Rename:input_type -> server.RenameRequest
+	25,  // 145: server.Game.AddBots:input_type -> server.AddBotsRequest
+	27,  // 146: server.Game.PauseGame:input_type -> server.PauseGameRequest
+	29,  // 147: server.Game.ResumeGame:input_type -> server.ResumeGameRequest
+	31,  // 148: server.Game.ExtendGame:input_type -> server.ExtendGameRequest
+	33,  // 149: server.Game.AnswerOvertime:input_type -> server.AnswerOvertimeRequest
+	80,  // 150: server.Game.CreateRoom:input_type -> server.CreateRoomRequest
+	82,  // 151: server.Game.ListRooms:input_type -> server.ListRoomsRequest
+	84,  // 152: server.Game.JoinRoom:input_type -> server.JoinRoomRequest
+	88,  // 153: server.Game.ListGames:input_type -> server.ListLobbiesRequest
+	86,  // 154: server.Game.Enqueue:input_type -> server.EnqueueRequest
+	116, // 155: server.Game.CreateTournament:input_type -> server.CreateTournamentRequest
+	118, // 156: server.Game.JoinTournament:input_type -> server.JoinTournamentRequest
+	121, // 157: server.Game.GetStandings:input_type -> server.GetStandingsRequest
+	35,  // 158: server.Game.Start:input_type -> server.StartRequest
+	37,  // 159: server.Game.CancelStart:input_type -> server.CancelStartRequest
+	39,  // 160: server.Game.Credit:input_type -> server.CreditRequest
+	41,  // 161: server.Game.RepayCredit:input_type -> server.RepayCreditRequest
+	43,  // 162: server.Game.OfferLoan:input_type -> server.OfferLoanRequest
+	45,  // 163: server.Game.AcceptLoan:input_type -> server.AcceptLoanRequest
+	49,  // 164: server.Game.PlaceOrder:input_type -> server.PlaceOrderRequest
+	51,  // 165: server.Game.CancelOrder:input_type -> server.CancelOrderRequest
+	53,  // 166: server.Game.GetMarket:input_type -> server.GetMarketRequest
+	58,  // 167: server.Game.BuyShares:input_type -> server.BuySharesRequest
+	60,  // 168: server.Game.SellShares:input_type -> server.SellSharesRequest
+	62,  // 169: server.Game.BuyInsurance:input_type -> server.BuyInsuranceRequest
+	70,  // 170: server.Game.Steal:input_type -> server.StealRequest
+	68,  // 171: server.Game.HireGuard:input_type -> server.HireGuardRequest
+	64,  // 172: server.Game.Deposit:input_type -> server.DepositRequest
+	66,  // 173: server.Game.WithdrawDeposit:input_type -> server.WithdrawDepositRequest
+	72,  // 174: server.Game.Lottery:input_type -> server.LotteryRequest
+	74,  // 175: server.Game.GenerateQuestion:input_type -> server.GenerateQuestionRequest
+	76,  // 176: server.Game.AnswerQuestion:input_type -> server.AnswerQuestionRequest
+	90,  // 177: server.Game.Stream:input_type -> server.StreamRequest
+	106, // 178: server.Game.GetGameState:input_type -> server.GetGameStateRequest
+	103, // 179: server.Game.GetTransactions:input_type -> server.GetTransactionsRequest
+	99,  // 180: server.Game.SendChat:input_type -> server.SendChatRequest
+	101, // 181: server.Game.React:input_type -> server.ReactRequest
+	91,  // 182: server.Game.Reconnect:input_type -> server.ReconnectRequest
+	142, // 183: server.Game.Replay:input_type -> server.ReplayRequest
+	138, // 184: server.Game.GetGameSummary:input_type -> server.GetGameSummaryRequest
+	140, // 185: server.Game.ListGameHistory:input_type -> server.ListGameHistoryRequest
+	134, // 186: server.Game.GetLeaderboard:input_type -> server.GetLeaderboardRequest
+	126, // 187: server.Game.Register:input_type -> server.RegisterRequest
+	128, // 188: server.Game.Login:input_type -> server.LoginRequest
+	130, // 189: server.Game.GetProfile:input_type -> server.GetProfileRequest
+	132, // 190: server.Game.UpdateProfile:input_type -> server.UpdateProfileRequest
+	123, // 191: server.Game.GetPlayerStats:input_type -> server.GetPlayerStatsRequest
+	111, // 192: server.Game.GetAchievements:input_type -> server.GetAchievementsRequest
+	114, // 193: server.Game.GetChallenges:input_type -> server.GetChallengesRequest
+	146, // 194: server.Admin.ListGames:input_type -> server.ListGamesRequest
+	148, // 195: server.Admin.InspectGame:input_type -> server.InspectGameRequest
+	150, // 196: server.Admin.FinishGame:input_type -> server.FinishGameRequest
+	152, // 197: server.Admin.EvictPlayer:input_type -> server.EvictPlayerRequest
+	27,  // 198: server.Admin.PauseGame:input_type -> server.PauseGameRequest
+	29,  // 199: server.Admin.ResumeGame:input_type -> server.ResumeGameRequest
+	162, // 200: server.Admin.ExportGame:input_type -> server.ExportGameRequest
+	155, // 201: server.Admin.Ban:input_type -> server.BanRequest
+	157, // 202: server.Admin.Unban:input_type -> server.UnbanRequest
+	159, // 203: server.Admin.ListBans:input_type -> server.ListBansRequest
+	164, // 204: server.Admin.SetDefaultConfig:input_type -> server.SetDefaultConfigRequest
+	16,  // 205: server.Game.Join:output_type -> server.JoinResponse
+	18,  // 206: server.Game.Leave:output_type -> server.LeaveResponse
+	20,  // 207: server.Game.KickPlayer:output_type -> server.KickPlayerResponse
+	22,  // 208: server.Game.ChooseTeam:output_type -> server.ChooseTeamResponse
+	24,  // 209: server.Game.Rename:output_type -> server.RenameResponse
+	26,  // 210: server.Game.AddBots:output_type -> server.AddBotsResponse
+	28,  // 211: server.Game.PauseGame:output_type -> server.PauseGameResponse
+	30,  // 212: server.Game.ResumeGame:output_type -> server.ResumeGameResponse
+	32,  // 213: server.Game.ExtendGame:output_type -> server.ExtendGameResponse
+	34,  // 214: server.Game.AnswerOvertime:output_type -> server.AnswerOvertimeResponse
+	81,  // 215: server.Game.CreateRoom:output_type -> server.CreateRoomResponse
+	83,  // 216: server.Game.ListRooms:output_type -> server.ListRoomsResponse
+	16,  // 217: server.Game.JoinRoom:output_type -> server.JoinResponse
+	89,  // 218: server.Game.ListGames:output_type -> server.ListLobbiesResponse
+	87,  // 219: server.Game.Enqueue:output_type -> server.MatchmakingEvent
+	117, // 220: server.Game.CreateTournament:output_type -> server.CreateTournamentResponse
+	120, // 221: server.Game.JoinTournament:output_type -> server.TournamentEvent
+	122, // 222: server.Game.GetStandings:output_type -> server.GetStandingsResponse
+	36,  // 223: server.Game.Start:output_type -> server.StartResponse
+	38,  // 224: server.Game.CancelStart:output_type -> server.CancelStartResponse
+	40,  // 225: server.Game.Credit:output_type -> server.CreditResponse
+	42,  // 226: server.Game.RepayCredit:output_type -> server.RepayCreditResponse
+	44,  // 227: server.Game.OfferLoan:output_type -> server.OfferLoanResponse
+	46,  // 228: server.Game.AcceptLoan:output_type -> server.AcceptLoanResponse
+	50,  // 229: server.Game.PlaceOrder:output_type -> server.PlaceOrderResponse
+	52,  // 230: server.Game.CancelOrder:output_type -> server.CancelOrderResponse
+	57,  // 231: server.Game.GetMarket:output_type -> server.GetMarketResponse
+	59,  // 232: server.Game.BuyShares:output_type -> server.BuySharesResponse
+	61,  // 233: server.Game.SellShares:output_type -> server.SellSharesResponse
+	63,  // 234: server.Game.BuyInsurance:output_type -> server.BuyInsuranceResponse
+	71,  // 235: server.Game.Steal:output_type -> server.StealResponse
+	69,  // 236: server.Game.HireGuard:output_type -> server.HireGuardResponse
+	65,  // 237: server.Game.Deposit:output_type -> server.DepositResponse
+	67,  // 238: server.Game.WithdrawDeposit:output_type -> server.WithdrawDepositResponse
+	73,  // 239: server.Game.Lottery:output_type -> server.LotteryResponse
+	75,  // 240: server.Game.GenerateQuestion:output_type -> server.GenerateQuestionResponse
+	77,  // 241: server.Game.AnswerQuestion:output_type -> server.AnswerQuestionResponse
+	166, // 242: server.Game.Stream:output_type -> server.StreamResponse
+	107, // 243: server.Game.GetGameState:output_type -> server.GetGameStateResponse
+	105, // 244: server.Game.GetTransactions:output_type -> server.GetTransactionsResponse
+	100, // 245: server.Game.SendChat:output_type -> server.SendChatResponse
+	102, // 246: server.Game.React:output_type -> server.ReactResponse
+	166, // 247: server.Game.Reconnect:output_type -> server.StreamResponse
+	143, // 248: server.Game.Replay:output_type -> server.ReplayEvent
+	139, // 249: server.Game.GetGameSummary:output_type -> server.GetGameSummaryResponse
+	141, // 250: server.Game.ListGameHistory:output_type -> server.ListGameHistoryResponse
+	136, // 251: server.Game.GetLeaderboard:output_type -> server.GetLeaderboardResponse
+	127, // 252: server.Game.Register:output_type -> server.RegisterResponse
+	129, // 253: server.Game.Login:output_type -> server.LoginResponse
+	131, // 254: server.Game.GetProfile:output_type -> server.GetProfileResponse
+	133, // 255: server.Game.UpdateProfile:output_type -> server.UpdateProfileResponse
+	124, // 256: server.Game.GetPlayerStats:output_type -> server.GetPlayerStatsResponse
+	112, // 257: server.Game.GetAchievements:output_type -> server.GetAchievementsResponse
+	115, // 258: server.Game.GetChallenges:output_type -> server.GetChallengesResponse
+	147, // 259: server.Admin.ListGames:output_type -> server.ListGamesResponse
+	149, // 260: server.Admin.InspectGame:output_type -> server.InspectGameResponse
+	151, // 261: server.Admin.FinishGame:output_type -> server.FinishGameResponse
+	153, // 262: server.Admin.EvictPlayer:output_type -> server.EvictPlayerResponse
+	28,  // 263: server.Admin.PauseGame:output_type -> server.PauseGameResponse
+	30,  // 264: server.Admin.ResumeGame:output_type -> server.ResumeGameResponse
+	163, // 265: server.Admin.ExportGame:output_type -> server.ExportGameResponse
+	156, // 266: server.Admin.Ban:output_type -> server.BanResponse
+	158, // 267: server.Admin.Unban:output_type -> server.UnbanResponse
+	160, // 268: server.Admin.ListBans:output_type -> server.ListBansResponse
+	165, // 269: server.Admin.SetDefaultConfig:output_type -> server.SetDefaultConfigResponse
+	205, // [205:270] is the sub-list for method output_type
+	140, // [140:205] is the sub-list for method input_type
+	140, // [140:140] is the sub-list for extension type_name
+	140, // [140:140] is the sub-list for extension extendee
+	0,   // [0:140] is the sub-list for field type_name
+}
+
+func init() { file_game_proto_init() }
+func file_game_proto_init() {
+	if File_game_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_game_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickPlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickPlayerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChooseTeamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChooseTeamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerOvertimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerOvertimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelStartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelStartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepayCreditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepayCreditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaxBracket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetHolding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuySharesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuySharesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SellSharesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SellSharesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyInsuranceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyInsuranceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawDepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawDepositResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HireGuardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HireGuardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StealRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StealResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LotteryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LotteryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Room); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameListing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchmakingEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLobbiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLobbiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoopProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Loan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Achievement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAchievementsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAchievementsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Challenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChallengesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChallengesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTournamentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentStandings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStandingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStandingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGameHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGameHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGamesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvictPlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvictPlayerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ban); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBansResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDefaultConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDefaultConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchmakingEvent_Searching); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentStandings_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentEvent_RoundStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Overtime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_OvertimeAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Extension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_TimeTick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Batch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Heartbeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Pause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Resume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_TeamChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Rename); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Afk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Countdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_StockTick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Reaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Inflation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Join); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Kicked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Leave); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Start); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Finish); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_UseCredit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_UseDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_ReturnCredit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_RepayCredit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_ReturnDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_WithdrawDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_LoanChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_Shares); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_Insurance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_Guard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_Steal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_Tax); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_Theft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_Lottery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_Question); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_QuestionTimeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_Tax_TaxedPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Transaction_Theft_RobbedPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_game_proto_msgTypes[73].OneofWrappers = []interface{}{
+		(*MatchmakingEvent_Searching_)(nil),
+		(*MatchmakingEvent_MatchFound)(nil),
+	}
+	file_game_proto_msgTypes[106].OneofWrappers = []interface{}{
+		(*TournamentEvent_Standings)(nil),
+		(*TournamentEvent_RoundStarted_)(nil),
+	}
+	file_game_proto_msgTypes[152].OneofWrappers = []interface{}{
+		(*StreamResponse_Join_)(nil),
+		(*StreamResponse_Leave_)(nil),
+		(*StreamResponse_Start_)(nil),
+		(*StreamResponse_Finish_)(nil),
+		(*StreamResponse_Transaction_)(nil),
+		(*StreamResponse_State)(nil),
+		(*StreamResponse_StockTick_)(nil),
+		(*StreamResponse_Inflation_)(nil),
+		(*StreamResponse_Chat)(nil),
+		(*StreamResponse_Reaction_)(nil),
+		(*StreamResponse_Kicked_)(nil),
+		(*StreamResponse_Countdown_)(nil),
+		(*StreamResponse_Achievement)(nil),
+		(*StreamResponse_TeamChange_)(nil),
+		(*StreamResponse_CoopProgress)(nil),
+		(*StreamResponse_Pause_)(nil),
+		(*StreamResponse_Resume_)(nil),
+		(*StreamResponse_Extension_)(nil),
+		(*StreamResponse_Overtime_)(nil),
+		(*StreamResponse_OvertimeAnswer_)(nil),
+		(*StreamResponse_Results)(nil),
+		(*StreamResponse_Heartbeat_)(nil),
+		(*StreamResponse_Batch_)(nil),
+		(*StreamResponse_TimeTick_)(nil),
+		(*StreamResponse_Rename_)(nil),
+		(*StreamResponse_Afk_)(nil),
+	}
+	file_game_proto_msgTypes[176].OneofWrappers = []interface{}{
+		(*StreamResponse_Transaction_UseCredit_)(nil),
+		(*StreamResponse_Transaction_UseDeposit_)(nil),
+		(*StreamResponse_Transaction_ReturnCredit_)(nil),
+		(*StreamResponse_Transaction_ReturnDeposit_)(nil),
+		(*StreamResponse_Transaction_Theft_)(nil),
+		(*StreamResponse_Transaction_Lottery_)(nil),
+		(*StreamResponse_Transaction_Question_)(nil),
+		(*StreamResponse_Transaction_QuestionTimeout_)(nil),
+		(*StreamResponse_Transaction_RepayCredit_)(nil),
+		(*StreamResponse_Transaction_WithdrawDeposit_)(nil),
+		(*StreamResponse_Transaction_LoanChange_)(nil),
+		(*StreamResponse_Transaction_Trade)(nil),
+		(*StreamResponse_Transaction_Shares_)(nil),
+		(*StreamResponse_Transaction_Insurance_)(nil),
+		(*StreamResponse_Transaction_Tax_)(nil),
+		(*StreamResponse_Transaction_Steal_)(nil),
+		(*StreamResponse_Transaction_Guard_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_game_proto_rawDesc,
+			NumEnums:      14,
+			NumMessages:   195,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_game_proto_goTypes,
+		DependencyIndexes: file_game_proto_depIdxs,
+		EnumInfos:         file_game_proto_enumTypes,
+		MessageInfos:      file_game_proto_msgTypes,
+	}.Build()
+	File_game_proto = out.File
+	file_game_proto_rawDesc = nil
+	file_game_proto_goTypes = nil
+	file_game_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// GameClient is the client API for Game service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GameClient interface {
+	// To join, user needs to provide username to be displayed.
+	// If all games are full or finished, we will
+	// create a new one.
+	// Unique user_id will be generated for using within a game
+	// and returned.
+	// We will also return the initial game configs such as
+	// game duration, amount of points, etc.
+	// NOTE: players can only join or leave the "Waiting" game.
+	// For "Active" game, new users cannot join and existing
+	// users cannot leave.
+	Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error)
+	Leave(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*LeaveResponse, error)
+	// KickPlayer removes the player from the waiting game. Only the host,
+	// who is the player that has joined the game first, can kick players.
+	// If the host leaves, the host role passes to the player, who has
+	// joined next.
+	KickPlayer(ctx context.Context, in *KickPlayerRequest, opts ...grpc.CallOption) (*KickPlayerResponse, error)
+	// ChooseTeam moves the player to another team before the game starts.
+	// Players join the team with the fewest players, and teams are
+	// reassigned, if they don't fit the number of teams overridden at start.
+	ChooseTeam(ctx context.Context, in *ChooseTeamRequest, opts ...grpc.CallOption) (*ChooseTeamResponse, error)
+	// Rename changes the username of the player before the game starts.
+	// Players of tournament rounds and players logged in with accounts
+	// cannot rename, since their results are kept by their usernames.
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*RenameResponse, error)
+	// AddBots adds bots to the waiting game on behalf of the host. Bots are
+	// driven by the server and make credits, deposits, lottery plays, and
+	// questions during the game as regular players do.
+	AddBots(ctx context.Context, in *AddBotsRequest, opts ...grpc.CallOption) (*AddBotsResponse, error)
+	// PauseGame freezes the active game: the game clock, credits, deposits,
+	// loans, questions, and cooldowns stop, and players cannot act until
+	// the game is resumed. The game resumes automatically after 5 minutes.
+	PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*PauseGameResponse, error)
+	ResumeGame(ctx context.Context, in *ResumeGameRequest, opts ...grpc.CallOption) (*ResumeGameResponse, error)
+	// ExtendGame votes to extend the active game. Once the majority of
+	// the players (bots don't vote) have voted, the game is extended by
+	// 30 seconds, and voting starts over. The game can be extended 3 times.
+	ExtendGame(ctx context.Context, in *ExtendGameRequest, opts ...grpc.CallOption) (*ExtendGameResponse, error)
+	// AnswerOvertime answers the question of the overtime. When players
+	// finish with the same points, the game enters overtime instead of
+	// finishing: tied players are asked the same question, and the first
+	// correct answer wins. Players cannot act during overtime. If nobody
+	// answers correctly in 15 seconds, the next question is asked, and the
+	// game is a draw after 3 rounds.
+	AnswerOvertime(ctx context.Context, in *AnswerOvertimeRequest, opts ...grpc.CallOption) (*AnswerOvertimeResponse, error)
+	// Rooms are waiting games, which exist alongside the default
+	// waiting game used by Join. Each room has its own game config.
+	// Room is removed from the list once it is started or once
+	// all of its players leave.
+	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error)
+	ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error)
+	JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*JoinResponse, error)
+	// ListGames returns all games, which can be joined, with the summary
+	// of their configs, so that clients can show the list of the games.
+	ListGames(ctx context.Context, in *ListLobbiesRequest, opts ...grpc.CallOption) (*ListLobbiesResponse, error)
+	// Enqueue waits for the players of similar rating and adds all of them
+	// to the new room. Closing the stream removes the player from the queue.
+	Enqueue(ctx context.Context, in *EnqueueRequest, opts ...grpc.CallOption) (Game_EnqueueClient, error)
+	// Tournaments are series of games played by the same players. Players
+	// register with JoinTournament, which keeps the stream open until the
+	// end of the tournament, and they are added to the game of every round.
+	// Closing the stream before the start cancels the registration.
+	CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*CreateTournamentResponse, error)
+	JoinTournament(ctx context.Context, in *JoinTournamentRequest, opts ...grpc.CallOption) (Game_JoinTournamentClient, error)
+	GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*GetStandingsResponse, error)
+	// The host will start the game. We cannot automatically
+	// start the game, since it is not some popular project with
+	// a constant flow of users - there may not be enough users in
+	// a game. Only the host can override the game config.
+	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
+	// CancelStart cancels the countdown to the start of the game, which
+	// has start countdown. Only the host can cancel the start.
+	CancelStart(ctx context.Context, in *CancelStartRequest, opts ...grpc.CallOption) (*CancelStartResponse, error)
+	Credit(ctx context.Context, in *CreditRequest, opts ...grpc.CallOption) (*CreditResponse, error)
+	// RepayCredit returns the whole credit or its part before its time ends.
+	// Interest on the repaid part is charged only for the time passed since
+	// the credit was taken.
+	RepayCredit(ctx context.Context, in *RepayCreditRequest, opts ...grpc.CallOption) (*RepayCreditResponse, error)
+	// OfferLoan offers a loan to another player of the game.
+	// Points move from the lender to the borrower, when the
+	// borrower accepts the loan with AcceptLoan, and they are
+	// collected back together with the interest, when its time ends.
+	OfferLoan(ctx context.Context, in *OfferLoanRequest, opts ...grpc.CallOption) (*OfferLoanResponse, error)
+	AcceptLoan(ctx context.Context, in *AcceptLoanRequest, opts ...grpc.CallOption) (*AcceptLoanResponse, error)
+	// PlaceOrder lists units of the asset for sale or bids on them.
+	// Trades are settled right away against the points and the assets
+	// of both players, and they are broadcast on the stream.
+	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	GetMarket(ctx context.Context, in *GetMarketRequest, opts ...grpc.CallOption) (*GetMarketResponse, error)
+	// BuyShares and SellShares trade shares of the stock with the bank
+	// at the current price, which changes every stock tick.
+	BuyShares(ctx context.Context, in *BuySharesRequest, opts ...grpc.CallOption) (*BuySharesResponse, error)
+	SellShares(ctx context.Context, in *SellSharesRequest, opts ...grpc.CallOption) (*SellSharesResponse, error)
+	// BuyInsurance insures the player against the next theft or steal. The
+	// premium is paid to the bank, and the coverage percentage of the stolen
+	// points is paid back, when the theft or the successful steal happens.
+	BuyInsurance(ctx context.Context, in *BuyInsuranceRequest, opts ...grpc.CallOption) (*BuyInsuranceResponse, error)
+	// Steal tries to steal theft_percentage of the points of the target.
+	// Each player can steal once per theft_time seconds.
+	Steal(ctx context.Context, in *StealRequest, opts ...grpc.CallOption) (*StealResponse, error)
+	// HireGuard protects the player from thefts for guard_time seconds.
+	HireGuard(ctx context.Context, in *HireGuardRequest, opts ...grpc.CallOption) (*HireGuardResponse, error)
+	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
+	// WithdrawDeposit returns the whole deposit or its part before its time
+	// ends without interest. Penalty percentage of the withdrawn part is
+	// kept by the bank.
+	WithdrawDeposit(ctx context.Context, in *WithdrawDepositRequest, opts ...grpc.CallOption) (*WithdrawDepositResponse, error)
+	Lottery(ctx context.Context, in *LotteryRequest, opts ...grpc.CallOption) (*LotteryResponse, error)
+	GenerateQuestion(ctx context.Context, in *GenerateQuestionRequest, opts ...grpc.CallOption) (*GenerateQuestionResponse, error)
+	AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*AnswerQuestionResponse, error)
+	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Game_StreamClient, error)
+	// GetGameState returns authoritative snapshot of the game for
+	// clients, which may have missed some of the stream events.
+	GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GetGameStateResponse, error)
+	// GetTransactions returns the ledger of the player, i.e. every change
+	// of the points of the player in the game with the points after it.
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	// SendChat sends the message to all players of the game, which
+	// can be either waiting or active. Latest messages are kept in
+	// the game and returned by GetGameState.
+	SendChat(ctx context.Context, in *SendChatRequest, opts ...grpc.CallOption) (*SendChatResponse, error)
+	// React broadcasts the emote to all players of the game. Each player
+	// can react once per second with bursts of up to 3 reactions, other
+	// reactions are refused with RESOURCE_EXHAUSTED code.
+	React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*ReactResponse, error)
+	// Reconnect replaces the stream of the player, who has lost
+	// connection, with the new one. The first event sent on the
+	// new stream contains the full current state of the game.
+	Reconnect(ctx context.Context, in *ReconnectRequest, opts ...grpc.CallOption) (Game_ReconnectClient, error)
+	// Replay sends the events of the finished game with the same
+	// intervals between them as in the original game, so that
+	// clients can play the game back.
+	Replay(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (Game_ReplayClient, error)
+	// GetGameSummary returns the Finish event and the results of the game,
+	// which has finished within the last hour, so that players, who
+	// reconnect after the finish, can still see the results.
+	GetGameSummary(ctx context.Context, in *GetGameSummaryRequest, opts ...grpc.CallOption) (*GetGameSummaryResponse, error)
+	// ListGameHistory returns summaries of the games, which the player has
+	// finished, so that players can browse their past games. It requires
+	// the server to keep finished games in the storage.
+	ListGameHistory(ctx context.Context, in *ListGameHistoryRequest, opts ...grpc.CallOption) (*ListGameHistoryResponse, error)
+	// GetLeaderboard ranks the players by the results of the games,
+	// which have finished in the requested time window. It requires
+	// the server to keep finished games in the storage.
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	// Register creates the account, which reserves the username, so that
+	// other players cannot join games with it. Account token returned by
+	// Register and Login is passed to Join, JoinRoom, and Enqueue to keep
+	// the same identity across games.
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	// GetPlayerStats returns statistics of the player over all finished
+	// games, which are updated when the game finishes. Players without
+	// account are identified by their usernames as well.
+	GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*GetPlayerStatsResponse, error)
+	// GetAchievements returns achievements, which the player has unlocked.
+	// Every achievement is unlocked once and pushed to the stream of the
+	// player, who has unlocked it.
+	GetAchievements(ctx context.Context, in *GetAchievementsRequest, opts ...grpc.CallOption) (*GetAchievementsResponse, error)
+	// GetChallenges returns the challenges, which are given to all players
+	// for the current day (UTC). Progress is made in the finished games,
+	// and completed challenges reward the player with coins.
+	GetChallenges(ctx context.Context, in *GetChallengesRequest, opts ...grpc.CallOption) (*GetChallengesResponse, error)
+}
+
+type gameClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameClient(cc grpc.ClientConnInterface) GameClient {
+	return &gameClient{cc}
+}
+
+func (c *gameClient) Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
+	out := new(JoinResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Join", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Leave(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*LeaveResponse, error) {
+	out := new(LeaveResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Leave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) KickPlayer(ctx context.Context, in *KickPlayerRequest, opts ...grpc.CallOption) (*KickPlayerResponse, error) {
+	out := new(KickPlayerResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/KickPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) ChooseTeam(ctx context.Context, in *ChooseTeamRequest, opts ...grpc.CallOption) (*ChooseTeamResponse, error) {
+	out := new(ChooseTeamResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/ChooseTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*RenameResponse, error) {
+	out := new(RenameResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Rename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) AddBots(ctx context.Context, in *AddBotsRequest, opts ...grpc.CallOption) (*AddBotsResponse, error) {
+	out := new(AddBotsResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/AddBots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*PauseGameResponse, error) {
+	out := new(PauseGameResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/PauseGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) ResumeGame(ctx context.Context, in *ResumeGameRequest, opts ...grpc.CallOption) (*ResumeGameResponse, error) {
+	out := new(ResumeGameResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/ResumeGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) ExtendGame(ctx context.Context, in *ExtendGameRequest, opts ...grpc.CallOption) (*ExtendGameResponse, error) {
+	out := new(ExtendGameResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/ExtendGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) AnswerOvertime(ctx context.Context, in *AnswerOvertimeRequest, opts ...grpc.CallOption) (*AnswerOvertimeResponse, error) {
+	out := new(AnswerOvertimeResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/AnswerOvertime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error) {
+	out := new(CreateRoomResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/CreateRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error) {
+	out := new(ListRoomsResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/ListRooms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
+	out := new(JoinResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/JoinRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) ListGames(ctx context.Context, in *ListLobbiesRequest, opts ...grpc.CallOption) (*ListLobbiesResponse, error) {
+	out := new(ListLobbiesResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/ListGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Enqueue(ctx context.Context, in *EnqueueRequest, opts ...grpc.CallOption) (Game_EnqueueClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Game_serviceDesc.Streams[0], "/server.Game/Enqueue", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gameEnqueueClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Game_EnqueueClient interface {
+	Recv() (*MatchmakingEvent, error)
+	grpc.ClientStream
+}
+
+type gameEnqueueClient struct {
+	grpc.ClientStream
+}
+
+func (x *gameEnqueueClient) Recv() (*MatchmakingEvent, error) {
+	m := new(MatchmakingEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gameClient) CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*CreateTournamentResponse, error) {
+	out := new(CreateTournamentResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/CreateTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) JoinTournament(ctx context.Context, in *JoinTournamentRequest, opts ...grpc.CallOption) (Game_JoinTournamentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Game_serviceDesc.Streams[1], "/server.Game/JoinTournament", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gameJoinTournamentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Game_JoinTournamentClient interface {
+	Recv() (*TournamentEvent, error)
+	grpc.ClientStream
+}
+
+type gameJoinTournamentClient struct {
+	grpc.ClientStream
+}
+
+func (x *gameJoinTournamentClient) Recv() (*TournamentEvent, error) {
+	m := new(TournamentEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gameClient) GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*GetStandingsResponse, error) {
+	out := new(GetStandingsResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetStandings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
+	out := new(StartResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Start", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) CancelStart(ctx context.Context, in *CancelStartRequest, opts ...grpc.CallOption) (*CancelStartResponse, error) {
+	out := new(CancelStartResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/CancelStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Credit(ctx context.Context, in *CreditRequest, opts ...grpc.CallOption) (*CreditResponse, error) {
+	out := new(CreditResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Credit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) RepayCredit(ctx context.Context, in *RepayCreditRequest, opts ...grpc.CallOption) (*RepayCreditResponse, error) {
+	out := new(RepayCreditResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/RepayCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) OfferLoan(ctx context.Context, in *OfferLoanRequest, opts ...grpc.CallOption) (*OfferLoanResponse, error) {
+	out := new(OfferLoanResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/OfferLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) AcceptLoan(ctx context.Context, in *AcceptLoanRequest, opts ...grpc.CallOption) (*AcceptLoanResponse, error) {
+	out := new(AcceptLoanResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/AcceptLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error) {
+	out := new(PlaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/PlaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GetMarket(ctx context.Context, in *GetMarketRequest, opts ...grpc.CallOption) (*GetMarketResponse, error) {
+	out := new(GetMarketResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) BuyShares(ctx context.Context, in *BuySharesRequest, opts ...grpc.CallOption) (*BuySharesResponse, error) {
+	out := new(BuySharesResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/BuyShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) SellShares(ctx context.Context, in *SellSharesRequest, opts ...grpc.CallOption) (*SellSharesResponse, error) {
+	out := new(SellSharesResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/SellShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) BuyInsurance(ctx context.Context, in *BuyInsuranceRequest, opts ...grpc.CallOption) (*BuyInsuranceResponse, error) {
+	out := new(BuyInsuranceResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/BuyInsurance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Steal(ctx context.Context, in *StealRequest, opts ...grpc.CallOption) (*StealResponse, error) {
+	out := new(StealResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Steal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) HireGuard(ctx context.Context, in *HireGuardRequest, opts ...grpc.CallOption) (*HireGuardResponse, error) {
+	out := new(HireGuardResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/HireGuard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
+	out := new(DepositResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) WithdrawDeposit(ctx context.Context, in *WithdrawDepositRequest, opts ...grpc.CallOption) (*WithdrawDepositResponse, error) {
+	out := new(WithdrawDepositResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/WithdrawDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Lottery(ctx context.Context, in *LotteryRequest, opts ...grpc.CallOption) (*LotteryResponse, error) {
+	out := new(LotteryResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Lottery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GenerateQuestion(ctx context.Context, in *GenerateQuestionRequest, opts ...grpc.CallOption) (*GenerateQuestionResponse, error) {
+	out := new(GenerateQuestionResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GenerateQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*AnswerQuestionResponse, error) {
+	out := new(AnswerQuestionResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/AnswerQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Game_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Game_serviceDesc.Streams[2], "/server.Game/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gameStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Game_StreamClient interface {
+	Recv() (*StreamResponse, error)
+	grpc.ClientStream
+}
+
+type gameStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *gameStreamClient) Recv() (*StreamResponse, error) {
+	m := new(StreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gameClient) GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GetGameStateResponse, error) {
+	out := new(GetGameStateResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetGameState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) SendChat(ctx context.Context, in *SendChatRequest, opts ...grpc.CallOption) (*SendChatResponse, error) {
+	out := new(SendChatResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/SendChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*ReactResponse, error) {
+	out := new(ReactResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/React", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Reconnect(ctx context.Context, in *ReconnectRequest, opts ...grpc.CallOption) (Game_ReconnectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Game_serviceDesc.Streams[3], "/server.Game/Reconnect", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gameReconnectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Game_ReconnectClient interface {
+	Recv() (*StreamResponse, error)
+	grpc.ClientStream
+}
+
+type gameReconnectClient struct {
+	grpc.ClientStream
+}
+
+func (x *gameReconnectClient) Recv() (*StreamResponse, error) {
+	m := new(StreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gameClient) Replay(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (Game_ReplayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Game_serviceDesc.Streams[4], "/server.Game/Replay", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gameReplayClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Game_ReplayClient interface {
+	Recv() (*ReplayEvent, error)
+	grpc.ClientStream
+}
+
+type gameReplayClient struct {
+	grpc.ClientStream
+}
+
+func (x *gameReplayClient) Recv() (*ReplayEvent, error) {
+	m := new(ReplayEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gameClient) GetGameSummary(ctx context.Context, in *GetGameSummaryRequest, opts ...grpc.CallOption) (*GetGameSummaryResponse, error) {
+	out := new(GetGameSummaryResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetGameSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) ListGameHistory(ctx context.Context, in *ListGameHistoryRequest, opts ...grpc.CallOption) (*ListGameHistoryResponse, error) {
+	out := new(ListGameHistoryResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/ListGameHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error) {
+	out := new(GetProfileResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*GetPlayerStatsResponse, error) {
+	out := new(GetPlayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetPlayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GetAchievements(ctx context.Context, in *GetAchievementsRequest, opts ...grpc.CallOption) (*GetAchievementsResponse, error) {
+	out := new(GetAchievementsResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetAchievements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GetChallenges(ctx context.Context, in *GetChallengesRequest, opts ...grpc.CallOption) (*GetChallengesResponse, error) {
+	out := new(GetChallengesResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetChallenges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameServer is the server API for Game service.
+type GameServer interface {
+	// To join, user needs to provide username to be displayed.
+	// If all games are full or finished, we will
+	// create a new one.
+	// Unique user_id will be generated for using within a game
+	// and returned.
+	// We will also return the initial game configs such as
+	// game duration, amount of points, etc.
+	// NOTE: players can only join or leave the "Waiting" game.
+	// For "Active" game, new users cannot join and existing
+	// users cannot leave.
+	Join(context.Context, *JoinRequest) (*JoinResponse, error)
+	Leave(context.Context, *LeaveRequest) (*LeaveResponse, error)
+	// KickPlayer removes the player from the waiting game. Only the host,
+	// who is the player that has joined the game first, can kick players.
+	// If the host leaves, the host role passes to the player, who has
+	// joined next.
+	KickPlayer(context.Context, *KickPlayerRequest) (*KickPlayerResponse, error)
+	// ChooseTeam moves the player to another team before the game starts.
+	// Players join the team with the fewest players, and teams are
+	// reassigned, if they don't fit the number of teams overridden at start.
+	ChooseTeam(context.Context, *ChooseTeamRequest) (*ChooseTeamResponse, error)
+	// Rename changes the username of the player before the game starts.
+	// Players of tournament rounds and players logged in with accounts
+	// cannot rename, since their results are kept by their usernames.
+	Rename(context.Context, *RenameRequest) (*RenameResponse, error)
+	// AddBots adds bots to the waiting game on behalf of the host. Bots are
+	// driven by the server and make credits, deposits, lottery plays, and
+	// questions during the game as regular players do.
+	AddBots(context.Context, *AddBotsRequest) (*AddBotsResponse, error)
+	// PauseGame freezes the active game: the game clock, credits, deposits,
+	// loans, questions, and cooldowns stop, and players cannot act until
+	// the game is resumed. The game resumes automatically after 5 minutes.
+	PauseGame(context.Context, *PauseGameRequest) (*PauseGameResponse, error)
+	ResumeGame(context.Context, *ResumeGameRequest) (*ResumeGameResponse, error)
+	// ExtendGame votes to extend the active game. Once the majority of
+	// the players (bots don't vote) have voted, the game is extended by
+	// 30 seconds, and voting starts over. The game can be extended 3 times.
+	ExtendGame(context.Context, *ExtendGameRequest) (*ExtendGameResponse, error)
+	// AnswerOvertime answers the question of the overtime. When players
+	// finish with the same points, the game enters overtime instead of
+	// finishing: tied players are asked the same question, and the first
+	// correct answer wins. Players cannot act during overtime. If nobody
+	// answers correctly in 15 seconds, the next question is asked, and the
+	// game is a draw after 3 rounds.
+	AnswerOvertime(context.Context, *AnswerOvertimeRequest) (*AnswerOvertimeResponse, error)
+	// Rooms are waiting games, which exist alongside the default
+	// waiting game used by Join. Each room has its own game config.
+	// Room is removed from the list once it is started or once
+	// all of its players leave.
+	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
+	ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error)
+	JoinRoom(context.Context, *JoinRoomRequest) (*JoinResponse, error)
+	// ListGames returns all games, which can be joined, with the summary
+	// of their configs, so that clients can show the list of the games.
+	ListGames(context.Context, *ListLobbiesRequest) (*ListLobbiesResponse, error)
+	// Enqueue waits for the players of similar rating and adds all of them
+	// to the new room. Closing the stream removes the player from the queue.
+	Enqueue(*EnqueueRequest, Game_EnqueueServer) error
+	// Tournaments are series of games played by the same players. Players
+	// register with JoinTournament, which keeps the stream open until the
+	// end of the tournament, and they are added to the game of every round.
+	// Closing the stream before the start cancels the registration.
+	CreateTournament(context.Context, *CreateTournamentRequest) (*CreateTournamentResponse, error)
+	JoinTournament(*JoinTournamentRequest, Game_JoinTournamentServer) error
+	GetStandings(context.Context, *GetStandingsRequest) (*GetStandingsResponse, error)
+	// The host will start the game. We cannot automatically
+	// start the game, since it is not some popular project with
+	// a constant flow of users - there may not be enough users in
+	// a game. Only the host can override the game config.
+	Start(context.Context, *StartRequest) (*StartResponse, error)
+	// CancelStart cancels the countdown to the start of the game, which
+	// has start countdown. Only the host can cancel the start.
+	CancelStart(context.Context, *CancelStartRequest) (*CancelStartResponse, error)
+	Credit(context.Context, *CreditRequest) (*CreditResponse, error)
+	// RepayCredit returns the whole credit or its part before its time ends.
+	// Interest on the repaid part is charged only for the time passed since
+	// the credit was taken.
+	RepayCredit(context.Context, *RepayCreditRequest) (*RepayCreditResponse, error)
+	// OfferLoan offers a loan to another player of the game.
+	// Points move from the lender to the borrower, when the
+	// borrower accepts the loan with AcceptLoan, and they are
+	// collected back together with the interest, when its time ends.
+	OfferLoan(context.Context, *OfferLoanRequest) (*OfferLoanResponse, error)
+	AcceptLoan(context.Context, *AcceptLoanRequest) (*AcceptLoanResponse, error)
+	// PlaceOrder lists units of the asset for sale or bids on them.
+	// Trades are settled right away against the points and the assets
+	// of both players, and they are broadcast on the stream.
+	PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	GetMarket(context.Context, *GetMarketRequest) (*GetMarketResponse, error)
+	// BuyShares and SellShares trade shares of the stock with the bank
+	// at the current price, which changes every stock tick.
+	BuyShares(context.Context, *BuySharesRequest) (*BuySharesResponse, error)
+	SellShares(context.Context, *SellSharesRequest) (*SellSharesResponse, error)
+	// BuyInsurance insures the player against the next theft or steal. The
+	// premium is paid to the bank, and the coverage percentage of the stolen
+	// points is paid back, when the theft or the successful steal happens.
+	BuyInsurance(context.Context, *BuyInsuranceRequest) (*BuyInsuranceResponse, error)
+	// Steal tries to steal theft_percentage of the points of the target.
+	// Each player can steal once per theft_time seconds.
+	Steal(context.Context, *StealRequest) (*StealResponse, error)
+	// HireGuard protects the player from thefts for guard_time seconds.
+	HireGuard(context.Context, *HireGuardRequest) (*HireGuardResponse, error)
+	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
+	// WithdrawDeposit returns the whole deposit or its part before its time
+	// ends without interest. Penalty percentage of the withdrawn part is
+	// kept by the bank.
+	WithdrawDeposit(context.Context, *WithdrawDepositRequest) (*WithdrawDepositResponse, error)
+	Lottery(context.Context, *LotteryRequest) (*LotteryResponse, error)
+	GenerateQuestion(context.Context, *GenerateQuestionRequest) (*GenerateQuestionResponse, error)
+	AnswerQuestion(context.Context, *AnswerQuestionRequest) (*AnswerQuestionResponse, error)
+	Stream(*StreamRequest, Game_StreamServer) error
+	// GetGameState returns authoritative snapshot of the game for
+	// clients, which may have missed some of the stream events.
+	GetGameState(context.Context, *GetGameStateRequest) (*GetGameStateResponse, error)
+	// GetTransactions returns the ledger of the player, i.e. every change
+	// of the points of the player in the game with the points after it.
+	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	// SendChat sends the message to all players of the game, which
+	// can be either waiting or active. Latest messages are kept in
+	// the game and returned by GetGameState.
+	SendChat(context.Context, *SendChatRequest) (*SendChatResponse, error)
+	// React broadcasts the emote to all players of the game. Each player
+	// can react once per second with bursts of up to 3 reactions, other
+	// reactions are refused with RESOURCE_EXHAUSTED code.
+	React(context.Context, *ReactRequest) (*ReactResponse, error)
+	// Reconnect replaces the stream of the player, who has lost
+	// connection, with the new one. The first event sent on the
+	// new stream contains the full current state of the game.
+	Reconnect(*ReconnectRequest, Game_ReconnectServer) error
+	// Replay sends the events of the finished game with the same
+	// intervals between them as in the original game, so that
+	// clients can play the game back.
+	Replay(*ReplayRequest, Game_ReplayServer) error
+	// GetGameSummary returns the Finish event and the results of the game,
+	// which has finished within the last hour, so that players, who
+	// reconnect after the finish, can still see the results.
+	GetGameSummary(context.Context, *GetGameSummaryRequest) (*GetGameSummaryResponse, error)
+	// ListGameHistory returns summaries of the games, which the player has
+	// finished, so that players can browse their past games. It requires
+	// the server to keep finished games in the storage.
+	ListGameHistory(context.Context, *ListGameHistoryRequest) (*ListGameHistoryResponse, error)
+	// GetLeaderboard ranks the players by the results of the games,
+	// which have finished in the requested time window. It requires
+	// the server to keep finished games in the storage.
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	// Register creates the account, which reserves the username, so that
+	// other players cannot join games with it. Account token returned by
+	// Register and Login is passed to Join, JoinRoom, and Enqueue to keep
+	// the same identity across games.
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// GetPlayerStats returns statistics of the player over all finished
+	// games, which are updated when the game finishes. Players without
+	// account are identified by their usernames as well.
+	GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*GetPlayerStatsResponse, error)
+	// GetAchievements returns achievements, which the player has unlocked.
+	// Every achievement is unlocked once and pushed to the stream of the
+	// player, who has unlocked it.
+	GetAchievements(context.Context, *GetAchievementsRequest) (*GetAchievementsResponse, error)
+	// GetChallenges returns the challenges, which are given to all players
+	// for the current day (UTC). Progress is made in the finished games,
+	// and completed challenges reward the player with coins.
+	GetChallenges(context.Context, *GetChallengesRequest) (*GetChallengesResponse, error)
+}
+
+// UnimplementedGameServer can be embedded to have forward compatible implementations.
+type UnimplementedGameServer struct {
+}
+
+func (*UnimplementedGameServer) Join(context.Context, *JoinRequest) (*JoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Join not implemented")
+}
+func (*UnimplementedGameServer) Leave(context.Context, *LeaveRequest) (*LeaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leave not implemented")
+}
+func (*UnimplementedGameServer) KickPlayer(context.Context, *KickPlayerRequest) (*KickPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickPlayer not implemented")
+}
+func (*UnimplementedGameServer) ChooseTeam(context.Context, *ChooseTeamRequest) (*ChooseTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChooseTeam not implemented")
+}
+func (*UnimplementedGameServer) Rename(context.Context, *RenameRequest) (*RenameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (*UnimplementedGameServer) AddBots(context.Context, *AddBotsRequest) (*AddBotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBots not implemented")
+}
+func (*UnimplementedGameServer) PauseGame(context.Context, *PauseGameRequest) (*PauseGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseGame not implemented")
+}
+func (*UnimplementedGameServer) ResumeGame(context.Context, *ResumeGameRequest) (*ResumeGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeGame not implemented")
+}
+func (*UnimplementedGameServer) ExtendGame(context.Context, *ExtendGameRequest) (*ExtendGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendGame not implemented")
+}
+func (*UnimplementedGameServer) AnswerOvertime(context.Context, *AnswerOvertimeRequest) (*AnswerOvertimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerOvertime not implemented")
+}
+func (*UnimplementedGameServer) CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (*UnimplementedGameServer) ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRooms not implemented")
+}
+func (*UnimplementedGameServer) JoinRoom(context.Context, *JoinRoomRequest) (*JoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinRoom not implemented")
+}
+func (*UnimplementedGameServer) ListGames(context.Context, *ListLobbiesRequest) (*ListLobbiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (*UnimplementedGameServer) Enqueue(*EnqueueRequest, Game_EnqueueServer) error {
+	return status.Errorf(codes.Unimplemented, "method Enqueue not implemented")
+}
+func (*UnimplementedGameServer) CreateTournament(context.Context, *CreateTournamentRequest) (*CreateTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournament not implemented")
+}
+func (*UnimplementedGameServer) JoinTournament(*JoinTournamentRequest, Game_JoinTournamentServer) error {
+	return status.Errorf(codes.Unimplemented, "method JoinTournament not implemented")
+}
+func (*UnimplementedGameServer) GetStandings(context.Context, *GetStandingsRequest) (*GetStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandings not implemented")
+}
+func (*UnimplementedGameServer) Start(context.Context, *StartRequest) (*StartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (*UnimplementedGameServer) CancelStart(context.Context, *CancelStartRequest) (*CancelStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStart not implemented")
+}
+func (*UnimplementedGameServer) Credit(context.Context, *CreditRequest) (*CreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Credit not implemented")
+}
+func (*UnimplementedGameServer) RepayCredit(context.Context, *RepayCreditRequest) (*RepayCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayCredit not implemented")
+}
+func (*UnimplementedGameServer) OfferLoan(context.Context, *OfferLoanRequest) (*OfferLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferLoan not implemented")
+}
+func (*UnimplementedGameServer) AcceptLoan(context.Context, *AcceptLoanRequest) (*AcceptLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptLoan not implemented")
+}
+func (*UnimplementedGameServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (*UnimplementedGameServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedGameServer) GetMarket(context.Context, *GetMarketRequest) (*GetMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarket not implemented")
+}
+func (*UnimplementedGameServer) BuyShares(context.Context, *BuySharesRequest) (*BuySharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyShares not implemented")
+}
+func (*UnimplementedGameServer) SellShares(context.Context, *SellSharesRequest) (*SellSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellShares not implemented")
+}
+func (*UnimplementedGameServer) BuyInsurance(context.Context, *BuyInsuranceRequest) (*BuyInsuranceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyInsurance not implemented")
+}
+func (*UnimplementedGameServer) Steal(context.Context, *StealRequest) (*StealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Steal not implemented")
+}
+func (*UnimplementedGameServer) HireGuard(context.Context, *HireGuardRequest) (*HireGuardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HireGuard not implemented")
+}
+func (*UnimplementedGameServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedGameServer) WithdrawDeposit(context.Context, *WithdrawDepositRequest) (*WithdrawDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDeposit not implemented")
+}
+func (*UnimplementedGameServer) Lottery(context.Context, *LotteryRequest) (*LotteryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lottery not implemented")
+}
+func (*UnimplementedGameServer) GenerateQuestion(context.Context, *GenerateQuestionRequest) (*GenerateQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateQuestion not implemented")
+}
+func (*UnimplementedGameServer) AnswerQuestion(context.Context, *AnswerQuestionRequest) (*AnswerQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerQuestion not implemented")
+}
+func (*UnimplementedGameServer) Stream(*StreamRequest, Game_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (*UnimplementedGameServer) GetGameState(context.Context, *GetGameStateRequest) (*GetGameStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameState not implemented")
+}
+func (*UnimplementedGameServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (*UnimplementedGameServer) SendChat(context.Context, *SendChatRequest) (*SendChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChat not implemented")
+}
+func (*UnimplementedGameServer) React(context.Context, *ReactRequest) (*ReactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method React not implemented")
+}
+func (*UnimplementedGameServer) Reconnect(*ReconnectRequest, Game_ReconnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Reconnect not implemented")
+}
+func (*UnimplementedGameServer) Replay(*ReplayRequest, Game_ReplayServer) error {
+	return status.Errorf(codes.Unimplemented, "method Replay not implemented")
+}
+func (*UnimplementedGameServer) GetGameSummary(context.Context, *GetGameSummaryRequest) (*GetGameSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameSummary not implemented")
+}
+func (*UnimplementedGameServer) ListGameHistory(context.Context, *ListGameHistoryRequest) (*ListGameHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGameHistory not implemented")
+}
+func (*UnimplementedGameServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (*UnimplementedGameServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedGameServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedGameServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (*UnimplementedGameServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (*UnimplementedGameServer) GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*GetPlayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerStats not implemented")
+}
+func (*UnimplementedGameServer) GetAchievements(context.Context, *GetAchievementsRequest) (*GetAchievementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAchievements not implemented")
+}
+func (*UnimplementedGameServer) GetChallenges(context.Context, *GetChallengesRequest) (*GetChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallenges not implemented")
+}
+
+func RegisterGameServer(s *grpc.Server, srv GameServer) {
+	s.RegisterService(&_Game_serviceDesc, srv)
+}
+
+func _Game_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Join(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Join",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Join(ctx, req.(*JoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Leave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Leave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Leave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Leave(ctx, req.(*LeaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_KickPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).KickPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/KickPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).KickPlayer(ctx, req.(*KickPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_ChooseTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChooseTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).ChooseTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/ChooseTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).ChooseTeam(ctx, req.(*ChooseTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Rename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Rename(ctx, req.(*RenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_AddBots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).AddBots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/AddBots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).AddBots(ctx, req.(*AddBotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_PauseGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).PauseGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/PauseGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).PauseGame(ctx, req.(*PauseGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_ResumeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).ResumeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/ResumeGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).ResumeGame(ctx, req.(*ResumeGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_ExtendGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).ExtendGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/ExtendGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).ExtendGame(ctx, req.(*ExtendGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_AnswerOvertime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerOvertimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).AnswerOvertime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/AnswerOvertime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).AnswerOvertime(ctx, req.(*AnswerOvertimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/CreateRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_ListRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).ListRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/ListRooms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).ListRooms(ctx, req.(*ListRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).JoinRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/JoinRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).JoinRoom(ctx, req.(*JoinRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLobbiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/ListGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).ListGames(ctx, req.(*ListLobbiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Enqueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EnqueueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServer).Enqueue(m, &gameEnqueueServer{stream})
+}
+
+type Game_EnqueueServer interface {
+	Send(*MatchmakingEvent) error
+	grpc.ServerStream
+}
+
+type gameEnqueueServer struct {
+	grpc.ServerStream
+}
+
+func (x *gameEnqueueServer) Send(m *MatchmakingEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Game_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).CreateTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/CreateTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).CreateTournament(ctx, req.(*CreateTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_JoinTournament_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JoinTournamentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServer).JoinTournament(m, &gameJoinTournamentServer{stream})
+}
+
+type Game_JoinTournamentServer interface {
+	Send(*TournamentEvent) error
+	grpc.ServerStream
+}
+
+type gameJoinTournamentServer struct {
+	grpc.ServerStream
+}
+
+func (x *gameJoinTournamentServer) Send(m *TournamentEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Game_GetStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetStandings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetStandings(ctx, req.(*GetStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Start",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Start(ctx, req.(*StartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_CancelStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).CancelStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/CancelStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).CancelStart(ctx, req.(*CancelStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Credit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Credit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Credit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Credit(ctx, req.(*CreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_RepayCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepayCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).RepayCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/RepayCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).RepayCredit(ctx, req.(*RepayCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_OfferLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OfferLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).OfferLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/OfferLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).OfferLoan(ctx, req.(*OfferLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_AcceptLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).AcceptLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/AcceptLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).AcceptLoan(ctx, req.(*AcceptLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/PlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).PlaceOrder(ctx, req.(*PlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_GetMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetMarket(ctx, req.(*GetMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_BuyShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuySharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).BuyShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/BuyShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).BuyShares(ctx, req.(*BuySharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_SellShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).SellShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/SellShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).SellShares(ctx, req.(*SellSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_BuyInsurance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyInsuranceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).BuyInsurance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/BuyInsurance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).BuyInsurance(ctx, req.(*BuyInsuranceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Steal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Steal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Steal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Steal(ctx, req.(*StealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_HireGuard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HireGuardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).HireGuard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/HireGuard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).HireGuard(ctx, req.(*HireGuardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_WithdrawDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).WithdrawDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/WithdrawDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).WithdrawDeposit(ctx, req.(*WithdrawDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Lottery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LotteryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Lottery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Lottery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Lottery(ctx, req.(*LotteryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_GenerateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GenerateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GenerateQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GenerateQuestion(ctx, req.(*GenerateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_AnswerQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).AnswerQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/AnswerQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).AnswerQuestion(ctx, req.(*AnswerQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServer).Stream(m, &gameStreamServer{stream})
+}
+
+type Game_StreamServer interface {
+	Send(*StreamResponse) error
+	grpc.ServerStream
+}
+
+type gameStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *gameStreamServer) Send(m *StreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Game_GetGameState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetGameState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetGameState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetGameState(ctx, req.(*GetGameStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_SendChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).SendChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/SendChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).SendChat(ctx, req.(*SendChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_React_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).React(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/React",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).React(ctx, req.(*ReactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Reconnect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReconnectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServer).Reconnect(m, &gameReconnectServer{stream})
+}
+
+type Game_ReconnectServer interface {
+	Send(*StreamResponse) error
+	grpc.ServerStream
+}
+
+type gameReconnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *gameReconnectServer) Send(m *StreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Game_Replay_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServer).Replay(m, &gameReplayServer{stream})
+}
+
+type Game_ReplayServer interface {
+	Send(*ReplayEvent) error
+	grpc.ServerStream
+}
+
+type gameReplayServer struct {
+	grpc.ServerStream
+}
+
+func (x *gameReplayServer) Send(m *ReplayEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Game_GetGameSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetGameSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetGameSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetGameSummary(ctx, req.(*GetGameSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_ListGameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGameHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).ListGameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/ListGameHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).ListGameHistory(ctx, req.(*ListGameHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	file_game_proto_msgTypes[18].OneofWrappers = []interface{}{
-		(*StreamResponse_Join_)(nil),
-		(*StreamResponse_Leave_)(nil),
-		(*StreamResponse_Start_)(nil),
-		(*StreamResponse_Finish_)(nil),
-		(*StreamResponse_Transaction_)(nil),
+	if interceptor == nil {
+		return srv.(GameServer).GetLeaderboard(ctx, in)
 	}
-	file_game_proto_msgTypes[23].OneofWrappers = []interface{}{
-		(*StreamResponse_Transaction_UseCredit_)(nil),
-		(*StreamResponse_Transaction_UseDeposit_)(nil),
-		(*StreamResponse_Transaction_ReturnCredit_)(nil),
-		(*StreamResponse_Transaction_ReturnDeposit_)(nil),
-		(*StreamResponse_Transaction_Theft_)(nil),
-		(*StreamResponse_Transaction_Lottery_)(nil),
-		(*StreamResponse_Transaction_Question_)(nil),
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetLeaderboard",
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_game_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   32,
-			NumExtensions: 0,
-			NumServices:   1,
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetPlayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetPlayerStats(ctx, req.(*GetPlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_GetAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetAchievements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetAchievements(ctx, req.(*GetAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_GetChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetChallenges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetChallenges(ctx, req.(*GetChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Game_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "server.Game",
+	HandlerType: (*GameServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Join",
+			Handler:    _Game_Join_Handler,
 		},
-		GoTypes:           file_game_proto_goTypes,
-		DependencyIndexes: file_game_proto_depIdxs,
-		MessageInfos:      file_game_proto_msgTypes,
-	}.Build()
-	File_game_proto = out.File
-	file_game_proto_rawDesc = nil
-	file_game_proto_goTypes = nil
-	file_game_proto_depIdxs = nil
+		{
+			MethodName: "Leave",
+			Handler:    _Game_Leave_Handler,
+		},
+		{
+			MethodName: "KickPlayer",
+			Handler:    _Game_KickPlayer_Handler,
+		},
+		{
+			MethodName: "ChooseTeam",
+			Handler:    _Game_ChooseTeam_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _Game_Rename_Handler,
+		},
+		{
+			MethodName: "AddBots",
+			Handler:    _Game_AddBots_Handler,
+		},
+		{
+			MethodName: "PauseGame",
+			Handler:    _Game_PauseGame_Handler,
+		},
+		{
+			MethodName: "ResumeGame",
+			Handler:    _Game_ResumeGame_Handler,
+		},
+		{
+			MethodName: "ExtendGame",
+			Handler:    _Game_ExtendGame_Handler,
+		},
+		{
+			MethodName: "AnswerOvertime",
+			Handler:    _Game_AnswerOvertime_Handler,
+		},
+		{
+			MethodName: "CreateRoom",
+			Handler:    _Game_CreateRoom_Handler,
+		},
+		{
+			MethodName: "ListRooms",
+			Handler:    _Game_ListRooms_Handler,
+		},
+		{
+			MethodName: "JoinRoom",
+			Handler:    _Game_JoinRoom_Handler,
+		},
+		{
+			MethodName: "ListGames",
+			Handler:    _Game_ListGames_Handler,
+		},
+		{
+			MethodName: "CreateTournament",
+			Handler:    _Game_CreateTournament_Handler,
+		},
+		{
+			MethodName: "GetStandings",
+			Handler:    _Game_GetStandings_Handler,
+		},
+		{
+			MethodName: "Start",
+			Handler:    _Game_Start_Handler,
+		},
+		{
+			MethodName: "CancelStart",
+			Handler:    _Game_CancelStart_Handler,
+		},
+		{
+			MethodName: "Credit",
+			Handler:    _Game_Credit_Handler,
+		},
+		{
+			MethodName: "RepayCredit",
+			Handler:    _Game_RepayCredit_Handler,
+		},
+		{
+			MethodName: "OfferLoan",
+			Handler:    _Game_OfferLoan_Handler,
+		},
+		{
+			MethodName: "AcceptLoan",
+			Handler:    _Game_AcceptLoan_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Game_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Game_CancelOrder_Handler,
+		},
+		{
+			MethodName: "GetMarket",
+			Handler:    _Game_GetMarket_Handler,
+		},
+		{
+			MethodName: "BuyShares",
+			Handler:    _Game_BuyShares_Handler,
+		},
+		{
+			MethodName: "SellShares",
+			Handler:    _Game_SellShares_Handler,
+		},
+		{
+			MethodName: "BuyInsurance",
+			Handler:    _Game_BuyInsurance_Handler,
+		},
+		{
+			MethodName: "Steal",
+			Handler:    _Game_Steal_Handler,
+		},
+		{
+			MethodName: "HireGuard",
+			Handler:    _Game_HireGuard_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Game_Deposit_Handler,
+		},
+		{
+			MethodName: "WithdrawDeposit",
+			Handler:    _Game_WithdrawDeposit_Handler,
+		},
+		{
+			MethodName: "Lottery",
+			Handler:    _Game_Lottery_Handler,
+		},
+		{
+			MethodName: "GenerateQuestion",
+			Handler:    _Game_GenerateQuestion_Handler,
+		},
+		{
+			MethodName: "AnswerQuestion",
+			Handler:    _Game_AnswerQuestion_Handler,
+		},
+		{
+			MethodName: "GetGameState",
+			Handler:    _Game_GetGameState_Handler,
+		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _Game_GetTransactions_Handler,
+		},
+		{
+			MethodName: "SendChat",
+			Handler:    _Game_SendChat_Handler,
+		},
+		{
+			MethodName: "React",
+			Handler:    _Game_React_Handler,
+		},
+		{
+			MethodName: "GetGameSummary",
+			Handler:    _Game_GetGameSummary_Handler,
+		},
+		{
+			MethodName: "ListGameHistory",
+			Handler:    _Game_ListGameHistory_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _Game_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _Game_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Game_Login_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _Game_GetProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _Game_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _Game_GetPlayerStats_Handler,
+		},
+		{
+			MethodName: "GetAchievements",
+			Handler:    _Game_GetAchievements_Handler,
+		},
+		{
+			MethodName: "GetChallenges",
+			Handler:    _Game_GetChallenges_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Enqueue",
+			Handler:       _Game_Enqueue_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "JoinTournament",
+			Handler:       _Game_JoinTournament_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Stream",
+			Handler:       _Game_Stream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Reconnect",
+			Handler:       _Game_Reconnect_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Replay",
+			Handler:       _Game_Replay_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "game.proto",
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
-
-// GameClient is the client API for Game service.
+// AdminClient is the client API for Admin service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GameClient interface {
-	// To join, user needs to provide username to be displayed.
-	// If all games are full or finished, we will
-	// create a new one.
-	// Unique user_id will be generated for using within a game
-	// and returned.
-	// We will also return the initial game configs such as
-	// game duration, amount of points, etc.
-	// NOTE: players can only join or leave the "Waiting" game.
-	// For "Active" game, new users cannot join and existing
-	// users cannot leave.
-	Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error)
-	Leave(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*LeaveResponse, error)
-	// One of the users will start the game. We cannot automatically
-	// start the game, since it is not some popular project with
-	// a constant flow of users - there may not be enough users in
-	// a game.
-	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
-	Credit(ctx context.Context, in *CreditRequest, opts ...grpc.CallOption) (*CreditResponse, error)
-	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
-	Lottery(ctx context.Context, in *LotteryRequest, opts ...grpc.CallOption) (*LotteryResponse, error)
-	GenerateQuestion(ctx context.Context, in *GenerateQuestionRequest, opts ...grpc.CallOption) (*GenerateQuestionResponse, error)
-	AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*AnswerQuestionResponse, error)
-	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Game_StreamClient, error)
-}
-
-type gameClient struct {
+type AdminClient interface {
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	InspectGame(ctx context.Context, in *InspectGameRequest, opts ...grpc.CallOption) (*InspectGameResponse, error)
+	// FinishGame finishes the game immediately. Players of the
+	// waiting game or the room receive Finish event as well.
+	FinishGame(ctx context.Context, in *FinishGameRequest, opts ...grpc.CallOption) (*FinishGameResponse, error)
+	// EvictPlayer removes the player from the game. Points of the player
+	// evicted from the active game are taken by the bank, and outstanding
+	// credits and deposits of the player are cancelled.
+	EvictPlayer(ctx context.Context, in *EvictPlayerRequest, opts ...grpc.CallOption) (*EvictPlayerResponse, error)
+	// PauseGame and ResumeGame work as the RPCs of the Game
+	// service, but admin can pause and resume any active game.
+	PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*PauseGameResponse, error)
+	ResumeGame(ctx context.Context, in *ResumeGameRequest, opts ...grpc.CallOption) (*ResumeGameResponse, error)
+	// ExportGame returns the journal and the final state of the finished
+	// game as JSON described by GameExport message. It requires the server
+	// to keep journals of finished games in the storage.
+	ExportGame(ctx context.Context, in *ExportGameRequest, opts ...grpc.CallOption) (*ExportGameResponse, error)
+	// Ban rejects Join and JoinRoom requests of the username or from the
+	// address with PermissionDenied, until the ban ends. Banning already
+	// banned username or address replaces its ban.
+	Ban(ctx context.Context, in *BanRequest, opts ...grpc.CallOption) (*BanResponse, error)
+	Unban(ctx context.Context, in *UnbanRequest, opts ...grpc.CallOption) (*UnbanResponse, error)
+	ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error)
+	// SetDefaultConfig overrides the default config of new games with
+	// non-zero values of provided config. Active games, rooms, and the
+	// waiting game, which players have joined, keep their configs.
+	SetDefaultConfig(ctx context.Context, in *SetDefaultConfigRequest, opts ...grpc.CallOption) (*SetDefaultConfigResponse, error)
+}
+
+type adminClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewGameClient(cc grpc.ClientConnInterface) GameClient {
-	return &gameClient{cc}
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
 }
 
-func (c *gameClient) Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
-	out := new(JoinResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/Join", in, out, opts...)
+func (c *adminClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error) {
+	out := new(ListGamesResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/ListGames", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gameClient) Leave(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*LeaveResponse, error) {
-	out := new(LeaveResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/Leave", in, out, opts...)
+func (c *adminClient) InspectGame(ctx context.Context, in *InspectGameRequest, opts ...grpc.CallOption) (*InspectGameResponse, error) {
+	out := new(InspectGameResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/InspectGame", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gameClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
-	out := new(StartResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/Start", in, out, opts...)
+func (c *adminClient) FinishGame(ctx context.Context, in *FinishGameRequest, opts ...grpc.CallOption) (*FinishGameResponse, error) {
+	out := new(FinishGameResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/FinishGame", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gameClient) Credit(ctx context.Context, in *CreditRequest, opts ...grpc.CallOption) (*CreditResponse, error) {
-	out := new(CreditResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/Credit", in, out, opts...)
+func (c *adminClient) EvictPlayer(ctx context.Context, in *EvictPlayerRequest, opts ...grpc.CallOption) (*EvictPlayerResponse, error) {
+	out := new(EvictPlayerResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/EvictPlayer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gameClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
-	out := new(DepositResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/Deposit", in, out, opts...)
+func (c *adminClient) PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*PauseGameResponse, error) {
+	out := new(PauseGameResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/PauseGame", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gameClient) Lottery(ctx context.Context, in *LotteryRequest, opts ...grpc.CallOption) (*LotteryResponse, error) {
-	out := new(LotteryResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/Lottery", in, out, opts...)
+func (c *adminClient) ResumeGame(ctx context.Context, in *ResumeGameRequest, opts ...grpc.CallOption) (*ResumeGameResponse, error) {
+	out := new(ResumeGameResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/ResumeGame", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gameClient) GenerateQuestion(ctx context.Context, in *GenerateQuestionRequest, opts ...grpc.CallOption) (*GenerateQuestionResponse, error) {
-	out := new(GenerateQuestionResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/GenerateQuestion", in, out, opts...)
+func (c *adminClient) ExportGame(ctx context.Context, in *ExportGameRequest, opts ...grpc.CallOption) (*ExportGameResponse, error) {
+	out := new(ExportGameResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/ExportGame", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gameClient) AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*AnswerQuestionResponse, error) {
-	out := new(AnswerQuestionResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/AnswerQuestion", in, out, opts...)
+func (c *adminClient) Ban(ctx context.Context, in *BanRequest, opts ...grpc.CallOption) (*BanResponse, error) {
+	out := new(BanResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/Ban", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gameClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Game_StreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Game_serviceDesc.Streams[0], "/server.Game/Stream", opts...)
+func (c *adminClient) Unban(ctx context.Context, in *UnbanRequest, opts ...grpc.CallOption) (*UnbanResponse, error) {
+	out := new(UnbanResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/Unban", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &gameStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type Game_StreamClient interface {
-	Recv() (*StreamResponse, error)
-	grpc.ClientStream
+	return out, nil
 }
 
-type gameStreamClient struct {
-	grpc.ClientStream
+func (c *adminClient) ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error) {
+	out := new(ListBansResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/ListBans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *gameStreamClient) Recv() (*StreamResponse, error) {
-	m := new(StreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *adminClient) SetDefaultConfig(ctx context.Context, in *SetDefaultConfigRequest, opts ...grpc.CallOption) (*SetDefaultConfigResponse, error) {
+	out := new(SetDefaultConfigResponse)
+	err := c.cc.Invoke(ctx, "/server.Admin/SetDefaultConfig", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-// GameServer is the server API for Game service.
-type GameServer interface {
-	// To join, user needs to provide username to be displayed.
-	// If all games are full or finished, we will
-	// create a new one.
-	// Unique user_id will be generated for using within a game
-	// and returned.
-	// We will also return the initial game configs such as
-	// game duration, amount of points, etc.
-	// NOTE: players can only join or leave the "Waiting" game.
-	// For "Active" game, new users cannot join and existing
-	// users cannot leave.
-	Join(context.Context, *JoinRequest) (*JoinResponse, error)
-	Leave(context.Context, *LeaveRequest) (*LeaveResponse, error)
-	// One of the users will start the game. We cannot automatically
-	// start the game, since it is not some popular project with
-	// a constant flow of users - there may not be enough users in
-	// a game.
-	Start(context.Context, *StartRequest) (*StartResponse, error)
-	Credit(context.Context, *CreditRequest) (*CreditResponse, error)
-	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
-	Lottery(context.Context, *LotteryRequest) (*LotteryResponse, error)
-	GenerateQuestion(context.Context, *GenerateQuestionRequest) (*GenerateQuestionResponse, error)
-	AnswerQuestion(context.Context, *AnswerQuestionRequest) (*AnswerQuestionResponse, error)
-	Stream(*StreamRequest, Game_StreamServer) error
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	InspectGame(context.Context, *InspectGameRequest) (*InspectGameResponse, error)
+	// FinishGame finishes the game immediately. Players of the
+	// waiting game or the room receive Finish event as well.
+	FinishGame(context.Context, *FinishGameRequest) (*FinishGameResponse, error)
+	// EvictPlayer removes the player from the game. Points of the player
+	// evicted from the active game are taken by the bank, and outstanding
+	// credits and deposits of the player are cancelled.
+	EvictPlayer(context.Context, *EvictPlayerRequest) (*EvictPlayerResponse, error)
+	// PauseGame and ResumeGame work as the RPCs of the Game
+	// service, but admin can pause and resume any active game.
+	PauseGame(context.Context, *PauseGameRequest) (*PauseGameResponse, error)
+	ResumeGame(context.Context, *ResumeGameRequest) (*ResumeGameResponse, error)
+	// ExportGame returns the journal and the final state of the finished
+	// game as JSON described by GameExport message. It requires the server
+	// to keep journals of finished games in the storage.
+	ExportGame(context.Context, *ExportGameRequest) (*ExportGameResponse, error)
+	// Ban rejects Join and JoinRoom requests of the username or from the
+	// address with PermissionDenied, until the ban ends. Banning already
+	// banned username or address replaces its ban.
+	Ban(context.Context, *BanRequest) (*BanResponse, error)
+	Unban(context.Context, *UnbanRequest) (*UnbanResponse, error)
+	ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error)
+	// SetDefaultConfig overrides the default config of new games with
+	// non-zero values of provided config. Active games, rooms, and the
+	// waiting game, which players have joined, keep their configs.
+	SetDefaultConfig(context.Context, *SetDefaultConfigRequest) (*SetDefaultConfigResponse, error)
 }
 
-// UnimplementedGameServer can be embedded to have forward compatible implementations.
-type UnimplementedGameServer struct {
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
 }
 
-func (*UnimplementedGameServer) Join(context.Context, *JoinRequest) (*JoinResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Join not implemented")
+func (*UnimplementedAdminServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
 }
-func (*UnimplementedGameServer) Leave(context.Context, *LeaveRequest) (*LeaveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Leave not implemented")
+func (*UnimplementedAdminServer) InspectGame(context.Context, *InspectGameRequest) (*InspectGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectGame not implemented")
 }
-func (*UnimplementedGameServer) Start(context.Context, *StartRequest) (*StartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+func (*UnimplementedAdminServer) FinishGame(context.Context, *FinishGameRequest) (*FinishGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishGame not implemented")
 }
-func (*UnimplementedGameServer) Credit(context.Context, *CreditRequest) (*CreditResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Credit not implemented")
+func (*UnimplementedAdminServer) EvictPlayer(context.Context, *EvictPlayerRequest) (*EvictPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictPlayer not implemented")
 }
-func (*UnimplementedGameServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (*UnimplementedAdminServer) PauseGame(context.Context, *PauseGameRequest) (*PauseGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseGame not implemented")
 }
-func (*UnimplementedGameServer) Lottery(context.Context, *LotteryRequest) (*LotteryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lottery not implemented")
+func (*UnimplementedAdminServer) ResumeGame(context.Context, *ResumeGameRequest) (*ResumeGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeGame not implemented")
 }
-func (*UnimplementedGameServer) GenerateQuestion(context.Context, *GenerateQuestionRequest) (*GenerateQuestionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateQuestion not implemented")
+func (*UnimplementedAdminServer) ExportGame(context.Context, *ExportGameRequest) (*ExportGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGame not implemented")
 }
-func (*UnimplementedGameServer) AnswerQuestion(context.Context, *AnswerQuestionRequest) (*AnswerQuestionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AnswerQuestion not implemented")
+func (*UnimplementedAdminServer) Ban(context.Context, *BanRequest) (*BanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ban not implemented")
 }
-func (*UnimplementedGameServer) Stream(*StreamRequest, Game_StreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+func (*UnimplementedAdminServer) Unban(context.Context, *UnbanRequest) (*UnbanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unban not implemented")
+}
+func (*UnimplementedAdminServer) ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBans not implemented")
+}
+func (*UnimplementedAdminServer) SetDefaultConfig(context.Context, *SetDefaultConfigRequest) (*SetDefaultConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultConfig not implemented")
 }
 
-func RegisterGameServer(s *grpc.Server, srv GameServer) {
-	s.RegisterService(&_Game_serviceDesc, srv)
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
 }
 
-func _Game_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinRequest)
+func _Admin_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServer).Join(ctx, in)
+		return srv.(AdminServer).ListGames(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/server.Game/Join",
+		FullMethod: "/server.Admin/ListGames",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServer).Join(ctx, req.(*JoinRequest))
+		return srv.(AdminServer).ListGames(ctx, req.(*ListGamesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Game_Leave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveRequest)
+func _Admin_InspectGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectGameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServer).Leave(ctx, in)
+		return srv.(AdminServer).InspectGame(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/server.Game/Leave",
+		FullMethod: "/server.Admin/InspectGame",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServer).Leave(ctx, req.(*LeaveRequest))
+		return srv.(AdminServer).InspectGame(ctx, req.(*InspectGameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Game_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartRequest)
+func _Admin_FinishGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishGameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServer).Start(ctx, in)
+		return srv.(AdminServer).FinishGame(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/server.Game/Start",
+		FullMethod: "/server.Admin/FinishGame",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServer).Start(ctx, req.(*StartRequest))
+		return srv.(AdminServer).FinishGame(ctx, req.(*FinishGameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Game_Credit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreditRequest)
+func _Admin_EvictPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictPlayerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServer).Credit(ctx, in)
+		return srv.(AdminServer).EvictPlayer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/server.Game/Credit",
+		FullMethod: "/server.Admin/EvictPlayer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServer).Credit(ctx, req.(*CreditRequest))
+		return srv.(AdminServer).EvictPlayer(ctx, req.(*EvictPlayerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Game_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepositRequest)
+func _Admin_PauseGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseGameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServer).Deposit(ctx, in)
+		return srv.(AdminServer).PauseGame(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/server.Game/Deposit",
+		FullMethod: "/server.Admin/PauseGame",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServer).Deposit(ctx, req.(*DepositRequest))
+		return srv.(AdminServer).PauseGame(ctx, req.(*PauseGameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Game_Lottery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LotteryRequest)
+func _Admin_ResumeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeGameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServer).Lottery(ctx, in)
+		return srv.(AdminServer).ResumeGame(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/server.Game/Lottery",
+		FullMethod: "/server.Admin/ResumeGame",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServer).Lottery(ctx, req.(*LotteryRequest))
+		return srv.(AdminServer).ResumeGame(ctx, req.(*ResumeGameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Game_GenerateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateQuestionRequest)
+func _Admin_ExportGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServer).GenerateQuestion(ctx, in)
+		return srv.(AdminServer).ExportGame(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/server.Game/GenerateQuestion",
+		FullMethod: "/server.Admin/ExportGame",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServer).GenerateQuestion(ctx, req.(*GenerateQuestionRequest))
+		return srv.(AdminServer).ExportGame(ctx, req.(*ExportGameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Game_AnswerQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AnswerQuestionRequest)
+func _Admin_Ban_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServer).AnswerQuestion(ctx, in)
+		return srv.(AdminServer).Ban(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/server.Game/AnswerQuestion",
+		FullMethod: "/server.Admin/Ban",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServer).AnswerQuestion(ctx, req.(*AnswerQuestionRequest))
+		return srv.(AdminServer).Ban(ctx, req.(*BanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Game_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Admin_Unban_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GameServer).Stream(m, &gameStreamServer{stream})
-}
-
-type Game_StreamServer interface {
-	Send(*StreamResponse) error
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(AdminServer).Unban(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Admin/Unban",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Unban(ctx, req.(*UnbanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type gameStreamServer struct {
-	grpc.ServerStream
+func _Admin_ListBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Admin/ListBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListBans(ctx, req.(*ListBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *gameStreamServer) Send(m *StreamResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _Admin_SetDefaultConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetDefaultConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Admin/SetDefaultConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetDefaultConfig(ctx, req.(*SetDefaultConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-var _Game_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "server.Game",
-	HandlerType: (*GameServer)(nil),
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "server.Admin",
+	HandlerType: (*AdminServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Join",
-			Handler:    _Game_Join_Handler,
+			MethodName: "ListGames",
+			Handler:    _Admin_ListGames_Handler,
 		},
 		{
-			MethodName: "Leave",
-			Handler:    _Game_Leave_Handler,
+			MethodName: "InspectGame",
+			Handler:    _Admin_InspectGame_Handler,
 		},
 		{
-			MethodName: "Start",
-			Handler:    _Game_Start_Handler,
+			MethodName: "FinishGame",
+			Handler:    _Admin_FinishGame_Handler,
 		},
 		{
-			MethodName: "Credit",
-			Handler:    _Game_Credit_Handler,
+			MethodName: "EvictPlayer",
+			Handler:    _Admin_EvictPlayer_Handler,
 		},
 		{
-			MethodName: "Deposit",
-			Handler:    _Game_Deposit_Handler,
+			MethodName: "PauseGame",
+			Handler:    _Admin_PauseGame_Handler,
 		},
 		{
-			MethodName: "Lottery",
-			Handler:    _Game_Lottery_Handler,
+			MethodName: "ResumeGame",
+			Handler:    _Admin_ResumeGame_Handler,
 		},
 		{
-			MethodName: "GenerateQuestion",
-			Handler:    _Game_GenerateQuestion_Handler,
+			MethodName: "ExportGame",
+			Handler:    _Admin_ExportGame_Handler,
 		},
 		{
-			MethodName: "AnswerQuestion",
-			Handler:    _Game_AnswerQuestion_Handler,
+			MethodName: "Ban",
+			Handler:    _Admin_Ban_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "Stream",
-			Handler:       _Game_Stream_Handler,
-			ServerStreams: true,
+			MethodName: "Unban",
+			Handler:    _Admin_Unban_Handler,
+		},
+		{
+			MethodName: "ListBans",
+			Handler:    _Admin_ListBans_Handler,
+		},
+		{
+			MethodName: "SetDefaultConfig",
+			Handler:    _Admin_SetDefaultConfig_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "game.proto",
 }
@@ -1,44 +1,98 @@
 package server
 
 import (
-	"encoding/json"
 	"fmt"
-	"log"
-	"net/http"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/cs489-team11/server/pb"
 	"github.com/google/uuid"
+	"golang.org/x/time/rate"
 )
 
 type questionID string
 type userID string
 type username string
+type sessionToken string
+type positionID string
 
 type questionInfo struct {
 	bidPoints     int32
 	correctAnswer int32 // index of correct answer from 1 to 4
+	difficulty    string
+	timer         Timer     // nil, if there is no deadline
+	deadline      time.Time // zero, if there is no deadline
+	expired       bool      // true, if player hasn't answered in time
 }
 
+// position is an outstanding credit or deposit of the player,
+// which is returned to the bank or to the player when its time ends.
+type position struct {
+	positionID positionID
+	value      int32
+	interest   int32 // percentage for the whole time, which is fixed when position is opened
+	startTime  time.Time
+	endTime    time.Time
+	timer      Timer
+}
+
+// Credit score of the player is between 0 and 100. Players start with
+// the neutral score, for which interest and credit limit are taken from
+// game config. Higher score lowers the interest and raises the limit.
+const (
+	minCreditScore     = 0
+	maxCreditScore     = 100
+	defaultCreditScore = 50
+	// added, when the player returns the credit in full
+	onTimeRepaymentScore = 10
+	// added, when the player doesn't have enough points to return the credit
+	creditDefaultScore = -25
+)
+
 // this struct does not have RWMutex as its member
 // this is done to avoid deadlocks between goroutines
 type player struct {
 	userID            userID
 	username          username
+	sessionToken      sessionToken
+	locale            string // not persisted, default language is used after restore
 	points            int32
+	creditScore       int32
+	rating            int32 // ELO rating before the game, updated, when the game finishes
+	team              int32 // from 1 to the number of teams, 0 if the game has no teams
+	bot               bool  // true, if the player is driven by the server
+	avatarID          string
+	color             string // "#rrggbb", distinguishable from the colors of other players
+	lastActionTime    time.Time
+	afk               bool // true, if the player hasn't acted for the AFK time
+	forfeited         bool // true, if the player has forfeited the game for being AFK
 	stream            pb.Game_StreamServer
+	streamDone        chan struct{}           // closed, when the stream is replaced or the player leaves the game
+	events            chan *pb.StreamResponse // events queued for the goroutine serving the stream
+	subscriptions     subscriptions           // categories of the events sent on the stream
 	gameStartNotified bool
 	lastLotteryTime   time.Time
+	lastStealTime     time.Time // zero, if the player has not stolen yet
+	guardEndTime      time.Time // zero, if the player has never hired the guard
+	reactionLimiter   *rate.Limiter
 	questions         map[questionID]*questionInfo
+	credits           map[positionID]*position
+	deposits          map[positionID]*position
+	assets            map[string]int32 // units of the market assets
+	shares            int32            // shares of the stock
+	insured           bool             // true, if the player is insured against the next theft
 }
 
 func newQuestionInfo(
 	bidPoints int32,
 	correctAnswer int32,
+	difficulty string,
 ) *questionInfo {
 	return &questionInfo{
-		bidPoints,
-		correctAnswer,
+		bidPoints:     bidPoints,
+		correctAnswer: correctAnswer,
+		difficulty:    difficulty,
 	}
 }
 
@@ -47,103 +101,210 @@ func newPlayer(username username, points int32) *player {
 	return &player{
 		userID:            userID,
 		username:          username,
+		sessionToken:      sessionToken(uuid.New().String()),
 		points:            points,
+		creditScore:       defaultCreditScore,
 		stream:            nil,
 		gameStartNotified: false,
 		lastLotteryTime:   time.Now(),
 		questions:         make(map[questionID]*questionInfo),
+		credits:           make(map[positionID]*position),
+		deposits:          make(map[positionID]*position),
+		assets:            make(map[string]int32),
+		reactionLimiter:   newReactionLimiter(),
+	}
+}
+
+func newPositionID() positionID {
+	return positionID(uuid.New().String())
+}
+
+// newPositionAt returns position, which starts at provided time
+// and lasts for provided number of seconds.
+func newPositionAt(
+	positionID positionID, value int32, interest int32, startTime time.Time, seconds int32,
+) *position {
+	return &position{
+		positionID: positionID,
+		value:      value,
+		interest:   interest,
+		startTime:  startTime,
+		endTime:    startTime.Add(time.Duration(seconds) * time.Second),
 	}
 }
 
 // when game calls this function on player, make sure to grab
 // WRITE lock on game
-func (p *player) setStream(stream pb.Game_StreamServer) {
+func (p *player) setStream(stream pb.Game_StreamServer, subscriptions subscriptions) {
+	p.closeStream()
 	p.stream = stream
-	log.Printf("Stream for user %v has been set.\n", p.userID)
+	p.subscriptions = subscriptions
+	p.streamDone = make(chan struct{})
+	p.events = make(chan *pb.StreamResponse, streamQueueSize)
 }
 
-// when game calls this function on player, make sure to grab
-// WRITE lock on game
-func (p *player) updateLastLotteryTime() {
-	p.lastLotteryTime = time.Now()
+// closeStream signals the goroutine serving the stream of the player
+// to end it. When game calls this function on player, make sure to
+// grab WRITE lock on game.
+func (p *player) closeStream() {
+	if p.streamDone != nil {
+		close(p.streamDone)
+		p.streamDone = nil
+	}
 }
 
 // when game calls this function on player, make sure to grab
 // READ lock on game
 // "lotteryTime" is the time in seconds from game config,
 // which has to pass before player can play lottery again
-func (p *player) canPlayLottery(lotteryTime int32) bool {
-	return time.Since(p.lastLotteryTime) >= (time.Duration(lotteryTime) * time.Second / time.Nanosecond)
+func (p *player) canPlayLottery(lotteryTime int32, now time.Time) bool {
+	return now.Sub(p.lastLotteryTime) >= (time.Duration(lotteryTime) * time.Second / time.Nanosecond)
 }
 
-func (p *player) generateQuestion(bidPoints int32) (questionID, string, []string, error) {
-	if bidPoints > p.points {
-		return "", "", nil, fmt.Errorf(
-			"bid points (%d) has to be less than or equal to player's points (%d)",
-			bidPoints,
-			p.points,
-		)
+// when game calls this function on player, make sure to grab
+// READ lock on game
+// returns seconds until the player can play lottery again
+func (p *player) getLotteryRemainingTime(lotteryTime int32, now time.Time) int32 {
+	nextLotteryTime := p.lastLotteryTime.Add(time.Duration(lotteryTime) * time.Second)
+	remainingTime := int32(math.Ceil(nextLotteryTime.Sub(now).Seconds()))
+	if remainingTime < 0 {
+		return 0
 	}
+	return remainingTime
+}
 
-	resp, err := http.Get("https://opentdb.com/api.php?amount=1&difficulty=easy&type=multiple&encode=base64")
-	if err != nil {
-		return "", "", nil, fmt.Errorf("HTTP request failed: %v", err)
+// getOutstandingCredit returns total value of the player's credits.
+// when game calls this function on player, make sure to grab
+// READ lock on game
+func (p *player) getOutstandingCredit() int32 {
+	total := int32(0)
+	for _, credit := range p.credits {
+		total += credit.value
 	}
-	defer resp.Body.Close()
-
-	/*
-		Sample API response body:
-			map[response_code:0 results:[map[category:Entertainment: Musicals & Theatres correct_answer:Et tu, Brute?  difficulty:easy
-			incorrect_answers:[Iacta alea est! Vidi, vini, vici. Aegri somnia vana.] question:In Shakespeare&#039;s play Julius Caesa
-			r, Caesar&#039;s last words were... type:multiple]]]
-	*/
+	return total
+}
 
-	var data map[string]interface{}
-	err = json.NewDecoder(resp.Body).Decode(&data)
-	if err != nil {
-		return "", "", nil, fmt.Errorf("response decoding failure: %v", err)
+// getNetWorth returns points of the player together with
+// his deposits without his credits. Interest is not included.
+// when game calls this function on player, make sure to grab
+// READ lock on game
+func (p *player) getNetWorth() int32 {
+	netWorth := p.points - p.getOutstandingCredit()
+	for _, deposit := range p.deposits {
+		netWorth += deposit.value
 	}
-	//log.Printf("Question response data:\n%v\n", results_[0])
+	return netWorth
+}
 
-	// parse API response body
-	results := data["results"].([]interface{})[0].(map[string]interface{})
-	question := decodeB64(results["question"].(string))
-	correctAnswer := decodeB64(results["correct_answer"].(string))
-	incorrectAnswers := make([]string, 3)
-	for i := 0; i < 3; i++ {
-		incorrectAnswers[i] = decodeB64(results["incorrect_answers"].([]interface{})[i].(string))
+// changeCreditScore adds provided delta to the credit score
+// of the player, keeping it between minimal and maximal score.
+// when game calls this function on player, make sure to grab
+// WRITE lock on game
+func (p *player) changeCreditScore(delta int32) {
+	p.creditScore += delta
+	if p.creditScore < minCreditScore {
+		p.creditScore = minCreditScore
+	}
+	if p.creditScore > maxCreditScore {
+		p.creditScore = maxCreditScore
 	}
+}
 
-	correctAnswerIndex := seededRand.Intn(4) // 0,1,2, or 3
-	allAnswers := insertToSlice(incorrectAnswers, correctAnswerIndex, correctAnswer)
+// generateQuestion saves the question together with
+// the bid of the player and returns its new id.
+func (p *player) generateQuestion(bidPoints int32, question Question) (questionID, error) {
+	if bidPoints > p.points {
+		return "", fmt.Errorf(
+			"bid points (%d) has to be less than or equal to player's points (%d)",
+			bidPoints,
+			p.points,
+		)
+	}
 
 	questionID := questionID(uuid.New().String())
-	qInfo := newQuestionInfo(bidPoints, int32(correctAnswerIndex+1))
+	qInfo := newQuestionInfo(bidPoints, question.CorrectAnswer, question.Difficulty)
 	p.questions[questionID] = qInfo
 
-	return questionID, question, allAnswers, nil
+	return questionID, nil
 }
 
 func (p *player) answerQuestion(
 	questionID questionID, userAnswer int32,
 ) (
-	bool, int32, int32, error,
+	bool, int32, int32, string, error,
 ) {
 	qInfo, ok := p.questions[questionID]
 	if !ok {
 		errMsg := fmt.Sprintf("there is no question %v for player %v", questionID, p.userID)
-		return false, 0, 0, fmt.Errorf(errMsg)
+		return false, 0, 0, "", fmt.Errorf(errMsg)
+	}
+	if qInfo.expired {
+		return false, 0, 0, "", ErrQuestionExpired
+	}
+
+	return qInfo.correctAnswer == userAnswer, qInfo.correctAnswer, qInfo.bidPoints, qInfo.difficulty, nil
+}
+
+// stopQuestionTimers stops deadlines of all unanswered questions.
+// when game calls this function on player, make sure to grab
+// WRITE lock on game
+func (p *player) stopQuestionTimers() {
+	for _, qInfo := range p.questions {
+		if qInfo.timer != nil {
+			qInfo.timer.Stop()
+		}
+	}
+}
+
+// when game calls this function on player, make sure to grab
+// READ lock on game
+func (pos *position) toPBPosition(config GameConfig, now time.Time) *pb.Position {
+	remainingTime := int32(math.Ceil(pos.endTime.Sub(now).Seconds()))
+	if remainingTime < 0 {
+		remainingTime = 0
 	}
+	return &pb.Position{
+		PositionId:        string(pos.positionID),
+		Value:             pos.value,
+		ValueWithInterest: config.getValueWithInterest(pos.value, pos.interest),
+		Interest:          pos.interest,
+		RemainingTime:     remainingTime,
+	}
+}
 
-	return qInfo.correctAnswer == userAnswer, qInfo.correctAnswer, qInfo.bidPoints, nil
+// positionsToPB converts map of positions to the list sorted by end time.
+// when game calls this function on player, make sure to grab
+// READ lock on game
+func positionsToPB(positions map[positionID]*position, config GameConfig, now time.Time) []*pb.Position {
+	sorted := make([]*position, 0, len(positions))
+	for _, pos := range positions {
+		sorted = append(sorted, pos)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].endTime.Before(sorted[j].endTime)
+	})
+
+	res := make([]*pb.Position, len(sorted))
+	for i, pos := range sorted {
+		res[i] = pos.toPBPosition(config, now)
+	}
+	return res
 }
 
 // when game calls this function on player, make sure to grab
 // READ lock on game
 func (p *player) toPBPlayer() *pb.Player {
 	return &pb.Player{
-		UserId:   string(p.userID),
-		Username: string(p.username),
-		Points:   p.points,
+		UserId:      string(p.userID),
+		Username:    string(p.username),
+		Points:      p.points,
+		CreditScore: p.creditScore,
+		Rating:      p.rating,
+		Team:        p.team,
+		Bot:         p.bot,
+		AvatarId:    p.avatarID,
+		Color:       p.color,
+		Afk:         p.afk,
+		Forfeited:   p.forfeited,
 	}
 }
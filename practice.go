@@ -0,0 +1,42 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// joinPractice creates a new practice game with the player and provided
+// number of bots and starts it right away. Practice games are not listed
+// among the rooms, so nobody else can join them.
+func (s *Server) joinPractice(username username, locale string, appearance appearance, bots int32) (*pb.JoinResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.shuttingDown {
+		return nil, newStatusError(codes.Unavailable, ReasonServerShuttingDown, 0, "server is shutting down")
+	}
+
+	config := s.gameConfig
+	config.practice = true
+	config.maxPlayers = 0
+	config.autoStart = false
+	game := s.newGame(config)
+	userID, err := game.addPlayer(username, locale, appearance)
+	if err := getUsernameError(err); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		err := fmt.Errorf("failed to join practice game: %v", err)
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	s.addBots(game, bots, s.newBot)
+
+	s.metrics.joins.Inc()
+	s.startLobby(game, config)
+	game.logger.Info("Practice game is started", zap.Int32("bots", bots))
+	return s.getJoinResponseMessage(userID, game)
+}
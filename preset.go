@@ -0,0 +1,82 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cs489-team11/server/pb"
+)
+
+// Names of the built-in presets, which every server has.
+const (
+	PresetQuick     = "quick"     // 5-minute game
+	PresetClassroom = "classroom" // 30-minute game with more time to answer the questions
+	PresetMarathon  = "marathon"  // 2-hour game with the market, the stock, and the tax
+)
+
+// defaultPresets returns the built-in presets.
+func defaultPresets() map[string]GameConfig {
+	return map[string]GameConfig{
+		PresetQuick: NewGameConfig(300, 200, 400, 30, 20, 15, 15, 25, 15, 10, 150, 150),
+		PresetClassroom: NewGameConfig(
+			1800, 500, 1000, 20, 10, 120, 120, 180, 10, 60, 300, 150,
+			WithQuestionTime(60),
+			WithTimeTicks(300),
+		),
+		PresetMarathon: NewGameConfig(
+			7200, 1000, 2000, 25, 15, 600, 600, 300, 10, 300, 600, 150,
+			WithMarket([]string{"gold", "oil", "wheat"}, 10),
+			WithStock(100, 10, 60),
+			WithTax(900, []TaxBracket{{Threshold: 0, Percentage: 5}, {Threshold: 2000, Percentage: 15}}),
+			WithTimeTicks(600),
+		),
+	}
+}
+
+// WithPreset registers the game config, which is selected by provided name
+// in the config overrides of Start, CreateRoom, CreateTournament, and
+// SetDefaultConfig. The preset replaces the built-in one with the same name.
+func WithPreset(name string, config GameConfig) ServerOption {
+	return func(s *Server) {
+		s.presets[name] = config
+	}
+}
+
+// withPreset returns the preset with the lobby settings and the rate limits
+// of the config, so that the lobby keeps its players and the practice flag.
+func (c GameConfig) withPreset(preset GameConfig) GameConfig {
+	preset.minPlayers = c.minPlayers
+	preset.maxPlayers = c.maxPlayers
+	preset.autoStart = c.autoStart
+	preset.startCountdown = c.startCountdown
+	preset.practice = c.practice
+	preset.userRateLimit = c.userRateLimit
+	preset.userRateBurst = c.userRateBurst
+	preset.peerRateLimit = c.peerRateLimit
+	preset.peerRateBurst = c.peerRateBurst
+	return preset
+}
+
+// getOverriddenConfig returns the config with the preset named in the
+// overrides, if any, and the other overrides applied on top of it.
+// The returned config is not validated.
+func (s *Server) getOverriddenConfig(config GameConfig, overrides *pb.GameConfig) (GameConfig, error) {
+	if name := overrides.GetPreset(); name != "" {
+		preset, ok := s.presets[name]
+		if !ok {
+			return GameConfig{}, fmt.Errorf("unknown preset %q, known presets: %v", name, s.getPresetNames())
+		}
+		config = config.withPreset(preset)
+	}
+	return config.withPBOverrides(overrides), nil
+}
+
+// getPresetNames returns the sorted names of the registered presets.
+func (s *Server) getPresetNames() []string {
+	names := make([]string, 0, len(s.presets))
+	for name := range s.presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
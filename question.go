@@ -0,0 +1,389 @@
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// questionAnswerCount is the number of answers of every question.
+const questionAnswerCount = 4
+
+// questionDifficultyMultipliers define the allowed difficulty levels of
+// questions and how many times win points are increased for each of them.
+var questionDifficultyMultipliers = map[string]float64{
+	"":       1,
+	"easy":   1,
+	"medium": 1.5,
+	"hard":   2,
+}
+
+// ErrNoQuestions is returned by question providers,
+// if there are no questions matching the filter.
+var ErrNoQuestions = errors.New("no questions matching the filter")
+
+// ErrQuestionExpired is returned on answer to the question,
+// which hasn't been answered before its deadline.
+var ErrQuestionExpired = errors.New("time to answer the question has expired")
+
+// Question is a multiple choice question, which is asked
+// to the player, who has placed a bid.
+type Question struct {
+	ID            string   `json:"id,omitempty"` // text identifies the question, if empty
+	Text          string   `json:"question"`
+	Answers       []string `json:"answers"`
+	CorrectAnswer int32    `json:"correct_answer"` // index from 1 to 4
+	Category      string   `json:"category"`
+	Difficulty    string   `json:"difficulty"` // "easy", "medium", "hard", or empty
+
+	// translations of the text and the answers by locale, e.g. "fr" or "fr-CA";
+	// answers are listed in the same order
+	Translations map[string]QuestionTranslation `json:"translations,omitempty"`
+}
+
+// QuestionTranslation is the text and the answers
+// of the question in another language.
+type QuestionTranslation struct {
+	Text    string   `json:"question"`
+	Answers []string `json:"answers"`
+}
+
+// QuestionFilter restricts questions, which can be asked.
+// Empty fields match questions with any value.
+type QuestionFilter struct {
+	Category   string
+	Difficulty string
+	// keys of the questions, which have already been asked in the game;
+	// ErrNoQuestions is returned, if all matching questions are excluded
+	Exclude map[string]bool
+	// random numbers, with which the question is picked, so that the game
+	// can be reproduced; provider's own are used, if nil, and remote
+	// providers may ignore them
+	Rand *rand.Rand
+}
+
+// QuestionProvider supplies questions for the bidding game.
+// It has to be safe for concurrent use.
+type QuestionProvider interface {
+	Question(ctx context.Context, filter QuestionFilter) (Question, error)
+}
+
+// match returns true, if the question passes the filter.
+func (f QuestionFilter) match(question Question) bool {
+	return (f.Category == "" || strings.EqualFold(f.Category, question.Category)) &&
+		(f.Difficulty == "" || f.Difficulty == question.Difficulty) &&
+		!f.Exclude[question.key()]
+}
+
+// key identifies the question regardless of the language it is asked in.
+func (q Question) key() string {
+	if q.ID != "" {
+		return q.ID
+	}
+	return q.Text
+}
+
+// validate checks that the filter can match any question.
+func (f QuestionFilter) validate() error {
+	if _, ok := questionDifficultyMultipliers[f.Difficulty]; !ok {
+		return fmt.Errorf("unknown difficulty %q, has to be easy, medium, or hard", f.Difficulty)
+	}
+	return nil
+}
+
+// WithQuestionProvider makes server take questions from provided provider.
+// Without this option, questions are fetched from the Open Trivia Database,
+// and built-in questions are asked, if it is not available.
+func WithQuestionProvider(provider QuestionProvider) ServerOption {
+	return func(s *Server) {
+		s.questions = provider
+	}
+}
+
+// validate checks that the question can be asked to the player.
+func (q Question) validate() error {
+	if err := validateQuestionText(q.Text, q.Answers); err != nil {
+		return err
+	}
+	for locale, translation := range q.Translations {
+		if err := validateQuestionText(translation.Text, translation.Answers); err != nil {
+			return fmt.Errorf("invalid %q translation: %v", locale, err)
+		}
+	}
+	if q.CorrectAnswer < 1 || q.CorrectAnswer > questionAnswerCount {
+		return fmt.Errorf("correct answer has to be from 1 to %d, received: %d", questionAnswerCount, q.CorrectAnswer)
+	}
+	if _, ok := questionDifficultyMultipliers[q.Difficulty]; !ok {
+		return fmt.Errorf("unknown difficulty %q", q.Difficulty)
+	}
+	return nil
+}
+
+func validateQuestionText(text string, answers []string) error {
+	if strings.TrimSpace(text) == "" {
+		return fmt.Errorf("question text is empty")
+	}
+	if len(answers) != questionAnswerCount {
+		return fmt.Errorf("question has to have %d answers, received: %d", questionAnswerCount, len(answers))
+	}
+	for i, answer := range answers {
+		if strings.TrimSpace(answer) == "" {
+			return fmt.Errorf("answer %d is empty", i+1)
+		}
+	}
+	return nil
+}
+
+// localize returns the question with the text and the answers translated
+// to the locale. If there is no translation for the locale (e.g. "fr-CA"),
+// translation for its language (e.g. "fr") is used. If there is no such
+// translation either, the question is returned in its default language.
+func (q Question) localize(locale string) Question {
+	for _, locale := range getLocaleFallbacks(locale) {
+		for translationLocale, translation := range q.Translations {
+			if strings.ToLower(translationLocale) == locale {
+				q.Text = translation.Text
+				q.Answers = translation.Answers
+				return q
+			}
+		}
+	}
+	return q
+}
+
+// getLocaleFallbacks returns the lowercase locale followed by its less
+// specific parents, e.g. "fr-ca" and "fr" for "fr_CA". Nil is returned
+// for the empty locale.
+func getLocaleFallbacks(locale string) []string {
+	var fallbacks []string
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	for locale != "" {
+		fallbacks = append(fallbacks, locale)
+		i := strings.LastIndex(locale, "-")
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	return fallbacks
+}
+
+// questionPack asks random questions from the loaded list.
+type questionPack struct {
+	mutex     sync.Mutex
+	questions []Question
+	rand      *rand.Rand
+}
+
+func newQuestionPack(questions []Question) *questionPack {
+	return &questionPack{
+		questions: questions,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (p *questionPack) Question(_ context.Context, filter QuestionFilter) (Question, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var matching []Question
+	for _, question := range p.questions {
+		if filter.match(question) {
+			matching = append(matching, question)
+		}
+	}
+	if len(matching) == 0 {
+		return Question{}, ErrNoQuestions
+	}
+	r := p.rand
+	if filter.Rand != nil {
+		r = filter.Rand
+	}
+	return matching[r.Intn(len(matching))], nil
+}
+
+func (p *questionPack) set(questions []Question) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.questions = questions
+}
+
+// Len returns the number of loaded questions.
+func (p *questionPack) Len() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.questions)
+}
+
+// FileQuestionProvider asks random questions from the question pack
+// loaded from JSON or CSV file.
+//
+// JSON file contains the array of objects with "question", "answers",
+// "correct_answer" (index from 1 to 4), "category", "difficulty", and
+// optional "id" and "translations" fields. Translations map locales to objects
+// with "question" and "answers" fields.
+// CSV file starts with the header row, which is followed by the rows
+// of question, 4 answers, correct answer index, category, and difficulty.
+// Translations are provided in additional groups of 5 columns with
+// headers "question_<locale>" and "answer_<n>_<locale>", e.g. "question_fr".
+// Empty cells of the group mean that the question is not translated.
+type FileQuestionProvider struct {
+	*questionPack
+	path string
+}
+
+// NewFileQuestionProvider loads the question pack from the file.
+// The format of the file is determined by its extension (.json or .csv).
+func NewFileQuestionProvider(path string) (*FileQuestionProvider, error) {
+	p := &FileQuestionProvider{
+		questionPack: newQuestionPack(nil),
+		path:         path,
+	}
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Reload loads the question pack from the file again. If the file is
+// invalid, error is returned and previously loaded questions are kept.
+func (p *FileQuestionProvider) Reload() error {
+	questions, err := loadQuestions(p.path)
+	if err != nil {
+		return err
+	}
+
+	p.set(questions)
+	return nil
+}
+
+// loadQuestions reads and validates all questions of the file.
+func loadQuestions(path string) ([]Question, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open question pack: %v", err)
+	}
+	defer file.Close()
+
+	var questions []Question
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if err := json.NewDecoder(file).Decode(&questions); err != nil {
+			return nil, fmt.Errorf("failed to decode question pack: %v", err)
+		}
+	case ".csv":
+		questions, err = readCSVQuestions(file)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported format of question pack %v, has to be .json or .csv", path)
+	}
+
+	if len(questions) == 0 {
+		return nil, fmt.Errorf("question pack %v is empty", path)
+	}
+	for i, question := range questions {
+		if err := question.validate(); err != nil {
+			return nil, fmt.Errorf("invalid question %d: %v", i+1, err)
+		}
+	}
+	return questions, nil
+}
+
+func readCSVQuestions(r io.Reader) ([]Question, error) {
+	// question, answers, correct answer, category, difficulty
+	const baseColumns = 1 + questionAnswerCount + 3
+	const translationColumns = 1 + questionAnswerCount
+
+	// all rows have the same number of columns as the header
+	reader := csv.NewReader(r)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read question pack: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	if len(header) < baseColumns || (len(header)-baseColumns)%translationColumns != 0 {
+		return nil, fmt.Errorf(
+			"question pack has to have %d columns followed by groups of %d translation columns, received: %d",
+			baseColumns, translationColumns, len(header),
+		)
+	}
+	var locales []string
+	for i := baseColumns; i < len(header); i += translationColumns {
+		if !strings.HasPrefix(header[i], "question_") {
+			return nil, fmt.Errorf("translation column %q has to be named question_<locale>", header[i])
+		}
+		locales = append(locales, strings.TrimPrefix(header[i], "question_"))
+	}
+
+	questions := make([]Question, 0, len(records)-1)
+	for i, record := range records[1:] { // skip header
+		correctAnswer, err := strconv.Atoi(record[1+questionAnswerCount])
+		if err != nil {
+			return nil, fmt.Errorf("correct answer of question %d is not an integer", i+1)
+		}
+		question := Question{
+			Text:          record[0],
+			Answers:       record[1 : 1+questionAnswerCount],
+			CorrectAnswer: int32(correctAnswer),
+			Category:      record[2+questionAnswerCount],
+			Difficulty:    record[3+questionAnswerCount],
+		}
+
+		for j, locale := range locales {
+			columns := record[baseColumns+j*translationColumns : baseColumns+(j+1)*translationColumns]
+			if strings.Join(columns, "") == "" {
+				continue
+			}
+			if question.Translations == nil {
+				question.Translations = make(map[string]QuestionTranslation)
+			}
+			question.Translations[locale] = QuestionTranslation{
+				Text:    columns[0],
+				Answers: columns[1:],
+			}
+		}
+		questions = append(questions, question)
+	}
+	return questions, nil
+}
+
+// builtinQuestions are asked, if no other questions are available.
+var builtinQuestions = []Question{
+	{Text: "What is the capital of Canada?", Answers: []string{"Toronto", "Ottawa", "Montreal", "Vancouver"}, CorrectAnswer: 2, Category: "Geography", Difficulty: "easy"},
+	{Text: "How many continents are there on Earth?", Answers: []string{"5", "6", "7", "8"}, CorrectAnswer: 3, Category: "Geography", Difficulty: "easy"},
+	{Text: "Which planet is known as the Red Planet?", Answers: []string{"Venus", "Jupiter", "Mercury", "Mars"}, CorrectAnswer: 4, Category: "Science & Nature", Difficulty: "easy"},
+	{Text: "What is the chemical symbol for gold?", Answers: []string{"Au", "Ag", "Gd", "Go"}, CorrectAnswer: 1, Category: "Science & Nature", Difficulty: "easy"},
+	{Text: "Who painted the Mona Lisa?", Answers: []string{"Michelangelo", "Raphael", "Leonardo da Vinci", "Donatello"}, CorrectAnswer: 3, Category: "Art", Difficulty: "easy"},
+	{Text: "How many sides does a hexagon have?", Answers: []string{"5", "6", "7", "8"}, CorrectAnswer: 2, Category: "Science: Mathematics", Difficulty: "easy"},
+	{Text: "In which year did World War II end?", Answers: []string{"1943", "1944", "1945", "1946"}, CorrectAnswer: 3, Category: "History", Difficulty: "easy"},
+	{Text: "What is the largest ocean on Earth?", Answers: []string{"Atlantic", "Indian", "Arctic", "Pacific"}, CorrectAnswer: 4, Category: "Geography", Difficulty: "easy"},
+	{Text: "Which language has the most native speakers?", Answers: []string{"English", "Mandarin Chinese", "Spanish", "Hindi"}, CorrectAnswer: 2, Category: "General Knowledge", Difficulty: "medium"},
+	{Text: "What is the smallest prime number?", Answers: []string{"0", "1", "2", "3"}, CorrectAnswer: 3, Category: "Science: Mathematics", Difficulty: "easy"},
+	{Text: "Which element has the atomic number 1?", Answers: []string{"Hydrogen", "Helium", "Oxygen", "Carbon"}, CorrectAnswer: 1, Category: "Science & Nature", Difficulty: "easy"},
+	{Text: "Which programming language was created by Google in 2009?", Answers: []string{"Rust", "Kotlin", "Swift", "Go"}, CorrectAnswer: 4, Category: "Science: Computers", Difficulty: "medium"},
+	{Text: "What is the speed of light in vacuum, approximately?", Answers: []string{"300,000 km/s", "150,000 km/s", "30,000 km/s", "1,000,000 km/s"}, CorrectAnswer: 1, Category: "Science & Nature", Difficulty: "medium"},
+	{Text: "Which treaty ended the Thirty Years' War?", Answers: []string{"Treaty of Utrecht", "Treaty of Versailles", "Peace of Westphalia", "Treaty of Paris"}, CorrectAnswer: 3, Category: "History", Difficulty: "hard"},
+	{Text: "In which year was the Magna Carta sealed?", Answers: []string{"1066", "1215", "1415", "1492"}, CorrectAnswer: 2, Category: "History", Difficulty: "hard"},
+	{Text: "Which philosopher wrote \"Critique of Pure Reason\"?", Answers: []string{"Hegel", "Kant", "Nietzsche", "Hume"}, CorrectAnswer: 2, Category: "General Knowledge", Difficulty: "hard"},
+}
+
+// newBuiltinQuestionProvider returns provider of built-in questions.
+func newBuiltinQuestionProvider() QuestionProvider {
+	return newQuestionPack(builtinQuestions)
+}
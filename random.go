@@ -61,3 +61,9 @@ func RandShuffle(src []int32) []int32 {
 	}
 	return dest
 }
+
+// RandChance returns true with provided
+// probability given in percents
+func RandChance(percentage int32) bool {
+	return rand.Int31n(100) < percentage
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+// rateLimiterIdleTime defines how long the limiter is kept
+// after the last request of its user or address.
+const rateLimiterIdleTime = 5 * time.Minute
+
+// rateLimiters hold token bucket for every key (user id or address).
+type rateLimiters struct {
+	mutex     sync.Mutex
+	limit     rate.Limit
+	burst     int
+	buckets   map[string]*rateBucket
+	lastPrune time.Time
+	clock     Clock
+}
+
+type rateBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newRateLimiters returns limiters, which allow requestsPerSecond
+// requests with bursts of up to burst requests for every key.
+// Nil is returned, if requests are not limited.
+func newRateLimiters(requestsPerSecond int32, burst int32, clock Clock) *rateLimiters {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	return &rateLimiters{
+		limit:     rate.Limit(requestsPerSecond),
+		burst:     int(burst),
+		buckets:   make(map[string]*rateBucket),
+		lastPrune: clock.Now(),
+		clock:     clock,
+	}
+}
+
+// allow takes a token from the bucket of the key. It is safe
+// to call it on nil limiters, in which case all requests are allowed.
+func (r *rateLimiters) allow(key string) bool {
+	if r == nil {
+		return true
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := r.clock.Now()
+	if now.Sub(r.lastPrune) > rateLimiterIdleTime {
+		for k, bucket := range r.buckets {
+			if now.Sub(bucket.lastSeen) > rateLimiterIdleTime {
+				delete(r.buckets, k)
+			}
+		}
+		r.lastPrune = now
+	}
+
+	bucket, ok := r.buckets[key]
+	if !ok {
+		bucket = &rateBucket{limiter: rate.NewLimiter(r.limit, r.burst)}
+		r.buckets[key] = bucket
+	}
+	bucket.lastSeen = now
+	return bucket.limiter.AllowN(now, 1)
+}
+
+// peerHost returns the address of the client without port.
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// getRetryAfter returns the time, in which a token is added to the bucket.
+func (r *rateLimiters) getRetryAfter() time.Duration {
+	return time.Duration(float64(time.Second) / float64(r.limit))
+}
+
+// limitRate checks that neither the address of the client
+// nor the player have exceeded their rate limits.
+func (s *Server) limitRate(ctx context.Context, method string, req interface{}) error {
+	if !strings.HasPrefix(method, gameMethodPrefix) {
+		return nil
+	}
+
+	if host := peerHost(ctx); !s.peerLimiters.allow(host) {
+		return newStatusError(
+			codes.ResourceExhausted, ReasonRateLimited, s.peerLimiters.getRetryAfter(),
+			"too many requests from address %v", host,
+		)
+	}
+	if r, ok := req.(playerRequest); ok && r.GetUserId() != "" && !s.userLimiters.allow(r.GetUserId()) {
+		return newStatusError(
+			codes.ResourceExhausted, ReasonRateLimited, s.userLimiters.getRetryAfter(),
+			"too many requests from user %v", r.GetUserId(),
+		)
+	}
+	return nil
+}
+
+// limitUnary is a unary interceptor, which rejects requests
+// exceeding rate limits of the client.
+func (s *Server) limitUnary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := s.limitRate(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// limitStream is a stream interceptor, which rejects requests
+// exceeding rate limits of the client.
+func (s *Server) limitStream(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &checkedStream{ServerStream: ss, check: func(req interface{}) error {
+		return s.limitRate(ss.Context(), info.FullMethod, req)
+	}})
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"math"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Rating of the player, who has not finished any game yet.
+const initialRating = 1000
+
+// Maximal change of the rating in a single game.
+const ratingKFactor = 32
+
+// RatingRecord is a persisted rating of the player.
+// Players are identified by their usernames.
+type RatingRecord struct {
+	Username string
+	Rating   int32
+	Games    int32 // number of finished games
+}
+
+// ratingBook caches ratings of the players and
+// saves their updates to the storage, if any.
+type ratingBook struct {
+	mutex   sync.Mutex
+	storage Storage // nil, if ratings are not persisted
+	ratings map[username]RatingRecord
+	logger  *zap.Logger
+}
+
+func newRatingBook(storage Storage, logger *zap.Logger) *ratingBook {
+	return &ratingBook{
+		storage: storage,
+		ratings: make(map[username]RatingRecord),
+		logger:  logger,
+	}
+}
+
+// get returns the rating of the player, which is loaded from the storage,
+// if it is not cached yet. It is safe to call it on nil book, in which case
+// the initial rating is returned.
+func (b *ratingBook) get(username username) RatingRecord {
+	if b == nil {
+		return RatingRecord{Username: string(username), Rating: initialRating}
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.getLocked(username)
+}
+
+// getLocked is the same as get, but
+// the calling function has to acquire the lock.
+func (b *ratingBook) getLocked(username username) RatingRecord {
+	if record, ok := b.ratings[username]; ok {
+		return record
+	}
+	record := RatingRecord{Username: string(username), Rating: initialRating}
+	if b.storage != nil {
+		saved, ok, err := b.storage.LoadRating(string(username))
+		if err != nil {
+			b.logger.Error("Failed to load rating", zap.String("username", string(username)), zap.Error(err))
+		} else if ok {
+			record = saved
+		}
+	}
+	b.ratings[username] = record
+	return record
+}
+
+// update changes ratings of the players according to their final
+// points and returns the new ratings. It is safe to call it on nil
+// book, in which case nothing is changed.
+func (b *ratingBook) update(usernames []username, points []int32) []int32 {
+	if b == nil {
+		return nil
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	ratings := make([]int32, len(usernames))
+	for i, username := range usernames {
+		ratings[i] = b.getLocked(username).Rating
+	}
+	newRatings := getNewRatings(ratings, points)
+	for i, username := range usernames {
+		record := b.ratings[username]
+		record.Rating = newRatings[i]
+		record.Games++
+		b.ratings[username] = record
+		if b.storage == nil {
+			continue
+		}
+		if err := b.storage.SaveRating(record); err != nil {
+			b.logger.Error("Failed to save rating", zap.String("username", string(username)), zap.Error(err))
+		}
+	}
+	return newRatings
+}
+
+// getNewRatings returns ELO ratings of the players after the game, in
+// which they have got provided points. Every player is compared with every
+// other one, as if they have played a separate match, which the player with
+// more points wins. Changes are scaled, so that the rating changes by at most
+// K-factor in a single game regardless of the number of players.
+func getNewRatings(ratings []int32, points []int32) []int32 {
+	newRatings := make([]int32, len(ratings))
+	copy(newRatings, ratings)
+	if len(ratings) < 2 {
+		return newRatings
+	}
+
+	for i := range ratings {
+		change := 0.0
+		for j := range ratings {
+			if i == j {
+				continue
+			}
+			expected := 1 / (1 + math.Pow(10, float64(ratings[j]-ratings[i])/400))
+			actual := 0.5
+			if points[i] > points[j] {
+				actual = 1
+			} else if points[i] < points[j] {
+				actual = 0
+			}
+			change += actual - expected
+		}
+		newRatings[i] += int32(math.Round(ratingKFactor * change / float64(len(ratings)-1)))
+	}
+	return newRatings
+}
+
+// updateRatings updates ratings of the players, who have finished
+// the game, by their final points. Co-op games and bots don't change
+// ratings. Players, who have forfeited, lose to everybody else.
+// The calling function has to acquire write lock.
+func (g *game) updateRatings() {
+	if g.config.mode == CoopMode {
+		return
+	}
+	players := make([]*player, 0, len(g.players))
+	for _, player := range g.players {
+		if player.bot {
+			continue
+		}
+		players = append(players, player)
+	}
+
+	// in team games, players are ranked by the points of their teams
+	teamPoints := g.getTeamPoints()
+	usernames := make([]username, len(players))
+	points := make([]int32, len(players))
+	for i, player := range players {
+		usernames[i] = player.username
+		points[i] = g.getScore(player, teamPoints)
+		if player.forfeited {
+			points[i] = math.MinInt32
+		}
+	}
+	newRatings := g.ratings.update(usernames, points)
+	for i, rating := range newRatings {
+		players[i].rating = rating
+	}
+}
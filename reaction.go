@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"golang.org/x/time/rate"
+)
+
+// Players can react once per reaction interval
+// with bursts of up to reaction burst reactions.
+const (
+	reactionInterval = time.Second
+	reactionBurst    = 3
+)
+
+func newReactionLimiter() *rate.Limiter {
+	return rate.NewLimiter(rate.Every(reactionInterval), reactionBurst)
+}
+
+// react broadcasts the emote of the player to all players of the game.
+// It returns "False", if the player reacts too often, in which case
+// nothing is broadcast. Players can react both before and during the game.
+func (g *game) react(ctx context.Context, userID userID, emote pb.Emote) (bool, error) {
+	ctx, span := startSpan(ctx, "game.react", g.spanAttrs(userID)...)
+	defer span.End()
+
+	if _, ok := pb.Emote_name[int32(emote)]; !ok {
+		return false, fmt.Errorf("unknown emote: %d", emote)
+	}
+
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	player, ok := g.players[userID]
+	if !ok {
+		return false, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if !player.reactionLimiter.Allow() {
+		return false, nil
+	}
+
+	msg := getReactionMessage(userID, emote)
+	go func() {
+		g.broadcast(msg)
+	}()
+	return true, nil
+}
+
+func getReactionMessage(userID userID, emote pb.Emote) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Reaction_{
+			Reaction: &pb.StreamResponse_Reaction{
+				UserId: string(userID),
+				Emote:  emote,
+			},
+		},
+	}
+}
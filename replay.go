@@ -0,0 +1,131 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Replay sends the journal of the finished game event by event.
+// Intervals between the events are the same as in the original game,
+// divided by the requested speed.
+func (s *Server) Replay(req *pb.ReplayRequest, srv pb.Game_ReplayServer) error {
+	reqGameID := req.GetGameId()
+	if err := s.validateRequest(req); err != nil {
+		return newStatusError(codes.InvalidArgument, ReasonInvalidRequest, 0, "%v", err)
+	}
+	speed := req.GetSpeed()
+	if speed == 0 {
+		speed = 1
+	}
+
+	if s.storage == nil {
+		return status.Errorf(codes.FailedPrecondition, "server doesn't keep journals of finished games")
+	}
+	events, err := s.storage.LoadEvents(reqGameID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load journal of game %v: %v", reqGameID, err)
+	}
+	if len(events) == 0 {
+		return status.Errorf(codes.NotFound, "there is no journal for game %v", reqGameID)
+	}
+	if events[len(events)-1].Kind != EventFinish {
+		return status.Errorf(codes.FailedPrecondition, "game %v is not finished yet", reqGameID)
+	}
+
+	ctx := srv.Context()
+	firstEventTime := events[0].Time
+	replayStartTime := s.clock.Now()
+	for _, event := range events {
+		offset := event.Time.Sub(firstEventTime)
+		sendTime := replayStartTime.Add(time.Duration(float64(offset) / speed))
+
+		due := make(chan struct{})
+		timer := s.clock.AfterFunc(sendTime.Sub(s.clock.Now()), func() {
+			close(due)
+		})
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-due:
+		}
+
+		if err := srv.Send(getReplayEventMessage(event, offset)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Version of the schema of the exported games.
+const exportVersion = 1
+
+// getPBGameExport rebuilds the finished game from its journal.
+func getPBGameExport(events []JournalEvent) (*pb.GameExport, error) {
+	g, err := replayJournal(events, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	firstEventTime := events[0].Time
+	res := &pb.GameExport{
+		Version:    exportVersion,
+		GameId:     string(g.gameID),
+		CreateTime: firstEventTime.UnixNano() / int64(time.Millisecond),
+		FinishTime: events[len(events)-1].Time.UnixNano() / int64(time.Millisecond),
+		Results:    g.getPBGameResults(),
+	}
+	for _, event := range events {
+		res.Events = append(res.Events, getReplayEventMessage(event, event.Time.Sub(firstEventTime)))
+		if event.Kind == EventStart {
+			res.Config = event.Config.toPBGameConfig()
+			res.Seed = event.Seed
+		}
+	}
+	for _, player := range g.players {
+		res.Players = append(res.Players, player.toPBPlayer())
+	}
+	sort.Slice(res.Players, func(i, j int) bool {
+		return res.Players[i].UserId < res.Players[j].UserId
+	})
+	res.Players = append(res.Players, g.getBankAsPBPlayer())
+	return res, nil
+}
+
+// getReplayEventMessage converts the journal event to the message.
+// Session tokens are not sent, since they grant access to the players.
+func getReplayEventMessage(event JournalEvent, offset time.Duration) *pb.ReplayEvent {
+	res := &pb.ReplayEvent{
+		Sequence:   event.Sequence,
+		TimeOffset: offset.Milliseconds(),
+		Kind:       event.Kind,
+		UserId:     event.UserID,
+		Value:      event.Value,
+		RefId:      event.RefID,
+		Principal:  event.Principal,
+		Shares:     event.Shares,
+		Price:      event.Price,
+		Interest:   event.Interest,
+		TargetId:   event.Target,
+		Username:   event.Username,
+		Team:       event.Team,
+		Bot:        event.Bot,
+		AvatarId:   event.AvatarID,
+		Color:      event.Color,
+		Auto:       event.Auto,
+	}
+	if event.Config != nil {
+		res.Config = event.Config.toPBGameConfig()
+	}
+	if event.Trade != nil {
+		res.Trade = event.Trade.toPBTrade()
+	}
+	if event.Loan != nil {
+		res.Loan = newLoan(loanID(event.RefID), *event.Loan).toPBLoan(event.Time)
+	}
+	return res
+}
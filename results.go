@@ -0,0 +1,108 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/cs489-team11/server/pb"
+)
+
+// getBiggestLotteryWins returns the most points, which every player has
+// won in a single lottery play, including the jackpot won on top of it.
+// The calling function has to acquire at least read lock.
+func (g *game) getBiggestLotteryWins() map[userID]int32 {
+	wins := make(map[userID]int32)
+	win := int32(0)
+	for _, event := range g.journal {
+		switch event.Kind {
+		case EventLottery:
+			win = event.Value
+		case EventJackpot:
+			// jackpot immediately follows the play, in which it is won
+			win += event.Value
+		default:
+			continue
+		}
+		if userID := userID(event.UserID); win > wins[userID] {
+			wins[userID] = win
+		}
+	}
+	return wins
+}
+
+// getPBGameResults returns the final results of the game with the players
+// ranked by their scores. Winners are ranked first, since the tie may be
+// broken by overtime, and the win condition may be met by the player
+// with the lower score. Players, who have forfeited, are ranked after
+// the others. The calling function has to acquire at least read lock.
+func (g *game) getPBGameResults() *pb.GameResults {
+	winners := g.findWinners()
+	teamPoints := g.getTeamPoints()
+	lotteryWins := g.getBiggestLotteryWins()
+
+	results := &pb.GameResults{}
+	for userID, player := range g.players {
+		deposits := int32(0)
+		for _, deposit := range player.deposits {
+			deposits += deposit.value
+		}
+		result := &pb.PlayerResult{
+			UserId:            string(userID),
+			Username:          string(player.username),
+			Won:               winners[userID],
+			Score:             g.getScore(player, teamPoints),
+			Cash:              player.points,
+			Deposits:          deposits,
+			OutstandingCredit: player.getOutstandingCredit(),
+			NetWorth:          player.getNetWorth(),
+			BiggestLotteryWin: lotteryWins[userID],
+			QuestionsAnswered: g.countEvents(userID, func(event JournalEvent) bool {
+				return event.Kind == EventQuestionAnswer
+			}),
+			QuestionsCorrect: g.countEvents(userID, func(event JournalEvent) bool {
+				return event.Kind == EventQuestionAnswer && event.Value > 0
+			}),
+			Team:      player.team,
+			Bot:       player.bot,
+			Forfeited: player.forfeited,
+		}
+		results.Rankings = append(results.Rankings, result)
+	}
+
+	rankings := results.Rankings
+	sort.Slice(rankings, func(i, j int) bool {
+		if rankings[i].Won != rankings[j].Won {
+			return rankings[i].Won
+		}
+		if rankings[i].Forfeited != rankings[j].Forfeited {
+			return rankings[j].Forfeited
+		}
+		if rankings[i].Score != rankings[j].Score {
+			return rankings[i].Score > rankings[j].Score
+		}
+		return rankings[i].Username < rankings[j].Username
+	})
+	for i, result := range rankings {
+		result.Rank = int32(i + 1)
+		if i > 0 && result.Won == rankings[i-1].Won && result.Forfeited == rankings[i-1].Forfeited &&
+			result.Score == rankings[i-1].Score {
+			result.Rank = rankings[i-1].Rank
+		}
+		if result.BiggestLotteryWin > results.BiggestLotteryWin {
+			results.BiggestLotteryUserId = result.UserId
+			results.BiggestLotteryWin = result.BiggestLotteryWin
+		}
+		if result.QuestionsCorrect > results.MostQuestions {
+			results.MostQuestionsUserId = result.UserId
+			results.MostQuestions = result.QuestionsCorrect
+		}
+	}
+	return results
+}
+
+func getResultsMessage(results *pb.GameResults) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Results{
+			Results: results,
+		},
+	}
+}
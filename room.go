@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultRoomName is the name, under which the default
+// waiting game is listed among the rooms.
+const defaultRoomName = "default"
+
+// getLobby returns either the waiting game or the room with provided id.
+// Error is returned, if there is no such game or the server is shutting down.
+func (s *Server) getLobby(gameID gameID) (*game, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.shuttingDown {
+		return nil, newStatusError(codes.Unavailable, ReasonServerShuttingDown, 0, "server is shutting down")
+	}
+	if s.waitingGame.gameID == gameID {
+		return s.waitingGame, nil
+	}
+	if room, ok := s.rooms[gameID]; ok {
+		return room, nil
+	}
+	return nil, newStatusError(codes.InvalidArgument, ReasonGameNotFound, 0, "there is no waiting game or room with id %v", gameID)
+}
+
+// pruneRoom removes the room from the list of rooms, if all
+// of its players have left. Removed room is marked as finished,
+// so that nobody can join it afterwards.
+// The default waiting game is never pruned.
+func (s *Server) pruneRoom(room *game) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.rooms[room.gameID]; !ok {
+		return
+	}
+	if room.getPlayerCount() == 0 {
+		delete(s.rooms, room.gameID)
+		room.finish()
+		room.logger.Info("Room is removed, since all players left", zap.String("name", room.name))
+	}
+}
+
+// CreateRoom creates a new waiting game with the provided name.
+// Non-zero values of the provided config override the server defaults.
+func (s *Server) CreateRoom(_ context.Context, req *pb.CreateRoomRequest) (*pb.CreateRoomResponse, error) {
+	reqName := req.GetName()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.shuttingDown {
+		return nil, newStatusError(codes.Unavailable, ReasonServerShuttingDown, 0, "server is shutting down")
+	}
+
+	config, err := s.getOverriddenConfig(s.gameConfig, req.GetConfig())
+	if err == nil {
+		err = config.Validate()
+	}
+	if err != nil {
+		err = fmt.Errorf("invalid game config overrides: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	room := s.newGame(config)
+	room.name = reqName
+	room.code = req.GetCode()
+	s.rooms[room.gameID] = room
+	room.logger.Info("Room has been created", zap.String("name", room.name))
+
+	return &pb.CreateRoomResponse{GameId: string(room.gameID)}, nil
+}
+
+// ListRooms returns all rooms, which can be joined,
+// including the default waiting game.
+func (s *Server) ListRooms(_ context.Context, _ *pb.ListRoomsRequest) (*pb.ListRoomsResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.shuttingDown {
+		return &pb.ListRoomsResponse{}, nil
+	}
+
+	rooms := []*pb.Room{s.getPBRoom(s.waitingGame)}
+	for _, room := range s.rooms {
+		rooms = append(rooms, s.getPBRoom(room))
+	}
+	return &pb.ListRoomsResponse{Rooms: rooms}, nil
+}
+
+// JoinRoom adds a player to the room with provided id.
+func (s *Server) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest) (*pb.JoinResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUsername, err := s.resolveUsername(username(req.GetUsername()), req.GetAccountToken())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBan(ctx, reqUsername); err != nil {
+		return nil, err
+	}
+
+	room, err := s.getLobby(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+	if room.code != "" && subtle.ConstantTimeCompare([]byte(req.GetCode()), []byte(room.code)) != 1 {
+		return nil, status.Errorf(codes.PermissionDenied, "wrong code of room with id %v", reqGameID)
+	}
+
+	userID, err := room.addPlayer(reqUsername, req.GetLocale(), appearance{avatarID: req.GetAvatarId(), color: req.GetColor()})
+	if err := getUsernameError(err); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		err := fmt.Errorf("failed to join room with id %v: %v", reqGameID, err)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	s.metrics.joins.Inc()
+	s.scheduleAutoStart(room)
+	return s.getJoinResponseMessage(userID, room)
+}
+
+// ListGames returns the default waiting game and the rooms,
+// which can be joined, together with the summary of their configs.
+func (s *Server) ListGames(_ context.Context, _ *pb.ListLobbiesRequest) (*pb.ListLobbiesResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.shuttingDown {
+		return &pb.ListLobbiesResponse{}, nil
+	}
+
+	games := []*pb.GameListing{s.waitingGame.getPBGameListing()}
+	for _, room := range s.rooms {
+		games = append(games, room.getPBGameListing())
+	}
+	return &pb.ListLobbiesResponse{Games: games}, nil
+}
+
+// As this function uses Readlock, it cannot be called while holding the game lock.
+func (g *game) getPBGameListing() *pb.GameListing {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	name := g.name
+	if name == "" {
+		name = defaultRoomName
+	}
+	hostUsername := ""
+	if host, ok := g.players[g.hostID]; ok {
+		hostUsername = string(host.username)
+	}
+	return &pb.GameListing{
+		GameId:       string(g.gameID),
+		Name:         name,
+		PlayerCount:  int32(len(g.players)),
+		MinPlayers:   g.config.minPlayers,
+		MaxPlayers:   g.config.maxPlayers,
+		CodeRequired: g.code != "",
+		Starting:     g.countdown != nil,
+		HostUsername: hostUsername,
+		Duration:     g.config.duration,
+		PlayerPoints: g.config.playerPoints,
+	}
+}
+
+func (s *Server) getPBRoom(room *game) *pb.Room {
+	name := room.name
+	if name == "" {
+		name = defaultRoomName
+	}
+	return &pb.Room{
+		GameId:      string(room.gameID),
+		Name:        name,
+		PlayerCount: int32(room.getPlayerCount()),
+		Config:      room.config.toPBGameConfig(),
+	}
+}
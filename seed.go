@@ -0,0 +1,68 @@
+package server
+
+import (
+	"math/rand"
+)
+
+// WithSeed makes server seed the random numbers of every game with
+// provided seed, so that the games are reproduced exactly.
+// Without this option, games are seeded with their start time.
+func WithSeed(seed int64) ServerOption {
+	return func(s *Server) {
+		s.seed = seed
+	}
+}
+
+// countingSource counts the numbers drawn from the source, so that
+// the restored game continues the same sequence of random numbers.
+type countingSource struct {
+	rand.Source
+	draws int64
+}
+
+func (s *countingSource) Int63() int64 {
+	s.draws++
+	return s.Source.Int63()
+}
+
+// seedRand seeds the random numbers of the game, which are used for the
+// lottery, thefts, share prices, and questions, and skips provided number
+// of draws. The calling function has to acquire write lock.
+func (g *game) seedRand(seed int64, draws int64) {
+	source := &countingSource{Source: rand.NewSource(seed)}
+	for source.draws < draws {
+		source.Int63()
+	}
+	g.seed = seed
+	g.randSource = source
+	g.rand = rand.New(source)
+}
+
+// randShuffle returns the randomly shuffled copy of the slice.
+// The calling function has to acquire write lock.
+func (g *game) randShuffle(src []int32) []int32 {
+	dest := make([]int32, len(src))
+	for i, v := range g.rand.Perm(len(src)) {
+		dest[v] = src[i]
+	}
+	return dest
+}
+
+// randChance returns true with provided probability given in percents.
+// The calling function has to acquire write lock.
+func (g *game) randChance(percentage int32) bool {
+	return g.rand.Int31n(100) < percentage
+}
+
+// getQuestionRand returns the random numbers for picking the next question.
+// They are derived from the random numbers of the game, since questions
+// are fetched without holding the game lock.
+func (g *game) getQuestionRand() *rand.Rand {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.rand == nil {
+		return nil
+	}
+	return rand.New(rand.NewSource(g.rand.Int63()))
+}
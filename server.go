@@ -2,60 +2,364 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
-	"log"
+	"math"
 	"net"
+	"net/http"
 	"sync"
 	"time"
 
 	"github.com/cs489-team11/server/pb"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.uber.org/zap"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// shutdownPollInterval defines how often Shutdown checks whether
+// all active games have finished.
+const shutdownPollInterval = 250 * time.Millisecond
+
+// gameServiceName is the name of the Game service reported
+// by the health checking service.
+const gameServiceName = "server.Game"
+
 // Server is a type for the server, which will
 // track the games, serve the user requests, maintain
 // money invariant, and broadcast events to users.
 type Server struct {
-	listener    net.Listener
-	mutex       sync.RWMutex
-	gameConfig  GameConfig
-	waitingGame *game
-	activeGames map[gameID]*game
+	listener     net.Listener
+	grpcServer   *grpc.Server
+	mutex        sync.RWMutex
+	gameConfig   GameConfig
+	configErr    error // violations of the default game config, which keep the server from listening
+	presets      map[string]GameConfig
+	messages     messageCatalog
+	waitingGame  *game
+	rooms        map[gameID]*game
+	activeGames  map[gameID]*game
+	tournaments  map[string]*tournament
+	shuttingDown bool
+	storage      Storage
+	adminToken   string // Admin service is disabled, if empty
+
+	metrics         *metrics
+	metricsAddr     string // metrics are not exported, if empty
+	metricsListener net.Listener
+	metricsServer   *http.Server
+
+	grpcWebAddr     string // gRPC-Web requests are not accepted, if empty
+	grpcWebListener net.Listener
+	grpcWebServer   *http.Server
+
+	debugAddr     string // pprof profiles are not served, if empty
+	debugListener net.Listener
+	debugServer   *http.Server
+
+	tracerProvider trace.TracerProvider // global provider is used, if nil
+	logger         *zap.Logger
+	logSampler     *logSampler
+	health         *health.Server
+	tlsConfig      *tls.Config // connections are not encrypted, if nil
+	authSecret     []byte
+	userLimiters   *rateLimiters // nil, if requests are not limited
+	peerLimiters   *rateLimiters
+	questions      QuestionProvider
+	bankStrategy   BankStrategy // default strategy is used, if nil
+	wordFilter     WordFilter   // usernames and chat are not filtered, if nil
+	bans           *banList
+	matchmaker     *matchmaker
+	ratings        *ratingBook
+	accounts       *accountBook
+	stats          *statsBook
+	achievements   *achievementBook
+	challenges     *challengeBook
+	summaries      *summaryBook
+	newBot         func() Bot
+	botProfiles    []BotProfile
+	botFill        int32 // lobbies are not filled with bots, if 0
+
+	heartbeatInterval time.Duration // heartbeats are not sent, if 0
+	clock             Clock
+	seed              int64 // games are seeded with their start time, if 0
+}
+
+// ServerOption configures optional features of the server.
+type ServerOption func(*Server)
+
+// WithStorage makes server persist active games to the provided storage.
+// Call Restore to load the games, which were active before the restart.
+func WithStorage(storage Storage) ServerOption {
+	return func(s *Server) {
+		s.storage = storage
+	}
 }
 
 // NewServer will return a new instance of the server.
-func NewServer(gameConfig GameConfig) *Server {
-	return &Server{
+func NewServer(gameConfig GameConfig, opts ...ServerOption) *Server {
+	s := &Server{
 		gameConfig:  gameConfig,
-		waitingGame: newGame(gameConfig),
+		rooms:       make(map[gameID]*game),
 		activeGames: make(map[gameID]*game),
+		tournaments: make(map[string]*tournament),
+		bans:        newBanList(),
+		matchmaker:  newMatchmaker(),
+		clock:       systemClock{},
+		presets:     defaultPresets(),
+		messages:    defaultMessages(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.bans.clock = s.clock
+	if s.logger == nil {
+		// "info" level is always valid
+		s.logger, _ = NewLogger("info")
 	}
+	if s.logSampler == nil {
+		s.logSampler = newLogSampler(defaultLogSampling)
+	}
+	if err := gameConfig.Validate(); err != nil {
+		s.configErr = err
+		s.logger.Error("Invalid game config", zap.Error(err))
+	}
+	if s.questions == nil {
+		s.questions = NewOpenTriviaDBProvider("", nil)
+	}
+	if s.authSecret == nil {
+		s.authSecret = newAuthSecret()
+	}
+	s.botProfiles = s.getValidBotProfiles()
+	if len(s.botProfiles) == 0 {
+		s.botProfiles = DefaultBotProfiles
+	}
+	if s.newBot == nil {
+		s.newBot = newProfileBots(s.botProfiles)
+	}
+	s.userLimiters = newRateLimiters(gameConfig.userRateLimit, gameConfig.userRateBurst, s.clock)
+	s.peerLimiters = newRateLimiters(gameConfig.peerRateLimit, gameConfig.peerRateBurst, s.clock)
+	s.metrics = newMetrics(s)
+	s.ratings = newRatingBook(s.storage, s.logger)
+	s.accounts = newAccountBook(s.storage, s.logger)
+	s.stats = newStatsBook(s.storage, s.logger)
+	s.achievements = newAchievementBook(s.storage, s.clock, s.logger)
+	s.challenges = newChallengeBook(s.storage, s.logger)
+	s.summaries = newSummaryBook(s.clock)
+	s.waitingGame = s.newGame(gameConfig)
+
+	// server is not serving until it starts listening
+	s.health = health.NewServer()
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	s.health.SetServingStatus(gameServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	return s
 }
 
-// Join adds a player to the game.
-func (s *Server) Join(_ context.Context, req *pb.JoinRequest) (*pb.JoinResponse, error) {
+// newGame creates a new waiting game, which uses server's storage.
+func (s *Server) newGame(config GameConfig) *game {
+	game := newGame(config, s.storage, s.logger)
+	game.clock = s.clock
+	game.seed = s.seed
+	if s.bankStrategy != nil {
+		game.bank = s.bankStrategy
+	}
+	game.words = s.wordFilter
+	game.ratings = s.ratings
+	game.stats = s.stats
+	game.achievements = s.achievements
+	game.challenges = s.challenges
+	game.summaries = s.summaries
+	return game
+}
+
+// SetGameConfig replaces the default config of the games, which are created
+// afterwards, without restarting the server. The waiting game is replaced as
+// well, unless players have joined it. Active games and rooms keep their
+// configs, and rate limits of the server are not changed.
+func (s *Server) SetGameConfig(config GameConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.gameConfig = config
+	s.configErr = nil
+	if s.waitingGame.getPlayerCount() == 0 {
+		s.waitingGame = s.newGame(config)
+	}
+	s.logger.Info("Default game config is replaced")
+	return nil
+}
+
+// Restore loads games, which were active when the server stopped,
+// from the storage. Players of restored games receive events after
+// they reconnect with their session tokens.
+func (s *Server) Restore() error {
+	if s.storage == nil {
+		return fmt.Errorf("server doesn't have storage to restore games from")
+	}
+
+	records, err := s.storage.LoadActiveGames()
+	if err != nil {
+		return fmt.Errorf("failed to load active games: %v", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, record := range records {
+		game, err := restoreGame(record, s.storage, s.clock, s.logger)
+		if err != nil {
+			return fmt.Errorf("failed to restore game %v: %v", record.GameID, err)
+		}
+		if s.bankStrategy != nil {
+			game.bank = s.bankStrategy
+		}
+		game.words = s.wordFilter
+		game.ratings = s.ratings
+		game.stats = s.stats
+		game.achievements = s.achievements
+		game.challenges = s.challenges
+		game.summaries = s.summaries
+		for _, player := range game.players {
+			player.rating = s.ratings.get(player.username).Rating
+		}
+		s.activeGames[game.gameID] = game
+		s.scheduleFinish(game, game.getEndTime().Sub(s.clock.Now()))
+		for userID, player := range game.players {
+			if player.bot {
+				go s.runBot(game, userID, s.newBot())
+			}
+		}
+		game.logger.Info("Game has been restored", zap.Int("players", len(record.Players)))
+	}
+	return nil
+}
+
+// scheduleFinish finishes the game and removes it from the active games
+// after provided duration.
+func (s *Server) scheduleFinish(game *game, duration time.Duration) {
+	timer := game.clock.AfterFunc(duration, func() {
+		// the timer is reset by the overtime
+		if s.beginOvertime(game) {
+			return
+		}
+		s.mutex.Lock()
+		delete(s.activeGames, game.gameID)
+		s.mutex.Unlock()
+
+		game.finish()
+	})
+
+	// the game may be finished earlier, e.g. in co-op mode
+	game.mutex.Lock()
+	defer game.mutex.Unlock()
+	game.finishTimer = timer
+	if game.coopReached || game.conditionWinnerID != "" {
+		timer.Reset(0)
+	}
+}
+
+// getWaitingGame returns the current waiting game.
+// Server mutex is held only for the lookup itself.
+// Error is returned, if the server is shutting down.
+func (s *Server) getWaitingGame() (*game, error) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
-	reqUsername := username(req.GetUsername())
-	userID := s.waitingGame.addPlayer(reqUsername)
+	if s.shuttingDown {
+		return nil, newStatusError(codes.Unavailable, ReasonServerShuttingDown, 0, "server is shutting down")
+	}
+	return s.waitingGame, nil
+}
 
-	res := s.getJoinResponseMessage(userID, s.waitingGame)
-	return res, nil
+// rollWaitingGame turns the full waiting game into a room, which
+// can still be started by its host, and creates a new waiting game
+// for other users to join. Nothing is done, if the game is not the
+// waiting game anymore.
+func (s *Server) rollWaitingGame(full *game) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.waitingGame != full {
+		return
+	}
+	s.rooms[full.gameID] = full
+	s.waitingGame = s.newGame(s.gameConfig)
+	full.logger.Info("Waiting game is full, new waiting game is created")
 }
 
-// Leave deleted player from the waiting game.
-func (s *Server) Leave(_ context.Context, req *pb.LeaveRequest) (*pb.LeaveResponse, error) {
+// getActiveGame returns the active game with the provided id.
+// Server mutex is held only for the lookup itself, all further
+// operations on the game are protected by the game's own lock.
+func (s *Server) getActiveGame(gameID gameID) (*game, error) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
+	game, ok := s.activeGames[gameID]
+	if !ok {
+		return nil, newStatusError(codes.InvalidArgument, ReasonGameNotFound, 0, "there is no active game with id %v", gameID)
+	}
+	return game, nil
+}
+
+// Join adds a player to the game.
+func (s *Server) Join(ctx context.Context, req *pb.JoinRequest) (*pb.JoinResponse, error) {
+	reqUsername, err := s.resolveUsername(username(req.GetUsername()), req.GetAccountToken())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBan(ctx, reqUsername); err != nil {
+		return nil, err
+	}
+
+	appearance := appearance{avatarID: req.GetAvatarId(), color: req.GetColor()}
+	if req.GetPractice() {
+		return s.joinPractice(reqUsername, req.GetLocale(), appearance, req.GetPracticeBots())
+	}
+
+	for {
+		game, err := s.getWaitingGame()
+		if err != nil {
+			return nil, err
+		}
+		// the waiting game may have been started between the lookup
+		// and adding the player, in which case we retry with the new one
+		userID, err := game.addPlayer(reqUsername, req.GetLocale(), appearance)
+		if err == errGameFull {
+			s.rollWaitingGame(game)
+			continue
+		}
+		if err := getUsernameError(err); err != nil {
+			return nil, err
+		}
+		if err != nil {
+			continue
+		}
+		s.metrics.joins.Inc()
+		s.scheduleAutoStart(game)
+		return s.getJoinResponseMessage(userID, game)
+	}
+}
+
+// Leave deleted player from the waiting game or the room.
+func (s *Server) Leave(_ context.Context, req *pb.LeaveRequest) (*pb.LeaveResponse, error) {
 	reqGameID := gameID(req.GetGameId())
 	reqUserID := userID(req.GetUserId())
 
-	if s.waitingGame.gameID != reqGameID {
+	game, err := s.getLobby(reqGameID)
+	// deletePlayer refuses to delete from a game, which is not waiting anymore
+	if err != nil || !game.deletePlayer(reqUserID) {
 		err := fmt.Errorf(
 			"game with id %v doesn't exist or has been already started (can't join active game)",
 			reqGameID,
@@ -63,10 +367,29 @@ func (s *Server) Leave(_ context.Context, req *pb.LeaveRequest) (*pb.LeaveRespon
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	s.waitingGame.deletePlayer(reqUserID)
+	s.pruneRoom(game)
 	return &pb.LeaveResponse{}, nil
 }
 
+// KickPlayer removes the player from the waiting game on behalf of the host.
+func (s *Server) KickPlayer(_ context.Context, req *pb.KickPlayerRequest) (*pb.KickPlayerResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqTargetID := userID(req.GetTargetId())
+
+	game, err := s.getLobby(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+	if err := game.kickPlayer(reqUserID, reqTargetID); err != nil {
+		if errors.Is(err, errNotHost) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.KickPlayerResponse{}, nil
+}
+
 // Start will start the game, i.e. change it from "waiting" to "active".
 // New waiting game will be created for other users to join.
 func (s *Server) Start(_ context.Context, req *pb.StartRequest) (*pb.StartResponse, error) {
@@ -74,177 +397,543 @@ func (s *Server) Start(_ context.Context, req *pb.StartRequest) (*pb.StartRespon
 	defer s.mutex.Unlock()
 
 	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
 
-	if s.waitingGame.gameID != reqGameID {
-		log.Printf(
-			"attempt to start game with id different from waiting game id; have: %v, want %v\n",
-			reqGameID,
-			s.waitingGame.gameID,
+	if s.shuttingDown {
+		return nil, newStatusError(codes.Unavailable, ReasonServerShuttingDown, 0, "server is shutting down")
+	}
+
+	var game *game = nil
+	if s.waitingGame.gameID == reqGameID {
+		game = s.waitingGame
+	} else if room, ok := s.rooms[reqGameID]; ok {
+		game = room
+	} else {
+		s.logger.Info(
+			"Attempt to start game with id different from waiting game or room ids",
+			zap.String("game_id", string(reqGameID)),
+			zap.String("waiting_game_id", string(s.waitingGame.gameID)),
 		)
 		// ignore the error
 		return &pb.StartResponse{}, nil
 	}
 
-	game := s.waitingGame
-	game.start()
-	s.activeGames[game.gameID] = game
-	// count down until game finishes
-	time.AfterFunc(time.Duration(game.config.duration)*time.Second, func() {
-		s.mutex.Lock()
-		defer s.mutex.Unlock()
-		delete(s.activeGames, game.gameID)
+	if !game.isHost(reqUserID) {
+		err := fmt.Errorf("only the host of the game can start it")
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
 
-		game.finish()
-	})
+	config, err := s.getOverriddenConfig(game.config, req.GetConfig())
+	if err == nil {
+		err = config.Validate()
+	}
+	if err != nil {
+		err = fmt.Errorf("invalid game config overrides: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	s.fillWithBots(game, config.maxPlayers)
+	playerCount := int32(game.getPlayerCount())
+	if playerCount < config.minPlayers && !config.practice {
+		err := fmt.Errorf("game needs at least %d players to start, it has %d", config.minPlayers, playerCount)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if config.maxPlayers > 0 && playerCount > config.maxPlayers {
+		err := fmt.Errorf("game can have at most %d players, it has %d", config.maxPlayers, playerCount)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
 
-	// create a new waiting game
-	s.waitingGame = newGame(s.gameConfig)
+	countdownTime := config.getCountdownTime(false)
+	if countdownTime == 0 {
+		s.startLobby(game, config)
+		return &pb.StartResponse{}, nil
+	}
 
+	countdown := &startCountdown{config: config}
+	if !game.beginCountdown(countdown) {
+		err := fmt.Errorf("game is already counting down to the start")
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	game.logger.Info("Counting down to the start", zap.Int32("countdown", countdownTime))
+	// the countdown acquires server lock at the end, so it cannot be run here
+	go s.countDown(game, countdown, countdownTime)
 	return &pb.StartResponse{}, nil
 }
 
+// CancelStart cancels the countdown to the start of the game on behalf of the host.
+func (s *Server) CancelStart(_ context.Context, req *pb.CancelStartRequest) (*pb.CancelStartResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, err := s.getLobby(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+	if err := game.cancelStart(reqUserID); err != nil {
+		if errors.Is(err, errNotHost) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.CancelStartResponse{}, nil
+}
+
+// startLobby starts the waiting game or the room with provided config.
+// New waiting game will be created for other users to join, if the
+// waiting game is started. The calling function has to acquire server lock.
+func (s *Server) startLobby(game *game, config GameConfig) {
+	if game == s.waitingGame {
+		// create a new waiting game
+		s.waitingGame = s.newGame(s.gameConfig)
+	} else {
+		delete(s.rooms, game.gameID)
+	}
+
+	game.start(config)
+	s.activeGames[game.gameID] = game
+	// count down until game finishes
+	s.scheduleFinish(game, time.Duration(game.config.duration)*time.Second)
+}
+
 // Credit will check if the credit can be granted. It will return "True" for success, if
 // credit has been granted. If "success == False", "explanation" will contain the relevant
 // explanation about why it hasn't been granted.
 // Requesting client has to make sure that provided game_id and user_id are vaild.
-func (s *Server) Credit(_ context.Context, req *pb.CreditRequest) (*pb.CreditResponse, error) {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
+func (s *Server) Credit(ctx context.Context, req *pb.CreditRequest) (*pb.CreditResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqVal := req.GetValue()
 
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	success, out, limit, used, err := game.useCredit(ctx, reqUserID, reqVal)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	out = s.localize(game, reqUserID, out)
+	if success {
+		s.metrics.creditVolume.Add(float64(reqVal))
+	}
+
+	return s.getCreditResponseMessage(success, out, limit, used), nil
+}
+
+// RepayCredit returns the outstanding credit of the player before its time ends.
+// If "success == False", "explanation" will contain the reason why it hasn't been repaid.
+func (s *Server) RepayCredit(ctx context.Context, req *pb.RepayCreditRequest) (*pb.RepayCreditResponse, error) {
 	reqGameID := gameID(req.GetGameId())
 	reqUserID := userID(req.GetUserId())
+	reqPositionID := positionID(req.GetPositionId())
 	reqVal := req.GetValue()
 
-	game, ok := s.activeGames[reqGameID]
-	if !ok {
-		err := fmt.Errorf("there is no active game with id %v", reqGameID)
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	success, out, val, remainingVal, err := game.repayCredit(ctx, reqUserID, reqPositionID, reqVal)
+	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	out = s.localize(game, reqUserID, out)
 
-	if reqVal <= 0 {
-		err := fmt.Errorf("requested value has to be positive value (received: %d)", reqVal)
+	return s.getRepayCreditResponseMessage(success, out, val, remainingVal), nil
+}
+
+// OfferLoan offers the loan from the requesting player to another player
+// of the game. Points are moved, when the borrower accepts the loan.
+func (s *Server) OfferLoan(ctx context.Context, req *pb.OfferLoanRequest) (*pb.OfferLoanResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqBorrowerID := userID(req.GetBorrowerId())
+	reqVal := req.GetValue()
+	reqInterest := req.GetInterest()
+	reqTime := req.GetTime()
+
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	loanID, err := game.offerLoan(ctx, reqUserID, reqBorrowerID, reqVal, reqInterest, reqTime)
+	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	success, explanation, err := game.useCredit(reqUserID, reqVal)
+	return s.getOfferLoanResponseMessage(loanID), nil
+}
+
+// AcceptLoan accepts the loan offered to the requesting player. If "success == False",
+// "explanation" will contain the explanation about why the loan hasn't been given.
+func (s *Server) AcceptLoan(ctx context.Context, req *pb.AcceptLoanRequest) (*pb.AcceptLoanResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqLoanID := loanID(req.GetLoanId())
+
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	success, out, err := game.acceptLoan(ctx, reqUserID, reqLoanID)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	out = s.localize(game, reqUserID, out)
 
-	return s.getCreditResponseMessage(success, explanation), nil
+	return s.getAcceptLoanResponseMessage(success, out), nil
 }
 
-// Deposit will check if the deposit can be granted. It will return "True" for success, if
-// deposit has been granted. If "success == False", "explanation" will contain the relevant
-// explanation about why it hasn't been granted.
-// Requesting client has to make sure that provided game_id and user_id are vaild.
-func (s *Server) Deposit(_ context.Context, req *pb.DepositRequest) (*pb.DepositResponse, error) {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
+// PlaceOrder lists units of the asset for sale or bids on them on the market
+// of the game. The order is traded right away with the best orders of the
+// other side, and its rest is kept in the order book.
+func (s *Server) PlaceOrder(ctx context.Context, req *pb.PlaceOrderRequest) (*pb.PlaceOrderResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqAsset := req.GetAsset()
+	reqSide := req.GetSide()
+	reqQuantity := req.GetQuantity()
+	reqPrice := req.GetPrice()
 
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, filled, remaining, err := game.placeOrder(
+		ctx, reqUserID, reqAsset, orderSide(reqSide), reqQuantity, reqPrice,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	return s.getPlaceOrderResponseMessage(orderID, filled, remaining), nil
+}
+
+// CancelOrder removes the open order of the requesting player from the order book.
+func (s *Server) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
 	reqGameID := gameID(req.GetGameId())
 	reqUserID := userID(req.GetUserId())
-	reqVal := req.GetValue()
+	reqOrderID := orderID(req.GetOrderId())
 
-	game, ok := s.activeGames[reqGameID]
-	if !ok {
-		err := fmt.Errorf("there is no active game with id %v", reqGameID)
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := game.cancelOrder(ctx, reqUserID, reqOrderID); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	if reqVal <= 0 {
-		err := fmt.Errorf("requested value has to be positive value (received: %d)", reqVal)
+	return &pb.CancelOrderResponse{}, nil
+}
+
+// GetMarket returns order books of the game and assets of the requesting player.
+func (s *Server) GetMarket(_ context.Context, req *pb.GetMarketRequest) (*pb.GetMarketResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, err := s.getActiveGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	books, holdings, err := game.getPBMarket(reqUserID)
+	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	success, explanation, err := game.useDeposit(reqUserID, reqVal)
+	return &pb.GetMarketResponse{
+		Books:    books,
+		Holdings: holdings,
+	}, nil
+}
+
+// BuyShares buys shares of the stock from the bank at the current price. If
+// "success == False", "explanation" will contain the explanation about why
+// the shares haven't been bought.
+func (s *Server) BuyShares(ctx context.Context, req *pb.BuySharesRequest) (*pb.BuySharesResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqShares := req.GetShares()
+
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	success, out, price, val, err := game.buyShares(ctx, reqUserID, reqShares)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	out = s.localize(game, reqUserID, out)
 
-	return s.getDepositResponseMessage(success, explanation), nil
+	return &pb.BuySharesResponse{
+		Success:     success,
+		Explanation: out.getExplanation(),
+		Reason:      string(out.reason),
+		RetryAfter:  out.getRetryAfter(),
+		Price:       price,
+		Value:       val,
+	}, nil
 }
 
-// Lottery conducts a lottery per player request.
-// Success will be false, if the user calls the lottery before it is allowed by timer.
-func (s *Server) Lottery(_ context.Context, req *pb.LotteryRequest) (*pb.LotteryResponse, error) {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
+// SellShares sells shares of the stock to the bank at the current price. If
+// "success == False", "explanation" will contain the explanation about why
+// the shares haven't been sold.
+func (s *Server) SellShares(ctx context.Context, req *pb.SellSharesRequest) (*pb.SellSharesResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqShares := req.GetShares()
+
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	success, out, price, val, err := game.sellShares(ctx, reqUserID, reqShares)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	out = s.localize(game, reqUserID, out)
 
+	return &pb.SellSharesResponse{
+		Success:     success,
+		Explanation: out.getExplanation(),
+		Reason:      string(out.reason),
+		RetryAfter:  out.getRetryAfter(),
+		Price:       price,
+		Value:       val,
+	}, nil
+}
+
+// Steal tries to steal points of the target for the requesting player.
+// If "success == False", "explanation" will contain the explanation
+// about why nothing has been stolen.
+func (s *Server) Steal(ctx context.Context, req *pb.StealRequest) (*pb.StealResponse, error) {
 	reqGameID := gameID(req.GetGameId())
 	reqUserID := userID(req.GetUserId())
-	reqCellIndex := req.GetCellIndex()
+	reqTargetID := userID(req.GetTargetId())
 
-	game, ok := s.activeGames[reqGameID]
-	if !ok {
-		err := fmt.Errorf("there is no active game with id %v", reqGameID)
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	success, out, stolen, penalty, err := game.steal(ctx, reqUserID, reqTargetID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	out = s.localize(game, reqUserID, out)
+
+	return &pb.StealResponse{
+		Success:     success,
+		Explanation: out.getExplanation(),
+		Reason:      string(out.reason),
+		RetryAfter:  out.getRetryAfter(),
+		Stolen:      stolen,
+		Penalty:     penalty,
+	}, nil
+}
+
+// HireGuard protects the requesting player from thefts. If
+// "success == False", "explanation" will contain the explanation about why
+// the guard hasn't been hired.
+func (s *Server) HireGuard(ctx context.Context, req *pb.HireGuardRequest) (*pb.HireGuardResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	success, out, price, err := game.hireGuard(ctx, reqUserID)
+	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	out = s.localize(game, reqUserID, out)
+
+	return &pb.HireGuardResponse{
+		Success:     success,
+		Explanation: out.getExplanation(),
+		Reason:      string(out.reason),
+		RetryAfter:  out.getRetryAfter(),
+		Price:       price,
+	}, nil
+}
+
+// BuyInsurance insures the requesting player against the next theft. If
+// "success == False", "explanation" will contain the explanation about why
+// the insurance hasn't been bought.
+func (s *Server) BuyInsurance(ctx context.Context, req *pb.BuyInsuranceRequest) (*pb.BuyInsuranceResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO: ideally, 1 and 9 have to be in game config and not be exact numbers in code.
-	if reqCellIndex < 1 || reqCellIndex > 9 {
-		err := fmt.Errorf("cell index has to be from 1 to 9, received: %d", reqCellIndex)
+	success, out, premium, err := game.buyInsurance(ctx, reqUserID)
+	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	out = s.localize(game, reqUserID, out)
+
+	return &pb.BuyInsuranceResponse{
+		Success:     success,
+		Explanation: out.getExplanation(),
+		Reason:      string(out.reason),
+		RetryAfter:  out.getRetryAfter(),
+		Premium:     premium,
+	}, nil
+}
+
+// Deposit will check if the deposit can be granted. It will return "True" for success, if
+// deposit has been granted. If "success == False", "explanation" will contain the relevant
+// explanation about why it hasn't been granted.
+// Requesting client has to make sure that provided game_id and user_id are vaild.
+func (s *Server) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqVal := req.GetValue()
+
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
 
-	success, cellValues, winPoints, err := game.playLottery(reqUserID, reqCellIndex)
+	success, out, err := game.useDeposit(ctx, reqUserID, reqVal)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	out = s.localize(game, reqUserID, out)
+	if success {
+		s.metrics.depositVolume.Add(float64(reqVal))
+	}
 
-	return s.getLotteryResponseMessage(success, cellValues, winPoints), nil
+	return s.getDepositResponseMessage(success, out), nil
 }
 
-func (s *Server) GenerateQuestion(_ context.Context, req *pb.GenerateQuestionRequest) (*pb.GenerateQuestionResponse, error) {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
+// WithdrawDeposit returns the deposit of the player before its time ends.
+func (s *Server) WithdrawDeposit(ctx context.Context, req *pb.WithdrawDepositRequest) (*pb.WithdrawDepositResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqPositionID := positionID(req.GetPositionId())
+	reqVal := req.GetValue()
+
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	val, penalty, remainingVal, err := game.withdrawDeposit(ctx, reqUserID, reqPositionID, reqVal)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
 
+	return s.getWithdrawDepositResponseMessage(val, penalty, remainingVal), nil
+}
+
+// Lottery conducts a lottery per player request.
+// Success will be false, if the user calls the lottery before it is allowed by timer,
+// and "retry_after" will contain the time left until the next lottery.
+func (s *Server) Lottery(ctx context.Context, req *pb.LotteryRequest) (*pb.LotteryResponse, error) {
 	reqGameID := gameID(req.GetGameId())
 	reqUserID := userID(req.GetUserId())
-	reqBidPoints := req.GetBidPoints()
+	reqCellIndex := req.GetCellIndex()
 
-	game, ok := s.activeGames[reqGameID]
-	if !ok {
-		err := fmt.Errorf("there is no active game with id %v", reqGameID)
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	success, out, cellValues, winPoints, jackpotWon, err := game.playLottery(ctx, reqUserID, reqCellIndex)
+	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	out = s.localize(game, reqUserID, out)
+	if success && winPoints > 0 {
+		s.metrics.lotteryWins.Inc()
+		s.metrics.lotteryWinPoints.Add(float64(winPoints))
+	}
 
-	if reqBidPoints <= 0 {
-		err := fmt.Errorf("bid points have to be more than 0, received: %d", reqBidPoints)
+	return s.getLotteryResponseMessage(success, out, cellValues, winPoints, jackpotWon), nil
+}
+
+func (s *Server) GenerateQuestion(ctx context.Context, req *pb.GenerateQuestionRequest) (*pb.GenerateQuestionResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqBidPoints := req.GetBidPoints()
+
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+
+	// question is fetched without holding the game lock,
+	// since provider may need to make network requests
+	filter := QuestionFilter{
+		Category:   req.GetCategory(),
+		Difficulty: req.GetDifficulty(),
+		Exclude:    game.getAskedQuestions(),
+		Rand:       game.getQuestionRand(),
+	}
+	if err := filter.validate(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	questionID, question, answers, err := game.doGenerateQuestion(reqUserID, reqBidPoints)
+	question, err := s.questions.Question(ctx, filter)
+	if errors.Is(err, ErrNoQuestions) && len(filter.Exclude) > 0 {
+		// all matching questions have been asked, so they can be repeated
+		game.resetAskedQuestions()
+		filter.Exclude = nil
+		question, err = s.questions.Question(ctx, filter)
+	}
+	if errors.Is(err, ErrNoQuestions) {
+		err = fmt.Errorf("no questions of category %q and difficulty %q", filter.Category, filter.Difficulty)
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to get question: %v", err)
+		return nil, status.Errorf(codes.Unavailable, err.Error())
+	}
+
+	questionID, err := game.doGenerateQuestion(reqUserID, reqBidPoints, question)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	return s.getGenerateQuestionResponseMessage(questionID, question, answers), nil
+	locale := req.GetLocale()
+	if locale == "" {
+		locale = game.getPlayerLocale(reqUserID)
+	}
+	question = question.localize(locale)
+
+	return s.getGenerateQuestionResponseMessage(questionID, question), nil
 }
 
 func (s *Server) AnswerQuestion(_ context.Context, req *pb.AnswerQuestionRequest) (*pb.AnswerQuestionResponse, error) {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
-
 	reqGameID := gameID(req.GetGameId())
 	reqUserID := userID(req.GetUserId())
 	reqQuestionID := questionID(req.GetQuestionId())
 	reqAnswer := req.GetAnswer()
 
-	game, ok := s.activeGames[reqGameID]
-	if !ok {
-		err := fmt.Errorf("there is no active game with id %v", reqGameID)
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
-	}
-
-	if reqAnswer < 1 || reqAnswer > 4 {
-		err := fmt.Errorf("user answer has to be an integer from 1 to 4, received: %d", reqAnswer)
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	game, err := s.getPlayableGame(reqGameID)
+	if err != nil {
+		return nil, err
 	}
 
 	answerIsCorrect, correctAnswer, winPoints, err := game.doAnswerQuestion(
 		reqUserID, reqQuestionID, reqAnswer,
 	)
+	if errors.Is(err, ErrQuestionExpired) {
+		return nil, newStatusError(codes.DeadlineExceeded, ReasonQuestionExpired, 0, "%v", err)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
@@ -252,105 +941,411 @@ func (s *Server) AnswerQuestion(_ context.Context, req *pb.AnswerQuestionRequest
 	return s.getAnswerQuestionResponseMessage(answerIsCorrect, correctAnswer, winPoints), nil
 }
 
-// Stream opens the server stream with the user.
-func (s *Server) Stream(req *pb.StreamRequest, srv pb.Game_StreamServer) error {
-	s.mutex.RLock()
+// GetGameState returns the snapshot of the game, which can be used by
+// the client to resync after missing some stream events.
+func (s *Server) GetGameState(_ context.Context, req *pb.GetGameStateRequest) (*pb.GetGameStateResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
 
-	var game *game = nil
+	game := s.getGame(reqGameID)
+	if game == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
+	}
+
+	res, err := s.getGameStateResponseMessage(reqUserID, game)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return res, nil
+}
+
+// SendChat sends the message of the player to the chat of the game.
+func (s *Server) SendChat(ctx context.Context, req *pb.SendChatRequest) (*pb.SendChatResponse, error) {
 	reqGameID := gameID(req.GetGameId())
 	reqUserID := userID(req.GetUserId())
 
-	if reqGameID == s.waitingGame.gameID {
-		game = s.waitingGame
-	} else if g, ok := s.activeGames[reqGameID]; ok {
-		game = g
+	game := s.getGame(reqGameID)
+	if game == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
 	}
 
-	// WARNING: pay attention to this
-	// when modifying code.
-	s.mutex.RUnlock()
+	message, err := game.sendChat(ctx, reqUserID, req.GetText(), req.GetTeamOnly())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.SendChatResponse{Message: message}, nil
+}
+
+// React broadcasts the emote of the player to the game.
+func (s *Server) React(ctx context.Context, req *pb.ReactRequest) (*pb.ReactResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game := s.getGame(reqGameID)
+	if game == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
+	}
+
+	allowed, err := game.react(ctx, reqUserID, req.GetEmote())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if !allowed {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many reactions from user %v", reqUserID)
+	}
+	return &pb.ReactResponse{}, nil
+}
+
+// Stream opens the server stream with the user.
+func (s *Server) Stream(req *pb.StreamRequest, srv pb.Game_StreamServer) error {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
 
+	game := s.getGame(reqGameID)
 	if game == nil {
 		return status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
 	}
 
-	err := game.setPlayerStream(reqUserID, srv)
+	subscriptions, err := newSubscriptions(req.GetCategories())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	stream, err := newBatchingStream(srv, req.GetBatchInterval(), req.GetBatchSize(), game.logger)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// headers are sent before the stream is shared with the game,
+	// so that HTTP clients (e.g. gRPC-Web) see the stream opened
+	// without waiting for the first event
+	if err := srv.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	err = game.setPlayerStream(reqUserID, stream, subscriptions, req.GetLastSeenSequence())
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "failed to set player stream: %v", err)
 	}
 
-	ctx := srv.Context()
+	return s.serveStream(game, reqUserID, stream)
+}
+
+// Reconnect replaces the stream of the player with provided session token.
+// Missed events or the game state are sent as the first events of the
+// new stream.
+func (s *Server) Reconnect(req *pb.ReconnectRequest, srv pb.Game_ReconnectServer) error {
+	reqGameID := gameID(req.GetGameId())
+	reqSessionToken := sessionToken(req.GetSessionToken())
+
+	game := s.getGame(reqGameID)
+	if game == nil {
+		return status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
+	}
+
+	subscriptions, err := newSubscriptions(req.GetCategories())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	stream, err := newBatchingStream(srv, req.GetBatchInterval(), req.GetBatchSize(), game.logger)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	userID, err := game.reattachPlayerStream(reqSessionToken, stream, subscriptions, req.GetLastSeenSequence())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to reconnect: %v", err)
+	}
+	game.logger.Info("Player has reconnected", zap.String("user_id", string(userID)))
+
+	return s.serveStream(game, userID, stream)
+}
+
+// serveStream sends the events queued for the stream, until the game is
+// finished and its final events are sent, the player leaves the game or
+// replaces the stream by reconnecting, or the stream context is done.
+// Heartbeats are sent meanwhile, if they are enabled. Queued and batched
+// events are sent before the stream is closed.
+func (s *Server) serveStream(game *game, userID userID, stream *batchingStream) error {
+	events, streamDone := game.getStreamQueue(userID, stream)
+	var heartbeats <-chan time.Time // never ready, if heartbeats are disabled
+	if s.heartbeatInterval > 0 {
+		ticker := s.clock.NewTicker(s.heartbeatInterval)
+		defer ticker.Stop()
+		heartbeats = ticker.C()
+	}
 	for {
-		if ctx.Err() == context.Canceled || ctx.Err() == context.DeadlineExceeded {
-			log.Printf("Stream context is cancelled for game %v\n", game.gameID)
+		select {
+		case <-stream.Context().Done():
+			game.logger.Debug("Stream context is cancelled", zap.String("user_id", string(userID)))
+			game.detachStream(userID, stream)
 			return nil
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				game.logger.Warn("Could not send event", zap.String("user_id", string(userID)), zap.Error(err))
+				game.detachStream(userID, stream)
+				return err
+			}
+		case <-game.done:
+			return drainStream(events, stream)
+		case <-streamDone:
+			return drainStream(events, stream)
+		case <-heartbeats:
+			game.sendHeartbeat(userID, stream)
 		}
+	}
+}
 
-		// stor streaming if the game is finished
-		// NOTE: "isFinished" method acquires read lock
-		if game.isFinished() {
-			return nil
+// drainStream sends the events, which are still queued for the
+// stream, and the batched ones, before the stream is closed.
+func drainStream(events <-chan *pb.StreamResponse, stream *batchingStream) error {
+	for {
+		select {
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		default:
+			return stream.Flush()
 		}
-
-		time.Sleep(1 * time.Second)
 	}
+}
 
+// getGame returns either waiting game, room, or active game with provided id.
+// If there is no such game, nil is returned.
+func (s *Server) getGame(gameID gameID) *game {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if gameID == s.waitingGame.gameID {
+		return s.waitingGame
+	}
+	if room, ok := s.rooms[gameID]; ok {
+		return room
+	}
+	if game, ok := s.activeGames[gameID]; ok {
+		return game
+	}
 	return nil
 }
 
 func (s *Server) getJoinResponseMessage(
 	userID userID, game *game,
-) *pb.JoinResponse {
+) (*pb.JoinResponse, error) {
+	authToken, err := s.newAuthToken(userID, game.gameID)
+	if err != nil {
+		err = fmt.Errorf("failed to issue auth token: %v", err)
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
 	game.mutex.RLock()
 	defer game.mutex.RUnlock()
+
+	// player may have already left the game
+	token := sessionToken("")
+	name := username("")
+	color := ""
+	if player, ok := game.players[userID]; ok {
+		token = player.sessionToken
+		name = player.username
+		color = player.color
+	}
+
 	return &pb.JoinResponse{
-		UserId:                string(userID),
-		GameId:                string(game.gameID),
-		Players:               game.getPBPlayersWithBank(),
-		Duration:              game.config.duration,
-		PlayerPoints:          game.config.playerPoints,
-		BankPointsPerPlayer:   game.config.bankPointsPerPlayer,
-		CreditInterest:        game.config.creditInterest,
-		DepositInterest:       game.config.depositInterest,
-		CreditTime:            game.config.creditTime,
-		DepositTime:           game.config.depositTime,
-		TheftTime:             game.config.theftTime,
-		TheftPercentage:       game.config.theftPercentage,
-		LotteryTime:           game.config.lotteryTime,
-		LotteryMaxWin:         game.config.lotteryMaxWin,
-		QuestionWinPercentage: game.config.questionWinPercentage,
-	}
-}
-
-func (s *Server) getCreditResponseMessage(success bool, explanation string) *pb.CreditResponse {
+		UserId:                      string(userID),
+		Username:                    string(name),
+		Color:                       color,
+		GameId:                      string(game.gameID),
+		HostId:                      string(game.hostID),
+		Players:                     game.getPBPlayersWithBank(),
+		Duration:                    game.config.duration,
+		PlayerPoints:                game.config.playerPoints,
+		BankPointsPerPlayer:         game.config.bankPointsPerPlayer,
+		CreditInterest:              game.config.creditInterest,
+		DepositInterest:             game.config.depositInterest,
+		CreditTime:                  game.config.creditTime,
+		CreditLimitPercentage:       game.config.creditLimit,
+		DepositTime:                 game.config.depositTime,
+		TheftTime:                   game.config.theftTime,
+		TheftPercentage:             game.config.theftPercentage,
+		LotteryTime:                 game.config.lotteryTime,
+		LotteryMaxWin:               game.config.lotteryMaxWin,
+		LotteryRows:                 game.config.lotteryRows,
+		LotteryColumns:              game.config.lotteryColumns,
+		LotteryPayouts:              game.config.getLotteryPayouts(),
+		JackpotPercentage:           game.config.jackpotPercentage,
+		JackpotChance:               game.config.jackpotChance,
+		DepositPenaltyPercentage:    game.config.depositPenalty,
+		InterestMode:                pb.InterestMode(game.config.interestMode),
+		InterestTicks:               game.config.interestTicks,
+		QuestionWinPercentage:       game.config.questionWinPercentage,
+		QuestionTime:                game.config.questionTime,
+		QuestionMaxBidPercentage:    game.config.questionMaxBid,
+		MarketAssets:                game.config.marketAssets,
+		MarketUnits:                 game.config.marketUnits,
+		StockPrice:                  game.config.stockPrice,
+		StockVolatilityPercentage:   game.config.stockVolatility,
+		StockTickTime:               game.config.stockTickTime,
+		InsurancePremium:            game.config.insurancePremium,
+		InsuranceCoveragePercentage: game.config.insuranceCoverage,
+		TaxTime:                     game.config.taxTime,
+		TaxBrackets:                 taxBracketsToPB(game.config.taxBrackets),
+		InflationRate:               game.config.inflationRate,
+		InflationTime:               game.config.inflationTime,
+		StealChance:                 game.config.stealChance,
+		StealPenaltyPercentage:      game.config.stealPenalty,
+		MaskThieves:                 game.config.maskThieves,
+		GuardPrice:                  game.config.guardPrice,
+		GuardTime:                   game.config.guardTime,
+		GuardProtectionPercentage:   game.config.guardProtection,
+		MinPlayers:                  game.config.minPlayers,
+		MaxPlayers:                  game.config.maxPlayers,
+		AutoStart:                   game.config.autoStart,
+		StartCountdown:              game.config.startCountdown,
+		TeamCount:                   game.config.teamCount,
+		Mode:                        pb.GameMode(game.config.mode),
+		CoopTarget:                  game.config.coopTarget,
+		Practice:                    game.config.practice,
+		WinCondition:                pb.WinCondition(game.config.winCondition),
+		WinTarget:                   game.config.winTarget,
+		TimeTickTime:                game.config.timeTickTime,
+		AfkTime:                     game.config.afkTime,
+		AfkPolicy:                   pb.AfkPolicy(game.config.afkPolicy),
+		SessionToken:                string(token),
+		AuthToken:                   authToken,
+	}, nil
+}
+
+func (s *Server) getGameStateResponseMessage(
+	userID userID, game *game,
+) (*pb.GetGameStateResponse, error) {
+	game.mutex.RLock()
+	defer game.mutex.RUnlock()
+
+	player, ok := game.players[userID]
+	if !ok {
+		return nil, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+
+	lotteryRemainingTime := int32(0)
+	theftRemainingTime := int32(0)
+	if game.state == activeState {
+		lotteryRemainingTime = player.getLotteryRemainingTime(game.config.lotteryTime, game.clock.Now())
+		theftRemainingTime = int32(math.Ceil(game.nextTheftTime.Sub(game.clock.Now()).Seconds()))
+		if theftRemainingTime < 0 {
+			theftRemainingTime = 0
+		}
+	}
+	conditions := game.getBankConditions()
+
+	return &pb.GetGameStateResponse{
+		State:                game.getPBGameState(),
+		Credits:              positionsToPB(player.credits, game.config, game.clock.Now()),
+		Deposits:             positionsToPB(player.deposits, game.config, game.clock.Now()),
+		LotteryRemainingTime: lotteryRemainingTime,
+		TheftRemainingTime:   theftRemainingTime,
+		Loans:                game.getPBLoansOfPlayer(userID),
+		Shares:               player.shares,
+		Insured:              player.insured,
+		CreditInterest:       game.bank.CreditInterest(conditions, player.creditScore),
+		DepositInterest:      game.bank.DepositInterest(conditions),
+		GuardRemainingTime:   player.getGuardRemainingTime(game.clock.Now()),
+		Chat:                 game.getPBChatHistory(userID),
+	}, nil
+}
+
+func (s *Server) getPlaceOrderResponseMessage(
+	orderID orderID, filled int32, remaining int32,
+) *pb.PlaceOrderResponse {
+	return &pb.PlaceOrderResponse{
+		OrderId:           string(orderID),
+		FilledQuantity:    filled,
+		RemainingQuantity: remaining,
+	}
+}
+
+func (s *Server) getOfferLoanResponseMessage(loanID loanID) *pb.OfferLoanResponse {
+	return &pb.OfferLoanResponse{
+		LoanId: string(loanID),
+	}
+}
+
+func (s *Server) getAcceptLoanResponseMessage(success bool, out outcome) *pb.AcceptLoanResponse {
+	return &pb.AcceptLoanResponse{
+		Success:     success,
+		Explanation: out.getExplanation(),
+		Reason:      string(out.reason),
+		RetryAfter:  out.getRetryAfter(),
+	}
+}
+
+func (s *Server) getCreditResponseMessage(
+	success bool, out outcome, limit int32, used int32,
+) *pb.CreditResponse {
 	return &pb.CreditResponse{
 		Success:     success,
-		Explanation: explanation,
+		Explanation: out.getExplanation(),
+		Reason:      string(out.reason),
+		RetryAfter:  out.getRetryAfter(),
+		CreditLimit: limit,
+		CreditUsed:  used,
+	}
+}
+
+func (s *Server) getRepayCreditResponseMessage(
+	success bool, out outcome, val int32, remainingVal int32,
+) *pb.RepayCreditResponse {
+	return &pb.RepayCreditResponse{
+		Success:        success,
+		Explanation:    out.getExplanation(),
+		Reason:         string(out.reason),
+		RetryAfter:     out.getRetryAfter(),
+		Value:          val,
+		RemainingValue: remainingVal,
 	}
 }
 
-func (s *Server) getDepositResponseMessage(success bool, explanation string) *pb.DepositResponse {
+func (s *Server) getDepositResponseMessage(success bool, out outcome) *pb.DepositResponse {
 	return &pb.DepositResponse{
 		Success:     success,
-		Explanation: explanation,
+		Explanation: out.getExplanation(),
+		Reason:      string(out.reason),
+		RetryAfter:  out.getRetryAfter(),
+	}
+}
+
+func (s *Server) getWithdrawDepositResponseMessage(
+	val int32, penalty int32, remainingVal int32,
+) *pb.WithdrawDepositResponse {
+	return &pb.WithdrawDepositResponse{
+		Value:          val,
+		Penalty:        penalty,
+		RemainingValue: remainingVal,
 	}
 }
 
-func (s *Server) getLotteryResponseMessage(success bool, cellValues []int32, winPoints int32) *pb.LotteryResponse {
+func (s *Server) getLotteryResponseMessage(
+	success bool, out outcome, cellValues []int32, winPoints int32, jackpotWon bool,
+) *pb.LotteryResponse {
 	return &pb.LotteryResponse{
-		Success:    success,
-		CellValues: cellValues,
-		WinPoints:  winPoints,
+		Success:     success,
+		CellValues:  cellValues,
+		WinPoints:   winPoints,
+		JackpotWon:  jackpotWon,
+		Explanation: out.getExplanation(),
+		Reason:      string(out.reason),
+		RetryAfter:  out.getRetryAfter(),
 	}
 }
 
 func (s *Server) getGenerateQuestionResponseMessage(
-	questionID questionID, question string, answers []string,
+	questionID questionID, question Question,
 ) *pb.GenerateQuestionResponse {
 	return &pb.GenerateQuestionResponse{
 		QuestionId: string(questionID),
-		Question:   question,
-		Answers:    answers,
+		Question:   question.Text,
+		Answers:    question.Answers,
+		Category:   question.Category,
+		Difficulty: question.Difficulty,
 	}
 }
 
@@ -365,23 +1360,159 @@ func (s *Server) getAnswerQuestionResponseMessage(
 }
 
 // Listen makes server listen for tcp connections on specified
-// server address.
+// server address. Metrics, gRPC-Web, and debug endpoints are started as well, if
+// the server has been created with WithMetrics, WithGRPCWeb, and WithDebug options.
 func (s *Server) Listen(servAddr string) (string, error) {
+	if s.configErr != nil {
+		return "", fmt.Errorf("invalid game config: %v", s.configErr)
+	}
 	listener, err := net.Listen("tcp", servAddr)
 	if err != nil {
-		log.Print("Failed to init listener:", err)
+		s.logger.Error("Failed to init listener", zap.Error(err))
 		return "", err
 	}
-	log.Print("Initialized listener:", listener.Addr().String())
+	s.logger.Info("Initialized listener", zap.String("addr", listener.Addr().String()))
+
+	if err := s.listenMetrics(); err != nil {
+		listener.Close()
+		return "", err
+	}
+	if err := s.listenGRPCWeb(); err != nil {
+		listener.Close()
+		if s.metricsServer != nil {
+			s.metricsServer.Close()
+		}
+		return "", err
+	}
+	if err := s.listenDebug(); err != nil {
+		listener.Close()
+		if s.metricsServer != nil {
+			s.metricsServer.Close()
+		}
+		if s.grpcWebListener != nil {
+			s.grpcWebListener.Close()
+		}
+		return "", err
+	}
+
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus(gameServiceName, healthpb.HealthCheckResponse_SERVING)
 
 	s.listener = listener
 	return s.listener.Addr().String(), nil
 }
 
-// Launch will register the server for Game service
-// and make it serve requests.
+// Launch will register the server for Game, Admin, and standard
+// health checking services and make it serve requests.
 func (s *Server) Launch() {
-	srv := grpc.NewServer()
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			otelgrpc.UnaryServerInterceptor(s.otelOptions()...),
+			s.observeUnary,
+			s.logUnary,
+			s.detailUnary,
+			s.authorizeAdmin,
+			s.authenticateUnary,
+			s.limitUnary,
+			s.validateUnary,
+		),
+		grpc.ChainStreamInterceptor(
+			otelgrpc.StreamServerInterceptor(s.otelOptions()...),
+			s.observeStream,
+			s.logStream,
+			s.detailStream,
+			s.authenticateStream,
+			s.limitStream,
+		),
+	}
+	if s.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
+	}
+	srv := grpc.NewServer(opts...)
 	pb.RegisterGameServer(srv, s)
+	pb.RegisterAdminServer(srv, &adminServer{server: s})
+	healthpb.RegisterHealthServer(srv, s.health)
+
+	s.mutex.Lock()
+	s.grpcServer = srv
+	s.mutex.Unlock()
+
+	s.serveGRPCWeb(srv)
 	srv.Serve(s.listener)
 }
+
+// Shutdown gracefully stops the server. New players cannot join
+// and new games cannot be started once Shutdown is called. Players of
+// the waiting game and the rooms are notified that it is finished. Active games are
+// given time to finish until the context is done, after which they are
+// finished forcefully. In both cases, streaming clients receive the final
+// Finish event before the underlying grpc server is gracefully stopped.
+// If active games had to be finished forcefully, context error is returned.
+func (s *Server) Shutdown(ctx context.Context) error {
+	// load balancers stop sending new players while games are drained
+	s.health.Shutdown()
+
+	s.mutex.Lock()
+	s.shuttingDown = true
+	lobbies := []*game{s.waitingGame}
+	for gameID, room := range s.rooms {
+		lobbies = append(lobbies, room)
+		delete(s.rooms, gameID)
+	}
+	s.mutex.Unlock()
+
+	s.logger.Info("Server is shutting down")
+	for _, lobby := range lobbies {
+		lobby.finish()
+	}
+
+	ticker := time.NewTicker(shutdownPollInterval)
+	defer ticker.Stop()
+
+	var ctxErr error
+	for ctxErr == nil && s.activeGameCount() > 0 {
+		select {
+		case <-ctx.Done():
+			ctxErr = ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	// finishing games, which have not finished on their own
+	s.mutex.Lock()
+	games := make([]*game, 0, len(s.activeGames))
+	for gameID, game := range s.activeGames {
+		games = append(games, game)
+		delete(s.activeGames, gameID)
+	}
+	srv := s.grpcServer
+	grpcWebServer := s.grpcWebServer
+	s.mutex.Unlock()
+
+	for _, game := range games {
+		game.logger.Info("Game is finished due to server shutdown")
+		game.finish()
+	}
+
+	if srv != nil {
+		// streams of finished games return on their own,
+		// so graceful stop is not blocked by them for long
+		srv.GracefulStop()
+	}
+	if grpcWebServer != nil {
+		grpcWebServer.Close()
+	}
+	if s.metricsServer != nil {
+		s.metricsServer.Close()
+	}
+	if s.debugServer != nil {
+		s.debugServer.Close()
+	}
+	return ctxErr
+}
+
+func (s *Server) activeGameCount() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return len(s.activeGames)
+}
@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatsRecord holds persisted aggregates of the player over all
+// finished games. Players are identified by their usernames.
+type StatsRecord struct {
+	Username          string
+	QuestionsAnswered int32
+	QuestionsCorrect  int32
+	CreditsTaken      int32
+	CreditsDefaulted  int32 // both bank credits and loans of other players
+	LotteryWins       int32
+	Steals            int32 // successful steals from other players
+	TimesRobbed       int32 // by thefts and by other players
+}
+
+func (r *StatsRecord) add(other StatsRecord) {
+	r.QuestionsAnswered += other.QuestionsAnswered
+	r.QuestionsCorrect += other.QuestionsCorrect
+	r.CreditsTaken += other.CreditsTaken
+	r.CreditsDefaulted += other.CreditsDefaulted
+	r.LotteryWins += other.LotteryWins
+	r.Steals += other.Steals
+	r.TimesRobbed += other.TimesRobbed
+}
+
+// statsBook caches statistics of the players and
+// saves their updates to the storage, if any.
+type statsBook struct {
+	mutex   sync.Mutex
+	storage Storage // nil, if statistics are not persisted
+	stats   map[username]StatsRecord
+	logger  *zap.Logger
+}
+
+func newStatsBook(storage Storage, logger *zap.Logger) *statsBook {
+	return &statsBook{
+		storage: storage,
+		stats:   make(map[username]StatsRecord),
+		logger:  logger,
+	}
+}
+
+// get returns statistics of the player, which are loaded from
+// the storage, if they are not cached yet.
+func (b *statsBook) get(username username) StatsRecord {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.getLocked(username)
+}
+
+// getLocked is the same as get, but
+// the calling function has to acquire the lock.
+func (b *statsBook) getLocked(username username) StatsRecord {
+	if record, ok := b.stats[username]; ok {
+		return record
+	}
+	record := StatsRecord{Username: string(username)}
+	if b.storage != nil {
+		saved, ok, err := b.storage.LoadStats(string(username))
+		if err != nil {
+			b.logger.Error("Failed to load stats", zap.String("username", string(username)), zap.Error(err))
+		} else if ok {
+			record = saved
+		}
+	}
+	b.stats[username] = record
+	return record
+}
+
+// add adds statistics of the finished game to the statistics of
+// the players. It is safe to call it on nil book, in which case
+// nothing is changed.
+func (b *statsBook) add(records []StatsRecord) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, game := range records {
+		record := b.getLocked(username(game.Username))
+		record.add(game)
+		b.stats[username(game.Username)] = record
+		if b.storage == nil {
+			continue
+		}
+		if err := b.storage.SaveStats(record); err != nil {
+			b.logger.Error("Failed to save stats", zap.String("username", game.Username), zap.Error(err))
+		}
+	}
+}
+
+// collectStats replays the journal of the game and returns
+// statistics of every player, who has ever joined it.
+func collectStats(journal []JournalEvent) (map[userID]*StatsRecord, error) {
+	stats := make(map[userID]*StatsRecord)
+	_, err := replayJournal(journal, func(g *game, event JournalEvent) error {
+		get := func(userID userID) *StatsRecord {
+			if _, ok := stats[userID]; !ok {
+				stats[userID] = &StatsRecord{}
+			}
+			return stats[userID]
+		}
+		userID := userID(event.UserID)
+
+		switch event.Kind {
+		case EventQuestionAnswer:
+			get(userID).QuestionsAnswered++
+			if event.Value > 0 {
+				get(userID).QuestionsCorrect++
+			}
+		case EventCredit:
+			get(userID).CreditsTaken++
+		case EventReturnCredit:
+			// points are taken even if the player cannot afford it
+			if player, ok := g.players[userID]; ok && player.points < 0 {
+				get(userID).CreditsDefaulted++
+			}
+		case EventLoanDefault:
+			get(userID).CreditsDefaulted++
+		case EventLottery:
+			if event.Value > 0 {
+				get(userID).LotteryWins++
+			}
+		case EventTheft:
+			get(userID).TimesRobbed++
+		case EventSteal:
+			get(userID).Steals++
+			get(event.targetID()).TimesRobbed++
+		}
+		return nil
+	})
+	return stats, err
+}
+
+// updateStats adds statistics of the game to the statistics of the
+// players, who have finished it. The calling function has to acquire
+// at least read lock.
+func (g *game) updateStats() {
+	if g.stats == nil {
+		return
+	}
+	stats, err := collectStats(g.journal)
+	if err != nil {
+		g.logger.Error("Failed to collect stats", zap.Error(err))
+		return
+	}
+
+	records := make([]StatsRecord, 0, len(g.players))
+	for userID, player := range g.players {
+		if player.bot {
+			continue
+		}
+		record := StatsRecord{}
+		if collected, ok := stats[userID]; ok {
+			record = *collected
+		}
+		record.Username = string(player.username)
+		records = append(records, record)
+	}
+	g.stats.add(records)
+}
+
+// getPBPlayerStats returns statistics of the player over all finished
+// games. Wins and winnings are known only, if the server has storage.
+func (s *Server) getPBPlayerStats(username username) (*pb.PlayerStats, error) {
+	rating := s.ratings.get(username)
+	record := s.stats.get(username)
+	stats := &pb.PlayerStats{
+		Rating:            rating.Rating,
+		Games:             rating.Games,
+		QuestionsAnswered: record.QuestionsAnswered,
+		QuestionsCorrect:  record.QuestionsCorrect,
+		CreditsTaken:      record.CreditsTaken,
+		CreditsDefaulted:  record.CreditsDefaulted,
+		LotteryWins:       record.LotteryWins,
+		Steals:            record.Steals,
+		TimesRobbed:       record.TimesRobbed,
+	}
+	if s.storage == nil {
+		return stats, nil
+	}
+
+	results, err := s.storage.LoadResults(time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	var own []ResultRecord
+	for _, result := range results {
+		if result.Username == string(username) {
+			own = append(own, result)
+		}
+	}
+	if entries := getLeaderboard(own, pb.LeaderboardOrder_LEADERBOARD_ORDER_RATING); len(entries) > 0 {
+		stats.Wins = entries[0].Wins
+		stats.TotalWinnings = entries[0].TotalWinnings
+	}
+	return stats, nil
+}
+
+// GetPlayerStats returns statistics of the player with provided username
+// over all finished games, regardless of whether the player has an account.
+func (s *Server) GetPlayerStats(_ context.Context, req *pb.GetPlayerStatsRequest) (*pb.GetPlayerStatsResponse, error) {
+	reqUsername := username(req.GetUsername())
+
+	stats, err := s.getPBPlayerStats(reqUsername)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load stats of %v: %v", reqUsername, err)
+	}
+	return &pb.GetPlayerStatsResponse{Stats: stats}, nil
+}
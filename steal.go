@@ -0,0 +1,281 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.opentelemetry.io/otel/label"
+	"go.uber.org/zap"
+)
+
+// theftReport describes the loss of the player in the theft or the steal.
+type theftReport struct {
+	userID          userID
+	thiefID         userID // empty for thefts
+	value           int32  // stolen points, or the penalty, if the thief is caught
+	caught          bool
+	insured         bool  // true, if the insurance has been used
+	insurancePayout int32 // already included in the points of the player
+	protection      int32 // percentage, by which the guard reduced the theft
+}
+
+func (r theftReport) toPBRobbedPlayer() *pb.StreamResponse_Transaction_Theft_RobbedPlayer {
+	return &pb.StreamResponse_Transaction_Theft_RobbedPlayer{
+		UserId:                    string(r.userID),
+		Value:                     r.value,
+		InsurancePayout:           r.insurancePayout,
+		Insured:                   r.insured,
+		GuardProtectionPercentage: r.protection,
+	}
+}
+
+// payInsurance pays coverage percentage of the stolen points back to
+// the player, if he is insured, and ends his insurance. Returns the
+// payout. The calling function has to acquire write lock.
+func (g *game) payInsurance(player *player, stolen int32) int32 {
+	if !player.insured {
+		return 0
+	}
+	payout := int32(0)
+	if stolen > 0 {
+		payout = getNumberProportion(stolen, g.config.insuranceCoverage)
+	}
+	g.apply(newEvent(EventInsurancePayout, player.userID, payout))
+	return payout
+}
+
+// when game calls this function on player, make sure to grab
+// READ lock on game
+// "theftTime" is the time in seconds from game config,
+// which has to pass before player can steal again
+func (p *player) getStealRemainingTime(theftTime int32, now time.Time) int32 {
+	nextStealTime := p.lastStealTime.Add(time.Duration(theftTime) * time.Second)
+	remainingTime := int32(math.Ceil(nextStealTime.Sub(now).Seconds()))
+	if remainingTime < 0 {
+		return 0
+	}
+	return remainingTime
+}
+
+// steal tries to steal theft percentage of the points of the target.
+// It succeeds with the steal chance from the config, which is reduced by
+// the guard of the target. Otherwise, the thief
+// is caught and pays penalty percentage of the points, which he tried to
+// steal, to the target. Players can steal once per theft time.
+// Returns "True" and stolen points, if the steal has succeeded. Otherwise,
+// it returns "False", the outcome, and the paid penalty.
+func (g *game) steal(ctx context.Context, thiefID userID, targetID userID) (bool, outcome, int32, int32, error) {
+	ctx, span := startSpan(ctx, "game.steal", append(g.spanAttrs(thiefID), label.String("target_id", string(targetID)))...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	thief, ok := g.players[thiefID]
+	if !ok {
+		return false, outcome{}, 0, 0, fmt.Errorf("there is no player with id %v in the game", thiefID)
+	}
+	target, ok := g.players[targetID]
+	if !ok {
+		return false, outcome{}, 0, 0, fmt.Errorf("there is no player with id %v in the game", targetID)
+	}
+	if g.config.stealChance == 0 {
+		return false, outcome{}, 0, 0, fmt.Errorf("stealing is not allowed in the game")
+	}
+	if thiefID == targetID {
+		return false, outcome{}, 0, 0, fmt.Errorf("player cannot steal from himself")
+	}
+
+	if remainingTime := thief.getStealRemainingTime(g.config.theftTime, g.clock.Now()); remainingTime > 0 {
+		out := deny(
+			ReasonStealOnCooldown, time.Duration(remainingTime)*time.Second,
+			"player can steal again in %d seconds", remainingTime,
+		)
+		return false, out, 0, 0, nil
+	}
+	if target.points <= 0 {
+		return false, deny(ReasonTargetHasNoPoints, 0, "target doesn't have any points to steal"), 0, 0, nil
+	}
+
+	amount := getNumberProportion(target.points, g.config.theftPercentage)
+	chance := getNumberProportion(g.config.stealChance, 100-g.getTheftProtection(target))
+	caught := !g.randChance(chance)
+	kind := EventSteal
+	val := amount
+	if caught {
+		kind = EventStealFailure
+		val = getNumberProportion(amount, g.config.stealPenalty)
+		// thief pays only the points, which he has
+		if val > thief.points {
+			val = thief.points
+		}
+		if val < 0 {
+			val = 0
+		}
+	}
+
+	event := newEvent(kind, thiefID, val)
+	if caught {
+		event.Value = -val
+	}
+	event.Target = string(targetID)
+	g.apply(event)
+
+	report := theftReport{
+		userID:     targetID,
+		thiefID:    thiefID,
+		value:      val,
+		caught:     caught,
+		insured:    target.insured,
+		protection: g.getTheftProtection(target),
+	}
+	if !caught {
+		report.insurancePayout = g.payInsurance(target, val)
+	}
+	g.persist()
+	span.SetAttributes(label.Bool("caught", caught))
+	g.logger.Info(
+		"Steal attempted",
+		zap.String("user_id", string(thiefID)),
+		zap.String("target_id", string(targetID)),
+		zap.Bool("caught", caught),
+		zap.Int32("value", val),
+	)
+
+	go func() {
+		msg := g.getStealMessage(report)
+		g.broadcast(msg)
+	}()
+
+	if caught {
+		return false, deny(ReasonThiefCaught, 0, "player has been caught"), 0, val, nil
+	}
+	return true, outcome{}, val, 0, nil
+}
+
+// As this function uses Readlock, it has to be spawned in a separate goroutine.
+func (g *game) getStealMessage(report theftReport) *pb.StreamResponse {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	// caught thieves are always revealed
+	thiefID := report.thiefID
+	if g.config.maskThieves && !report.caught {
+		thiefID = ""
+	}
+
+	players := g.getPBPlayersWithBank()
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Transaction_{
+			Transaction: &pb.StreamResponse_Transaction{
+				Players: players,
+				Jackpot: g.jackpot,
+				Event: &pb.StreamResponse_Transaction_Steal_{
+					Steal: &pb.StreamResponse_Transaction_Steal{
+						ThiefId:                   string(thiefID),
+						TargetId:                  string(report.userID),
+						Value:                     report.value,
+						Caught:                    report.caught,
+						Insured:                   report.insured,
+						InsurancePayout:           report.insurancePayout,
+						GuardProtectionPercentage: report.protection,
+					},
+				},
+			},
+		},
+	}
+	return res
+}
+
+// when game calls this function on player, make sure to grab
+// READ lock on game
+func (p *player) getGuardRemainingTime(now time.Time) int32 {
+	remainingTime := int32(math.Ceil(p.guardEndTime.Sub(now).Seconds()))
+	if remainingTime < 0 {
+		return 0
+	}
+	return remainingTime
+}
+
+// getTheftProtection returns percentage, by which the guard of the
+// player reduces the points stolen from him and the chance to steal
+// from him. The calling function has to acquire at least read lock.
+func (g *game) getTheftProtection(player *player) int32 {
+	if g.clock.Now().Before(player.guardEndTime) {
+		return g.config.guardProtection
+	}
+	return 0
+}
+
+// hireGuard returns "True", if the guard has been hired to protect the
+// player from thefts for the guard time. Otherwise, it will return "False"
+// and the outcome why the guard has not been hired. The paid price is
+// returned as well.
+func (g *game) hireGuard(ctx context.Context, userID userID) (bool, outcome, int32, error) {
+	ctx, span := startSpan(ctx, "game.hireGuard", g.spanAttrs(userID)...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	player, ok := g.players[userID]
+	if !ok {
+		return false, outcome{}, 0, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if g.config.guardPrice == 0 {
+		return false, outcome{}, 0, fmt.Errorf("there are no guards in the game")
+	}
+
+	price := g.config.guardPrice
+	if remainingTime := player.getGuardRemainingTime(g.clock.Now()); remainingTime > 0 {
+		out := deny(
+			ReasonAlreadyGuarded, time.Duration(remainingTime)*time.Second,
+			"player is already guarded for %d seconds", remainingTime,
+		)
+		return false, out, 0, nil
+	}
+	if player.points < price {
+		out := deny(ReasonInsufficientPoints, 0, "not enough points to hire the guard (%d required)", price)
+		return false, out, 0, nil
+	}
+
+	g.apply(newEvent(EventGuard, userID, -price))
+	g.persist()
+	span.AddEvent(ctx, "guard hired")
+
+	go func() {
+		msg := g.getGuardMessage(userID, price)
+		g.broadcast(msg)
+	}()
+
+	return true, outcome{}, price, nil
+}
+
+// As this function uses Readlock, it has to be spawned in a separate goroutine.
+func (g *game) getGuardMessage(userID userID, price int32) *pb.StreamResponse {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	players := g.getPBPlayersWithBank()
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Transaction_{
+			Transaction: &pb.StreamResponse_Transaction{
+				Players: players,
+				Jackpot: g.jackpot,
+				Event: &pb.StreamResponse_Transaction_Guard_{
+					Guard: &pb.StreamResponse_Transaction_Guard{
+						UserId: string(userID),
+						Price:  price,
+						Time:   g.config.guardTime,
+					},
+				},
+			},
+		},
+	}
+	return res
+}
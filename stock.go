@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.opentelemetry.io/otel/label"
+	"go.uber.org/zap"
+)
+
+// getNextStockPrice returns the price of the share after a step of the
+// random walk, which changes the price by up to provided percentage in
+// either direction. The price never goes below 1.
+func getNextStockPrice(r *rand.Rand, price int32, volatility int32) int32 {
+	percentage := r.Int31n(2*volatility+1) - volatility
+	next := price + int32(float64(price)*float64(percentage)/100.0)
+	if next < 1 {
+		next = 1
+	}
+	return next
+}
+
+// buyShares returns "True", if the player has bought the shares from the
+// bank at the current price. Otherwise, it will return "False" and the outcome
+// why the shares have not been bought. The price and paid points are returned.
+func (g *game) buyShares(ctx context.Context, userID userID, shares int32) (bool, outcome, int32, int32, error) {
+	ctx, span := startSpan(ctx, "game.buyShares", append(g.spanAttrs(userID), label.Int32("shares", shares))...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	player, ok := g.players[userID]
+	if !ok {
+		return false, outcome{}, 0, 0, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if g.stockPrice == 0 {
+		return false, outcome{}, 0, 0, fmt.Errorf("there is no stock in the game")
+	}
+
+	price := g.stockPrice
+	if int64(player.points) < int64(shares)*int64(price) {
+		return false, deny(ReasonInsufficientPoints, 0, "player doesn't have enough points to buy the shares"), price, 0, nil
+	}
+
+	val := shares * price
+	event := newEvent(EventBuyShares, userID, -val)
+	event.Shares = shares
+	event.Price = price
+	g.apply(event)
+	g.persist()
+	span.AddEvent(ctx, "shares bought")
+
+	go func() {
+		msg := g.getSharesMessage(userID, shares, price)
+		g.broadcast(msg)
+	}()
+
+	return true, outcome{}, price, val, nil
+}
+
+// sellShares returns "True", if the player has sold the shares to the
+// bank at the current price. Otherwise, it will return "False" and the outcome
+// why the shares have not been sold. The price and received points are returned.
+func (g *game) sellShares(ctx context.Context, userID userID, shares int32) (bool, outcome, int32, int32, error) {
+	ctx, span := startSpan(ctx, "game.sellShares", append(g.spanAttrs(userID), label.Int32("shares", shares))...)
+	defer span.End()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	span.AddEvent(ctx, "game lock acquired")
+
+	player, ok := g.players[userID]
+	if !ok {
+		return false, outcome{}, 0, 0, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if g.stockPrice == 0 {
+		return false, outcome{}, 0, 0, fmt.Errorf("there is no stock in the game")
+	}
+
+	price := g.stockPrice
+	if player.shares < shares {
+		out := deny(ReasonInsufficientShares, 0, "player has only %d shares", player.shares)
+		return false, out, price, 0, nil
+	}
+	if int64(g.bankPoints) < int64(shares)*int64(price) {
+		out := deny(ReasonBankPolicy, 0, "bank cannot buy the shares due to bank's undisclosed policies")
+		return false, out, price, 0, nil
+	}
+
+	val := shares * price
+	event := newEvent(EventSellShares, userID, val)
+	event.Shares = shares
+	event.Price = price
+	g.apply(event)
+	g.persist()
+	span.AddEvent(ctx, "shares sold")
+
+	go func() {
+		msg := g.getSharesMessage(userID, -shares, price)
+		g.broadcast(msg)
+	}()
+
+	return true, outcome{}, price, val, nil
+}
+
+// sellAllShares sells shares of all players to the bank at the current
+// price, so that they are counted in the final points of the players.
+// The calling function has to acquire write lock.
+func (g *game) sellAllShares() {
+	for userID, player := range g.players {
+		if player.shares == 0 {
+			continue
+		}
+		event := newEvent(EventSellShares, userID, player.shares*g.stockPrice)
+		event.Shares = player.shares
+		event.Price = g.stockPrice
+		g.apply(event)
+	}
+}
+
+func (g *game) scheduleStockTick() {
+	g.clock.AfterFunc(time.Duration(g.config.stockTickTime)*time.Second, func() {
+		g.doStockTick()
+	})
+}
+
+// doStockTick changes the price of the stock and broadcasts it.
+// It is repeated, until the game is finished.
+func (g *game) doStockTick() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state == finishedState || g.deferWhilePaused(g.doStockTick) {
+		return
+	}
+
+	oldPrice := g.stockPrice
+	event := newEvent(EventStockTick, "", 0)
+	event.Price = getNextStockPrice(g.rand, oldPrice, g.config.stockVolatility)
+	g.apply(event)
+	g.persist()
+	g.logger.Debug("Stock price has changed", zap.Int32("price", g.stockPrice))
+
+	price := g.stockPrice
+	go func() {
+		g.broadcast(getStockTickMessage(price, price-oldPrice))
+	}()
+
+	g.scheduleStockTick()
+}
+
+func getStockTickMessage(price int32, change int32) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_StockTick_{
+			StockTick: &pb.StreamResponse_StockTick{
+				Price:  price,
+				Change: change,
+			},
+		},
+	}
+}
+
+// As this function uses Readlock, it has to be spawned in a separate goroutine.
+func (g *game) getSharesMessage(userID userID, shares int32, price int32) *pb.StreamResponse {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	players := g.getPBPlayersWithBank()
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Transaction_{
+			Transaction: &pb.StreamResponse_Transaction{
+				Players: players,
+				Jackpot: g.jackpot,
+				Event: &pb.StreamResponse_Transaction_Shares_{
+					Shares: &pb.StreamResponse_Transaction_Shares{
+						UserId: string(userID),
+						Shares: shares,
+						Price:  price,
+					},
+				},
+			},
+		},
+	}
+	return res
+}
@@ -0,0 +1,1509 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Storage persists games, so that active games
+// can be restored after the server crashes.
+type Storage interface {
+	// SaveGame inserts the game together with its players
+	// or replaces the previously saved version of it.
+	SaveGame(game GameRecord) error
+	// AddTransaction records a single money movement in the game.
+	AddTransaction(transaction TransactionRecord) error
+	// LoadActiveGames returns all saved games in "active" state.
+	LoadActiveGames() ([]GameRecord, error)
+	// AppendEvent adds the event to the end of the game's journal.
+	AppendEvent(event JournalEvent) error
+	// LoadEvents returns the journal of the game ordered by sequence number.
+	LoadEvents(gameID string) ([]JournalEvent, error)
+	// SaveRating inserts or replaces the rating of the player.
+	SaveRating(rating RatingRecord) error
+	// LoadRating returns the rating of the player with provided
+	// username and false, if the player has no saved rating.
+	LoadRating(username string) (RatingRecord, bool, error)
+	// AddResults records final results of the players in the finished game.
+	AddResults(results []ResultRecord) error
+	// LoadResults returns results of the games,
+	// which have finished at or after provided time.
+	LoadResults(since time.Time) ([]ResultRecord, error)
+	// SaveAccount inserts or replaces the account of the player.
+	SaveAccount(account AccountRecord) error
+	// LoadAccount returns the account with provided username
+	// and false, if there is no such account.
+	LoadAccount(username string) (AccountRecord, bool, error)
+	// SaveStats inserts or replaces statistics of the player.
+	SaveStats(stats StatsRecord) error
+	// LoadStats returns statistics of the player with provided
+	// username and false, if the player has no saved statistics.
+	LoadStats(username string) (StatsRecord, bool, error)
+	// AddAchievement records the achievement unlocked by the player.
+	AddAchievement(achievement AchievementRecord) error
+	// LoadAchievements returns all achievements unlocked by the player.
+	LoadAchievements(username string) ([]AchievementRecord, error)
+	// SaveChallenge inserts or replaces progress of the player in the challenge.
+	SaveChallenge(challenge ChallengeRecord) error
+	// LoadChallenges returns progress of the player in all challenges.
+	LoadChallenges(username string) ([]ChallengeRecord, error)
+	// AddHistory records the summary of the finished game.
+	AddHistory(history HistoryRecord) error
+	// LoadHistory returns summaries of the games, which pass the filter,
+	// ordered from the newest to the oldest.
+	LoadHistory(filter HistoryFilter) ([]HistoryRecord, error)
+}
+
+// Values of GameRecord.State.
+const (
+	GameRecordActive   = "active"
+	GameRecordFinished = "finished"
+)
+
+// GameRecord is a persisted snapshot of the game.
+type GameRecord struct {
+	GameID        string
+	Name          string
+	State         string
+	Config        GameConfig
+	BankPoints    int32
+	Jackpot       int32
+	StockPrice    int32
+	PriceLevel    int32
+	StartTime     time.Time
+	NextTheftTime time.Time
+	// seed of the random numbers and the number of drawn ones
+	Seed      int64
+	RandDraws int64
+	Players   []PlayerRecord
+	Loans     []LoanRecord
+}
+
+// PlayerRecord is a persisted snapshot of the player in the game.
+type PlayerRecord struct {
+	UserID          string
+	Username        string
+	SessionToken    string
+	Points          int32
+	CreditScore     int32
+	LastLotteryTime time.Time
+	LastStealTime   time.Time
+	GuardEndTime    time.Time
+	Credits         []PositionRecord
+	Deposits        []PositionRecord
+	Assets          map[string]int32 // units of the market assets
+	Shares          int32
+	Insured         bool
+}
+
+// PositionRecord is a persisted outstanding credit or deposit.
+type PositionRecord struct {
+	PositionID string
+	Value      int32
+	Interest   int32
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// Values of LoanRecord.State.
+const (
+	LoanRecordOffered     = "offered"
+	LoanRecordActive      = "active"
+	LoanRecordCollections = "collections"
+)
+
+// LoanRecord is a persisted loan between two players, which is not repaid yet.
+type LoanRecord struct {
+	LoanID  string
+	Terms   LoanTerms
+	State   string
+	Due     int32
+	EndTime time.Time
+}
+
+// TransactionRecord is a persisted money movement between
+// the player and the bank.
+type TransactionRecord struct {
+	GameID string
+	UserID string
+	Kind   string
+	// positive value means that points moved from the bank (or from
+	// the other player for loans) to the player, negative value means
+	// the opposite
+	Value int32
+	Time  time.Time
+}
+
+// Kinds of transactions.
+const (
+	TransactionCredit          = "credit"
+	TransactionDeposit         = "deposit"
+	TransactionReturnCredit    = "return_credit"
+	TransactionRepayCredit     = "repay_credit"
+	TransactionReturnDeposit   = "return_deposit"
+	TransactionWithdrawDeposit = "withdraw_deposit"
+	TransactionLottery         = "lottery"
+	TransactionJackpot         = "jackpot"
+	TransactionQuestionBid     = "question_bid"
+	TransactionQuestionWin     = "question_win"
+	TransactionTheft           = "theft"
+	TransactionEvict           = "evict"
+	TransactionLoanAccept      = "loan_accept"
+	TransactionLoanRepay       = "loan_repay"
+	TransactionLoanDefault     = "loan_default"
+	TransactionLoanCollect     = "loan_collect"
+	TransactionTrade           = "trade"
+	TransactionBuyShares       = "buy_shares"
+	TransactionSellShares      = "sell_shares"
+	TransactionInsurance       = "insurance"
+	TransactionInsurancePayout = "insurance_payout"
+	TransactionTax             = "tax"
+	TransactionSteal           = "steal"
+	TransactionStealFailure    = "steal_failure"
+	TransactionGuard           = "guard"
+)
+
+// MarshalJSON encodes the config in the same format, which is used
+// for GameConfig message of the protocol.
+func (c GameConfig) MarshalJSON() ([]byte, error) {
+	return protojson.Marshal(c.toPBGameConfig())
+}
+
+// UnmarshalJSON decodes the config encoded by MarshalJSON.
+func (c *GameConfig) UnmarshalJSON(data []byte) error {
+	pbConfig := &pb.GameConfig{}
+	if err := protojson.Unmarshal(data, pbConfig); err != nil {
+		return err
+	}
+	// configs saved before the lottery grid and the credit
+	// limit were configurable have the default values
+	*c = GameConfig{
+		creditLimit:    defaultCreditLimit,
+		lotteryRows:    defaultLotteryRows,
+		lotteryColumns: defaultLotteryColumns,
+	}.withPBOverrides(pbConfig)
+	return nil
+}
+
+// MemoryStorage keeps records in memory. It does not survive
+// restarts of the process, so it is mostly useful for testing.
+type MemoryStorage struct {
+	mutex        sync.Mutex
+	games        map[string]GameRecord
+	transactions []TransactionRecord
+	events       map[string][]JournalEvent
+	ratings      map[string]RatingRecord
+	results      []ResultRecord
+	accounts     map[string]AccountRecord
+	stats        map[string]StatsRecord
+	achievements []AchievementRecord
+	challenges   map[challengeKey]ChallengeRecord
+	history      []HistoryRecord
+}
+
+// challengeKey identifies progress of the player in the challenge.
+type challengeKey struct {
+	username    string
+	day         string
+	challengeID string
+}
+
+// NewMemoryStorage returns a new empty instance of MemoryStorage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		games:      make(map[string]GameRecord),
+		events:     make(map[string][]JournalEvent),
+		ratings:    make(map[string]RatingRecord),
+		accounts:   make(map[string]AccountRecord),
+		stats:      make(map[string]StatsRecord),
+		challenges: make(map[challengeKey]ChallengeRecord),
+	}
+}
+
+func (m *MemoryStorage) SaveGame(game GameRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.games[game.GameID] = game
+	return nil
+}
+
+func (m *MemoryStorage) AddTransaction(transaction TransactionRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.transactions = append(m.transactions, transaction)
+	return nil
+}
+
+func (m *MemoryStorage) LoadActiveGames() ([]GameRecord, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var games []GameRecord
+	for _, game := range m.games {
+		if game.State == GameRecordActive {
+			games = append(games, game)
+		}
+	}
+	return games, nil
+}
+
+func (m *MemoryStorage) AppendEvent(event JournalEvent) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	events := m.events[event.GameID]
+	if event.Sequence != int64(len(events))+1 {
+		return fmt.Errorf(
+			"event %d of game %v doesn't follow the last saved event %d",
+			event.Sequence, event.GameID, len(events),
+		)
+	}
+	m.events[event.GameID] = append(events, event)
+	return nil
+}
+
+func (m *MemoryStorage) LoadEvents(gameID string) ([]JournalEvent, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	events := make([]JournalEvent, len(m.events[gameID]))
+	copy(events, m.events[gameID])
+	return events, nil
+}
+
+func (m *MemoryStorage) SaveRating(rating RatingRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.ratings[rating.Username] = rating
+	return nil
+}
+
+func (m *MemoryStorage) LoadRating(username string) (RatingRecord, bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	rating, ok := m.ratings[username]
+	return rating, ok, nil
+}
+
+func (m *MemoryStorage) AddResults(results []ResultRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.results = append(m.results, results...)
+	return nil
+}
+
+func (m *MemoryStorage) LoadResults(since time.Time) ([]ResultRecord, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var results []ResultRecord
+	for _, result := range m.results {
+		if !result.Time.Before(since) {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+func (m *MemoryStorage) SaveAccount(account AccountRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.accounts[account.Username] = account
+	return nil
+}
+
+func (m *MemoryStorage) LoadAccount(username string) (AccountRecord, bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	account, ok := m.accounts[username]
+	return account, ok, nil
+}
+
+func (m *MemoryStorage) SaveStats(stats StatsRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.stats[stats.Username] = stats
+	return nil
+}
+
+func (m *MemoryStorage) LoadStats(username string) (StatsRecord, bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	stats, ok := m.stats[username]
+	return stats, ok, nil
+}
+
+func (m *MemoryStorage) AddAchievement(achievement AchievementRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.achievements = append(m.achievements, achievement)
+	return nil
+}
+
+func (m *MemoryStorage) LoadAchievements(username string) ([]AchievementRecord, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var achievements []AchievementRecord
+	for _, achievement := range m.achievements {
+		if achievement.Username == username {
+			achievements = append(achievements, achievement)
+		}
+	}
+	return achievements, nil
+}
+
+func (m *MemoryStorage) SaveChallenge(challenge ChallengeRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.challenges[challengeKey{challenge.Username, challenge.Day, challenge.ChallengeID}] = challenge
+	return nil
+}
+
+func (m *MemoryStorage) LoadChallenges(username string) ([]ChallengeRecord, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var challenges []ChallengeRecord
+	for key, challenge := range m.challenges {
+		if key.username == username {
+			challenges = append(challenges, challenge)
+		}
+	}
+	return challenges, nil
+}
+
+func (m *MemoryStorage) AddHistory(history HistoryRecord) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.history = append(m.history, history)
+	return nil
+}
+
+func (m *MemoryStorage) LoadHistory(filter HistoryFilter) ([]HistoryRecord, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	// games are added in the order, in which they finish
+	var history []HistoryRecord
+	skipped := 0
+	for i := len(m.history) - 1; i >= 0 && len(history) < filter.Limit; i-- {
+		if !filter.matches(m.history[i]) {
+			continue
+		}
+		if skipped < filter.Offset {
+			skipped++
+			continue
+		}
+		history = append(history, m.history[i])
+	}
+	return history, nil
+}
+
+// Transactions returns all recorded transactions of the game.
+func (m *MemoryStorage) Transactions(gameID string) []TransactionRecord {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var transactions []TransactionRecord
+	for _, transaction := range m.transactions {
+		if transaction.GameID == gameID {
+			transactions = append(transactions, transaction)
+		}
+	}
+	return transactions
+}
+
+// SQLStorage keeps records in SQL database. Queries are written for
+// PostgreSQL, but they are also understood by SQLite (3.24 or newer).
+// The caller is responsible for importing the database driver.
+type SQLStorage struct {
+	db *sql.DB
+}
+
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS games (
+		game_id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		state TEXT NOT NULL,
+		config TEXT NOT NULL,
+		bank_points INTEGER NOT NULL,
+		jackpot INTEGER NOT NULL DEFAULT 0,
+		stock_price INTEGER NOT NULL DEFAULT 0,
+		price_level INTEGER NOT NULL DEFAULT 100,
+		start_time BIGINT NOT NULL,
+		next_theft_time BIGINT NOT NULL,
+		seed BIGINT NOT NULL DEFAULT 0,
+		rand_draws BIGINT NOT NULL DEFAULT 0
+	)`,
+	`CREATE TABLE IF NOT EXISTS players (
+		game_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		username TEXT NOT NULL,
+		session_token TEXT NOT NULL,
+		points INTEGER NOT NULL,
+		credit_score INTEGER NOT NULL DEFAULT 50,
+		last_lottery_time BIGINT NOT NULL,
+		last_steal_time BIGINT NOT NULL DEFAULT 0,
+		guard_end_time BIGINT NOT NULL DEFAULT 0,
+		assets TEXT NOT NULL DEFAULT '{}',
+		shares INTEGER NOT NULL DEFAULT 0,
+		insured BOOLEAN NOT NULL DEFAULT FALSE,
+		PRIMARY KEY (game_id, user_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS positions (
+		game_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		position_id TEXT PRIMARY KEY,
+		kind TEXT NOT NULL,
+		value INTEGER NOT NULL,
+		interest INTEGER NOT NULL DEFAULT 0,
+		start_time BIGINT NOT NULL,
+		end_time BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS transactions (
+		game_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		kind TEXT NOT NULL,
+		value INTEGER NOT NULL,
+		time BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS events (
+		game_id TEXT NOT NULL,
+		sequence BIGINT NOT NULL,
+		time BIGINT NOT NULL,
+		kind TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		value INTEGER NOT NULL,
+		ref_id TEXT NOT NULL,
+		principal INTEGER NOT NULL DEFAULT 0,
+		shares INTEGER NOT NULL DEFAULT 0,
+		price INTEGER NOT NULL DEFAULT 0,
+		interest INTEGER NOT NULL DEFAULT 0,
+		target TEXT NOT NULL DEFAULT '',
+		username TEXT NOT NULL,
+		session_token TEXT NOT NULL,
+		config TEXT,
+		loan TEXT,
+		trade TEXT,
+		team INTEGER NOT NULL DEFAULT 0,
+		bot BOOLEAN NOT NULL DEFAULT FALSE,
+		seed BIGINT NOT NULL DEFAULT 0,
+		avatar_id TEXT NOT NULL DEFAULT '',
+		color TEXT NOT NULL DEFAULT '',
+		auto BOOLEAN NOT NULL DEFAULT FALSE,
+		PRIMARY KEY (game_id, sequence)
+	)`,
+	`CREATE TABLE IF NOT EXISTS loans (
+		game_id TEXT NOT NULL,
+		loan_id TEXT PRIMARY KEY,
+		lender_id TEXT NOT NULL,
+		borrower_id TEXT NOT NULL,
+		value INTEGER NOT NULL,
+		interest INTEGER NOT NULL,
+		time INTEGER NOT NULL,
+		state TEXT NOT NULL,
+		due INTEGER NOT NULL,
+		end_time BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS ratings (
+		username TEXT PRIMARY KEY,
+		rating INTEGER NOT NULL,
+		games INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS results (
+		game_id TEXT NOT NULL,
+		username TEXT NOT NULL,
+		winnings INTEGER NOT NULL,
+		won BOOLEAN NOT NULL,
+		rating INTEGER NOT NULL,
+		time BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS accounts (
+		username TEXT PRIMARY KEY,
+		password_hash TEXT NOT NULL,
+		password_salt TEXT NOT NULL,
+		display_name TEXT NOT NULL,
+		avatar TEXT NOT NULL,
+		created_time BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS player_stats (
+		username TEXT PRIMARY KEY,
+		questions_answered INTEGER NOT NULL,
+		questions_correct INTEGER NOT NULL,
+		credits_taken INTEGER NOT NULL,
+		credits_defaulted INTEGER NOT NULL,
+		lottery_wins INTEGER NOT NULL,
+		steals INTEGER NOT NULL,
+		times_robbed INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS achievements (
+		username TEXT NOT NULL,
+		achievement_id TEXT NOT NULL,
+		time BIGINT NOT NULL,
+		PRIMARY KEY (username, achievement_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS challenges (
+		username TEXT NOT NULL,
+		day TEXT NOT NULL,
+		challenge_id TEXT NOT NULL,
+		progress INTEGER NOT NULL,
+		PRIMARY KEY (username, day, challenge_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS history (
+		game_id TEXT PRIMARY KEY,
+		finish_time BIGINT NOT NULL,
+		summary TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS history_players (
+		game_id TEXT NOT NULL,
+		username TEXT NOT NULL,
+		PRIMARY KEY (game_id, username)
+	)`,
+}
+
+// kinds of positions in the positions table
+const (
+	sqlCreditPosition  = "credit"
+	sqlDepositPosition = "deposit"
+)
+
+// NewSQLStorage creates the tables, if they do not exist yet,
+// and returns the storage using provided database.
+func NewSQLStorage(db *sql.DB) (*SQLStorage, error) {
+	for _, query := range sqlSchema {
+		if _, err := db.Exec(query); err != nil {
+			return nil, fmt.Errorf("failed to create tables: %v", err)
+		}
+	}
+	return &SQLStorage{db: db}, nil
+}
+
+func (s *SQLStorage) SaveGame(game GameRecord) error {
+	config, err := json.Marshal(game.Config)
+	if err != nil {
+		return fmt.Errorf("failed to encode game config: %v", err)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(
+		`INSERT INTO games (
+			game_id, name, state, config, bank_points, jackpot, stock_price, price_level, start_time, next_theft_time,
+			seed, rand_draws
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (game_id) DO UPDATE SET
+			name = excluded.name,
+			state = excluded.state,
+			config = excluded.config,
+			bank_points = excluded.bank_points,
+			jackpot = excluded.jackpot,
+			stock_price = excluded.stock_price,
+			price_level = excluded.price_level,
+			start_time = excluded.start_time,
+			next_theft_time = excluded.next_theft_time,
+			seed = excluded.seed,
+			rand_draws = excluded.rand_draws`,
+		game.GameID, game.Name, game.State, string(config), game.BankPoints, game.Jackpot, game.StockPrice,
+		game.PriceLevel, game.StartTime.UnixNano(), game.NextTheftTime.UnixNano(), game.Seed, game.RandDraws,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save game %v: %v", game.GameID, err)
+	}
+
+	// players and positions are replaced as a whole
+	if _, err := tx.Exec(`DELETE FROM players WHERE game_id = $1`, game.GameID); err != nil {
+		return fmt.Errorf("failed to delete players of game %v: %v", game.GameID, err)
+	}
+	if _, err := tx.Exec(`DELETE FROM positions WHERE game_id = $1`, game.GameID); err != nil {
+		return fmt.Errorf("failed to delete positions of game %v: %v", game.GameID, err)
+	}
+	if _, err := tx.Exec(`DELETE FROM loans WHERE game_id = $1`, game.GameID); err != nil {
+		return fmt.Errorf("failed to delete loans of game %v: %v", game.GameID, err)
+	}
+
+	for _, player := range game.Players {
+		assets, err := json.Marshal(player.Assets)
+		if err != nil {
+			return fmt.Errorf("failed to encode assets of player %v: %v", player.UserID, err)
+		}
+		_, err = tx.Exec(
+			`INSERT INTO players (game_id, user_id, username, session_token, points, credit_score, last_lottery_time, assets, shares, insured,
+				last_steal_time, guard_end_time)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+			game.GameID, player.UserID, player.Username, player.SessionToken,
+			player.Points, player.CreditScore, player.LastLotteryTime.UnixNano(), string(assets), player.Shares,
+			player.Insured, player.LastStealTime.UnixNano(), player.GuardEndTime.UnixNano(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save player %v: %v", player.UserID, err)
+		}
+		if err := s.insertPositions(tx, game.GameID, player.UserID, sqlCreditPosition, player.Credits); err != nil {
+			return err
+		}
+		if err := s.insertPositions(tx, game.GameID, player.UserID, sqlDepositPosition, player.Deposits); err != nil {
+			return err
+		}
+	}
+
+	for _, loan := range game.Loans {
+		_, err := tx.Exec(
+			`INSERT INTO loans (game_id, loan_id, lender_id, borrower_id, value, interest, time, state, due, end_time)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			game.GameID, loan.LoanID, loan.Terms.Lender, loan.Terms.Borrower, loan.Terms.Value,
+			loan.Terms.Interest, loan.Terms.Time, loan.State, loan.Due, loan.EndTime.UnixNano(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save loan %v: %v", loan.LoanID, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *SQLStorage) insertPositions(
+	tx *sql.Tx, gameID string, userID string, kind string, positions []PositionRecord,
+) error {
+	for _, pos := range positions {
+		_, err := tx.Exec(
+			`INSERT INTO positions (game_id, user_id, position_id, kind, value, interest, start_time, end_time)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			gameID, userID, pos.PositionID, kind, pos.Value, pos.Interest,
+			pos.StartTime.UnixNano(), pos.EndTime.UnixNano(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save %s %v: %v", kind, pos.PositionID, err)
+		}
+	}
+	return nil
+}
+
+func (s *SQLStorage) AddTransaction(transaction TransactionRecord) error {
+	_, err := s.db.Exec(
+		`INSERT INTO transactions (game_id, user_id, kind, value, time)
+		VALUES ($1, $2, $3, $4, $5)`,
+		transaction.GameID, transaction.UserID, transaction.Kind,
+		transaction.Value, transaction.Time.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save transaction: %v", err)
+	}
+	return nil
+}
+
+func (s *SQLStorage) SaveRating(rating RatingRecord) error {
+	_, err := s.db.Exec(
+		`INSERT INTO ratings (username, rating, games) VALUES ($1, $2, $3)
+		ON CONFLICT (username) DO UPDATE SET rating = EXCLUDED.rating, games = EXCLUDED.games`,
+		rating.Username, rating.Rating, rating.Games,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save rating: %v", err)
+	}
+	return nil
+}
+
+func (s *SQLStorage) LoadRating(username string) (RatingRecord, bool, error) {
+	rating := RatingRecord{Username: username}
+	err := s.db.QueryRow(
+		`SELECT rating, games FROM ratings WHERE username = $1`,
+		username,
+	).Scan(&rating.Rating, &rating.Games)
+	if err == sql.ErrNoRows {
+		return RatingRecord{}, false, nil
+	}
+	if err != nil {
+		return RatingRecord{}, false, fmt.Errorf("failed to load rating: %v", err)
+	}
+	return rating, true, nil
+}
+
+func (s *SQLStorage) SaveAccount(account AccountRecord) error {
+	_, err := s.db.Exec(
+		`INSERT INTO accounts (username, password_hash, password_salt, display_name, avatar, created_time)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (username) DO UPDATE SET password_hash = EXCLUDED.password_hash,
+			password_salt = EXCLUDED.password_salt, display_name = EXCLUDED.display_name,
+			avatar = EXCLUDED.avatar`,
+		account.Username, account.PasswordHash, account.PasswordSalt,
+		account.DisplayName, account.Avatar, account.CreatedTime.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save account: %v", err)
+	}
+	return nil
+}
+
+func (s *SQLStorage) LoadAccount(username string) (AccountRecord, bool, error) {
+	account := AccountRecord{Username: username}
+	var createdTime int64
+	err := s.db.QueryRow(
+		`SELECT password_hash, password_salt, display_name, avatar, created_time
+		FROM accounts WHERE username = $1`,
+		username,
+	).Scan(&account.PasswordHash, &account.PasswordSalt, &account.DisplayName, &account.Avatar, &createdTime)
+	if err == sql.ErrNoRows {
+		return AccountRecord{}, false, nil
+	}
+	if err != nil {
+		return AccountRecord{}, false, fmt.Errorf("failed to load account: %v", err)
+	}
+	account.CreatedTime = time.Unix(0, createdTime)
+	return account, true, nil
+}
+
+func (s *SQLStorage) SaveStats(stats StatsRecord) error {
+	_, err := s.db.Exec(
+		`INSERT INTO player_stats (username, questions_answered, questions_correct, credits_taken,
+			credits_defaulted, lottery_wins, steals, times_robbed)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (username) DO UPDATE SET questions_answered = EXCLUDED.questions_answered,
+			questions_correct = EXCLUDED.questions_correct, credits_taken = EXCLUDED.credits_taken,
+			credits_defaulted = EXCLUDED.credits_defaulted, lottery_wins = EXCLUDED.lottery_wins,
+			steals = EXCLUDED.steals, times_robbed = EXCLUDED.times_robbed`,
+		stats.Username, stats.QuestionsAnswered, stats.QuestionsCorrect, stats.CreditsTaken,
+		stats.CreditsDefaulted, stats.LotteryWins, stats.Steals, stats.TimesRobbed,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save stats: %v", err)
+	}
+	return nil
+}
+
+func (s *SQLStorage) LoadStats(username string) (StatsRecord, bool, error) {
+	stats := StatsRecord{Username: username}
+	err := s.db.QueryRow(
+		`SELECT questions_answered, questions_correct, credits_taken, credits_defaulted,
+			lottery_wins, steals, times_robbed
+		FROM player_stats WHERE username = $1`,
+		username,
+	).Scan(
+		&stats.QuestionsAnswered, &stats.QuestionsCorrect, &stats.CreditsTaken,
+		&stats.CreditsDefaulted, &stats.LotteryWins, &stats.Steals, &stats.TimesRobbed,
+	)
+	if err == sql.ErrNoRows {
+		return StatsRecord{}, false, nil
+	}
+	if err != nil {
+		return StatsRecord{}, false, fmt.Errorf("failed to load stats: %v", err)
+	}
+	return stats, true, nil
+}
+
+func (s *SQLStorage) AddAchievement(achievement AchievementRecord) error {
+	_, err := s.db.Exec(
+		`INSERT INTO achievements (username, achievement_id, time) VALUES ($1, $2, $3)
+		ON CONFLICT (username, achievement_id) DO NOTHING`,
+		achievement.Username, achievement.AchievementID, achievement.Time.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save achievement: %v", err)
+	}
+	return nil
+}
+
+func (s *SQLStorage) LoadAchievements(username string) ([]AchievementRecord, error) {
+	rows, err := s.db.Query(
+		`SELECT achievement_id, time FROM achievements WHERE username = $1 ORDER BY time`,
+		username,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load achievements: %v", err)
+	}
+	defer rows.Close()
+
+	var achievements []AchievementRecord
+	for rows.Next() {
+		achievement := AchievementRecord{Username: username}
+		var unlockTime int64
+		if err := rows.Scan(&achievement.AchievementID, &unlockTime); err != nil {
+			return nil, fmt.Errorf("failed to load achievement: %v", err)
+		}
+		achievement.Time = time.Unix(0, unlockTime)
+		achievements = append(achievements, achievement)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load achievements: %v", err)
+	}
+	return achievements, nil
+}
+
+func (s *SQLStorage) SaveChallenge(challenge ChallengeRecord) error {
+	_, err := s.db.Exec(
+		`INSERT INTO challenges (username, day, challenge_id, progress) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (username, day, challenge_id) DO UPDATE SET progress = EXCLUDED.progress`,
+		challenge.Username, challenge.Day, challenge.ChallengeID, challenge.Progress,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save challenge: %v", err)
+	}
+	return nil
+}
+
+func (s *SQLStorage) LoadChallenges(username string) ([]ChallengeRecord, error) {
+	rows, err := s.db.Query(
+		`SELECT day, challenge_id, progress FROM challenges WHERE username = $1`,
+		username,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load challenges: %v", err)
+	}
+	defer rows.Close()
+
+	var challenges []ChallengeRecord
+	for rows.Next() {
+		challenge := ChallengeRecord{Username: username}
+		if err := rows.Scan(&challenge.Day, &challenge.ChallengeID, &challenge.Progress); err != nil {
+			return nil, fmt.Errorf("failed to load challenge: %v", err)
+		}
+		challenges = append(challenges, challenge)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load challenges: %v", err)
+	}
+	return challenges, nil
+}
+
+func (s *SQLStorage) AddResults(results []ResultRecord) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	for _, result := range results {
+		_, err := tx.Exec(
+			`INSERT INTO results (game_id, username, winnings, won, rating, time)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			result.GameID, result.Username, result.Winnings,
+			result.Won, result.Rating, result.Time.UnixNano(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save result of player %v: %v", result.Username, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *SQLStorage) LoadResults(since time.Time) ([]ResultRecord, error) {
+	rows, err := s.db.Query(
+		`SELECT game_id, username, winnings, won, rating, time
+		FROM results WHERE time >= $1 ORDER BY time`,
+		since.UnixNano(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load results: %v", err)
+	}
+	defer rows.Close()
+
+	var results []ResultRecord
+	for rows.Next() {
+		var result ResultRecord
+		var resultTime int64
+		err := rows.Scan(
+			&result.GameID, &result.Username, &result.Winnings,
+			&result.Won, &result.Rating, &resultTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load result: %v", err)
+		}
+		result.Time = time.Unix(0, resultTime)
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load results: %v", err)
+	}
+	return results, nil
+}
+
+func (s *SQLStorage) AddHistory(history HistoryRecord) error {
+	summary, err := protojson.Marshal(history.Summary)
+	if err != nil {
+		return fmt.Errorf("failed to encode game summary: %v", err)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(
+		`INSERT INTO history (game_id, finish_time, summary) VALUES ($1, $2, $3)`,
+		history.GameID, history.FinishTime.UnixNano(), string(summary),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save history of game %v: %v", history.GameID, err)
+	}
+	for _, username := range history.getUsernames() {
+		_, err := tx.Exec(
+			`INSERT INTO history_players (game_id, username) VALUES ($1, $2)`,
+			history.GameID, username,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save history of player %v: %v", username, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *SQLStorage) LoadHistory(filter HistoryFilter) ([]HistoryRecord, error) {
+	since := int64(0)
+	if !filter.Since.IsZero() {
+		since = filter.Since.UnixNano()
+	}
+	until := int64(math.MaxInt64)
+	if !filter.Until.IsZero() {
+		until = filter.Until.UnixNano()
+	}
+	rows, err := s.db.Query(
+		`SELECT history.game_id, history.finish_time, history.summary
+		FROM history JOIN history_players ON history_players.game_id = history.game_id
+		WHERE history_players.username = $1 AND history.finish_time >= $2 AND history.finish_time < $3
+		ORDER BY history.finish_time DESC, history.game_id LIMIT $4 OFFSET $5`,
+		filter.Username, since, until, filter.Limit, filter.Offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load history: %v", err)
+	}
+	defer rows.Close()
+
+	var history []HistoryRecord
+	for rows.Next() {
+		var record HistoryRecord
+		var finishTime int64
+		var summary string
+		if err := rows.Scan(&record.GameID, &finishTime, &summary); err != nil {
+			return nil, fmt.Errorf("failed to load history: %v", err)
+		}
+		record.FinishTime = time.Unix(0, finishTime)
+		record.Summary = &pb.GameSummary{}
+		if err := protojson.Unmarshal([]byte(summary), record.Summary); err != nil {
+			return nil, fmt.Errorf("failed to decode summary of game %v: %v", record.GameID, err)
+		}
+		history = append(history, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load history: %v", err)
+	}
+	return history, nil
+}
+
+func (s *SQLStorage) AppendEvent(event JournalEvent) error {
+	var config sql.NullString
+	if event.Config != nil {
+		data, err := json.Marshal(event.Config)
+		if err != nil {
+			return fmt.Errorf("failed to encode game config: %v", err)
+		}
+		config = sql.NullString{String: string(data), Valid: true}
+	}
+	var loan sql.NullString
+	if event.Loan != nil {
+		data, err := json.Marshal(event.Loan)
+		if err != nil {
+			return fmt.Errorf("failed to encode loan terms: %v", err)
+		}
+		loan = sql.NullString{String: string(data), Valid: true}
+	}
+	var trade sql.NullString
+	if event.Trade != nil {
+		data, err := json.Marshal(event.Trade)
+		if err != nil {
+			return fmt.Errorf("failed to encode trade: %v", err)
+		}
+		trade = sql.NullString{String: string(data), Valid: true}
+	}
+
+	_, err := s.db.Exec(
+		`INSERT INTO events (game_id, sequence, time, kind, user_id, value, ref_id, principal, shares, price,
+			interest, target, username, session_token, config, loan, trade, team, bot, seed, avatar_id, color, auto)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)`,
+		event.GameID, event.Sequence, event.Time.UnixNano(), event.Kind, event.UserID, event.Value, event.RefID,
+		event.Principal, event.Shares, event.Price, event.Interest, event.Target, event.Username, event.SessionToken, config, loan, trade,
+		event.Team, event.Bot, event.Seed, event.AvatarID, event.Color, event.Auto,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save event %d of game %v: %v", event.Sequence, event.GameID, err)
+	}
+	return nil
+}
+
+func (s *SQLStorage) LoadEvents(gameID string) ([]JournalEvent, error) {
+	rows, err := s.db.Query(
+		`SELECT sequence, time, kind, user_id, value, ref_id, principal, shares, price,
+			interest, target, username, session_token, config, loan, trade, team, bot, seed, avatar_id, color, auto
+		FROM events WHERE game_id = $1 ORDER BY sequence`,
+		gameID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load events of game %v: %v", gameID, err)
+	}
+	defer rows.Close()
+
+	var events []JournalEvent
+	for rows.Next() {
+		event := JournalEvent{GameID: gameID}
+		var eventTime int64
+		var config, loan, trade sql.NullString
+		err := rows.Scan(
+			&event.Sequence, &eventTime, &event.Kind, &event.UserID, &event.Value,
+			&event.RefID, &event.Principal, &event.Shares, &event.Price,
+			&event.Interest, &event.Target, &event.Username, &event.SessionToken, &config, &loan, &trade,
+			&event.Team, &event.Bot, &event.Seed, &event.AvatarID, &event.Color, &event.Auto,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load event: %v", err)
+		}
+		event.Time = time.Unix(0, eventTime)
+		if config.Valid {
+			event.Config = &GameConfig{}
+			if err := json.Unmarshal([]byte(config.String), event.Config); err != nil {
+				return nil, fmt.Errorf("failed to decode config of event %d: %v", event.Sequence, err)
+			}
+		}
+		if loan.Valid {
+			event.Loan = &LoanTerms{}
+			if err := json.Unmarshal([]byte(loan.String), event.Loan); err != nil {
+				return nil, fmt.Errorf("failed to decode loan terms of event %d: %v", event.Sequence, err)
+			}
+		}
+		if trade.Valid {
+			event.Trade = &Trade{}
+			if err := json.Unmarshal([]byte(trade.String), event.Trade); err != nil {
+				return nil, fmt.Errorf("failed to decode trade of event %d: %v", event.Sequence, err)
+			}
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load events of game %v: %v", gameID, err)
+	}
+	return events, nil
+}
+
+func (s *SQLStorage) LoadActiveGames() ([]GameRecord, error) {
+	rows, err := s.db.Query(
+		`SELECT game_id, name, config, bank_points, jackpot, stock_price, price_level, start_time, next_theft_time,
+			seed, rand_draws
+		FROM games WHERE state = $1`,
+		GameRecordActive,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load games: %v", err)
+	}
+	defer rows.Close()
+
+	var games []GameRecord
+	for rows.Next() {
+		var config string
+		var startTime, nextTheftTime int64
+		game := GameRecord{State: GameRecordActive}
+		err := rows.Scan(
+			&game.GameID, &game.Name, &config, &game.BankPoints,
+			&game.Jackpot, &game.StockPrice, &game.PriceLevel, &startTime, &nextTheftTime,
+			&game.Seed, &game.RandDraws,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load game: %v", err)
+		}
+		if err := json.Unmarshal([]byte(config), &game.Config); err != nil {
+			return nil, fmt.Errorf("failed to decode config of game %v: %v", game.GameID, err)
+		}
+		game.StartTime = time.Unix(0, startTime)
+		game.NextTheftTime = time.Unix(0, nextTheftTime)
+		games = append(games, game)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load games: %v", err)
+	}
+
+	for i := range games {
+		players, err := s.loadPlayers(games[i].GameID)
+		if err != nil {
+			return nil, err
+		}
+		games[i].Players = players
+
+		loans, err := s.loadLoans(games[i].GameID)
+		if err != nil {
+			return nil, err
+		}
+		games[i].Loans = loans
+	}
+	return games, nil
+}
+
+func (s *SQLStorage) loadLoans(gameID string) ([]LoanRecord, error) {
+	rows, err := s.db.Query(
+		`SELECT loan_id, lender_id, borrower_id, value, interest, time, state, due, end_time
+		FROM loans WHERE game_id = $1`,
+		gameID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load loans of game %v: %v", gameID, err)
+	}
+	defer rows.Close()
+
+	var loans []LoanRecord
+	for rows.Next() {
+		var loan LoanRecord
+		var endTime int64
+		err := rows.Scan(
+			&loan.LoanID, &loan.Terms.Lender, &loan.Terms.Borrower, &loan.Terms.Value,
+			&loan.Terms.Interest, &loan.Terms.Time, &loan.State, &loan.Due, &endTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load loan: %v", err)
+		}
+		loan.EndTime = time.Unix(0, endTime)
+		loans = append(loans, loan)
+	}
+	return loans, rows.Err()
+}
+
+func (s *SQLStorage) loadPlayers(gameID string) ([]PlayerRecord, error) {
+	rows, err := s.db.Query(
+		`SELECT user_id, username, session_token, points, credit_score, last_lottery_time, assets, shares, insured,
+			last_steal_time, guard_end_time
+		FROM players WHERE game_id = $1`,
+		gameID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load players of game %v: %v", gameID, err)
+	}
+	defer rows.Close()
+
+	var players []PlayerRecord
+	for rows.Next() {
+		var player PlayerRecord
+		var lastLotteryTime, lastStealTime, guardEndTime int64
+		var assets string
+		err := rows.Scan(
+			&player.UserID, &player.Username, &player.SessionToken,
+			&player.Points, &player.CreditScore, &lastLotteryTime, &assets, &player.Shares, &player.Insured,
+			&lastStealTime, &guardEndTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load player: %v", err)
+		}
+		if err := json.Unmarshal([]byte(assets), &player.Assets); err != nil {
+			return nil, fmt.Errorf("failed to decode assets of player %v: %v", player.UserID, err)
+		}
+		player.LastLotteryTime = time.Unix(0, lastLotteryTime)
+		player.LastStealTime = time.Unix(0, lastStealTime)
+		player.GuardEndTime = time.Unix(0, guardEndTime)
+		players = append(players, player)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load players of game %v: %v", gameID, err)
+	}
+
+	for i := range players {
+		credits, deposits, err := s.loadPositions(gameID, players[i].UserID)
+		if err != nil {
+			return nil, err
+		}
+		players[i].Credits = credits
+		players[i].Deposits = deposits
+	}
+	return players, nil
+}
+
+func (s *SQLStorage) loadPositions(gameID string, userID string) ([]PositionRecord, []PositionRecord, error) {
+	rows, err := s.db.Query(
+		`SELECT position_id, kind, value, interest, start_time, end_time
+		FROM positions WHERE game_id = $1 AND user_id = $2`,
+		gameID, userID,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load positions of player %v: %v", userID, err)
+	}
+	defer rows.Close()
+
+	var credits, deposits []PositionRecord
+	for rows.Next() {
+		var pos PositionRecord
+		var kind string
+		var startTime, endTime int64
+		if err := rows.Scan(&pos.PositionID, &kind, &pos.Value, &pos.Interest, &startTime, &endTime); err != nil {
+			return nil, nil, fmt.Errorf("failed to load position: %v", err)
+		}
+		pos.StartTime = time.Unix(0, startTime)
+		pos.EndTime = time.Unix(0, endTime)
+		if kind == sqlCreditPosition {
+			credits = append(credits, pos)
+		} else {
+			deposits = append(deposits, pos)
+		}
+	}
+	return credits, deposits, rows.Err()
+}
+
+// persist saves the snapshot of the game, if the game has storage.
+// Games in waiting state are not persisted, since their players
+// have to join again after the server restarts anyway.
+// The calling function has to acquire at least read lock.
+func (g *game) persist() {
+	if g.storage == nil || g.state == waitingState {
+		return
+	}
+	if err := g.storage.SaveGame(g.toGameRecord()); err != nil {
+		g.logger.Error("Failed to persist game", zap.Error(err))
+	}
+}
+
+// recordTransaction saves money movement between the player and the bank,
+// if the game has storage. Positive value means that points moved from
+// the bank to the player.
+// The calling function has to acquire at least read lock.
+func (g *game) recordTransaction(userID userID, kind string, value int32) {
+	if g.storage == nil {
+		return
+	}
+	transaction := TransactionRecord{
+		GameID: string(g.gameID),
+		UserID: string(userID),
+		Kind:   kind,
+		Value:  value,
+		Time:   g.clock.Now(),
+	}
+	if err := g.storage.AddTransaction(transaction); err != nil {
+		g.logger.Error("Failed to persist transaction", zap.String("user_id", string(userID)), zap.Error(err))
+	}
+}
+
+// The calling function has to acquire at least read lock.
+func (g *game) toGameRecord() GameRecord {
+	state := GameRecordActive
+	if g.state == finishedState {
+		state = GameRecordFinished
+	}
+	var randDraws int64
+	if g.randSource != nil {
+		randDraws = g.randSource.draws
+	}
+
+	players := make([]PlayerRecord, 0, len(g.players))
+	for _, player := range g.players {
+		assets := make(map[string]int32, len(player.assets))
+		for asset, units := range player.assets {
+			assets[asset] = units
+		}
+		players = append(players, PlayerRecord{
+			UserID:          string(player.userID),
+			Username:        string(player.username),
+			SessionToken:    string(player.sessionToken),
+			Points:          player.points,
+			CreditScore:     player.creditScore,
+			LastLotteryTime: player.lastLotteryTime,
+			LastStealTime:   player.lastStealTime,
+			GuardEndTime:    player.guardEndTime,
+			Credits:         positionsToRecords(player.credits),
+			Deposits:        positionsToRecords(player.deposits),
+			Assets:          assets,
+			Shares:          player.shares,
+			Insured:         player.insured,
+		})
+	}
+
+	return GameRecord{
+		GameID:        string(g.gameID),
+		Name:          g.name,
+		State:         state,
+		Config:        g.config,
+		BankPoints:    g.bankPoints,
+		Jackpot:       g.jackpot,
+		StockPrice:    g.stockPrice,
+		PriceLevel:    g.priceLevel,
+		StartTime:     g.startTime,
+		NextTheftTime: g.nextTheftTime,
+		Seed:          g.seed,
+		RandDraws:     randDraws,
+		Players:       players,
+		Loans:         loansToRecords(g.loans),
+	}
+}
+
+func loansToRecords(loans map[loanID]*loan) []LoanRecord {
+	records := make([]LoanRecord, 0, len(loans))
+	for _, loan := range loans {
+		state := LoanRecordOffered
+		switch loan.state {
+		case activeLoan:
+			state = LoanRecordActive
+		case collectionsLoan:
+			state = LoanRecordCollections
+		}
+		records = append(records, LoanRecord{
+			LoanID:  string(loan.loanID),
+			Terms:   loan.terms,
+			State:   state,
+			Due:     loan.due,
+			EndTime: loan.endTime,
+		})
+	}
+	return records
+}
+
+func positionsToRecords(positions map[positionID]*position) []PositionRecord {
+	records := make([]PositionRecord, 0, len(positions))
+	for _, pos := range positions {
+		records = append(records, PositionRecord{
+			PositionID: string(pos.positionID),
+			Value:      pos.value,
+			Interest:   pos.interest,
+			StartTime:  pos.startTime,
+			EndTime:    pos.endTime,
+		})
+	}
+	return records
+}
+
+// restoreGame recreates active game from its record and relaunches
+// the timers of credits, deposits, loans, and thefts on provided clock.
+// Timers, which should have fired while the server was down, fire immediately.
+// Players have to reconnect with their session tokens to get the events.
+func restoreGame(record GameRecord, storage Storage, clock Clock, logger *zap.Logger) (*game, error) {
+	g := newGameWithID(gameID(record.GameID), record.Config, storage, logger)
+	g.clock = clock
+	// the journal is continued after the restart
+	journal, err := storage.LoadEvents(record.GameID)
+	if err != nil {
+		return nil, err
+	}
+	g.journal = journal
+	g.name = record.Name
+	g.state = activeState
+	g.bankPoints = record.BankPoints
+	g.jackpot = record.Jackpot
+	g.stockPrice = record.StockPrice
+	g.priceLevel = record.PriceLevel
+	g.generateLotteryCellValues()
+	g.startTime = record.StartTime
+	g.nextTheftTime = record.NextTheftTime
+	g.seedRand(record.Seed, record.RandDraws)
+
+	for _, playerRecord := range record.Players {
+		player := newPlayer(username(playerRecord.Username), playerRecord.Points)
+		player.userID = userID(playerRecord.UserID)
+		player.sessionToken = sessionToken(playerRecord.SessionToken)
+		player.creditScore = playerRecord.CreditScore
+		player.lastLotteryTime = playerRecord.LastLotteryTime
+		player.lastStealTime = playerRecord.LastStealTime
+		player.guardEndTime = playerRecord.GuardEndTime
+		player.shares = playerRecord.Shares
+		player.insured = playerRecord.Insured
+		for asset, units := range playerRecord.Assets {
+			player.assets[asset] = units
+		}
+		// players have been notified about the start before the restart
+		player.gameStartNotified = true
+		g.players[player.userID] = player
+
+		for _, creditRecord := range playerRecord.Credits {
+			credit := positionFromRecord(creditRecord, g.config.creditInterest)
+			userID := player.userID
+			credit.timer = g.clock.AfterFunc(credit.endTime.Sub(g.clock.Now()), func() {
+				g.returnCredit(userID, credit.positionID)
+			})
+			player.credits[credit.positionID] = credit
+		}
+		for _, depositRecord := range playerRecord.Deposits {
+			deposit := positionFromRecord(depositRecord, g.config.depositInterest)
+			userID := player.userID
+			deposit.timer = g.clock.AfterFunc(deposit.endTime.Sub(g.clock.Now()), func() {
+				g.returnDeposit(userID, deposit.positionID)
+			})
+			player.deposits[deposit.positionID] = deposit
+		}
+	}
+
+	// bots and appearance are recorded only in their join events, and
+	// forfeits and the times of the last actions only in the journal
+	for _, event := range journal {
+		player, ok := g.players[userID(event.UserID)]
+		if !ok {
+			continue
+		}
+		switch {
+		case event.Kind == EventJoin:
+			player.bot = event.Bot
+			player.avatarID = event.AvatarID
+			player.color = event.Color
+			player.lastActionTime = g.startTime
+		case event.Kind == EventForfeit:
+			player.forfeited = true
+		case afkActions[event.Kind] && !event.Auto:
+			player.lastActionTime = event.Time
+		}
+	}
+
+	for _, loanRecord := range record.Loans {
+		loan := newLoan(loanID(loanRecord.LoanID), loanRecord.Terms)
+		loan.due = loanRecord.Due
+		loan.endTime = loanRecord.EndTime
+		switch loanRecord.State {
+		case LoanRecordActive:
+			loan.state = activeLoan
+			loan.timer = g.clock.AfterFunc(loan.endTime.Sub(g.clock.Now()), func() {
+				g.collectLoan(loan.loanID)
+			})
+		case LoanRecordCollections:
+			loan.state = collectionsLoan
+			loan.timer = g.clock.AfterFunc(loanCollectionInterval, func() {
+				g.collectLoan(loan.loanID)
+			})
+		}
+		g.loans[loan.loanID] = loan
+	}
+
+	g.clock.AfterFunc(g.nextTheftTime.Sub(g.clock.Now()), func() {
+		g.doTheft()
+	})
+	if g.stockPrice > 0 {
+		g.scheduleStockTick()
+	}
+	if g.config.taxTime > 0 {
+		g.scheduleTax()
+	}
+	if g.config.inflationRate > 0 {
+		g.scheduleInflation()
+	}
+	if g.config.timeTickTime > 0 {
+		g.scheduleTimeTick()
+	}
+	if g.config.afkTime > 0 {
+		g.scheduleAFKCheck()
+	}
+	return g, nil
+}
+
+// positionFromRecord recreates the position. Positions saved before
+// their interest was persisted get provided interest from game config.
+func positionFromRecord(record PositionRecord, configInterest int32) *position {
+	interest := record.Interest
+	if interest == 0 {
+		interest = configInterest
+	}
+	return &position{
+		positionID: positionID(record.PositionID),
+		value:      record.Value,
+		interest:   interest,
+		startTime:  record.StartTime,
+		endTime:    record.EndTime,
+	}
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/cs489-team11/server/pb"
+)
+
+// subscriptions are the categories of the events, which are sent
+// on the stream of the player. All events are sent, if it is nil.
+type subscriptions map[pb.EventCategory]bool
+
+// newSubscriptions returns the subscriptions to provided categories,
+// or nil, if no categories are provided.
+func newSubscriptions(categories []pb.EventCategory) (subscriptions, error) {
+	if len(categories) == 0 {
+		return nil, nil
+	}
+	res := make(subscriptions)
+	for _, category := range categories {
+		if _, ok := pb.EventCategory_name[int32(category)]; !ok {
+			return nil, fmt.Errorf("unknown event category: %d", category)
+		}
+		res[category] = true
+	}
+	return res, nil
+}
+
+// includes returns true, if the event belongs to one of the subscribed
+// categories.
+func (s subscriptions) includes(response *pb.StreamResponse) bool {
+	return s == nil || s[getEventCategory(response)]
+}
+
+// getEventCategory returns the category, to which the event belongs.
+func getEventCategory(response *pb.StreamResponse) pb.EventCategory {
+	switch event := response.Event.(type) {
+	case *pb.StreamResponse_Transaction_:
+		switch event.Transaction.Event.(type) {
+		case *pb.StreamResponse_Transaction_Lottery_:
+			return pb.EventCategory_EVENT_CATEGORY_LOTTERY
+		case *pb.StreamResponse_Transaction_Question_, *pb.StreamResponse_Transaction_QuestionTimeout_:
+			return pb.EventCategory_EVENT_CATEGORY_QUESTIONS
+		}
+		return pb.EventCategory_EVENT_CATEGORY_BALANCE
+	case *pb.StreamResponse_StockTick_, *pb.StreamResponse_Inflation_, *pb.StreamResponse_CoopProgress:
+		return pb.EventCategory_EVENT_CATEGORY_BALANCE
+	case *pb.StreamResponse_Chat, *pb.StreamResponse_Reaction_:
+		return pb.EventCategory_EVENT_CATEGORY_CHAT
+	case *pb.StreamResponse_Overtime_, *pb.StreamResponse_OvertimeAnswer_:
+		return pb.EventCategory_EVENT_CATEGORY_QUESTIONS
+	}
+	return pb.EventCategory_EVENT_CATEGORY_SYSTEM
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Time, for which the summary of the finished game is kept.
+const summaryTime = time.Hour
+
+// Maximal number of the kept summaries, the oldest ones are dropped first.
+const maxSummaries = 1000
+
+// summaryBook keeps summaries of recently finished games, so that
+// players, who reconnect after the finish, can still see the results.
+type summaryBook struct {
+	mutex     sync.Mutex
+	summaries map[gameID]*pb.GameSummary
+	order     []gameID // from the oldest to the newest
+	clock     Clock
+}
+
+func newSummaryBook(clock Clock) *summaryBook {
+	return &summaryBook{
+		summaries: make(map[gameID]*pb.GameSummary),
+		clock:     clock,
+	}
+}
+
+// add keeps the summary of the game, which has just finished, and
+// drops the expired ones. It is safe to call it on nil book, in
+// which case nothing is kept.
+func (b *summaryBook) add(summary *pb.GameSummary) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.dropExpired(b.clock.Now())
+	gameID := gameID(summary.GameId)
+	if _, ok := b.summaries[gameID]; !ok {
+		b.order = append(b.order, gameID)
+	}
+	b.summaries[gameID] = summary
+	for len(b.order) > maxSummaries {
+		delete(b.summaries, b.order[0])
+		b.order = b.order[1:]
+	}
+}
+
+// get returns the summary of the game or false, if the game
+// hasn't finished recently.
+func (b *summaryBook) get(gameID gameID) (*pb.GameSummary, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.dropExpired(b.clock.Now())
+	summary, ok := b.summaries[gameID]
+	return summary, ok
+}
+
+// dropExpired drops the summaries, which have been kept for longer than
+// summaryTime. The calling function has to acquire the lock.
+func (b *summaryBook) dropExpired(now time.Time) {
+	expired := now.Add(-summaryTime).UnixNano() / int64(time.Millisecond)
+	for len(b.order) > 0 && b.summaries[b.order[0]].FinishTime < expired {
+		delete(b.summaries, b.order[0])
+		b.order = b.order[1:]
+	}
+}
+
+// GetGameSummary returns the final state and the results of the game,
+// which has finished within the last hour.
+func (s *Server) GetGameSummary(_ context.Context, req *pb.GetGameSummaryRequest) (*pb.GetGameSummaryResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+
+	summary, ok := s.summaries.get(reqGameID)
+	if !ok {
+		if game, err := s.getActiveGame(reqGameID); err == nil && !game.isFinished() {
+			return nil, status.Errorf(codes.FailedPrecondition, "game %v is not finished yet", reqGameID)
+		}
+		return nil, status.Errorf(codes.NotFound, "there is no summary of game %v", reqGameID)
+	}
+	return &pb.GetGameSummaryResponse{Summary: summary}, nil
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"math"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+)
+
+// TaxBracket is the percentage, at which points of the player
+// above the threshold (and below the threshold of the next bracket)
+// are taxed.
+type TaxBracket struct {
+	Threshold  int32
+	Percentage int32
+}
+
+func taxBracketsToPB(brackets []TaxBracket) []*pb.TaxBracket {
+	res := make([]*pb.TaxBracket, len(brackets))
+	for i, bracket := range brackets {
+		res[i] = &pb.TaxBracket{
+			Threshold:  bracket.Threshold,
+			Percentage: bracket.Percentage,
+		}
+	}
+	return res
+}
+
+func taxBracketsFromPB(brackets []*pb.TaxBracket) []TaxBracket {
+	res := make([]TaxBracket, len(brackets))
+	for i, bracket := range brackets {
+		res[i] = TaxBracket{
+			Threshold:  bracket.GetThreshold(),
+			Percentage: bracket.GetPercentage(),
+		}
+	}
+	return res
+}
+
+// getTax returns the tax on provided points. Brackets have to be
+// sorted by their thresholds. Points below the first threshold
+// are not taxed.
+func getTax(points int32, brackets []TaxBracket) int32 {
+	tax := 0.0
+	for i, bracket := range brackets {
+		if points <= bracket.Threshold {
+			break
+		}
+		taxed := points
+		if i+1 < len(brackets) && brackets[i+1].Threshold < taxed {
+			taxed = brackets[i+1].Threshold
+		}
+		tax += float64(taxed-bracket.Threshold) * float64(bracket.Percentage) / 100.0
+	}
+	return int32(math.Ceil(tax))
+}
+
+func (g *game) scheduleTax() {
+	g.clock.AfterFunc(time.Duration(g.config.taxTime)*time.Second, func() {
+		g.doTax()
+	})
+}
+
+// doTax moves the tax on points of every player to the bank and
+// broadcasts it. It is repeated, until the game is finished.
+func (g *game) doTax() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state == finishedState || g.deferWhilePaused(g.doTax) {
+		return
+	}
+
+	var userIDs []userID
+	var taxes []int32
+	for userID, player := range g.players {
+		tax := getTax(player.points, g.config.taxBrackets)
+		if tax <= 0 {
+			continue
+		}
+		g.apply(newEvent(EventTax, userID, -tax))
+		userIDs = append(userIDs, userID)
+		taxes = append(taxes, tax)
+	}
+	g.persist()
+
+	go func() {
+		msg := g.getTaxMessage(userIDs, taxes)
+		g.broadcast(msg)
+		g.logger.Info("Tax collected", zap.Int("taxed_players", len(userIDs)))
+	}()
+
+	g.scheduleTax()
+}
+
+// As this function uses Readlock, it has to be spawned in a separate goroutine.
+func (g *game) getTaxMessage(userIDs []userID, taxes []int32) *pb.StreamResponse {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	taxedPlayers := make([]*pb.StreamResponse_Transaction_Tax_TaxedPlayer, len(userIDs))
+	for ind := range userIDs {
+		taxedPlayers[ind] = &pb.StreamResponse_Transaction_Tax_TaxedPlayer{
+			UserId: string(userIDs[ind]),
+			Value:  taxes[ind],
+		}
+	}
+
+	players := g.getPBPlayersWithBank()
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Transaction_{
+			Transaction: &pb.StreamResponse_Transaction{
+				Players: players,
+				Jackpot: g.jackpot,
+				Event: &pb.StreamResponse_Transaction_Tax_{
+					Tax: &pb.StreamResponse_Transaction_Tax{
+						TaxedPlayers: taxedPlayers,
+					},
+				},
+			},
+		},
+	}
+	return res
+}
@@ -0,0 +1,245 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Maximal number of teams in the game.
+const maxTeamCount = 8
+
+// getSmallestTeam returns the team with the fewest players, which the
+// joining player is added to. Ties are broken by the number of the team.
+// Returns 0, if the game has no teams. The calling function has to
+// acquire at least read lock.
+func (g *game) getSmallestTeam() int32 {
+	if g.config.teamCount == 0 {
+		return 0
+	}
+	sizes := make([]int, g.config.teamCount+1)
+	for _, player := range g.players {
+		if player.team > 0 && player.team <= g.config.teamCount {
+			sizes[player.team]++
+		}
+	}
+	smallest := int32(1)
+	for team := int32(2); team <= g.config.teamCount; team++ {
+		if sizes[team] < sizes[smallest] {
+			smallest = team
+		}
+	}
+	return smallest
+}
+
+// fitTeams returns true, if every player is in one of provided
+// number of teams. The calling function has to acquire at least
+// read lock.
+func (g *game) fitTeams(teamCount int32) bool {
+	for _, player := range g.players {
+		if (teamCount == 0 && player.team != 0) || (teamCount > 0 && (player.team < 1 || player.team > teamCount)) {
+			return false
+		}
+	}
+	return true
+}
+
+// assignTeams splits the players into provided number of teams in the
+// order, in which they have joined the game, so that replaying the journal
+// assigns the same teams. The calling function has to acquire write lock.
+func (g *game) assignTeams(teamCount int32) {
+	i := int32(0)
+	for _, event := range g.journal {
+		if event.Kind != EventJoin {
+			continue
+		}
+		player, ok := g.players[userID(event.UserID)]
+		if !ok {
+			continue
+		}
+		player.team = 0
+		if teamCount > 0 {
+			player.team = i%teamCount + 1
+			i++
+		}
+	}
+}
+
+// getTeamPoints returns the sum of the points of the players in every
+// team. Returns nil, if the game has no teams. The calling function has
+// to acquire at least read lock.
+func (g *game) getTeamPoints() map[int32]int32 {
+	if g.config.teamCount == 0 {
+		return nil
+	}
+	points := make(map[int32]int32)
+	for team := int32(1); team <= g.config.teamCount; team++ {
+		points[team] = 0
+	}
+	for _, player := range g.players {
+		points[player.team] += player.points
+	}
+	return points
+}
+
+// findWinnerTeam returns the team with the most points, which has at least
+// one player. Ties are broken by the number of the team. Returns 0, if the
+// game has no teams. The calling function has to acquire at least read lock.
+func (g *game) findWinnerTeam() int32 {
+	points := g.getTeamPoints()
+	winnerTeam := int32(0)
+	for _, player := range g.players {
+		team := player.team
+		if winnerTeam == 0 || points[team] > points[winnerTeam] ||
+			(points[team] == points[winnerTeam] && team < winnerTeam) {
+			winnerTeam = team
+		}
+	}
+	return winnerTeam
+}
+
+// findWinners returns the players, who have won the game, i.e. the player
+// with the most points or all players of the winning team. In co-op mode,
+// all players win, if they have reached the target. The calling function
+// has to acquire at least read lock.
+func (g *game) findWinners() map[userID]bool {
+	winners := make(map[userID]bool)
+	if g.config.mode == CoopMode {
+		for userID := range g.players {
+			if g.coopReached {
+				winners[userID] = true
+			}
+		}
+		return winners
+	}
+	if g.config.teamCount == 0 {
+		if winnerID := g.findWinnerID(); winnerID != "" {
+			winners[winnerID] = true
+		}
+		return winners
+	}
+	winnerTeam := g.findWinnerTeam()
+	for userID, player := range g.players {
+		if player.team == winnerTeam {
+			winners[userID] = true
+		}
+	}
+	return winners
+}
+
+// getScore returns the points, by which the player is ranked at the end
+// of the game, i.e. points of the team in team games, or the score under
+// the win condition otherwise. The calling function has to acquire at
+// least read lock.
+func (g *game) getScore(player *player, teamPoints map[int32]int32) int32 {
+	if g.config.teamCount == 0 {
+		return g.getPlayerScore(player)
+	}
+	return teamPoints[player.team]
+}
+
+// getPBTeams returns teams ordered by their numbers. Returns nil, if the
+// game has no teams. The calling function has to acquire at least read lock.
+func (g *game) getPBTeams() []*pb.Team {
+	if g.config.teamCount == 0 {
+		return nil
+	}
+	points := g.getTeamPoints()
+	teams := make([]*pb.Team, g.config.teamCount)
+	for i := range teams {
+		teams[i] = &pb.Team{Team: int32(i + 1), Points: points[int32(i+1)]}
+	}
+	for userID, player := range g.players {
+		if player.team > 0 && player.team <= g.config.teamCount {
+			team := teams[player.team-1]
+			team.UserIds = append(team.UserIds, string(userID))
+		}
+	}
+	for _, team := range teams {
+		sort.Strings(team.UserIds)
+	}
+	return teams
+}
+
+// chooseTeam moves the player to provided team before the game starts.
+// Other players are notified with the TeamChange event.
+func (g *game) chooseTeam(userID userID, team int32) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state != waitingState {
+		return fmt.Errorf("teams can be chosen only before the game starts")
+	}
+	player, ok := g.players[userID]
+	if !ok {
+		return fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if player.team == team {
+		return nil
+	}
+
+	event := newEvent(EventTeam, userID, 0)
+	event.Team = team
+	g.apply(event)
+	g.logger.Info("Player has changed the team", zap.String("user_id", string(userID)), zap.Int32("team", team))
+
+	go func() {
+		g.broadcast(getTeamChangeMessage(userID, team))
+	}()
+
+	return nil
+}
+
+// isTeammate returns true, if the player is in provided team.
+// The calling function has to acquire at least read lock.
+func (g *game) isTeammate(userID userID, team int32) bool {
+	player, ok := g.players[userID]
+	return ok && player.team == team
+}
+
+// broadcastToTeam sends the event only to the players of provided team.
+func (g *game) broadcastToTeam(team int32, response *pb.StreamResponse) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	g.streamMutex.Lock()
+	defer g.streamMutex.Unlock()
+
+	response.Sequence = g.getStreamSequence()
+	for _, player := range g.players {
+		if player.team != team || player.stream == nil || !player.subscriptions.includes(response) {
+			continue
+		}
+		g.queue(player, response)
+	}
+}
+
+func getTeamChangeMessage(userID userID, team int32) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_TeamChange_{
+			TeamChange: &pb.StreamResponse_TeamChange{
+				UserId: string(userID),
+				Team:   team,
+			},
+		},
+	}
+}
+
+// ChooseTeam moves the player to another team of the waiting game.
+func (s *Server) ChooseTeam(_ context.Context, req *pb.ChooseTeamRequest) (*pb.ChooseTeamResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, err := s.getLobby(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+	if err := game.chooseTeam(reqUserID, req.GetTeam()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.ChooseTeamResponse{}, nil
+}
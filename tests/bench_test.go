@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cs489-team11/server"
+	"github.com/cs489-team11/server/pb"
+	"github.com/stretchr/testify/require"
+)
+
+// long timers, so that game operations are not interleaved with
+// returning credits/deposits and thefts during the benchmark
+var benchGameConfig = server.NewGameConfig(
+	7200, 1000000, 1000000, 30, 20, 3600, 3600, 3600, 15, 2, 150, 150,
+)
+
+// startBenchGames starts gameCount games with a single player in each
+// and returns CreditRequest templates for these players.
+func startBenchGames(b *testing.B, s *server.Server, gameCount int) []*pb.CreditRequest {
+	ctx := context.Background()
+	reqs := make([]*pb.CreditRequest, gameCount)
+	for i := range reqs {
+		joinRes, err := s.Join(ctx, &pb.JoinRequest{Username: "bench"})
+		require.NoError(b, err)
+		_, err = s.Start(ctx, &pb.StartRequest{GameId: joinRes.GameId, UserId: joinRes.UserId})
+		require.NoError(b, err)
+		reqs[i] = &pb.CreditRequest{
+			UserId: joinRes.UserId,
+			GameId: joinRes.GameId,
+			Value:  1,
+		}
+	}
+	return reqs
+}
+
+func benchmarkConcurrentGames(b *testing.B, gameCount int) {
+	s := server.NewServer(benchGameConfig)
+	reqs := startBenchGames(b, s, gameCount)
+	ctx := context.Background()
+	// every parallel goroutine starts from its own game, so that
+	// goroutines do not contend for the same game lock in lockstep
+	var offset int64
+
+	b.ResetTimer()
+	b.RunParallel(func(p *testing.PB) {
+		i := int(atomic.AddInt64(&offset, 1))
+		for p.Next() {
+			creditReq := reqs[i%len(reqs)]
+			depositReq := &pb.DepositRequest{
+				UserId: creditReq.UserId,
+				GameId: creditReq.GameId,
+				Value:  creditReq.Value,
+			}
+			s.Credit(ctx, creditReq)
+			s.Deposit(ctx, depositReq)
+			i++
+		}
+	})
+}
+
+func BenchmarkSingleGame(b *testing.B) {
+	benchmarkConcurrentGames(b, 1)
+}
+
+func BenchmarkConcurrentGames(b *testing.B) {
+	benchmarkConcurrentGames(b, 64)
+}
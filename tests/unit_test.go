@@ -1,14 +1,49 @@
 package tests
 
 import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cs489-team11/server"
+	"github.com/cs489-team11/server/config"
 	"github.com/cs489-team11/server/pb"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/api/trace/tracetest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 const testServAddr = "localhost:9090" //"178.128.85.78:9090" //"localhost:9090"//
@@ -89,7 +124,10 @@ func TestStart(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, joinRes1.GameId, joinRes2.GameId)
 
+	// only the host, who has joined first, can start the game
 	err = client2.StartGame()
+	require.Error(t, err)
+	err = client1.StartGame()
 	require.NoError(t, err)
 
 	joinRes3, err := client3.JoinGame()
@@ -332,3 +370,5667 @@ func TestQuestionGenerateAndAnswer(t *testing.T) {
 	// to process events.
 	time.Sleep(2 * time.Second) // sleep time needs to be increased to see theft events
 }
+
+func TestShutdown(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 1, 1, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	// game lasts for 30 seconds, so it has to be finished forcefully
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	shutdownErr := make(chan error)
+	go func() {
+		shutdownErr <- s.Shutdown(ctx)
+	}()
+
+	var lastEvent *pb.StreamResponse
+	for {
+		streamRes, streamErr := client1.Stream.Recv()
+		if streamErr != nil {
+			break
+		}
+		lastEvent = streamRes
+	}
+	require.NotNil(t, lastEvent)
+	require.NotNil(t, lastEvent.GetFinish())
+	require.Equal(t, context.DeadlineExceeded, <-shutdownErr)
+
+	// server does not accept new players after shutdown
+	client2 := server.NewSampleClient()
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NotNil(t, err)
+}
+
+func TestReconnect(t *testing.T) {
+	var err error
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(testServAddr)
+	require.NoError(t, err)
+
+	joinRes1, err := client1.JoinGame()
+	require.NoError(t, err)
+	require.NotEmpty(t, joinRes1.SessionToken)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	err = client1.Reconnect()
+	require.NoError(t, err)
+	streamRes, err := client1.Stream.Recv()
+	require.NoError(t, err)
+	state := streamRes.GetState()
+	require.NotNil(t, state)
+	require.Equal(t, joinRes1.GameId, state.GameId)
+	require.Equal(t, pb.GameStatus_GAME_STATUS_ACTIVE, state.Status)
+	require.LessOrEqual(t, state.RemainingTime, joinRes1.Duration)
+	// player and the bank
+	require.Len(t, state.Players, 2)
+
+	// reconnecting with invalid token fails
+	client1.SessionToken = ""
+	err = client1.Reconnect()
+	require.NoError(t, err)
+	_, err = client1.Stream.Recv()
+	require.NotNil(t, err)
+}
+
+func TestRooms(t *testing.T) {
+	var err error
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(testServAddr)
+	require.NoError(t, err)
+	client2 := server.NewSampleClient()
+	err = client2.Connect(testServAddr)
+	require.NoError(t, err)
+
+	_, err = client1.CreateRoom("", nil)
+	require.NotNil(t, err)
+
+	createRes, err := client1.CreateRoom("quick game", &pb.GameConfig{Duration: 40})
+	require.NoError(t, err)
+
+	joinRes1, err := client1.JoinRoom(createRes.GameId)
+	require.NoError(t, err)
+	require.Equal(t, createRes.GameId, joinRes1.GameId)
+	// overridden value is used, other values are server defaults
+	require.Equal(t, int32(40), joinRes1.Duration)
+	require.NotEqual(t, int32(0), joinRes1.PlayerPoints)
+
+	listRes, err := client2.ListRooms()
+	require.NoError(t, err)
+	var room *pb.Room
+	for _, r := range listRes.Rooms {
+		if r.GameId == createRes.GameId {
+			room = r
+		}
+	}
+	require.NotNil(t, room)
+	require.Equal(t, "quick game", room.Name)
+	require.Equal(t, int32(1), room.PlayerCount)
+
+	joinRes2, err := client2.JoinRoom(createRes.GameId)
+	require.NoError(t, err)
+	require.Equal(t, joinRes1.GameId, joinRes2.GameId)
+
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	// started room can't be joined anymore
+	client3 := server.NewSampleClient()
+	err = client3.Connect(testServAddr)
+	require.NoError(t, err)
+	_, err = client3.JoinRoom(createRes.GameId)
+	require.NotNil(t, err)
+
+	// room is removed after all players leave
+	createRes, err = client3.CreateRoom("empty room", nil)
+	require.NoError(t, err)
+	_, err = client3.JoinRoom(createRes.GameId)
+	require.NoError(t, err)
+	err = client3.LeaveGame()
+	require.NoError(t, err)
+	_, err = client2.JoinRoom(createRes.GameId)
+	require.NotNil(t, err)
+}
+
+func TestStartWithConfig(t *testing.T) {
+	var err error
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(testServAddr)
+	require.NoError(t, err)
+
+	joinRes1, err := client1.JoinGame()
+	require.NoError(t, err)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+
+	// deposit interest has to be less than credit interest
+	err = client1.StartGameWithConfig(&pb.GameConfig{DepositInterest: joinRes1.CreditInterest + 1})
+	require.NotNil(t, err)
+
+	err = client1.StartGameWithConfig(&pb.GameConfig{Duration: 40, PlayerPoints: 500})
+	require.NoError(t, err)
+
+	streamRes, err := client1.Stream.Recv()
+	require.NoError(t, err)
+	start := streamRes.GetStart()
+	require.NotNil(t, start)
+	require.Equal(t, int32(40), start.Config.Duration)
+	require.Equal(t, int32(500), start.Config.PlayerPoints)
+	require.Equal(t, joinRes1.CreditInterest, start.Config.CreditInterest)
+
+	err = client1.Reconnect()
+	require.NoError(t, err)
+	streamRes, err = client1.Stream.Recv()
+	require.NoError(t, err)
+	for _, player := range streamRes.GetState().Players {
+		if player.UserId == joinRes1.UserId {
+			require.Equal(t, int32(500), player.Points)
+		}
+	}
+}
+
+func TestGetGameState(t *testing.T) {
+	var err error
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(testServAddr)
+	require.NoError(t, err)
+
+	joinRes1, err := client1.JoinGame()
+	require.NoError(t, err)
+
+	stateRes, err := client1.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, pb.GameStatus_GAME_STATUS_WAITING, stateRes.State.Status)
+
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	_, err = client1.TakeCredit(50)
+	require.NoError(t, err)
+	_, err = client1.TakeDeposit(20)
+	require.NoError(t, err)
+
+	stateRes, err = client1.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, pb.GameStatus_GAME_STATUS_ACTIVE, stateRes.State.Status)
+	require.Len(t, stateRes.Credits, 1)
+	require.Equal(t, int32(50), stateRes.Credits[0].Value)
+	require.Less(t, int32(50), stateRes.Credits[0].ValueWithInterest)
+	require.Len(t, stateRes.Deposits, 1)
+	require.Equal(t, int32(20), stateRes.Deposits[0].Value)
+	require.LessOrEqual(t, stateRes.LotteryRemainingTime, joinRes1.LotteryTime)
+
+	client2 := server.NewSampleClient()
+	err = client2.Connect(testServAddr)
+	require.NoError(t, err)
+	client2.GameID = client1.GameID
+	_, err = client2.GetGameState()
+	require.NotNil(t, err)
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	storage := server.NewMemoryStorage()
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+
+	s1 := server.NewServer(gameConfig, server.WithStorage(storage))
+	joinRes, err := s1.Join(ctx, &pb.JoinRequest{Username: "Alice"})
+	require.NoError(t, err)
+	_, err = s1.Start(ctx, &pb.StartRequest{UserId: joinRes.UserId, GameId: joinRes.GameId})
+	require.NoError(t, err)
+	creditRes, err := s1.Credit(ctx, &pb.CreditRequest{
+		UserId: joinRes.UserId, GameId: joinRes.GameId, Value: 50,
+	})
+	require.NoError(t, err)
+	require.True(t, creditRes.Success)
+
+	transactions := storage.Transactions(joinRes.GameId)
+	require.Len(t, transactions, 1)
+	require.Equal(t, server.TransactionCredit, transactions[0].Kind)
+	require.Equal(t, int32(50), transactions[0].Value)
+
+	// new server instance picks up the game, as if the first one crashed
+	s2 := server.NewServer(gameConfig, server.WithStorage(storage))
+	err = s2.Restore()
+	require.NoError(t, err)
+
+	stateRes, err := s2.GetGameState(ctx, &pb.GetGameStateRequest{
+		UserId: joinRes.UserId, GameId: joinRes.GameId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, pb.GameStatus_GAME_STATUS_ACTIVE, stateRes.State.Status)
+	require.Len(t, stateRes.Credits, 1)
+	require.Equal(t, int32(50), stateRes.Credits[0].Value)
+	for _, player := range stateRes.State.Players {
+		if player.UserId == joinRes.UserId {
+			require.Equal(t, int32(250), player.Points)
+		} else {
+			require.Equal(t, int32(350), player.Points)
+		}
+	}
+
+	// server without storage cannot restore games
+	s3 := server.NewServer(gameConfig)
+	require.NotNil(t, s3.Restore())
+}
+
+func TestJournal(t *testing.T) {
+	ctx := context.Background()
+	storage := server.NewMemoryStorage()
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithStorage(storage))
+
+	aliceRes, err := s.Join(ctx, &pb.JoinRequest{Username: "Alice"})
+	require.NoError(t, err)
+	bobRes, err := s.Join(ctx, &pb.JoinRequest{Username: "Bob"})
+	require.NoError(t, err)
+	require.Equal(t, aliceRes.GameId, bobRes.GameId)
+	_, err = s.Leave(ctx, &pb.LeaveRequest{UserId: bobRes.UserId, GameId: bobRes.GameId})
+	require.NoError(t, err)
+
+	// events of waiting game are saved only after the start
+	events, err := storage.LoadEvents(aliceRes.GameId)
+	require.NoError(t, err)
+	require.Len(t, events, 0)
+
+	_, err = s.Start(ctx, &pb.StartRequest{UserId: aliceRes.UserId, GameId: aliceRes.GameId})
+	require.NoError(t, err)
+	_, err = s.Credit(ctx, &pb.CreditRequest{UserId: aliceRes.UserId, GameId: aliceRes.GameId, Value: 50})
+	require.NoError(t, err)
+	_, err = s.Deposit(ctx, &pb.DepositRequest{UserId: aliceRes.UserId, GameId: aliceRes.GameId, Value: 30})
+	require.NoError(t, err)
+
+	events, err = storage.LoadEvents(aliceRes.GameId)
+	require.NoError(t, err)
+	kinds := []string{
+		server.EventJoin, server.EventJoin, server.EventLeave,
+		server.EventStart, server.EventCredit, server.EventDeposit,
+	}
+	require.Len(t, events, len(kinds))
+	for i, event := range events {
+		require.Equal(t, int64(i+1), event.Sequence)
+		require.Equal(t, kinds[i], event.Kind)
+	}
+	require.Equal(t, "Alice", events[0].Username)
+	require.NotNil(t, events[3].Config)
+	require.Equal(t, int32(50), events[4].Value)
+	require.Equal(t, int32(-30), events[5].Value)
+	require.NotEqual(t, events[4].RefID, events[5].RefID)
+}
+
+func TestReplay(t *testing.T) {
+	var err error
+
+	storage := server.NewMemoryStorage()
+	gameConfig := server.NewGameConfig(2, 200, 400, 30, 20, 1, 1, 1, 15, 1, 150, 150)
+	s := server.NewServer(gameConfig, server.WithStorage(storage))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+
+	// game is not finished yet
+	stream, err := client1.Replay(string(client1.GameID), 0)
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NotNil(t, err)
+
+	err = client1.StartGame()
+	require.NoError(t, err)
+	_, err = client1.TakeCredit(50)
+	require.NoError(t, err)
+	time.Sleep(3 * time.Second)
+
+	stream, err = client1.Replay(string(client1.GameID), 100)
+	require.NoError(t, err)
+	var events []*pb.ReplayEvent
+	for {
+		event, streamErr := stream.Recv()
+		if streamErr == io.EOF {
+			break
+		}
+		require.NoError(t, streamErr)
+		events = append(events, event)
+	}
+
+	require.True(t, len(events) >= 4)
+	require.Equal(t, server.EventJoin, events[0].Kind)
+	require.Equal(t, string(client1.Username), events[0].Username)
+	require.Equal(t, server.EventStart, events[1].Kind)
+	require.Equal(t, int32(2), events[1].Config.Duration)
+	require.Equal(t, server.EventCredit, events[2].Kind)
+	require.Equal(t, int32(50), events[2].Value)
+	require.Equal(t, server.EventFinish, events[len(events)-1].Kind)
+	for i := 1; i < len(events); i++ {
+		require.Equal(t, int64(i+1), events[i].Sequence)
+		require.True(t, events[i].TimeOffset >= events[i-1].TimeOffset)
+	}
+
+	// speed cannot be negative
+	stream, err = client1.Replay(string(client1.GameID), -1)
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NotNil(t, err)
+}
+
+func TestAdmin(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithAdminToken("secret"))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	client2 := server.NewSampleClient()
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+	_, err = client1.TakeCredit(50)
+	require.NoError(t, err)
+
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := pb.NewAdminClient(conn)
+	gameID := string(client1.GameID)
+
+	// requests without valid token are rejected
+	_, err = admin.ListGames(context.Background(), &pb.ListGamesRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	wrongCtx := metadata.AppendToOutgoingContext(context.Background(), server.AdminTokenMetadataKey, "wrong")
+	_, err = admin.ListGames(wrongCtx, &pb.ListGamesRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), server.AdminTokenMetadataKey, "secret")
+	listRes, err := admin.ListGames(ctx, &pb.ListGamesRequest{})
+	require.NoError(t, err)
+	require.Len(t, listRes.Games, 2)
+	for _, game := range listRes.Games {
+		if game.GameId == gameID {
+			require.Equal(t, pb.GameStatus_GAME_STATUS_ACTIVE, game.Status)
+			require.Equal(t, int32(2), game.PlayerCount)
+		} else {
+			require.Equal(t, pb.GameStatus_GAME_STATUS_WAITING, game.Status)
+		}
+	}
+
+	inspectRes, err := admin.InspectGame(ctx, &pb.InspectGameRequest{GameId: gameID})
+	require.NoError(t, err)
+	require.Len(t, inspectRes.Players, 2)
+	for _, player := range inspectRes.Players {
+		if player.Player.UserId == string(client1.UserID) {
+			require.Len(t, player.Credits, 1)
+		}
+	}
+
+	// points of evicted player are taken by the bank
+	_, err = admin.EvictPlayer(ctx, &pb.EvictPlayerRequest{GameId: gameID, UserId: string(client1.UserID)})
+	require.NoError(t, err)
+	inspectRes, err = admin.InspectGame(ctx, &pb.InspectGameRequest{GameId: gameID})
+	require.NoError(t, err)
+	require.Len(t, inspectRes.Players, 1)
+	total := int32(0)
+	for _, player := range inspectRes.State.Players {
+		total += player.Points
+	}
+	require.Equal(t, int32(2*200+2*400), total)
+	_, err = admin.EvictPlayer(ctx, &pb.EvictPlayerRequest{GameId: gameID, UserId: string(client1.UserID)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = admin.FinishGame(ctx, &pb.FinishGameRequest{GameId: gameID})
+	require.NoError(t, err)
+	_, err = admin.InspectGame(ctx, &pb.InspectGameRequest{GameId: gameID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Admin service is disabled without token
+	s2 := server.NewServer(gameConfig)
+	addr2, err := s2.Listen("localhost:0")
+	require.NoError(t, err)
+	go s2.Launch()
+	conn2, err := grpc.Dial(addr2, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn2.Close()
+	_, err = pb.NewAdminClient(conn2).ListGames(ctx, &pb.ListGamesRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestMetrics(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithMetrics("localhost:0"))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+	_, err = client1.TakeCredit(50)
+	require.NoError(t, err)
+	_, err = client1.TakeDeposit(30)
+	require.NoError(t, err)
+
+	res, err := http.Get("http://" + s.MetricsAddr() + "/metrics")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	lines := strings.Split(string(body), "\n")
+	for _, expected := range []string{
+		"game_active_games 1",
+		"game_connected_streams 1",
+		"game_joins_total 1",
+		"game_credit_points_total 50",
+		"game_deposit_points_total 30",
+		`game_rpc_duration_seconds_count{code="OK",method="/server.Game/Credit"} 1`,
+	} {
+		require.Contains(t, lines, expected)
+	}
+}
+
+func TestTracing(t *testing.T) {
+	var err error
+
+	spanRecorder := &tracetest.StandardSpanRecorder{}
+	tracerProvider := tracetest.NewTracerProvider(tracetest.WithSpanRecorder(spanRecorder))
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithTracerProvider(tracerProvider))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+	_, err = client1.TakeCredit(50)
+	require.NoError(t, err)
+
+	spans := make(map[string]*tracetest.Span)
+	for _, span := range spanRecorder.Completed() {
+		spans[span.Name()] = span
+	}
+	rpcSpan, ok := spans["server.Game/Credit"]
+	require.True(t, ok)
+	gameSpan, ok := spans["game.useCredit"]
+	require.True(t, ok)
+	require.Equal(t, rpcSpan.SpanContext().SpanID, gameSpan.ParentSpanID())
+	require.Equal(t, string(client1.GameID), gameSpan.Attributes()["game_id"].AsString())
+	require.Equal(t, int32(50), gameSpan.Attributes()["value"].AsInt32())
+}
+
+func TestLogging(t *testing.T) {
+	var err error
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithLogger(zap.New(core)))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+	_, err = client1.TakeCredit(50)
+	require.NotNil(t, err)
+
+	// stream is set asynchronously
+	time.Sleep(100 * time.Millisecond)
+	streamLogs := logs.FilterMessage("Stream has been set").All()
+	require.Len(t, streamLogs, 1)
+	require.Equal(t, string(client1.GameID), streamLogs[0].ContextMap()["game_id"])
+	require.Equal(t, string(client1.UserID), streamLogs[0].ContextMap()["user_id"])
+
+	failedLogs := logs.FilterMessage("RPC failed").All()
+	require.Len(t, failedLogs, 1)
+	require.Equal(t, "/server.Game/Credit", failedLogs[0].ContextMap()["rpc"])
+	require.Equal(t, codes.InvalidArgument.String(), failedLogs[0].ContextMap()["code"])
+
+	// messages below the level of the logger are dropped
+	_, err = server.NewLogger("verbose")
+	require.NotNil(t, err)
+	logger, err := server.NewLogger("warn")
+	require.NoError(t, err)
+	require.False(t, logger.Core().Enabled(zapcore.InfoLevel))
+}
+
+func TestHealth(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	healthClient := healthpb.NewHealthClient(conn)
+	ctx := context.Background()
+
+	for _, service := range []string{"", "server.Game"} {
+		res, err := healthClient.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		require.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+	}
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	// the active game keeps the server draining until the context is done
+	shutdownCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	shutdownErr := make(chan error)
+	go func() {
+		shutdownErr <- s.Shutdown(shutdownCtx)
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+	res, err := healthClient.Check(ctx, &healthpb.HealthCheckRequest{Service: "server.Game"})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.GetStatus())
+	require.Equal(t, context.DeadlineExceeded, <-shutdownErr)
+}
+
+// writeTestCert creates the certificate signed by the parent (self-signed,
+// if parent is nil) and writes it together with its key to the directory.
+func writeTestCert(
+	t *testing.T,
+	dir string,
+	name string,
+	template *x509.Certificate,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+".pem"), certPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+"-key.pem"), keyPEM, 0600))
+	return cert, key
+}
+
+func TestTLS(t *testing.T) {
+	var err error
+
+	dir := t.TempDir()
+	caCert, caKey := writeTestCert(t, dir, "ca", &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	writeTestCert(t, dir, "server", &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "localhost"},
+		DNSNames:    []string{"localhost"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, caCert, caKey)
+	writeTestCert(t, dir, "admin", &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "admin"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, caCert, caKey)
+
+	tlsConfig, err := server.LoadTLSConfig(
+		filepath.Join(dir, "server.pem"),
+		filepath.Join(dir, "server-key.pem"),
+		filepath.Join(dir, "ca.pem"),
+	)
+	require.NoError(t, err)
+	_, err = server.LoadTLSConfig(filepath.Join(dir, "server.pem"), filepath.Join(dir, "ca-key.pem"), "")
+	require.NotNil(t, err)
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithTLS(tlsConfig))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+
+	// players connect without client certificates
+	client1 := server.NewSampleClient()
+	err = client1.ConnectTLS(addr, &tls.Config{RootCAs: roots})
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+
+	// plaintext connections are rejected
+	client2 := server.NewSampleClient()
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = client2.GameClient.Join(ctx, client2.GetJoinRequest())
+	require.NotNil(t, err)
+
+	// admin service requires client certificate, as no token is configured
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: roots})))
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = pb.NewAdminClient(conn).ListGames(context.Background(), &pb.ListGamesRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	adminCert, err := tls.LoadX509KeyPair(filepath.Join(dir, "admin.pem"), filepath.Join(dir, "admin-key.pem"))
+	require.NoError(t, err)
+	adminConn, err := grpc.Dial(addr, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		RootCAs:      roots,
+		Certificates: []tls.Certificate{adminCert},
+	})))
+	require.NoError(t, err)
+	defer adminConn.Close()
+	listRes, err := pb.NewAdminClient(adminConn).ListGames(context.Background(), &pb.ListGamesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), listRes.GetGames()[0].GetPlayerCount())
+}
+
+func TestAuth(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithAuthSecret([]byte("secret")))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	client2 := server.NewSampleClient()
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+	require.NotEmpty(t, client1.AuthToken)
+	err = client1.StartGame()
+	require.NoError(t, err)
+	_, err = client1.TakeCredit(50)
+	require.NoError(t, err)
+
+	authContext := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), server.AuthMetadataKey, "Bearer "+token)
+	}
+
+	// requests without token are rejected
+	_, err = client1.GameClient.Credit(context.Background(), client1.GetCreditRequest(50))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client1.GameClient.Credit(authContext(client1.AuthToken+"x"), client1.GetCreditRequest(50))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// player cannot act on behalf of another player
+	_, err = client1.GameClient.Deposit(authContext(client2.AuthToken), client1.GetDepositRequest(50))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	stream, err := client1.GameClient.Stream(authContext(client2.AuthToken), client1.GetStreamRequest())
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// tokens of another server are not accepted
+	s2 := server.NewServer(gameConfig)
+	addr2, err := s2.Listen("localhost:0")
+	require.NoError(t, err)
+	go s2.Launch()
+	client3 := server.NewSampleClient()
+	err = client3.Connect(addr2)
+	require.NoError(t, err)
+	_, err = client3.JoinGame()
+	require.NoError(t, err)
+	client3.AuthToken = client1.AuthToken
+	err = client3.OpenStream()
+	require.NoError(t, err)
+	_, err = client3.Stream.Recv()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestRateLimit(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150,
+		server.WithUserRateLimit(1, 3),
+		server.WithPeerRateLimit(1, 7),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	client2 := server.NewSampleClient()
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+
+	// the player exhausts its burst, but the other player is not affected
+	for i := 0; i < 3; i++ {
+		_, err = client1.GetGameState()
+		require.NoError(t, err)
+	}
+	_, err = client1.GetGameState()
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), codes.ResourceExhausted.String())
+	_, err = client2.GetGameState()
+	require.NoError(t, err)
+
+	// requests of both players and their joins come from the same address
+	_, err = client2.GetGameState()
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "address")
+
+	// tokens are refilled over time
+	time.Sleep(time.Second)
+	_, err = client2.GetGameState()
+	require.NoError(t, err)
+}
+
+// grpcWebFrame encodes the message as gRPC-Web data frame.
+func grpcWebFrame(t *testing.T, msg proto.Message) []byte {
+	data, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	frame := make([]byte, 5, 5+len(data))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	return append(frame, data...)
+}
+
+// readGRPCWebFrame reads the next frame of gRPC-Web response
+// and returns its flags and payload.
+func readGRPCWebFrame(t *testing.T, r io.Reader) (byte, []byte) {
+	header := make([]byte, 5)
+	_, err := io.ReadFull(r, header)
+	require.NoError(t, err)
+	payload := make([]byte, binary.BigEndian.Uint32(header[1:]))
+	_, err = io.ReadFull(r, payload)
+	require.NoError(t, err)
+	return header[0], payload
+}
+
+func TestGRPCWeb(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithGRPCWeb("localhost:0"))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+	time.Sleep(100 * time.Millisecond)
+
+	call := func(method string, authToken string, req proto.Message) *http.Response {
+		httpReq, err := http.NewRequest(
+			http.MethodPost,
+			"http://"+s.GRPCWebAddr()+"/server.Game/"+method,
+			bytes.NewReader(grpcWebFrame(t, req)),
+		)
+		require.NoError(t, err)
+		httpReq.Header.Set("Content-Type", "application/grpc-web+proto")
+		if authToken != "" {
+			httpReq.Header.Set(server.AuthMetadataKey, "Bearer "+authToken)
+		}
+		res, err := http.DefaultClient.Do(httpReq)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		return res
+	}
+
+	// the browser joins the game and opens the event stream
+	res := call("Join", "", &pb.JoinRequest{Username: "browser"})
+	defer res.Body.Close()
+	flags, payload := readGRPCWebFrame(t, res.Body)
+	require.Equal(t, byte(0), flags)
+	joinRes := &pb.JoinResponse{}
+	require.NoError(t, proto.Unmarshal(payload, joinRes))
+	require.NotEmpty(t, joinRes.GetAuthToken())
+
+	streamRes := call("Stream", joinRes.GetAuthToken(), &pb.StreamRequest{
+		UserId: joinRes.GetUserId(),
+		GameId: joinRes.GetGameId(),
+	})
+	defer streamRes.Body.Close()
+
+	// the native client joins the same game, which is started by the browser as the host
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, joinRes.GetGameId(), string(client1.GameID))
+
+	// events arrive to the browser as soon as they happen
+	flags, payload = readGRPCWebFrame(t, streamRes.Body)
+	require.Equal(t, byte(0), flags)
+	event := &pb.StreamResponse{}
+	require.NoError(t, proto.Unmarshal(payload, event))
+	require.NotNil(t, event.GetJoin())
+	require.Equal(t, string(client1.UserID), event.GetJoin().GetPlayer().GetUserId())
+
+	startRes := call("Start", joinRes.GetAuthToken(), &pb.StartRequest{
+		UserId: joinRes.GetUserId(),
+		GameId: joinRes.GetGameId(),
+	})
+	startRes.Body.Close()
+	flags, payload = readGRPCWebFrame(t, streamRes.Body)
+	require.Equal(t, byte(0), flags)
+	require.NoError(t, proto.Unmarshal(payload, event))
+	require.NotNil(t, event.GetStart())
+}
+
+func TestFileQuestionProvider(t *testing.T) {
+	var err error
+
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "questions.json")
+	err = ioutil.WriteFile(jsonPath, []byte(`[{
+		"question": "What is the capital of Canada?",
+		"answers": ["Toronto", "Ottawa", "Montreal", "Vancouver"],
+		"correct_answer": 2,
+		"category": "Geography",
+		"difficulty": "easy"
+	}]`), 0600)
+	require.NoError(t, err)
+
+	questions, err := server.NewFileQuestionProvider(jsonPath)
+	require.NoError(t, err)
+	require.Equal(t, 1, questions.Len())
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithQuestionProvider(questions))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	res1, err := client1.DoGenerateQuestion(100)
+	require.NoError(t, err)
+	require.Equal(t, "What is the capital of Canada?", res1.Question)
+	require.Equal(t, []string{"Toronto", "Ottawa", "Montreal", "Vancouver"}, res1.Answers)
+	res2, err := client1.DoAnswerQuestion(res1.QuestionId, 2)
+	require.NoError(t, err)
+	require.True(t, res2.AnswerIsCorrect)
+	require.Equal(t, int32(150), res2.WinPoints)
+
+	// invalid pack is rejected and previous questions are kept
+	err = ioutil.WriteFile(jsonPath, []byte(`[{"question": "No answers?", "correct_answer": 1}]`), 0600)
+	require.NoError(t, err)
+	require.NotNil(t, questions.Reload())
+	require.Equal(t, 1, questions.Len())
+
+	csvPath := filepath.Join(dir, "questions.csv")
+	err = ioutil.WriteFile(csvPath, []byte(
+		"question,answer_1,answer_2,answer_3,answer_4,correct_answer,category,difficulty\n"+
+			"2 + 2 = ?,3,4,5,22,2,Math,easy\n"+
+			"\"Largest planet, by mass?\",Earth,Mars,Saturn,Jupiter,4,Science,medium\n",
+	), 0600)
+	require.NoError(t, err)
+	csvQuestions, err := server.NewFileQuestionProvider(csvPath)
+	require.NoError(t, err)
+	require.Equal(t, 2, csvQuestions.Len())
+
+	question, err := csvQuestions.Question(context.Background(), server.QuestionFilter{})
+	require.NoError(t, err)
+	require.Contains(t, []string{"2 + 2 = ?", "Largest planet, by mass?"}, question.Text)
+	require.Len(t, question.Answers, 4)
+
+	_, err = server.NewFileQuestionProvider(filepath.Join(dir, "questions.txt"))
+	require.NotNil(t, err)
+}
+
+func TestOpenTriviaDBProvider(t *testing.T) {
+	b64 := base64.StdEncoding.EncodeToString
+	requests := 0
+	available := true
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// the first request fails to check that it is retried
+		if !available || requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		require.Equal(t, "base64", r.URL.Query().Get("encode"))
+		fmt.Fprintf(w, `{"response_code": 0, "results": [
+			{"category": "%s", "difficulty": "%s", "question": "%s", "correct_answer": "%s", "incorrect_answers": ["%s", "%s", "%s"]},
+			{"category": "%s", "difficulty": "%s", "question": "%s", "correct_answer": "%s", "incorrect_answers": ["%s", "%s", "%s"]}
+		]}`,
+			b64([]byte("Geography")), b64([]byte("easy")), b64([]byte("Capital of France?")),
+			b64([]byte("Paris")), b64([]byte("Lyon")), b64([]byte("Nice")), b64([]byte("Marseille")),
+			b64([]byte("Math")), b64([]byte("easy")), b64([]byte("2 + 2 = ?")),
+			b64([]byte("4")), b64([]byte("3")), b64([]byte("5")), b64([]byte("22")),
+		)
+	}))
+	defer api.Close()
+
+	fallback := server.NewOpenTriviaDBProvider(api.URL, nil)
+	provider := server.NewOpenTriviaDBProvider(api.URL, fallback)
+	ctx := context.Background()
+
+	// both questions are fetched at once and asked only once
+	question1, err := provider.Question(ctx, server.QuestionFilter{})
+	require.NoError(t, err)
+	question2, err := provider.Question(ctx, server.QuestionFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+	require.ElementsMatch(t, []string{"Capital of France?", "2 + 2 = ?"}, []string{question1.Text, question2.Text})
+	for _, question := range []server.Question{question1, question2} {
+		require.Len(t, question.Answers, 4)
+		correctAnswer := question.Answers[question.CorrectAnswer-1]
+		require.Contains(t, []string{"Paris", "4"}, correctAnswer)
+	}
+
+	// fallback is used, when the API is unavailable; the fallback itself
+	// falls back to built-in questions, as it shares the same API
+	available = false
+	question3, err := provider.Question(ctx, server.QuestionFilter{})
+	require.NoError(t, err)
+	require.NotEmpty(t, question3.Text)
+	require.Len(t, question3.Answers, 4)
+	requestsAfterFailure := requests
+
+	// the unavailable API is not requested again for a while
+	_, err = provider.Question(ctx, server.QuestionFilter{})
+	require.NoError(t, err)
+	require.Equal(t, requestsAfterFailure, requests)
+}
+
+func TestQuestionDifficulty(t *testing.T) {
+	var err error
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "questions.csv")
+	err = ioutil.WriteFile(path, []byte(
+		"question,answer_1,answer_2,answer_3,answer_4,correct_answer,category,difficulty\n"+
+			"2 + 2 = ?,3,4,5,22,2,Math,easy\n"+
+			"Square root of 1764?,38,40,42,44,3,Math,hard\n"+
+			"Capital of Australia?,Sydney,Canberra,Melbourne,Perth,2,Geography,medium\n",
+	), 0600)
+	require.NoError(t, err)
+	questions, err := server.NewFileQuestionProvider(path)
+	require.NoError(t, err)
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 50)
+	s := server.NewServer(gameConfig, server.WithQuestionProvider(questions))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	// win points are multiplied by 1, 1.5, and 2 for easy, medium, and hard questions
+	for _, tc := range []struct {
+		category   string
+		difficulty string
+		question   string
+		answer     int32
+		winPoints  int32
+	}{
+		{"math", "easy", "2 + 2 = ?", 2, 10},
+		{"math", "hard", "Square root of 1764?", 3, 20},
+		{"", "medium", "Capital of Australia?", 2, 15},
+	} {
+		res1, err := client1.DoGenerateQuestionWithFilter(20, tc.category, tc.difficulty)
+		require.NoError(t, err)
+		require.Equal(t, tc.question, res1.Question)
+		require.Equal(t, tc.difficulty, res1.Difficulty)
+		res2, err := client1.DoAnswerQuestion(res1.QuestionId, tc.answer)
+		require.NoError(t, err)
+		require.Equal(t, tc.winPoints, res2.WinPoints)
+	}
+
+	_, err = client1.DoGenerateQuestionWithFilter(20, "geography", "hard")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), codes.NotFound.String())
+	_, err = client1.DoGenerateQuestionWithFilter(20, "", "impossible")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), codes.InvalidArgument.String())
+}
+
+func TestQuestionLocalization(t *testing.T) {
+	var err error
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "questions.csv")
+	err = ioutil.WriteFile(path, []byte(
+		"question,answer_1,answer_2,answer_3,answer_4,correct_answer,category,difficulty,"+
+			"question_fr,answer_1_fr,answer_2_fr,answer_3_fr,answer_4_fr\n"+
+			"Which color is the sky?,Green,Blue,Red,Yellow,2,Nature,easy,"+
+			"De quelle couleur est le ciel ?,Vert,Bleu,Rouge,Jaune\n",
+	), 0600)
+	require.NoError(t, err)
+	questions, err := server.NewFileQuestionProvider(path)
+	require.NoError(t, err)
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithQuestionProvider(questions))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	// region falls back to the language
+	client1 := server.NewSampleClient()
+	client1.Locale = "fr-CA"
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	client2 := server.NewSampleClient()
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	res1, err := client1.DoGenerateQuestion(10)
+	require.NoError(t, err)
+	require.Equal(t, "De quelle couleur est le ciel ?", res1.Question)
+	require.Equal(t, []string{"Vert", "Bleu", "Rouge", "Jaune"}, res1.Answers)
+	res2, err := client1.DoAnswerQuestion(res1.QuestionId, 2)
+	require.NoError(t, err)
+	require.True(t, res2.AnswerIsCorrect)
+
+	res3, err := client2.DoGenerateQuestion(10)
+	require.NoError(t, err)
+	require.Equal(t, "Which color is the sky?", res3.Question)
+
+	// locale of the request overrides the locale of the player,
+	// missing translation falls back to the default language
+	req := client1.GetGenerateQuestionRequest(10)
+	req.Locale = "de"
+	res4, err := client1.GameClient.GenerateQuestion(
+		metadata.AppendToOutgoingContext(context.Background(), server.AuthMetadataKey, "Bearer "+client1.AuthToken),
+		req,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "Which color is the sky?", res4.Question)
+}
+
+func TestQuestionNoRepeat(t *testing.T) {
+	var err error
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "questions.json")
+	err = ioutil.WriteFile(path, []byte(`[
+		{"id": "q1", "question": "Question 1", "answers": ["a", "b", "c", "d"], "correct_answer": 1},
+		{"id": "q2", "question": "Question 2", "answers": ["a", "b", "c", "d"], "correct_answer": 1},
+		{"id": "q3", "question": "Question 3", "answers": ["a", "b", "c", "d"], "correct_answer": 1}
+	]`), 0600)
+	require.NoError(t, err)
+	questions, err := server.NewFileQuestionProvider(path)
+	require.NoError(t, err)
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithQuestionProvider(questions))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	client2 := server.NewSampleClient()
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	// questions are not repeated within the game, even for different players
+	asked := make(map[string]bool)
+	for _, client := range []*server.SampleClient{client1, client2, client1} {
+		res, err := client.DoGenerateQuestion(1)
+		require.NoError(t, err)
+		require.False(t, asked[res.Question])
+		asked[res.Question] = true
+	}
+
+	// questions are repeated, once all of them have been asked
+	_, err = client2.DoGenerateQuestion(1)
+	require.NoError(t, err)
+}
+
+func TestQuestionTimeout(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(10, 200, 400, 30, 20, 5, 5, 8, 15, 2, 150, 150, server.WithQuestionTime(1))
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(1), joinRes.QuestionTime)
+	err = client.OpenStream()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	questionRes, err := client.DoGenerateQuestion(10)
+	require.NoError(t, err)
+
+	// the game finishes later than the question expires, so the
+	// timeout event has to be received before the finish event
+	var timeout *pb.StreamResponse_Transaction_QuestionTimeout
+	for timeout == nil {
+		res, err := client.Stream.Recv()
+		require.NoError(t, err)
+		require.Nil(t, res.GetFinish())
+		timeout = res.GetTransaction().GetQuestionTimeout()
+	}
+	require.Equal(t, string(client.UserID), timeout.UserId)
+	require.Equal(t, int32(10), timeout.BidPoints)
+
+	// bid stays in the bank and the question cannot be answered anymore
+	ctx := metadata.AppendToOutgoingContext(context.Background(), server.AuthMetadataKey, "Bearer "+client.AuthToken)
+	_, err = client.GameClient.AnswerQuestion(ctx, &pb.AnswerQuestionRequest{
+		UserId:     string(client.UserID),
+		GameId:     string(client.GameID),
+		QuestionId: questionRes.QuestionId,
+		Answer:     1,
+	})
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	for _, player := range stateRes.State.Players {
+		if player.UserId == string(client.UserID) {
+			require.Equal(t, int32(190), player.Points)
+		}
+	}
+}
+
+func TestQuestionMaxBid(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 2, 150, 150, server.WithQuestionMaxBid(50))
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(50), joinRes.QuestionMaxBidPercentage)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	// cap is relative to the current points of the player
+	_, err = client.DoGenerateQuestion(101)
+	require.NotNil(t, err)
+	_, err = client.DoGenerateQuestion(100)
+	require.NoError(t, err)
+	_, err = client.DoGenerateQuestion(51)
+	require.NotNil(t, err)
+	_, err = client.DoGenerateQuestion(50)
+	require.NoError(t, err)
+}
+
+func TestLotteryGrid(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 1, 150, 150, server.WithLotteryGrid(2, 4))
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(2), joinRes.LotteryRows)
+	require.Equal(t, int32(4), joinRes.LotteryColumns)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	// cell index is checked against the configured grid
+	_, err = client.PlayLottery(9)
+	require.NotNil(t, err)
+
+	time.Sleep(1 * time.Second)
+
+	res, err := client.PlayLottery(8)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+	require.Len(t, res.CellValues, 8)
+	require.Contains(t, res.CellValues, int32(150))
+}
+
+func TestLotteryPayouts(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 10, 10, 25, 15, 1, 100, 150,
+		server.WithLotteryPayouts(0, 0, 0, 100),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, []int32{0, 0, 0, 100}, joinRes.LotteryPayouts)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	time.Sleep(1 * time.Second)
+
+	// 3 of 4 payouts are zero, so only the last cell wins
+	res, err := client.PlayLottery(1)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+	values := append([]int32{}, res.CellValues...)
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	require.Equal(t, []int32{0, 0, 0, 0, 0, 0, 0, 0, 100}, values)
+}
+
+func TestLotteryJackpot(t *testing.T) {
+	var err error
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "questions.csv")
+	err = ioutil.WriteFile(path, []byte(
+		"question,answer_1,answer_2,answer_3,answer_4,correct_answer,category,difficulty\n"+
+			"2 + 2 = ?,3,4,5,22,2,Math,easy\n",
+	), 0600)
+	require.NoError(t, err)
+	questions, err := server.NewFileQuestionProvider(path)
+	require.NoError(t, err)
+
+	// lottery never wins anything, but the jackpot is always won
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 10, 10, 25, 15, 1, 100, 150,
+		server.WithLotteryPayouts(0),
+		server.WithJackpot(20, 100),
+	)
+	s := server.NewServer(gameConfig, server.WithQuestionProvider(questions))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(20), joinRes.JackpotPercentage)
+	require.Equal(t, int32(100), joinRes.JackpotChance)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	// 20 percent of the lost bid goes to the jackpot
+	questionRes, err := client.DoGenerateQuestion(50)
+	require.NoError(t, err)
+	answerRes, err := client.DoAnswerQuestion(questionRes.QuestionId, 1)
+	require.NoError(t, err)
+	require.False(t, answerRes.AnswerIsCorrect)
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(10), stateRes.State.Jackpot)
+
+	time.Sleep(1 * time.Second)
+
+	// lost play adds 20 percent of the max win before the jackpot is won
+	res, err := client.PlayLottery(1)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+	require.True(t, res.JackpotWon)
+	require.Equal(t, int32(30), res.WinPoints)
+
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(0), stateRes.State.Jackpot)
+	for _, player := range stateRes.State.Players {
+		if player.UserId == string(client.UserID) {
+			require.Equal(t, int32(180), player.Points)
+		}
+	}
+}
+
+func TestRepayCredit(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	_, err = client.RepayCredit("unknown", 0)
+	require.NotNil(t, err)
+
+	creditRes, err := client.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, creditRes.Success)
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Len(t, stateRes.Credits, 1)
+	positionID := stateRes.Credits[0].PositionId
+
+	// player has 80 points, which is not enough to repay
+	_, err = client.TakeDeposit(220)
+	require.NoError(t, err)
+	repayRes, err := client.RepayCredit(positionID, 0)
+	require.NoError(t, err)
+	require.False(t, repayRes.Success)
+	require.NotEmpty(t, repayRes.Explanation)
+
+	// only a small part of the interest has accrued so far
+	_, err = client.TakeCredit(60)
+	require.NoError(t, err)
+	repayRes, err = client.RepayCredit(positionID, 0)
+	require.NoError(t, err)
+	require.True(t, repayRes.Success)
+	require.LessOrEqual(t, int32(100), repayRes.Value)
+	require.Less(t, repayRes.Value, int32(130))
+
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Len(t, stateRes.Credits, 1)
+	require.NotEqual(t, positionID, stateRes.Credits[0].PositionId)
+	for _, player := range stateRes.State.Players {
+		if player.UserId == string(client.UserID) {
+			require.Equal(t, 140-repayRes.Value, player.Points)
+		}
+	}
+
+	_, err = client.RepayCredit(positionID, 0)
+	require.NotNil(t, err)
+}
+
+func TestWithdrawDeposit(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 2, 150, 150, server.WithDepositPenalty(10))
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(10), joinRes.DepositPenaltyPercentage)
+	err = client.OpenStream()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	_, err = client.WithdrawDeposit("unknown", 0)
+	require.NotNil(t, err)
+
+	depositRes, err := client.TakeDeposit(100)
+	require.NoError(t, err)
+	require.True(t, depositRes.Success)
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Len(t, stateRes.Deposits, 1)
+	positionID := stateRes.Deposits[0].PositionId
+
+	withdrawRes, err := client.WithdrawDeposit(positionID, 0)
+	require.NoError(t, err)
+	require.Equal(t, int32(90), withdrawRes.Value)
+	require.Equal(t, int32(10), withdrawRes.Penalty)
+
+	var withdrawal *pb.StreamResponse_Transaction_WithdrawDeposit
+	for withdrawal == nil {
+		res, err := client.Stream.Recv()
+		require.NoError(t, err)
+		withdrawal = res.GetTransaction().GetWithdrawDeposit()
+	}
+	require.Equal(t, string(client.UserID), withdrawal.UserId)
+	require.Equal(t, int32(90), withdrawal.Value)
+
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Empty(t, stateRes.Deposits)
+	for _, player := range stateRes.State.Players {
+		if player.UserId == string(client.UserID) {
+			require.Equal(t, int32(190), player.Points)
+		}
+	}
+
+	_, err = client.WithdrawDeposit(positionID, 0)
+	require.NotNil(t, err)
+}
+
+func TestPartialRepayAndWithdraw(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 2, 150, 150, server.WithDepositPenalty(10))
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	_, err = client.TakeCredit(100)
+	require.NoError(t, err)
+	_, err = client.TakeDeposit(100)
+	require.NoError(t, err)
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	creditID := stateRes.Credits[0].PositionId
+	depositID := stateRes.Deposits[0].PositionId
+
+	// part cannot exceed the value of the position
+	_, err = client.RepayCredit(creditID, 101)
+	require.NotNil(t, err)
+	_, err = client.WithdrawDeposit(depositID, 101)
+	require.NotNil(t, err)
+
+	repayRes, err := client.RepayCredit(creditID, 40)
+	require.NoError(t, err)
+	require.True(t, repayRes.Success)
+	require.LessOrEqual(t, int32(40), repayRes.Value)
+	require.Less(t, repayRes.Value, int32(52))
+	require.Equal(t, int32(60), repayRes.RemainingValue)
+
+	withdrawRes, err := client.WithdrawDeposit(depositID, 30)
+	require.NoError(t, err)
+	require.Equal(t, int32(27), withdrawRes.Value)
+	require.Equal(t, int32(3), withdrawRes.Penalty)
+	require.Equal(t, int32(70), withdrawRes.RemainingValue)
+
+	// interest at the end of the time is charged on the remaining values
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Len(t, stateRes.Credits, 1)
+	require.Equal(t, int32(60), stateRes.Credits[0].Value)
+	require.Equal(t, int32(78), stateRes.Credits[0].ValueWithInterest)
+	require.Len(t, stateRes.Deposits, 1)
+	require.Equal(t, int32(70), stateRes.Deposits[0].Value)
+	require.Equal(t, int32(84), stateRes.Deposits[0].ValueWithInterest)
+
+	repayRes, err = client.RepayCredit(creditID, 0)
+	require.NoError(t, err)
+	require.True(t, repayRes.Success)
+	require.Equal(t, int32(0), repayRes.RemainingValue)
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Empty(t, stateRes.Credits)
+}
+
+func TestCompoundInterest(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		10, 200, 400, 30, 20, 2, 2, 8, 15, 2, 150, 150,
+		server.WithInterestMode(server.CompoundInterest, 10),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, pb.InterestMode_INTEREST_MODE_COMPOUND, joinRes.InterestMode)
+	require.Equal(t, int32(10), joinRes.InterestTicks)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	_, err = client.TakeCredit(100)
+	require.NoError(t, err)
+	_, err = client.TakeDeposit(100)
+	require.NoError(t, err)
+
+	// 100 * (1 + 0.3 / 10) ^ 10 and 100 * (1 + 0.2 / 10) ^ 10 rounded up
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(135), stateRes.Credits[0].ValueWithInterest)
+	require.Equal(t, int32(122), stateRes.Deposits[0].ValueWithInterest)
+
+	time.Sleep(3 * time.Second)
+
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Empty(t, stateRes.Credits)
+	require.Empty(t, stateRes.Deposits)
+	for _, player := range stateRes.State.Players {
+		if player.UserId == string(client.UserID) {
+			require.Equal(t, int32(187), player.Points)
+		}
+	}
+}
+
+func TestCreditLimit(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 1000, 30, 20, 20, 20, 25, 15, 2, 150, 150,
+		server.WithCreditLimit(150),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	// net worth of the player is 200, so the limit is 300
+	creditRes, err := client.TakeCredit(200)
+	require.NoError(t, err)
+	require.True(t, creditRes.Success)
+	require.Equal(t, int32(300), creditRes.CreditLimit)
+	require.Equal(t, int32(200), creditRes.CreditUsed)
+	require.Equal(t, "credit limit is 300, 200 (67%) of it is used", creditRes.Explanation)
+
+	// credit doesn't change the net worth, so the limit stays the same
+	creditRes, err = client.TakeCredit(150)
+	require.NoError(t, err)
+	require.False(t, creditRes.Success)
+	require.Equal(t, int32(300), creditRes.CreditLimit)
+	require.Equal(t, int32(200), creditRes.CreditUsed)
+	require.Equal(t, "asking for too much money: credit limit is 300, 200 (67%) of it is used", creditRes.Explanation)
+
+	creditRes, err = client.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, creditRes.Success)
+	require.Equal(t, int32(300), creditRes.CreditUsed)
+}
+
+func TestErrorDetails(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 1000, 30, 20, 20, 20, 25, 15, 10, 150, 150,
+		server.WithCreditLimit(150),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	// denied actions have the reason, and the temporary ones the retry delay
+	creditRes, err := client.TakeCredit(400)
+	require.NoError(t, err)
+	require.False(t, creditRes.Success)
+	require.Equal(t, string(server.ReasonCreditLimitExceeded), creditRes.Reason)
+	require.Zero(t, creditRes.RetryAfter)
+
+	// lottery time starts with the game
+	lotteryRes, err := client.PlayLottery(1)
+	require.NoError(t, err)
+	require.False(t, lotteryRes.Success)
+	require.Equal(t, string(server.ReasonLotteryOnCooldown), lotteryRes.Reason)
+	require.True(t, lotteryRes.RetryAfter > 0 && lotteryRes.RetryAfter <= 10000)
+	require.NotEmpty(t, lotteryRes.Explanation)
+
+	// status errors have the ErrorInfo details
+	getErrorInfo := func(err error) *errdetails.ErrorInfo {
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok {
+				require.Equal(t, server.ErrorDomain, info.Domain)
+				return info
+			}
+		}
+		t.Fatalf("error has no ErrorInfo details: %v", err)
+		return nil
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), server.AuthMetadataKey, "Bearer "+client.AuthToken)
+	_, err = client.GameClient.Credit(ctx, &pb.CreditRequest{
+		GameId: string(client.GameID),
+		UserId: string(client.UserID),
+		Value:  0,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, string(server.ReasonInvalidRequest), getErrorInfo(err).Reason)
+
+	_, err = client.GameClient.JoinRoom(context.Background(), &pb.JoinRoomRequest{
+		GameId:   "unknown",
+		Username: "Silas",
+	})
+	require.Equal(t, string(server.ReasonGameNotFound), getErrorInfo(err).Reason)
+
+	// errors without a specific reason are named after their code
+	_, err = client.GameClient.RepayCredit(ctx, &pb.RepayCreditRequest{
+		GameId:     string(client.GameID),
+		UserId:     string(client.UserID),
+		PositionId: "unknown",
+	})
+	require.Equal(t, "INVALID_ARGUMENT", getErrorInfo(err).Reason)
+}
+
+func TestLocalizedExplanations(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 1000, 30, 20, 20, 20, 25, 15, 10, 150, 150,
+		server.WithCreditLimit(150),
+	)
+	s := server.NewServer(gameConfig, server.WithMessages("fr", map[string]string{
+		"player can play the lottery again in %d seconds": "le joueur peut rejouer à la loterie dans %d secondes",
+	}))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	// language of the locale is used, if there is no translation for the locale
+	russian := server.NewSampleClient()
+	russian.Locale = "ru-RU"
+	err = russian.Connect(addr)
+	require.NoError(t, err)
+	_, err = russian.JoinGame()
+	require.NoError(t, err)
+
+	french := server.NewSampleClient()
+	french.Locale = "fr"
+	err = french.Connect(addr)
+	require.NoError(t, err)
+	_, err = french.JoinGame()
+	require.NoError(t, err)
+
+	english := server.NewSampleClient()
+	err = english.Connect(addr)
+	require.NoError(t, err)
+	_, err = english.JoinGame()
+	require.NoError(t, err)
+	err = russian.StartGame()
+	require.NoError(t, err)
+
+	creditRes, err := russian.TakeCredit(400)
+	require.NoError(t, err)
+	require.Equal(t, "запрошено слишком много: кредитный лимит 300, использовано 0 (0%)", creditRes.Explanation)
+	require.Equal(t, string(server.ReasonCreditLimitExceeded), creditRes.Reason)
+	creditRes, err = russian.TakeCredit(150)
+	require.NoError(t, err)
+	require.Equal(t, "кредитный лимит 300, использовано 150 (50%)", creditRes.Explanation)
+	depositRes, err := russian.TakeDeposit(1000)
+	require.NoError(t, err)
+	require.Equal(t, "нельзя внести на депозит больше, чем есть у игрока", depositRes.Explanation)
+
+	// custom translations are used for their formats only
+	lotteryRes, err := french.PlayLottery(1)
+	require.NoError(t, err)
+	require.Regexp(t, `^le joueur peut rejouer à la loterie dans \d+ secondes$`, lotteryRes.Explanation)
+	creditRes, err = french.TakeCredit(400)
+	require.NoError(t, err)
+	require.Equal(t, "asking for too much money: credit limit is 300, 0 (0%) of it is used", creditRes.Explanation)
+
+	lotteryRes, err = english.PlayLottery(1)
+	require.NoError(t, err)
+	require.Regexp(t, `^player can play the lottery again in \d+ seconds$`, lotteryRes.Explanation)
+}
+
+func TestUsernameRules(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	for _, name := range []string{"", " Rhea", "Rhea!", "Bartholomew Montgomery"} {
+		_, err = client.GameClient.Join(context.Background(), &pb.JoinRequest{Username: name})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), name)
+		require.Contains(t, err.Error(), "invalid username")
+	}
+
+	// taken usernames are suffixed with the first free number
+	rhea := server.NewSampleClient()
+	rhea.Username = "Rhea"
+	err = rhea.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := rhea.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, "Rhea", joinRes.Username)
+
+	for _, suffixed := range []string{"Rhea 2", "Rhea 3"} {
+		other := server.NewSampleClient()
+		other.Username = "Rhea"
+		err = other.Connect(addr)
+		require.NoError(t, err)
+		joinRes, err = other.JoinGame()
+		require.NoError(t, err)
+		require.Equal(t, suffixed, joinRes.Username)
+		require.Equal(t, suffixed, string(other.Username))
+	}
+
+	// suffix is fitted into the max length
+	long := server.NewSampleClient()
+	long.Username = "Bartholomew Tamsin Q"
+	err = long.Connect(addr)
+	require.NoError(t, err)
+	_, err = long.JoinGame()
+	require.NoError(t, err)
+	long.Username = "Bartholomew Tamsin Q"
+	joinRes, err = long.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, "Bartholomew Tamsin 2", joinRes.Username)
+	// the space is not left before the suffix
+	long.Username = "Bartholomew Tamsi Q"
+	_, err = long.JoinGame()
+	require.NoError(t, err)
+	joinRes, err = long.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, "Bartholomew Tamsi 2", joinRes.Username)
+
+	// taken usernames are rejected, if the config says so
+	gameConfig = server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithUsernameRules(4, 10, server.RejectUsernames),
+	)
+	s = server.NewServer(gameConfig)
+	addr, err = s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client = server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.GameClient.Join(context.Background(), &pb.JoinRequest{Username: "Tamsin"})
+	require.NoError(t, err)
+	_, err = client.GameClient.Join(context.Background(), &pb.JoinRequest{Username: "Tamsin"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = client.GameClient.Join(context.Background(), &pb.JoinRequest{Username: "Tam"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// taken usernames are rejected, if no suffix fits into the max length
+	gameConfig = server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithUsernameRules(1, 1, server.SuffixUsernames),
+	)
+	s = server.NewServer(gameConfig)
+	addr, err = s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client = server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.GameClient.Join(context.Background(), &pb.JoinRequest{Username: "a"})
+	require.NoError(t, err)
+	_, err = client.GameClient.Join(context.Background(), &pb.JoinRequest{Username: "a"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	err = server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithUsernameRules(10, 5, server.SuffixUsernames),
+	).Validate()
+	require.Error(t, err)
+}
+
+func TestCreditScore(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 1000, 30, 20, 1, 20, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	getCreditScore := func(res *pb.GetGameStateResponse) int32 {
+		for _, player := range res.State.Players {
+			if player.UserId == string(client.UserID) {
+				return player.CreditScore
+			}
+		}
+		return -1
+	}
+
+	// players start with the neutral score, which gets interest from config
+	creditRes, err := client.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, creditRes.Success)
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(50), getCreditScore(stateRes))
+	require.Len(t, stateRes.Credits, 1)
+	require.Equal(t, int32(30), stateRes.Credits[0].Interest)
+
+	// returned credit raises the score and lowers the interest
+	repayRes, err := client.RepayCredit(stateRes.Credits[0].PositionId, 0)
+	require.NoError(t, err)
+	require.True(t, repayRes.Success)
+	creditRes, err = client.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, creditRes.Success)
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(60), getCreditScore(stateRes))
+	require.Len(t, stateRes.Credits, 1)
+	require.Equal(t, int32(27), stateRes.Credits[0].Interest)
+
+	// player cannot afford to return the credit, when its time ends
+	depositRes, err := client.TakeDeposit(250)
+	require.NoError(t, err)
+	require.True(t, depositRes.Success)
+	time.Sleep(1500 * time.Millisecond)
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(35), getCreditScore(stateRes))
+	require.Empty(t, stateRes.Credits)
+}
+
+func TestLoan(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	lender := server.NewSampleClient()
+	err = lender.Connect(addr)
+	require.NoError(t, err)
+	_, err = lender.JoinGame()
+	require.NoError(t, err)
+	borrower := server.NewSampleClient()
+	err = borrower.Connect(addr)
+	require.NoError(t, err)
+	_, err = borrower.JoinGame()
+	require.NoError(t, err)
+	err = lender.StartGame()
+	require.NoError(t, err)
+
+	getPlayer := func(client *server.SampleClient) *pb.Player {
+		stateRes, err := lender.GetGameState()
+		require.NoError(t, err)
+		for _, player := range stateRes.State.Players {
+			if player.UserId == string(client.UserID) {
+				return player
+			}
+		}
+		return nil
+	}
+
+	_, err = lender.OfferLoan(string(lender.UserID), 100, 10, 1)
+	require.NotNil(t, err)
+	_, err = lender.OfferLoan(string(borrower.UserID), 300, 10, 1)
+	require.NotNil(t, err)
+
+	// loan is repaid in full, when its time ends
+	offerRes, err := lender.OfferLoan(string(borrower.UserID), 100, 10, 1)
+	require.NoError(t, err)
+	_, err = lender.AcceptLoan(offerRes.LoanId)
+	require.NotNil(t, err)
+	acceptRes, err := borrower.AcceptLoan(offerRes.LoanId)
+	require.NoError(t, err)
+	require.True(t, acceptRes.Success)
+	require.Equal(t, int32(100), getPlayer(lender).Points)
+	require.Equal(t, int32(300), getPlayer(borrower).Points)
+
+	time.Sleep(1500 * time.Millisecond)
+	require.Equal(t, int32(210), getPlayer(lender).Points)
+	require.Equal(t, int32(190), getPlayer(borrower).Points)
+	require.Equal(t, int32(60), getPlayer(borrower).CreditScore)
+	stateRes, err := borrower.GetGameState()
+	require.NoError(t, err)
+	require.Empty(t, stateRes.Loans)
+
+	// borrower cannot afford the loan, so it moves to collections
+	offerRes, err = lender.OfferLoan(string(borrower.UserID), 100, 50, 1)
+	require.NoError(t, err)
+	acceptRes, err = borrower.AcceptLoan(offerRes.LoanId)
+	require.NoError(t, err)
+	require.True(t, acceptRes.Success)
+	depositRes, err := borrower.TakeDeposit(280)
+	require.NoError(t, err)
+	require.True(t, depositRes.Success)
+
+	time.Sleep(1500 * time.Millisecond)
+	require.Equal(t, int32(120), getPlayer(lender).Points)
+	require.Equal(t, int32(0), getPlayer(borrower).Points)
+	require.Equal(t, int32(35), getPlayer(borrower).CreditScore)
+	stateRes, err = lender.GetGameState()
+	require.NoError(t, err)
+	require.Len(t, stateRes.Loans, 1)
+	require.Equal(t, pb.LoanStatus_LOAN_STATUS_COLLECTIONS, stateRes.Loans[0].Status)
+	require.Equal(t, int32(140), stateRes.Loans[0].Due)
+}
+
+func TestMarket(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 2, 150, 150,
+		server.WithMarket([]string{"gold", "oil"}, 5),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	seller := server.NewSampleClient()
+	err = seller.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := seller.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, []string{"gold", "oil"}, joinRes.MarketAssets)
+	buyer := server.NewSampleClient()
+	err = buyer.Connect(addr)
+	require.NoError(t, err)
+	_, err = buyer.JoinGame()
+	require.NoError(t, err)
+	err = seller.StartGame()
+	require.NoError(t, err)
+
+	_, err = seller.PlaceOrder("silver", pb.OrderSide_ORDER_SIDE_SELL, 1, 20)
+	require.NotNil(t, err)
+	_, err = seller.PlaceOrder("gold", pb.OrderSide_ORDER_SIDE_SELL, 6, 20)
+	require.NotNil(t, err)
+
+	placeRes, err := seller.PlaceOrder("gold", pb.OrderSide_ORDER_SIDE_SELL, 3, 20)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), placeRes.FilledQuantity)
+	require.Equal(t, int32(3), placeRes.RemainingQuantity)
+
+	// the trade is settled at the price of the open order
+	placeRes, err = buyer.PlaceOrder("gold", pb.OrderSide_ORDER_SIDE_BUY, 5, 25)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), placeRes.FilledQuantity)
+	require.Equal(t, int32(2), placeRes.RemainingQuantity)
+	bidID := placeRes.OrderId
+
+	marketRes, err := buyer.GetMarket()
+	require.NoError(t, err)
+	require.Len(t, marketRes.Books, 2)
+	gold := marketRes.Books[0]
+	require.Equal(t, "gold", gold.Asset)
+	require.Equal(t, int32(20), gold.LastPrice)
+	require.Empty(t, gold.Asks)
+	require.Len(t, gold.Bids, 1)
+	require.Equal(t, int32(2), gold.Bids[0].Quantity)
+	require.Equal(t, int32(25), gold.Bids[0].Price)
+	require.Equal(t, int32(8), marketRes.Holdings[0].Quantity)
+	require.Equal(t, int32(5), marketRes.Holdings[1].Quantity)
+
+	stateRes, err := buyer.GetGameState()
+	require.NoError(t, err)
+	for _, player := range stateRes.State.Players {
+		switch player.UserId {
+		case string(buyer.UserID):
+			require.Equal(t, int32(140), player.Points)
+		case string(seller.UserID):
+			require.Equal(t, int32(260), player.Points)
+		}
+	}
+
+	err = seller.CancelOrder(bidID)
+	require.NotNil(t, err)
+	err = buyer.CancelOrder(bidID)
+	require.NoError(t, err)
+	marketRes, err = buyer.GetMarket()
+	require.NoError(t, err)
+	require.Empty(t, marketRes.Books[0].Bids)
+}
+
+func TestStock(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 2, 150, 150,
+		server.WithStock(10, 0, 1),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(10), joinRes.StockPrice)
+	err = client.OpenStream()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	_, err = client.BuyShares(0)
+	require.NotNil(t, err)
+	buyRes, err := client.BuyShares(21)
+	require.NoError(t, err)
+	require.False(t, buyRes.Success)
+
+	buyRes, err = client.BuyShares(5)
+	require.NoError(t, err)
+	require.True(t, buyRes.Success)
+	require.Equal(t, int32(10), buyRes.Price)
+	require.Equal(t, int32(50), buyRes.Value)
+
+	sellRes, err := client.SellShares(6)
+	require.NoError(t, err)
+	require.False(t, sellRes.Success)
+	sellRes, err = client.SellShares(2)
+	require.NoError(t, err)
+	require.True(t, sellRes.Success)
+	require.Equal(t, int32(20), sellRes.Value)
+
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(3), stateRes.Shares)
+	require.Equal(t, int32(10), stateRes.State.StockPrice)
+	for _, player := range stateRes.State.Players {
+		if player.UserId == string(client.UserID) {
+			require.Equal(t, int32(170), player.Points)
+		}
+	}
+
+	// price doesn't change without volatility, but it is still broadcast
+	for {
+		streamRes, err := client.Stream.Recv()
+		require.NoError(t, err)
+		if tick := streamRes.GetStockTick(); tick != nil {
+			require.Equal(t, int32(10), tick.Price)
+			require.Equal(t, int32(0), tick.Change)
+			break
+		}
+	}
+}
+
+func TestTheftInsurance(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 1, 50, 20, 150, 150,
+		server.WithInsurance(20, 50),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(20), joinRes.InsurancePremium)
+	require.Equal(t, int32(50), joinRes.InsuranceCoveragePercentage)
+	err = client.OpenStream()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	insuranceRes, err := client.BuyInsurance()
+	require.NoError(t, err)
+	require.True(t, insuranceRes.Success)
+	require.Equal(t, int32(20), insuranceRes.Premium)
+	insuranceRes, err = client.BuyInsurance()
+	require.NoError(t, err)
+	require.False(t, insuranceRes.Success)
+
+	// 90 of 180 points are stolen, and half of them is paid back
+	for {
+		streamRes, err := client.Stream.Recv()
+		require.NoError(t, err)
+		if theft := streamRes.GetTransaction().GetTheft(); theft != nil {
+			require.Len(t, theft.RobbedPlayers, 1)
+			require.Equal(t, int32(90), theft.RobbedPlayers[0].Value)
+			require.Equal(t, int32(45), theft.RobbedPlayers[0].InsurancePayout)
+			break
+		}
+	}
+
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.False(t, stateRes.Insured)
+	for _, player := range stateRes.State.Players {
+		if player.UserId == string(client.UserID) {
+			require.Equal(t, int32(135), player.Points)
+		}
+	}
+}
+
+func TestWealthTax(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithTax(1, []server.TaxBracket{
+			{Threshold: 0, Percentage: 10},
+			{Threshold: 150, Percentage: 50},
+		}),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(1), joinRes.TaxTime)
+	require.Len(t, joinRes.TaxBrackets, 2)
+	err = client.OpenStream()
+	require.NoError(t, err)
+
+	// tax brackets have to be sorted by their thresholds
+	err = client.StartGameWithConfig(&pb.GameConfig{TaxBrackets: []*pb.TaxBracket{
+		{Threshold: 150, Percentage: 50},
+		{Threshold: 0, Percentage: 10},
+	}})
+	require.NotNil(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	// 10% of 150 points and 50% of the other 50 points
+	for {
+		streamRes, err := client.Stream.Recv()
+		require.NoError(t, err)
+		if tax := streamRes.GetTransaction().GetTax(); tax != nil {
+			require.Len(t, tax.TaxedPlayers, 1)
+			require.Equal(t, int32(40), tax.TaxedPlayers[0].Value)
+			break
+		}
+	}
+}
+
+func TestInflation(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithInflation(50, 1),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(50), joinRes.InflationRate)
+	require.Equal(t, int32(1), joinRes.InflationTime)
+	err = client.OpenStream()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(100), stateRes.State.PriceLevel)
+
+	for {
+		streamRes, err := client.Stream.Recv()
+		require.NoError(t, err)
+		if inflation := streamRes.GetInflation(); inflation != nil {
+			require.Equal(t, int32(150), inflation.PriceLevel)
+			require.Equal(t, int32(225), inflation.LotteryMaxWin)
+			break
+		}
+	}
+
+	// points of the players don't change
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, stateRes.State.PriceLevel, int32(150))
+	for _, player := range stateRes.State.Players {
+		if player.UserId == string(client.UserID) {
+			require.Equal(t, int32(200), player.Points)
+		}
+	}
+}
+
+func TestBankStrategy(t *testing.T) {
+	var err error
+
+	_, err = server.NewBankStrategy("generous")
+	require.NotNil(t, err)
+	strategy, err := server.NewBankStrategy("demand")
+	require.NoError(t, err)
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithBankStrategy(strategy))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	// rates are taken from the config, while nothing is lent out
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(30), stateRes.CreditInterest)
+	require.Equal(t, int32(20), stateRes.DepositInterest)
+
+	creditRes, err := client.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, creditRes.Success)
+
+	// 100 of 400 points of the bank are lent out, so rates grow by 25%
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(38), stateRes.CreditInterest)
+	require.Equal(t, int32(25), stateRes.DepositInterest)
+	require.Len(t, stateRes.Credits, 1)
+	require.Equal(t, int32(30), stateRes.Credits[0].Interest)
+
+	creditRes, err = client.TakeCredit(10)
+	require.NoError(t, err)
+	require.True(t, creditRes.Success)
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	for _, credit := range stateRes.Credits {
+		if credit.Value == 10 {
+			require.Equal(t, int32(38), credit.Interest)
+		}
+	}
+}
+
+func TestSteal(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithSteal(100, 50),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	thief := server.NewSampleClient()
+	err = thief.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := thief.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(100), joinRes.StealChance)
+	require.Equal(t, int32(50), joinRes.StealPenaltyPercentage)
+	target := server.NewSampleClient()
+	err = target.Connect(addr)
+	require.NoError(t, err)
+	_, err = target.JoinGame()
+	require.NoError(t, err)
+	err = thief.StartGame()
+	require.NoError(t, err)
+
+	_, err = thief.Steal(string(thief.UserID))
+	require.NotNil(t, err)
+	_, err = thief.Steal("unknown")
+	require.NotNil(t, err)
+
+	// 15% of the points of the target are stolen
+	stealRes, err := thief.Steal(string(target.UserID))
+	require.NoError(t, err)
+	require.True(t, stealRes.Success)
+	require.Equal(t, int32(30), stealRes.Stolen)
+
+	// thief has to wait for the theft time before stealing again
+	stealRes, err = thief.Steal(string(target.UserID))
+	require.NoError(t, err)
+	require.False(t, stealRes.Success)
+	require.Equal(t, int32(0), stealRes.Penalty)
+
+	stateRes, err := target.GetGameState()
+	require.NoError(t, err)
+	for _, player := range stateRes.State.Players {
+		switch player.UserId {
+		case string(thief.UserID):
+			require.Equal(t, int32(230), player.Points)
+		case string(target.UserID):
+			require.Equal(t, int32(170), player.Points)
+		}
+	}
+}
+
+func TestHireGuard(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithSteal(100, 50),
+		server.WithGuard(20, 5, 100),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	thief := server.NewSampleClient()
+	err = thief.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := thief.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(20), joinRes.GuardPrice)
+	require.Equal(t, int32(5), joinRes.GuardTime)
+	require.Equal(t, int32(100), joinRes.GuardProtectionPercentage)
+	target := server.NewSampleClient()
+	err = target.Connect(addr)
+	require.NoError(t, err)
+	_, err = target.JoinGame()
+	require.NoError(t, err)
+	err = thief.StartGame()
+	require.NoError(t, err)
+
+	guardRes, err := target.HireGuard()
+	require.NoError(t, err)
+	require.True(t, guardRes.Success)
+	require.Equal(t, int32(20), guardRes.Price)
+	guardRes, err = target.HireGuard()
+	require.NoError(t, err)
+	require.False(t, guardRes.Success)
+
+	// full protection makes every steal fail, and the thief pays
+	// half of 15% of the points of the target to the target
+	stealRes, err := thief.Steal(string(target.UserID))
+	require.NoError(t, err)
+	require.False(t, stealRes.Success)
+	require.Equal(t, int32(14), stealRes.Penalty)
+
+	stateRes, err := target.GetGameState()
+	require.NoError(t, err)
+	require.Greater(t, stateRes.GuardRemainingTime, int32(0))
+	for _, player := range stateRes.State.Players {
+		switch player.UserId {
+		case string(thief.UserID):
+			require.Equal(t, int32(186), player.Points)
+		case string(target.UserID):
+			require.Equal(t, int32(194), player.Points)
+		}
+	}
+}
+
+func TestStealEventPayload(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithSteal(100, 50),
+		server.WithMaskedThieves(true),
+		server.WithInsurance(20, 50),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	thief := server.NewSampleClient()
+	err = thief.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := thief.JoinGame()
+	require.NoError(t, err)
+	require.True(t, joinRes.MaskThieves)
+	target := server.NewSampleClient()
+	err = target.Connect(addr)
+	require.NoError(t, err)
+	_, err = target.JoinGame()
+	require.NoError(t, err)
+	err = target.OpenStream()
+	require.NoError(t, err)
+	err = thief.StartGame()
+	require.NoError(t, err)
+
+	insuranceRes, err := target.BuyInsurance()
+	require.NoError(t, err)
+	require.True(t, insuranceRes.Success)
+
+	// 27 of 180 points are stolen, and half of them is paid back
+	stealRes, err := thief.Steal(string(target.UserID))
+	require.NoError(t, err)
+	require.True(t, stealRes.Success)
+	require.Equal(t, int32(27), stealRes.Stolen)
+
+	for {
+		streamRes, err := target.Stream.Recv()
+		require.NoError(t, err)
+		if steal := streamRes.GetTransaction().GetSteal(); steal != nil {
+			require.Empty(t, steal.ThiefId)
+			require.Equal(t, string(target.UserID), steal.TargetId)
+			require.Equal(t, int32(27), steal.Value)
+			require.False(t, steal.Caught)
+			require.True(t, steal.Insured)
+			require.Equal(t, int32(14), steal.InsurancePayout)
+			break
+		}
+	}
+
+	stateRes, err := target.GetGameState()
+	require.NoError(t, err)
+	require.False(t, stateRes.Insured)
+	for _, player := range stateRes.State.Players {
+		if player.UserId == string(target.UserID) {
+			require.Equal(t, int32(167), player.Points)
+		}
+	}
+}
+
+func TestChat(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	sender := server.NewSampleClient()
+	err = sender.Connect(addr)
+	require.NoError(t, err)
+	_, err = sender.JoinGame()
+	require.NoError(t, err)
+	receiver := server.NewSampleClient()
+	err = receiver.Connect(addr)
+	require.NoError(t, err)
+	_, err = receiver.JoinGame()
+	require.NoError(t, err)
+	err = receiver.OpenStream()
+	require.NoError(t, err)
+
+	_, err = sender.SendChat("   ")
+	require.NotNil(t, err)
+	_, err = sender.SendChat(strings.Repeat("a", 281))
+	require.NotNil(t, err)
+
+	// players can chat in the lobby
+	chatRes, err := sender.SendChat(" hello ")
+	require.NoError(t, err)
+	require.Equal(t, "hello", chatRes.Message.Text)
+	require.Equal(t, string(sender.UserID), chatRes.Message.UserId)
+	require.NotZero(t, chatRes.Message.Time)
+
+	for {
+		streamRes, err := receiver.Stream.Recv()
+		require.NoError(t, err)
+		if chat := streamRes.GetChat(); chat != nil {
+			require.Equal(t, "hello", chat.Text)
+			require.Equal(t, chatRes.Message.Username, chat.Username)
+			break
+		}
+	}
+
+	// only the latest messages are kept
+	for i := 0; i < 105; i++ {
+		_, err = receiver.SendChat(fmt.Sprintf("message %d", i))
+		require.NoError(t, err)
+	}
+	stateRes, err := sender.GetGameState()
+	require.NoError(t, err)
+	require.Len(t, stateRes.Chat, 100)
+	require.Equal(t, "message 5", stateRes.Chat[0].Text)
+	require.Equal(t, "message 104", stateRes.Chat[99].Text)
+}
+
+func TestWordFilter(t *testing.T) {
+	var err error
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "words.txt")
+	err = ioutil.WriteFile(path, []byte("# classroom list\ndarn\n\n  Heck \n"), 0600)
+	require.NoError(t, err)
+	words, err := server.LoadWordList(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, words.Len())
+	_, err = server.LoadWordList(filepath.Join(dir, "missing.txt"))
+	require.Error(t, err)
+
+	// words are matched as a whole and regardless of the case
+	text, masked := words.Filter("oh DARN, what the heck_ is darned")
+	require.True(t, masked)
+	require.Equal(t, "oh ****, what the ****_ is darned", text)
+	text, masked = words.Filter("darned heckler")
+	require.False(t, masked)
+	require.Equal(t, "darned heckler", text)
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithWordFilter(words))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.GameClient.Join(context.Background(), &pb.JoinRequest{Username: "Darn_It"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "not allowed")
+
+	client.Username = "Tamsin Darnell"
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	chatRes, err := client.SendChat("darn, this heckin game")
+	require.NoError(t, err)
+	require.Equal(t, "****, this heckin game", chatRes.Message.Text)
+}
+
+func TestRename(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	host := server.NewSampleClient()
+	host.Username = "Ottoline"
+	err = host.Connect(addr)
+	require.NoError(t, err)
+	_, err = host.JoinGame()
+	require.NoError(t, err)
+	other := server.NewSampleClient()
+	other.Username = "Percival"
+	err = other.Connect(addr)
+	require.NoError(t, err)
+	_, err = other.JoinGame()
+	require.NoError(t, err)
+	err = other.OpenStream()
+	require.NoError(t, err)
+
+	_, err = host.Rename("Ottoline!")
+	require.Contains(t, err.Error(), "invalid username")
+
+	// taken username is suffixed as on join
+	renameRes, err := host.Rename("Percival")
+	require.NoError(t, err)
+	require.Equal(t, "Percival 2", renameRes.Username)
+	require.Equal(t, "Percival 2", string(host.Username))
+
+	for {
+		streamRes, err := other.Stream.Recv()
+		require.NoError(t, err)
+		if rename := streamRes.GetRename(); rename != nil {
+			require.Equal(t, string(host.UserID), rename.UserId)
+			require.Equal(t, "Percival 2", rename.Username)
+			break
+		}
+	}
+
+	// final username is carried into the active game
+	err = host.StartGame()
+	require.NoError(t, err)
+	stateRes, err := other.GetGameState()
+	require.NoError(t, err)
+	usernames := make(map[string]string)
+	for _, player := range stateRes.State.Players {
+		usernames[player.UserId] = player.Username
+	}
+	require.Equal(t, "Percival 2", usernames[string(host.UserID)])
+
+	_, err = other.Rename("Percy")
+	require.Contains(t, err.Error(), "no waiting game")
+}
+
+func TestAppearance(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.GameClient.Join(context.Background(), &pb.JoinRequest{Username: "Ines", Color: "red"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.GameClient.Join(context.Background(), &pb.JoinRequest{Username: "Ines", AvatarId: "fox!"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	client.AvatarID = "fox"
+	client.Color = "#FF0000"
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, "#ff0000", joinRes.Color)
+
+	// color too close to the taken one is replaced from the palette
+	similar := server.NewSampleClient()
+	similar.Color = "#fe0101"
+	err = similar.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err = similar.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, "#e6194b", joinRes.Color)
+	require.Equal(t, "#e6194b", similar.Color)
+
+	other := server.NewSampleClient()
+	err = other.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err = other.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, "#3cb44b", joinRes.Color)
+	for _, player := range joinRes.Players {
+		if player.UserId == string(client.UserID) {
+			require.Equal(t, "fox", player.AvatarId)
+			require.Equal(t, "#ff0000", player.Color)
+		}
+	}
+}
+
+func TestReact(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.OpenStream()
+	require.NoError(t, err)
+
+	err = client.React(pb.Emote(100))
+	require.NotNil(t, err)
+
+	err = client.React(pb.Emote_EMOTE_LAUGH)
+	require.NoError(t, err)
+	for {
+		streamRes, err := client.Stream.Recv()
+		require.NoError(t, err)
+		if reaction := streamRes.GetReaction(); reaction != nil {
+			require.Equal(t, string(client.UserID), reaction.UserId)
+			require.Equal(t, pb.Emote_EMOTE_LAUGH, reaction.Emote)
+			break
+		}
+	}
+
+	// reactions are limited after the burst
+	err = client.React(pb.Emote_EMOTE_WOW)
+	require.NoError(t, err)
+	err = client.React(pb.Emote_EMOTE_WOW)
+	require.NoError(t, err)
+	err = client.React(pb.Emote_EMOTE_WOW)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "ResourceExhausted")
+}
+
+func TestKickPlayer(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	clients := make([]*server.SampleClient, 3)
+	for i := range clients {
+		clients[i] = server.NewSampleClient()
+		err = clients[i].Connect(addr)
+		require.NoError(t, err)
+		_, err = clients[i].JoinGame()
+		require.NoError(t, err)
+	}
+	host, victim, other := clients[0], clients[1], clients[2]
+	err = victim.OpenStream()
+	require.NoError(t, err)
+
+	// only the host can kick players
+	err = other.KickPlayer(string(victim.UserID))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "PermissionDenied")
+	err = host.KickPlayer(string(host.UserID))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "InvalidArgument")
+
+	err = host.KickPlayer(string(victim.UserID))
+	require.NoError(t, err)
+	for {
+		streamRes, err := victim.Stream.Recv()
+		require.NoError(t, err)
+		if kicked := streamRes.GetKicked(); kicked != nil {
+			require.Equal(t, string(host.UserID), kicked.HostId)
+			break
+		}
+	}
+	err = host.KickPlayer(string(victim.UserID))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "InvalidArgument")
+
+	// players cannot be kicked after the start
+	err = host.StartGame()
+	require.NoError(t, err)
+	err = host.KickPlayer(string(other.UserID))
+	require.NotNil(t, err)
+}
+
+func TestBan(t *testing.T) {
+	var err error
+
+	clock := server.NewManualClock(time.Now())
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithAdminToken("secret"), server.WithClock(clock))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := pb.NewAdminClient(conn)
+	game := pb.NewGameClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), server.AdminTokenMetadataKey, "secret")
+
+	_, err = admin.Ban(ctx, &pb.BanRequest{Kind: pb.BanKind_BAN_KIND_USERNAME})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	banRes, err := admin.Ban(ctx, &pb.BanRequest{Kind: pb.BanKind_BAN_KIND_USERNAME, Value: "griefer", Duration: 1})
+	require.NoError(t, err)
+	require.Equal(t, "griefer", banRes.Ban.Value)
+	_, err = game.Join(context.Background(), &pb.JoinRequest{Username: "griefer"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = game.Join(context.Background(), &pb.JoinRequest{Username: "player"})
+	require.NoError(t, err)
+
+	// bans end after their duration
+	clock.Advance(time.Second)
+	_, err = game.Join(context.Background(), &pb.JoinRequest{Username: "griefer"})
+	require.NoError(t, err)
+
+	_, err = admin.Ban(ctx, &pb.BanRequest{Kind: pb.BanKind_BAN_KIND_ADDRESS, Value: "127.0.0.1"})
+	require.NoError(t, err)
+	listRes, err := admin.ListBans(ctx, &pb.ListBansRequest{})
+	require.NoError(t, err)
+	require.Len(t, listRes.Bans, 1)
+	require.Equal(t, pb.BanKind_BAN_KIND_ADDRESS, listRes.Bans[0].Kind)
+	_, err = game.Join(context.Background(), &pb.JoinRequest{Username: "player"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = admin.Unban(ctx, &pb.UnbanRequest{Kind: pb.BanKind_BAN_KIND_ADDRESS, Value: "127.0.0.1"})
+	require.NoError(t, err)
+	_, err = admin.Unban(ctx, &pb.UnbanRequest{Kind: pb.BanKind_BAN_KIND_ADDRESS, Value: "127.0.0.1"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = game.Join(context.Background(), &pb.JoinRequest{Username: "player"})
+	require.NoError(t, err)
+}
+
+func TestHostMigration(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	clients := make([]*server.SampleClient, 3)
+	for i := range clients {
+		clients[i] = server.NewSampleClient()
+		err = clients[i].Connect(addr)
+		require.NoError(t, err)
+		joinRes, err := clients[i].JoinGame()
+		require.NoError(t, err)
+		require.Equal(t, string(clients[0].UserID), joinRes.HostId)
+	}
+	host, next, other := clients[0], clients[1], clients[2]
+	err = other.OpenStream()
+	require.NoError(t, err)
+
+	// only the host can start the game
+	err = next.StartGame()
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "PermissionDenied")
+
+	// host role passes to the player, who has joined next
+	err = host.LeaveGame()
+	require.NoError(t, err)
+	for {
+		streamRes, err := other.Stream.Recv()
+		require.NoError(t, err)
+		if leave := streamRes.GetLeave(); leave != nil {
+			require.Equal(t, string(host.UserID), leave.UserId)
+			require.Equal(t, string(next.UserID), leave.HostId)
+			break
+		}
+	}
+	stateRes, err := other.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, string(next.UserID), stateRes.State.HostId)
+
+	err = other.KickPlayer(string(next.UserID))
+	require.NotNil(t, err)
+	err = next.StartGame()
+	require.NoError(t, err)
+}
+
+func TestLeaveTwice(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	host := server.NewSampleClient()
+	host.Username = "Odalys"
+	err = host.Connect(addr)
+	require.NoError(t, err)
+	_, err = host.JoinGame()
+	require.NoError(t, err)
+	client := server.NewSampleClient()
+	client.Username = "Quentin"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+
+	// leaving again does nothing
+	err = client.LeaveGame()
+	require.NoError(t, err)
+	err = client.LeaveGame()
+	require.NoError(t, err)
+
+	stateRes, err := host.GetGameState()
+	require.NoError(t, err)
+	require.Len(t, stateRes.State.Players, 2) // host and bank
+	require.Equal(t, string(host.UserID), stateRes.State.HostId)
+}
+
+func TestPlayerLimits(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithPlayerLimits(2, 3),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	clients := make([]*server.SampleClient, 4)
+	for i := range clients {
+		clients[i] = server.NewSampleClient()
+		err = clients[i].Connect(addr)
+		require.NoError(t, err)
+	}
+
+	joinRes, err := clients[0].JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(2), joinRes.MinPlayers)
+	require.Equal(t, int32(3), joinRes.MaxPlayers)
+
+	// the game cannot be started below the minimum
+	err = clients[0].StartGame()
+	require.NotNil(t, err)
+
+	_, err = clients[1].JoinGame()
+	require.NoError(t, err)
+	_, err = clients[2].JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, clients[0].GameID, clients[2].GameID)
+
+	// the full waiting game becomes a room, and the new waiting game is created
+	_, err = clients[3].JoinGame()
+	require.NoError(t, err)
+	require.NotEqual(t, clients[0].GameID, clients[3].GameID)
+	_, err = clients[3].JoinRoom(string(clients[0].GameID))
+	require.NotNil(t, err)
+
+	err = clients[0].StartGameWithConfig(&pb.GameConfig{MaxPlayers: 2})
+	require.NotNil(t, err)
+	err = clients[0].StartGame()
+	require.NoError(t, err)
+}
+
+func TestAutoStart(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithPlayerLimits(1, 2),
+		server.WithAutoStart(true),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client1.JoinGame()
+	require.NoError(t, err)
+	require.True(t, joinRes.AutoStart)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+
+	client2 := server.NewSampleClient()
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+
+	// the countdown is broadcast before the start
+	var countdown []int32
+	for {
+		streamRes, err := client1.Stream.Recv()
+		require.NoError(t, err)
+		if event := streamRes.GetCountdown(); event != nil {
+			require.False(t, event.Cancelled)
+			countdown = append(countdown, event.RemainingTime)
+		}
+		if streamRes.GetStart() != nil {
+			break
+		}
+	}
+	require.Equal(t, []int32{3, 2, 1}, countdown)
+
+	stateRes, err := client1.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, pb.GameStatus_GAME_STATUS_ACTIVE, stateRes.State.Status)
+}
+
+func TestStartCountdown(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithStartCountdown(5),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	host := server.NewSampleClient()
+	err = host.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := host.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(5), joinRes.StartCountdown)
+	err = host.OpenStream()
+	require.NoError(t, err)
+	player := server.NewSampleClient()
+	err = player.Connect(addr)
+	require.NoError(t, err)
+	_, err = player.JoinGame()
+	require.NoError(t, err)
+
+	err = host.CancelStart()
+	require.NotNil(t, err)
+
+	err = host.StartGame()
+	require.NoError(t, err)
+	err = host.StartGame()
+	require.NotNil(t, err)
+	err = player.CancelStart()
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "PermissionDenied")
+
+	// the countdown is broadcast until the host cancels the start
+	nextCountdown := func() *pb.StreamResponse_Countdown {
+		for {
+			streamRes, err := host.Stream.Recv()
+			require.NoError(t, err)
+			require.Nil(t, streamRes.GetStart())
+			if countdown := streamRes.GetCountdown(); countdown != nil {
+				return countdown
+			}
+		}
+	}
+	countdown := nextCountdown()
+	require.Equal(t, int32(5), countdown.RemainingTime)
+	err = host.CancelStart()
+	require.NoError(t, err)
+	countdown = nextCountdown()
+	require.True(t, countdown.Cancelled)
+
+	// nothing is broadcast after the cancellation
+	time.Sleep(1100 * time.Millisecond)
+	stateRes, err := host.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, pb.GameStatus_GAME_STATUS_WAITING, stateRes.State.Status)
+	_, err = host.SendChat("still waiting")
+	require.NoError(t, err)
+	streamRes, err := host.Stream.Recv()
+	require.NoError(t, err)
+	require.NotNil(t, streamRes.GetChat())
+}
+
+func TestListGames(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150,
+		server.WithPlayerLimits(1, 4),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	host := server.NewSampleClient()
+	err = host.Connect(addr)
+	require.NoError(t, err)
+	host.RoomCode = "1234"
+	createRes, err := host.CreateRoom("private", &pb.GameConfig{Duration: 40})
+	require.NoError(t, err)
+	_, err = host.JoinRoom(createRes.GameId)
+	require.NoError(t, err)
+
+	// rooms with code cannot be joined without it
+	guest := server.NewSampleClient()
+	err = guest.Connect(addr)
+	require.NoError(t, err)
+	_, err = guest.JoinRoom(createRes.GameId)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "PermissionDenied")
+
+	listRes, err := guest.ListGames()
+	require.NoError(t, err)
+	require.Len(t, listRes.Games, 2)
+	require.Equal(t, "default", listRes.Games[0].Name)
+	require.False(t, listRes.Games[0].CodeRequired)
+	room := listRes.Games[1]
+	require.Equal(t, createRes.GameId, room.GameId)
+	require.Equal(t, "private", room.Name)
+	require.Equal(t, int32(1), room.PlayerCount)
+	require.Equal(t, int32(4), room.MaxPlayers)
+	require.Equal(t, int32(40), room.Duration)
+	require.Equal(t, string(host.Username), room.HostUsername)
+	require.True(t, room.CodeRequired)
+	require.False(t, room.Starting)
+
+	guest.RoomCode = "1234"
+	_, err = guest.JoinRoom(createRes.GameId)
+	require.NoError(t, err)
+}
+
+func TestMatchmaking(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithMatchmaking(2, 100, 100))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	ratings := []int32{1000, 1500, 1050, 1700}
+	clients := make([]*server.SampleClient, len(ratings))
+	errs := make([]chan error, len(ratings))
+	for i := range clients {
+		clients[i] = server.NewSampleClient()
+		err = clients[i].Connect(addr)
+		require.NoError(t, err)
+		errs[i] = make(chan error, 1)
+		go func(i int) {
+			_, err := clients[i].Enqueue(ratings[i])
+			errs[i] <- err
+		}(i)
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// players of similar ratings are matched right away
+	require.NoError(t, <-errs[0])
+	require.NoError(t, <-errs[2])
+	require.Equal(t, clients[0].GameID, clients[2].GameID)
+
+	// the window grows, until distant ratings are matched
+	require.NoError(t, <-errs[1])
+	require.NoError(t, <-errs[3])
+	require.Equal(t, clients[1].GameID, clients[3].GameID)
+	require.NotEqual(t, clients[0].GameID, clients[1].GameID)
+
+	// the player, who has waited the longest, is the host
+	err = clients[3].StartGame()
+	require.NotNil(t, err)
+	err = clients[1].StartGame()
+	require.NoError(t, err)
+
+	// matched rooms cannot be joined by other players
+	other := server.NewSampleClient()
+	err = other.Connect(addr)
+	require.NoError(t, err)
+	_, err = other.JoinRoom(string(clients[0].GameID))
+	require.NotNil(t, err)
+}
+
+func TestRatings(t *testing.T) {
+	var err error
+
+	storage := server.NewMemoryStorage()
+	clock := server.NewManualClock(time.Now())
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 0, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithStorage(storage), server.WithClock(clock))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	winner := server.NewSampleClient()
+	winner.Username = "Winner"
+	err = winner.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := winner.JoinGame()
+	require.NoError(t, err)
+	for _, player := range joinRes.Players {
+		if player.UserId == joinRes.UserId {
+			require.Equal(t, int32(1000), player.Rating)
+		}
+	}
+	loser := server.NewSampleClient()
+	loser.Username = "Loser"
+	err = loser.Connect(addr)
+	require.NoError(t, err)
+	_, err = loser.JoinGame()
+	require.NoError(t, err)
+	err = winner.OpenStream()
+	require.NoError(t, err)
+	err = winner.StartGame()
+	require.NoError(t, err)
+
+	// deposited points are not returned until the game finishes
+	clock.Advance(15 * time.Second)
+	_, err = loser.TakeDeposit(50)
+	require.NoError(t, err)
+	clock.Advance(15 * time.Second)
+
+	var finish *pb.StreamResponse_Finish
+	for finish == nil {
+		streamRes, err := winner.Stream.Recv()
+		require.NoError(t, err)
+		finish = streamRes.GetFinish()
+	}
+	require.Equal(t, string(winner.UserID), finish.WinnerUserId)
+	for _, player := range finish.Players {
+		switch player.UserId {
+		case string(winner.UserID):
+			require.Equal(t, int32(1016), player.Rating)
+		case string(loser.UserID):
+			require.Equal(t, int32(984), player.Rating)
+		}
+	}
+
+	// ratings are persisted
+	rating, ok, err := storage.LoadRating(string(winner.Username))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int32(1016), rating.Rating)
+	require.Equal(t, int32(1), rating.Games)
+	results, err := storage.LoadResults(time.Time{})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.Equal(t, result.Username == string(winner.Username), result.Won)
+	}
+
+	s2 := server.NewServer(gameConfig, server.WithStorage(storage))
+	joinRes, err = s2.Join(context.Background(), &pb.JoinRequest{Username: string(loser.Username)})
+	require.NoError(t, err)
+	for _, player := range joinRes.Players {
+		if player.UserId == joinRes.UserId {
+			require.Equal(t, int32(984), player.Rating)
+		}
+	}
+}
+
+func TestLeaderboard(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(2, 200, 400, 30, 20, 1, 1, 1, 0, 1, 150, 150)
+	_, err = server.NewServer(gameConfig).GetLeaderboard(context.Background(), &pb.GetLeaderboardRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	storage := server.NewMemoryStorage()
+	now := time.Now()
+	err = storage.AddResults([]server.ResultRecord{
+		{GameID: "old", Username: "Alice", Winnings: 500, Won: true, Rating: 1016, Time: now.Add(-48 * time.Hour)},
+		{GameID: "old", Username: "Bob", Winnings: -500, Won: false, Rating: 984, Time: now.Add(-48 * time.Hour)},
+		{GameID: "new", Username: "Bob", Winnings: 100, Won: true, Rating: 1001, Time: now},
+		{GameID: "new", Username: "Alice", Winnings: -100, Won: false, Rating: 999, Time: now},
+		{GameID: "new", Username: "Carol", Winnings: 20, Won: false, Rating: 1000, Time: now},
+	})
+	require.NoError(t, err)
+	s := server.NewServer(gameConfig, server.WithStorage(storage))
+
+	getUsernames := func(res *pb.GetLeaderboardResponse) []string {
+		var usernames []string
+		for _, entry := range res.Entries {
+			usernames = append(usernames, entry.Username)
+		}
+		return usernames
+	}
+
+	res, err := s.GetLeaderboard(context.Background(), &pb.GetLeaderboardRequest{
+		Order: pb.LeaderboardOrder_LEADERBOARD_ORDER_WINNINGS,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), res.Total)
+	require.Equal(t, []string{"Alice", "Carol", "Bob"}, getUsernames(res))
+	require.Equal(t, int64(400), res.Entries[0].TotalWinnings)
+	require.Equal(t, int32(999), res.Entries[0].Rating)
+	require.Equal(t, int32(2), res.Entries[0].Games)
+	require.Equal(t, int32(50), res.Entries[0].WinRatePercentage)
+
+	// results older than a day are not ranked in the daily window
+	res, err = s.GetLeaderboard(context.Background(), &pb.GetLeaderboardRequest{
+		Window: pb.LeaderboardWindow_LEADERBOARD_WINDOW_DAILY,
+		Order:  pb.LeaderboardOrder_LEADERBOARD_ORDER_WINNINGS,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Bob", "Carol", "Alice"}, getUsernames(res))
+
+	res, err = s.GetLeaderboard(context.Background(), &pb.GetLeaderboardRequest{
+		Order:  pb.LeaderboardOrder_LEADERBOARD_ORDER_RATING,
+		Offset: 1,
+		Limit:  1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), res.Total)
+	require.Equal(t, []string{"Carol"}, getUsernames(res))
+	require.Equal(t, int32(2), res.Entries[0].Rank)
+
+	res, err = s.GetLeaderboard(context.Background(), &pb.GetLeaderboardRequest{
+		Order: pb.LeaderboardOrder_LEADERBOARD_ORDER_WIN_RATE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Alice", "Bob", "Carol"}, getUsernames(res))
+
+	res, err = s.GetLeaderboard(context.Background(), &pb.GetLeaderboardRequest{Offset: 5})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+}
+
+func TestAccounts(t *testing.T) {
+	var err error
+
+	storage := server.NewMemoryStorage()
+	gameConfig := server.NewGameConfig(2, 200, 400, 30, 20, 1, 1, 1, 0, 1, 150, 150)
+	s := server.NewServer(gameConfig, server.WithStorage(storage))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	player := server.NewSampleClient()
+	player.Username = "Alice"
+	err = player.Connect(addr)
+	require.NoError(t, err)
+
+	_, err = player.Register("short")
+	require.Error(t, err)
+	profile, err := player.Register("password")
+	require.NoError(t, err)
+	require.Equal(t, "Alice", profile.DisplayName)
+	require.Equal(t, int32(1000), profile.Stats.Rating)
+	require.NotEmpty(t, player.AccountToken)
+
+	// username is reserved for the account
+	_, err = player.Register("password")
+	require.Error(t, err)
+	impostor := server.NewSampleClient()
+	impostor.Username = "Alice"
+	err = impostor.Connect(addr)
+	require.NoError(t, err)
+	_, err = impostor.JoinGame()
+	require.Error(t, err)
+	_, err = impostor.Login("wrong password")
+	require.Error(t, err)
+
+	// the account username is used regardless of the requested one
+	player.Username = "Bob"
+	joinRes, err := player.JoinGame()
+	require.NoError(t, err)
+	for _, p := range joinRes.Players {
+		if p.UserId == joinRes.UserId {
+			require.Equal(t, "Alice", p.Username)
+		}
+	}
+
+	// account token is not accepted as the game token
+	player.AuthToken = player.AccountToken
+	err = player.OpenStream()
+	if err == nil {
+		_, err = player.Stream.Recv()
+	}
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	updateRes, err := s.UpdateProfile(context.Background(), &pb.UpdateProfileRequest{
+		AccountToken: player.AccountToken,
+		DisplayName:  "Queen Alice",
+		Avatar:       "crown",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Queen Alice", updateRes.Profile.DisplayName)
+
+	// accounts are persisted
+	s2 := server.NewServer(gameConfig, server.WithStorage(storage))
+	_, err = s2.Join(context.Background(), &pb.JoinRequest{Username: "Alice"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	loginRes, err := s2.Login(context.Background(), &pb.LoginRequest{Username: "Alice", Password: "password"})
+	require.NoError(t, err)
+	require.Equal(t, "crown", loginRes.Profile.Avatar)
+	_, err = s2.GetProfile(context.Background(), &pb.GetProfileRequest{Username: "Bob"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPlayerStats(t *testing.T) {
+	var err error
+
+	storage := server.NewMemoryStorage()
+	clock := server.NewManualClock(time.Now())
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 1, 20, 25, 0, 20, 150, 150,
+		server.WithSteal(100, 50),
+	)
+	s := server.NewServer(gameConfig, server.WithStorage(storage), server.WithClock(clock))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	thief := server.NewSampleClient()
+	thief.Username = "Thief"
+	err = thief.Connect(addr)
+	require.NoError(t, err)
+	_, err = thief.JoinGame()
+	require.NoError(t, err)
+	target := server.NewSampleClient()
+	target.Username = "Target"
+	err = target.Connect(addr)
+	require.NoError(t, err)
+	_, err = target.JoinGame()
+	require.NoError(t, err)
+	err = thief.OpenStream()
+	require.NoError(t, err)
+	err = thief.StartGame()
+	require.NoError(t, err)
+
+	clock.Advance(15 * time.Second)
+	stealRes, err := thief.Steal(string(target.UserID))
+	require.NoError(t, err)
+	require.True(t, stealRes.Success)
+	// target deposits all points, so he cannot return the credit
+	_, err = target.TakeCredit(50)
+	require.NoError(t, err)
+	_, err = target.TakeDeposit(170 + 50)
+	require.NoError(t, err)
+	clock.Advance(15 * time.Second)
+
+	for {
+		streamRes, err := thief.Stream.Recv()
+		require.NoError(t, err)
+		if streamRes.GetFinish() != nil {
+			break
+		}
+	}
+
+	stats, err := thief.GetPlayerStats()
+	require.NoError(t, err)
+	require.Equal(t, int32(1), stats.Games)
+	require.Equal(t, int32(1), stats.Steals)
+	require.Equal(t, int32(0), stats.CreditsTaken)
+	stats, err = target.GetPlayerStats()
+	require.NoError(t, err)
+	require.Equal(t, int32(1), stats.TimesRobbed)
+	require.Equal(t, int32(1), stats.CreditsTaken)
+	require.Equal(t, int32(1), stats.CreditsDefaulted)
+
+	// stats are persisted
+	s2 := server.NewServer(gameConfig, server.WithStorage(storage))
+	statsRes, err := s2.GetPlayerStats(context.Background(), &pb.GetPlayerStatsRequest{Username: string(thief.Username)})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), statsRes.Stats.Steals)
+}
+
+func TestAchievements(t *testing.T) {
+	var err error
+
+	storage := server.NewMemoryStorage()
+	clock := server.NewManualClock(time.Now())
+	gameConfig := server.NewGameConfig(30, 1000000, 400, 30, 20, 20, 20, 25, 0, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithStorage(storage), server.WithClock(clock))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	winner := server.NewSampleClient()
+	winner.Username = "Winner"
+	err = winner.Connect(addr)
+	require.NoError(t, err)
+	_, err = winner.JoinGame()
+	require.NoError(t, err)
+	loser := server.NewSampleClient()
+	loser.Username = "Loser"
+	err = loser.Connect(addr)
+	require.NoError(t, err)
+	_, err = loser.JoinGame()
+	require.NoError(t, err)
+	err = winner.OpenStream()
+	require.NoError(t, err)
+	err = loser.OpenStream()
+	require.NoError(t, err)
+	err = winner.StartGame()
+	require.NoError(t, err)
+
+	// loser has more than a million points after the credit,
+	// but he has less points than the winner in the end
+	clock.Advance(15 * time.Second)
+	_, err = loser.TakeCredit(10)
+	require.NoError(t, err)
+	_, err = loser.TakeDeposit(100)
+	require.NoError(t, err)
+	clock.Advance(15 * time.Second)
+
+	getAchievements := func(stream pb.Game_StreamClient) []string {
+		var achievements []string
+		for {
+			streamRes, err := stream.Recv()
+			require.NoError(t, err)
+			if streamRes.GetFinish() != nil {
+				return achievements
+			}
+			if achievement := streamRes.GetAchievement(); achievement != nil {
+				achievements = append(achievements, achievement.AchievementId)
+			}
+		}
+	}
+	require.Equal(t, []string{"debt_free_win"}, getAchievements(winner.Stream))
+	require.Equal(t, []string{"first_million"}, getAchievements(loser.Stream))
+
+	achievements, err := winner.GetAchievements()
+	require.NoError(t, err)
+	require.Len(t, achievements, 1)
+	require.Equal(t, "Debt free", achievements[0].Name)
+
+	// achievements are persisted
+	s2 := server.NewServer(gameConfig, server.WithStorage(storage))
+	res, err := s2.GetAchievements(context.Background(), &pb.GetAchievementsRequest{Username: string(loser.Username)})
+	require.NoError(t, err)
+	require.Len(t, res.Achievements, 1)
+	require.Equal(t, "first_million", res.Achievements[0].AchievementId)
+}
+
+func TestChallenges(t *testing.T) {
+	var err error
+
+	storage := server.NewMemoryStorage()
+	clock := server.NewManualClock(time.Now())
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 0, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithStorage(storage), server.WithClock(clock))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	winner := server.NewSampleClient()
+	winner.Username = "Winner"
+	err = winner.Connect(addr)
+	require.NoError(t, err)
+	challengesRes, err := winner.GetChallenges()
+	require.NoError(t, err)
+	require.Len(t, challengesRes.Challenges, 3)
+	require.Equal(t, int32(0), challengesRes.Coins)
+	require.True(t, challengesRes.EndTime > time.Now().UnixNano()/int64(time.Millisecond))
+	for _, challenge := range challengesRes.Challenges {
+		require.Equal(t, int32(0), challenge.Progress)
+	}
+
+	_, err = winner.JoinGame()
+	require.NoError(t, err)
+	loser := server.NewSampleClient()
+	loser.Username = "Loser"
+	err = loser.Connect(addr)
+	require.NoError(t, err)
+	_, err = loser.JoinGame()
+	require.NoError(t, err)
+	err = winner.OpenStream()
+	require.NoError(t, err)
+	err = winner.StartGame()
+	require.NoError(t, err)
+
+	// deposited points are not returned until the game finishes
+	clock.Advance(15 * time.Second)
+	_, err = winner.TakeDeposit(10)
+	require.NoError(t, err)
+	_, err = loser.TakeDeposit(50)
+	require.NoError(t, err)
+	clock.Advance(15 * time.Second)
+	for {
+		streamRes, err := winner.Stream.Recv()
+		require.NoError(t, err)
+		if streamRes.GetFinish() != nil {
+			break
+		}
+	}
+
+	// challenges of the day are random, so progress is checked for any of them
+	expected := map[string]int32{
+		"play_games":       1,
+		"win_games":        1,
+		"deposit_only_win": 1,
+	}
+	s2 := server.NewServer(gameConfig, server.WithStorage(storage))
+	challengesRes, err = s2.GetChallenges(context.Background(), &pb.GetChallengesRequest{Username: string(winner.Username)})
+	require.NoError(t, err)
+	coins := int32(0)
+	for _, challenge := range challengesRes.Challenges {
+		require.Equal(t, expected[challenge.ChallengeId], challenge.Progress, challenge.ChallengeId)
+		if challenge.Completed {
+			coins += challenge.Reward
+		}
+	}
+	require.Equal(t, coins, challengesRes.Coins)
+}
+
+func TestTournament(t *testing.T) {
+	var err error
+
+	// rounds are created with validated config
+	gameConfig := server.NewGameConfig(3, 200, 400, 30, 20, 2, 2, 2, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	host := server.NewSampleClient()
+	err = host.Connect(addr)
+	require.NoError(t, err)
+	_, err = host.CreateTournament("cup", 0, 1, 0, nil)
+	require.Error(t, err)
+	tournamentID, err := host.CreateTournament("cup", 2, 1, 0, nil)
+	require.NoError(t, err)
+
+	clients := []*server.SampleClient{server.NewSampleClient(), server.NewSampleClient()}
+	clients[0].Username = "Leader"
+	clients[1].Username = "Debtor"
+	streams := make([]pb.Game_JoinTournamentClient, 2)
+	for i := range clients {
+		err = clients[i].Connect(addr)
+		require.NoError(t, err)
+		streams[i], err = clients[i].JoinTournament(tournamentID)
+		require.NoError(t, err)
+		event, err := streams[i].Recv()
+		require.NoError(t, err)
+		require.NotNil(t, event.GetStandings())
+		require.Equal(t, int32(0), event.GetStandings().Round)
+	}
+
+	// the same player cannot register twice
+	duplicate, err := clients[0].JoinTournament(tournamentID)
+	require.NoError(t, err)
+	_, err = duplicate.Recv()
+	require.Error(t, err)
+
+	for round := int32(1); round <= 2; round++ {
+		for i, client := range clients {
+			event, err := streams[i].Recv()
+			require.NoError(t, err)
+			roundStarted := event.GetRoundStarted()
+			require.NotNil(t, roundStarted)
+			require.Equal(t, round, roundStarted.Round)
+			client.ProcessJoinResponse(roundStarted.Join)
+		}
+		// the first player has more points after every round
+		err = clients[0].OpenStream()
+		require.NoError(t, err)
+		for {
+			streamRes, err := clients[0].Stream.Recv()
+			require.NoError(t, err)
+			if streamRes.GetStart() != nil {
+				break
+			}
+		}
+		_, err = clients[1].TakeCredit(50)
+		require.NoError(t, err)
+
+		for i := range clients {
+			event, err := streams[i].Recv()
+			require.NoError(t, err)
+			standings := event.GetStandings()
+			require.NotNil(t, standings)
+			require.Equal(t, round, standings.Round)
+			require.Equal(t, round == 2, standings.Finished)
+			require.Len(t, standings.Entries, 2)
+			require.Equal(t, string(clients[0].Username), standings.Entries[0].Username)
+			require.Len(t, standings.Entries[0].RoundPoints, int(round))
+		}
+	}
+	for i := range clients {
+		_, err = streams[i].Recv()
+		require.Equal(t, io.EOF, err)
+	}
+
+	standingsRes, err := s.GetStandings(context.Background(), &pb.GetStandingsRequest{TournamentId: tournamentID})
+	require.NoError(t, err)
+	require.True(t, standingsRes.Standings.Finished)
+	require.Equal(t, int32(1), standingsRes.Standings.Entries[0].Rank)
+	_, err = s.GetStandings(context.Background(), &pb.GetStandingsRequest{TournamentId: "unknown"})
+	require.Error(t, err)
+}
+
+func TestTeams(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(2, 200, 400, 30, 20, 1, 1, 1, 0, 1, 150, 150, server.WithTeams(2))
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	// players join the smallest team
+	clients := []*server.SampleClient{server.NewSampleClient(), server.NewSampleClient(), server.NewSampleClient()}
+	clients[0].Username = "Alice"
+	clients[1].Username = "Bob"
+	clients[2].Username = "Carol"
+	for i, client := range clients {
+		err = client.Connect(addr)
+		require.NoError(t, err)
+		joinRes, err := client.JoinGame()
+		require.NoError(t, err)
+		require.Equal(t, int32(2), joinRes.TeamCount)
+		for _, player := range joinRes.Players {
+			if player.UserId == string(client.UserID) {
+				require.Equal(t, int32(i%2+1), player.Team)
+			}
+		}
+	}
+	for _, client := range clients {
+		err = client.OpenStream()
+		require.NoError(t, err)
+	}
+
+	err = clients[2].ChooseTeam(3)
+	require.Error(t, err)
+	err = clients[2].ChooseTeam(2)
+	require.NoError(t, err)
+	for {
+		streamRes, err := clients[0].Stream.Recv()
+		require.NoError(t, err)
+		if teamChange := streamRes.GetTeamChange(); teamChange != nil {
+			require.Equal(t, string(clients[2].UserID), teamChange.UserId)
+			require.Equal(t, int32(2), teamChange.Team)
+			break
+		}
+	}
+
+	// team messages are seen only by the team
+	chatRes, err := clients[1].SendTeamChat("psst")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), chatRes.Message.Team)
+	for {
+		streamRes, err := clients[2].Stream.Recv()
+		require.NoError(t, err)
+		if chat := streamRes.GetChat(); chat != nil {
+			require.Equal(t, "psst", chat.Text)
+			break
+		}
+	}
+	stateRes, err := clients[0].GetGameState()
+	require.NoError(t, err)
+	require.Empty(t, stateRes.Chat)
+	require.Len(t, stateRes.State.Teams, 2)
+	require.Len(t, stateRes.State.Teams[0].UserIds, 1)
+	require.Len(t, stateRes.State.Teams[1].UserIds, 2)
+	stateRes, err = clients[2].GetGameState()
+	require.NoError(t, err)
+	require.Len(t, stateRes.Chat, 1)
+
+	// team with more players has more points
+	err = clients[0].StartGame()
+	require.NoError(t, err)
+	for {
+		streamRes, err := clients[0].Stream.Recv()
+		require.NoError(t, err)
+		if finish := streamRes.GetFinish(); finish != nil {
+			require.Equal(t, int32(2), finish.WinnerTeam)
+			require.Len(t, finish.Teams, 2)
+			require.Equal(t, int32(200), finish.Teams[0].Points)
+			require.Equal(t, int32(400), finish.Teams[1].Points)
+			require.Contains(t, []string{string(clients[1].UserID), string(clients[2].UserID)}, finish.WinnerUserId)
+			break
+		}
+	}
+}
+
+func TestCoop(t *testing.T) {
+	var err error
+
+	// interest of the returned deposit reaches the target
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 2, 25, 15, 20, 150, 150,
+		server.WithGameMode(server.CoopMode, 20),
+	)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	client1.Username = "Alice"
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client1.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, pb.GameMode_GAME_MODE_COOP, joinRes.Mode)
+	require.Equal(t, int32(20), joinRes.CoopTarget)
+	client2 := server.NewSampleClient()
+	client2.Username = "Bob"
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	_, err = client1.TakeDeposit(100)
+	require.NoError(t, err)
+	stateRes, err := client2.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(0), stateRes.State.CoopProgress.Progress)
+
+	// game finishes as soon as the target is reached, progress
+	// event may be sent either before or after the finish
+	start := time.Now()
+	for {
+		streamRes, err := client1.Stream.Recv()
+		require.NoError(t, err)
+		if progress := streamRes.GetCoopProgress(); progress != nil {
+			require.Equal(t, int32(20), progress.Progress)
+			require.True(t, progress.Reached)
+		}
+		if finish := streamRes.GetFinish(); finish != nil {
+			require.Empty(t, finish.WinnerUserId)
+			require.Equal(t, int32(20), finish.CoopProgress.Progress)
+			require.True(t, finish.CoopProgress.Reached)
+			break
+		}
+	}
+	require.True(t, time.Since(start) < 10*time.Second)
+}
+
+// depositBot deposits 10 points every second.
+type depositBot struct{}
+
+func (depositBot) Act(state server.BotState) server.BotAction {
+	return server.BotAction{Kind: server.BotDeposit, Value: 10}
+}
+
+func (depositBot) Answer(_ string, _ []string, correct int32) int32 {
+	return correct
+}
+
+func TestBots(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(3, 200, 400, 30, 20, 2, 2, 2, 0, 2, 150, 150)
+	s := server.NewServer(
+		gameConfig,
+		server.WithBots(func() server.Bot { return depositBot{} }),
+		server.WithBotFill(4),
+	)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	clients := []*server.SampleClient{server.NewSampleClient(), server.NewSampleClient()}
+	clients[0].Username = "Alice"
+	clients[1].Username = "Bob"
+	for _, client := range clients {
+		err = client.Connect(addr)
+		require.NoError(t, err)
+		_, err = client.JoinGame()
+		require.NoError(t, err)
+	}
+	err = clients[0].OpenStream()
+	require.NoError(t, err)
+
+	// only the host can add bots
+	_, err = clients[1].AddBots(1, "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "PermissionDenied")
+	_, err = clients[0].AddBots(11, "")
+	require.Error(t, err)
+	botIDs, err := clients[0].AddBots(1, "")
+	require.NoError(t, err)
+	require.Len(t, botIDs, 1)
+
+	// the rest of the lobby is filled with bots at start
+	err = clients[0].StartGame()
+	require.NoError(t, err)
+	stateRes, err := clients[0].GetGameState()
+	require.NoError(t, err)
+	bots := 0
+	for _, player := range stateRes.State.Players {
+		if player.Bot {
+			bots++
+			require.Contains(t, player.Username, "Bot")
+		}
+	}
+	require.Equal(t, 2, bots)
+
+	// bots act through the same transactions as players
+	for {
+		streamRes, err := clients[0].Stream.Recv()
+		require.NoError(t, err)
+		if deposit := streamRes.GetTransaction().GetUseDeposit(); deposit != nil {
+			require.NotEqual(t, string(clients[0].UserID), deposit.UserId)
+			require.NotEqual(t, string(clients[1].UserID), deposit.UserId)
+			require.Equal(t, int32(10), deposit.Value)
+			break
+		}
+	}
+}
+
+func TestBotProfiles(t *testing.T) {
+	state := server.BotState{Points: 200, NetWorth: 200, LotteryReady: true, LotteryCells: 9}
+
+	// conservative bots never borrow, gamblers never deposit
+	conservative := server.NewProfileBot(server.ConservativeBotProfile, 1)
+	gambler := server.NewProfileBot(server.GamblerBotProfile, 1)
+	for i := 0; i < 100; i++ {
+		action := conservative.Act(state)
+		require.NotEqual(t, server.BotCredit, action.Kind)
+		if action.Kind == server.BotDeposit {
+			require.True(t, action.Value >= 10 && action.Value <= 30)
+		}
+		require.NotEqual(t, server.BotDeposit, gambler.Act(state).Kind)
+	}
+
+	// accuracy defines the share of correct answers
+	sharp := server.BalancedBotProfile
+	sharp.Accuracy = 100
+	dull := server.BalancedBotProfile
+	dull.Accuracy = 0
+	answers := []string{"a", "b", "c", "d"}
+	for i := 0; i < 100; i++ {
+		require.Equal(t, int32(3), server.NewProfileBot(sharp, int64(i)).Answer("", answers, 3))
+		answer := server.NewProfileBot(dull, int64(i)).Answer("", answers, 3)
+		require.NotEqual(t, int32(3), answer)
+		require.True(t, answer >= 1 && answer <= 4)
+	}
+
+	gameConfig := server.NewGameConfig(3, 200, 400, 30, 20, 2, 2, 2, 0, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithBotProfiles(server.GamblerBotProfile))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	_, err = client.AddBots(1, "conservative")
+	require.Error(t, err)
+	botIDs, err := client.AddBots(2, "gambler")
+	require.NoError(t, err)
+	require.Len(t, botIDs, 2)
+}
+
+func TestPractice(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(
+		3, 200, 400, 30, 20, 2, 2, 2, 15, 2, 150, 150,
+		server.WithPlayerLimits(2, 4),
+	)
+	s := server.NewServer(gameConfig, server.WithBots(func() server.Bot { return depositBot{} }))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	// practice starts right away despite min players
+	client := server.NewSampleClient()
+	client.Username = "Learner"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinPractice(11)
+	require.Error(t, err)
+	joinRes, err := client.JoinPractice(1)
+	require.NoError(t, err)
+	require.True(t, joinRes.Practice)
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, pb.GameStatus_GAME_STATUS_ACTIVE, stateRes.State.Status)
+
+	// practice is not listed and doesn't take the waiting game
+	roomsRes, err := client.ListRooms()
+	require.NoError(t, err)
+	require.Len(t, roomsRes.Rooms, 1)
+	require.Zero(t, roomsRes.Rooms[0].PlayerCount)
+
+	err = client.OpenStream()
+	require.NoError(t, err)
+	for {
+		streamRes, err := client.Stream.Recv()
+		require.NoError(t, err)
+		if streamRes.GetFinish() != nil {
+			break
+		}
+	}
+
+	// practice doesn't count
+	stats, err := client.GetPlayerStats()
+	require.NoError(t, err)
+	require.Zero(t, stats.Games)
+
+	// the host can start the lobby as a practice
+	host := server.NewSampleClient()
+	host.Username = "Host"
+	err = host.Connect(addr)
+	require.NoError(t, err)
+	_, err = host.JoinGame()
+	require.NoError(t, err)
+	err = host.StartGame()
+	require.Error(t, err)
+	err = host.StartGameWithConfig(&pb.GameConfig{Practice: true})
+	require.NoError(t, err)
+}
+
+func TestPauseGame(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(3, 200, 400, 30, 20, 2, 2, 2, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	clients := []*server.SampleClient{server.NewSampleClient(), server.NewSampleClient()}
+	clients[0].Username = "Host"
+	clients[1].Username = "Guest"
+	for _, client := range clients {
+		err = client.Connect(addr)
+		require.NoError(t, err)
+		_, err = client.JoinGame()
+		require.NoError(t, err)
+		err = client.OpenStream()
+		require.NoError(t, err)
+	}
+
+	err = clients[0].PauseGame()
+	require.Error(t, err)
+	start := time.Now()
+	err = clients[0].StartGame()
+	require.NoError(t, err)
+
+	// only the host can pause the game, and players cannot act during the pause
+	err = clients[1].PauseGame()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "PermissionDenied")
+	err = clients[0].PauseGame()
+	require.NoError(t, err)
+	err = clients[0].PauseGame()
+	require.Error(t, err)
+	for {
+		streamRes, err := clients[1].Stream.Recv()
+		require.NoError(t, err)
+		if pause := streamRes.GetPause(); pause != nil {
+			require.Equal(t, string(clients[0].UserID), pause.UserId)
+			break
+		}
+	}
+	_, err = clients[1].TakeDeposit(10)
+	require.Error(t, err)
+	stateRes, err := clients[1].GetGameState()
+	require.NoError(t, err)
+	require.True(t, stateRes.State.Paused)
+	remainingTime := stateRes.State.RemainingTime
+
+	// the clock is frozen during the pause
+	time.Sleep(2 * time.Second)
+	stateRes, err = clients[1].GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, remainingTime, stateRes.State.RemainingTime)
+	err = clients[1].ResumeGame()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "PermissionDenied")
+	err = clients[0].ResumeGame()
+	require.NoError(t, err)
+	for {
+		streamRes, err := clients[1].Stream.Recv()
+		require.NoError(t, err)
+		if resume := streamRes.GetResume(); resume != nil {
+			require.Equal(t, remainingTime, resume.RemainingTime)
+			break
+		}
+	}
+	_, err = clients[1].TakeDeposit(10)
+	require.NoError(t, err)
+
+	// the game finishes later by the duration of the pause
+	for {
+		streamRes, err := clients[1].Stream.Recv()
+		require.NoError(t, err)
+		if streamRes.GetFinish() != nil {
+			break
+		}
+	}
+	require.True(t, time.Since(start) >= 5*time.Second)
+}
+
+func TestExtendGame(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(3, 200, 400, 30, 20, 2, 2, 2, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	clients := []*server.SampleClient{server.NewSampleClient(), server.NewSampleClient(), server.NewSampleClient()}
+	clients[0].Username = "Alice"
+	clients[1].Username = "Bob"
+	clients[2].Username = "Carol"
+	for _, client := range clients {
+		err = client.Connect(addr)
+		require.NoError(t, err)
+		_, err = client.JoinGame()
+		require.NoError(t, err)
+		err = client.OpenStream()
+		require.NoError(t, err)
+	}
+	_, err = clients[0].ExtendGame()
+	require.Error(t, err)
+	err = clients[0].StartGame()
+	require.NoError(t, err)
+
+	// two votes out of three extend the game
+	extendRes, err := clients[0].ExtendGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(1), extendRes.Votes)
+	require.Equal(t, int32(2), extendRes.Needed)
+	require.False(t, extendRes.Extended)
+	_, err = clients[0].ExtendGame()
+	require.Error(t, err)
+	extendRes, err = clients[1].ExtendGame()
+	require.NoError(t, err)
+	require.True(t, extendRes.Extended)
+	for {
+		streamRes, err := clients[2].Stream.Recv()
+		require.NoError(t, err)
+		if extension := streamRes.GetExtension(); extension != nil && extension.Extended {
+			require.True(t, extension.RemainingTime > 30)
+			break
+		}
+	}
+	stateRes, err := clients[2].GetGameState()
+	require.NoError(t, err)
+	require.True(t, stateRes.State.RemainingTime > 30)
+
+	// voting starts over after the extension
+	extendRes, err = clients[0].ExtendGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(1), extendRes.Votes)
+	require.False(t, extendRes.Extended)
+}
+
+func TestOvertime(t *testing.T) {
+	var err error
+
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "questions.json")
+	err = ioutil.WriteFile(jsonPath, []byte(`[{
+		"question": "What is the capital of Canada?",
+		"answers": ["Toronto", "Ottawa", "Montreal", "Vancouver"],
+		"correct_answer": 2,
+		"category": "Geography",
+		"difficulty": "easy"
+	}]`), 0600)
+	require.NoError(t, err)
+	questions, err := server.NewFileQuestionProvider(jsonPath)
+	require.NoError(t, err)
+
+	gameConfig := server.NewGameConfig(3, 200, 400, 30, 20, 2, 2, 2, 15, 2, 150, 150)
+	s := server.NewServer(gameConfig, server.WithQuestionProvider(questions))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	clients := []*server.SampleClient{server.NewSampleClient(), server.NewSampleClient()}
+	clients[0].Username = "Alice"
+	clients[1].Username = "Bob"
+	for _, client := range clients {
+		err = client.Connect(addr)
+		require.NoError(t, err)
+		_, err = client.JoinGame()
+		require.NoError(t, err)
+		err = client.OpenStream()
+		require.NoError(t, err)
+	}
+	err = clients[0].StartGame()
+	require.NoError(t, err)
+	_, err = clients[0].AnswerOvertime(2)
+	require.Error(t, err)
+
+	// players, who haven't acted, are tied
+	for {
+		streamRes, err := clients[0].Stream.Recv()
+		require.NoError(t, err)
+		require.Nil(t, streamRes.GetFinish())
+		if overtime := streamRes.GetOvertime(); overtime != nil {
+			require.Equal(t, int32(1), overtime.Round)
+			require.Len(t, overtime.UserIds, 2)
+			require.Equal(t, "What is the capital of Canada?", overtime.Question)
+			break
+		}
+	}
+	_, err = clients[1].TakeDeposit(10)
+	require.Error(t, err)
+
+	// wrong answer doesn't win, and the tied player answers once per round
+	answerRes, err := clients[1].AnswerOvertime(1)
+	require.NoError(t, err)
+	require.False(t, answerRes.Correct)
+	_, err = clients[1].AnswerOvertime(2)
+	require.Error(t, err)
+	answerRes, err = clients[0].AnswerOvertime(2)
+	require.NoError(t, err)
+	require.True(t, answerRes.Correct)
+	for {
+		streamRes, err := clients[1].Stream.Recv()
+		require.NoError(t, err)
+		if finish := streamRes.GetFinish(); finish != nil {
+			require.Equal(t, string(clients[0].UserID), finish.WinnerUserId)
+			require.Equal(t, int32(1), finish.OvertimeRounds)
+			break
+		}
+	}
+}
+
+func TestWinConditions(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 2, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	client1.Username = "Paula"
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client1.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, pb.WinCondition_WIN_CONDITION_POINTS, joinRes.WinCondition)
+	client2 := server.NewSampleClient()
+	client2.Username = "Quentin"
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+
+	// target has to be greater than player points
+	err = client1.StartGameWithConfig(&pb.GameConfig{
+		WinCondition: pb.WinCondition_WIN_CONDITION_TARGET,
+		WinTarget:    200,
+	})
+	require.NotNil(t, err)
+	err = client1.StartGameWithConfig(&pb.GameConfig{
+		WinCondition: pb.WinCondition_WIN_CONDITION_TARGET,
+		WinTarget:    220,
+	})
+	require.NoError(t, err)
+
+	// interest of the returned deposit reaches the target
+	_, err = client1.TakeDeposit(100)
+	require.NoError(t, err)
+
+	start := time.Now()
+	for {
+		streamRes, err := client1.Stream.Recv()
+		require.NoError(t, err)
+		if finish := streamRes.GetFinish(); finish != nil {
+			require.Equal(t, string(client1.UserID), finish.WinnerUserId)
+			break
+		}
+	}
+	require.True(t, time.Since(start) < 10*time.Second)
+}
+
+func TestGameResults(t *testing.T) {
+	var err error
+
+	clock := server.NewManualClock(time.Now())
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 0, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithClock(clock))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	client1.Username = "Rita"
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	client2 := server.NewSampleClient()
+	client2.Username = "Steve"
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	// positions are still outstanding, when the game finishes
+	clock.Advance(15 * time.Second)
+	_, err = client1.TakeDeposit(100)
+	require.NoError(t, err)
+	_, err = client2.TakeCredit(50)
+	require.NoError(t, err)
+	clock.Advance(15 * time.Second)
+
+	var results *pb.GameResults
+	for {
+		streamRes, err := client1.Stream.Recv()
+		require.NoError(t, err)
+		if res := streamRes.GetResults(); res != nil {
+			results = res
+		}
+		if streamRes.GetFinish() != nil {
+			break
+		}
+	}
+	require.NotNil(t, results)
+	require.Len(t, results.Rankings, 2)
+
+	winner := results.Rankings[0]
+	require.Equal(t, string(client2.UserID), winner.UserId)
+	require.Equal(t, int32(1), winner.Rank)
+	require.True(t, winner.Won)
+	require.Equal(t, int32(250), winner.Cash)
+	require.Equal(t, int32(50), winner.OutstandingCredit)
+	require.Equal(t, int32(200), winner.NetWorth)
+
+	loser := results.Rankings[1]
+	require.Equal(t, string(client1.UserID), loser.UserId)
+	require.Equal(t, int32(2), loser.Rank)
+	require.False(t, loser.Won)
+	require.Equal(t, int32(100), loser.Cash)
+	require.Equal(t, int32(100), loser.Deposits)
+	require.Equal(t, int32(200), loser.NetWorth)
+
+	require.Empty(t, results.BiggestLotteryUserId)
+	require.Empty(t, results.MostQuestionsUserId)
+}
+
+func TestGameSummary(t *testing.T) {
+	var err error
+
+	clock := server.NewManualClock(time.Now())
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 0, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithStorage(server.NewMemoryStorage()), server.WithClock(clock))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Trudy"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.OpenStream()
+	require.NoError(t, err)
+
+	// summary is kept only after the finish
+	_, err = client.GetGameSummary(string(client.GameID))
+	require.NotNil(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+	_, err = client.GetGameSummary(string(client.GameID))
+	require.NotNil(t, err)
+
+	// credit is still outstanding, when the game finishes
+	clock.Advance(15 * time.Second)
+	_, err = client.TakeCredit(50)
+	require.NoError(t, err)
+	clock.Advance(15 * time.Second)
+	for {
+		streamRes, err := client.Stream.Recv()
+		require.NoError(t, err)
+		if streamRes.GetFinish() != nil {
+			break
+		}
+	}
+
+	summary, err := client.GetGameSummary(string(client.GameID))
+	require.NoError(t, err)
+	require.Equal(t, string(client.GameID), summary.GameId)
+	require.Equal(t, string(client.UserID), summary.Finish.WinnerUserId)
+	require.Len(t, summary.Results.Rankings, 1)
+	require.Equal(t, int32(250), summary.Results.Rankings[0].Cash)
+
+	// finished game is kept in the history of the player
+	historyRes, err := client.ListGameHistory(0, 0, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, historyRes.Games, 1)
+	require.Equal(t, summary.GameId, historyRes.Games[0].GameId)
+}
+
+func TestGameHistory(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(2, 200, 400, 30, 20, 1, 1, 1, 0, 1, 150, 150)
+	_, err = server.NewServer(gameConfig).ListGameHistory(context.Background(), &pb.ListGameHistoryRequest{Username: "Uma"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	storage := server.NewMemoryStorage()
+	now := time.Now()
+	addGame := func(gameID string, finishTime time.Time, usernames ...string) {
+		summary := &pb.GameSummary{GameId: gameID, Results: &pb.GameResults{}}
+		for _, username := range usernames {
+			summary.Results.Rankings = append(summary.Results.Rankings, &pb.PlayerResult{Username: username})
+		}
+		err := storage.AddHistory(server.HistoryRecord{GameID: gameID, FinishTime: finishTime, Summary: summary})
+		require.NoError(t, err)
+	}
+	addGame("first", now.Add(-72*time.Hour), "Uma", "Victor")
+	addGame("second", now.Add(-48*time.Hour), "Victor")
+	addGame("third", now.Add(-24*time.Hour), "Uma")
+	addGame("fourth", now, "Uma", "Victor")
+	s := server.NewServer(gameConfig, server.WithStorage(storage))
+
+	getGameIDs := func(res *pb.ListGameHistoryResponse) []string {
+		var gameIDs []string
+		for _, game := range res.Games {
+			gameIDs = append(gameIDs, game.GameId)
+		}
+		return gameIDs
+	}
+
+	res, err := s.ListGameHistory(context.Background(), &pb.ListGameHistoryRequest{Username: "Uma"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"fourth", "third", "first"}, getGameIDs(res))
+	require.Equal(t, int32(0), res.NextOffset)
+
+	res, err = s.ListGameHistory(context.Background(), &pb.ListGameHistoryRequest{Username: "Uma", Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"fourth", "third"}, getGameIDs(res))
+	require.Equal(t, int32(2), res.NextOffset)
+	res, err = s.ListGameHistory(context.Background(), &pb.ListGameHistoryRequest{Username: "Uma", Offset: 2, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first"}, getGameIDs(res))
+	require.Equal(t, int32(0), res.NextOffset)
+
+	// until is exclusive
+	toMillis := func(t time.Time) int64 {
+		return t.UnixNano() / int64(time.Millisecond)
+	}
+	res, err = s.ListGameHistory(context.Background(), &pb.ListGameHistoryRequest{
+		Username: "Victor",
+		Since:    toMillis(now.Add(-60 * time.Hour)),
+		Until:    toMillis(now),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"second"}, getGameIDs(res))
+}
+
+func TestExportGame(t *testing.T) {
+	var err error
+
+	clock := server.NewManualClock(time.Now())
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 0, 20, 150, 150)
+	s := server.NewServer(
+		gameConfig,
+		server.WithStorage(server.NewMemoryStorage()),
+		server.WithAdminToken("secret"),
+		server.WithClock(clock),
+	)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Wendy"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.OpenStream()
+	require.NoError(t, err)
+
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := pb.NewAdminClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), server.AdminTokenMetadataKey, "secret")
+	gameID := string(client.GameID)
+
+	_, err = admin.ExportGame(ctx, &pb.ExportGameRequest{GameId: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// only finished games are exported
+	err = client.StartGame()
+	require.NoError(t, err)
+	_, err = admin.ExportGame(ctx, &pb.ExportGameRequest{GameId: gameID})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	clock.Advance(15 * time.Second)
+	_, err = client.TakeCredit(50)
+	require.NoError(t, err)
+	clock.Advance(15 * time.Second)
+	for {
+		streamRes, err := client.Stream.Recv()
+		require.NoError(t, err)
+		if streamRes.GetFinish() != nil {
+			break
+		}
+	}
+
+	exportRes, err := admin.ExportGame(ctx, &pb.ExportGameRequest{GameId: gameID})
+	require.NoError(t, err)
+	export := &pb.GameExport{}
+	err = protojson.Unmarshal([]byte(exportRes.Json), export)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), export.Version)
+	require.Equal(t, gameID, export.GameId)
+	require.Equal(t, int32(30), export.Config.Duration)
+	require.True(t, export.FinishTime >= export.CreateTime)
+	require.Equal(t, server.EventJoin, export.Events[0].Kind)
+	require.Equal(t, server.EventFinish, export.Events[len(export.Events)-1].Kind)
+	require.Len(t, export.Players, 2)
+	require.Equal(t, string(client.UserID), export.Players[0].UserId)
+	require.Equal(t, int32(250), export.Players[0].Points)
+	require.Equal(t, "bank", export.Players[1].UserId)
+	require.Len(t, export.Results.Rankings, 1)
+	require.True(t, export.Results.Rankings[0].Won)
+}
+
+func TestGetTransactions(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Xavier"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+	_, err = client.TakeCredit(50)
+	require.NoError(t, err)
+	_, err = client.TakeDeposit(100)
+	require.NoError(t, err)
+
+	res, err := client.GetTransactions(0, 0)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), res.Total)
+	require.Equal(t, server.EventJoin, res.Entries[0].Kind)
+	require.Equal(t, int32(200), res.Entries[0].Balance)
+	require.Equal(t, server.EventCredit, res.Entries[1].Kind)
+	require.Equal(t, int32(50), res.Entries[1].Value)
+	require.Equal(t, int32(250), res.Entries[1].Balance)
+	require.NotEmpty(t, res.Entries[1].RefId)
+	require.Equal(t, server.EventDeposit, res.Entries[2].Kind)
+	require.Equal(t, int32(-100), res.Entries[2].Value)
+	require.Equal(t, int32(150), res.Entries[2].Balance)
+
+	res, err = client.GetTransactions(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), res.Total)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, server.EventCredit, res.Entries[0].Kind)
+
+	_, err = client.GetTransactions(-1, 0)
+	require.NotNil(t, err)
+}
+
+func TestStreamSequence(t *testing.T) {
+	var err error
+
+	clock := server.NewManualClock(time.Now())
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 0, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithClock(clock))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	client1.Username = "Yara"
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+	client2 := server.NewSampleClient()
+	client2.Username = "Zed"
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+	// positions are outstanding, when the game finishes
+	clock.Advance(15 * time.Second)
+	_, err = client1.TakeCredit(50)
+	require.NoError(t, err)
+	_, err = client2.TakeDeposit(50)
+	require.NoError(t, err)
+
+	// every broadcast event follows the previous one without gaps,
+	// events sent before the stream has been opened are missed
+	sequence := int64(0)
+	for {
+		streamRes, err := client1.Stream.Recv()
+		require.NoError(t, err)
+		if sequence > 0 {
+			require.Equal(t, sequence+1, streamRes.Sequence)
+		}
+		sequence = streamRes.Sequence
+		if streamRes.GetTransaction() != nil {
+			stateRes, err := client2.GetGameState()
+			require.NoError(t, err)
+			require.True(t, stateRes.State.Sequence >= sequence)
+			if streamRes.GetTransaction().GetUseDeposit() != nil {
+				clock.Advance(15 * time.Second)
+			}
+		}
+		if streamRes.GetFinish() != nil {
+			break
+		}
+	}
+}
+
+func TestStreamBacklog(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Abigail"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.OpenStream()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	// events are broadcast asynchronously
+	recvTransaction := func() *pb.StreamResponse {
+		for {
+			streamRes, err := client.Stream.Recv()
+			require.NoError(t, err)
+			if streamRes.GetTransaction() != nil {
+				return streamRes
+			}
+		}
+	}
+	_, err = client.TakeCredit(50)
+	require.NoError(t, err)
+	client.LastSeenSequence = recvTransaction().Sequence
+	_, err = client.TakeDeposit(20)
+	require.NoError(t, err)
+	recvTransaction()
+	_, err = client.TakeDeposit(30)
+	require.NoError(t, err)
+	recvTransaction()
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, client.LastSeenSequence+2, stateRes.State.Sequence)
+
+	// missed events are sent instead of the state
+	err = client.Reconnect()
+	require.NoError(t, err)
+	for i := int64(1); i <= 2; i++ {
+		streamRes, err := client.Stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, client.LastSeenSequence+i, streamRes.Sequence)
+		require.NotNil(t, streamRes.GetTransaction().GetUseDeposit())
+	}
+
+	// unknown sequence number is answered with the state
+	client.LastSeenSequence = 1000
+	err = client.Reconnect()
+	require.NoError(t, err)
+	streamRes, err := client.Stream.Recv()
+	require.NoError(t, err)
+	require.NotNil(t, streamRes.GetState())
+}
+
+func TestHeartbeat(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithHeartbeat(time.Second))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Heidi"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.OpenStream()
+	require.NoError(t, err)
+
+	recvHeartbeat := func() *pb.StreamResponse_Heartbeat {
+		for {
+			streamRes, err := client.Stream.Recv()
+			require.NoError(t, err)
+			if heartbeat := streamRes.GetHeartbeat(); heartbeat != nil {
+				return heartbeat
+			}
+		}
+	}
+
+	// waiting game has no remaining time
+	heartbeat := recvHeartbeat()
+	require.Equal(t, pb.GameStatus_GAME_STATUS_WAITING, heartbeat.Status)
+	require.Equal(t, int32(0), heartbeat.RemainingTime)
+	require.False(t, heartbeat.Paused)
+	require.NotZero(t, heartbeat.ServerTime)
+
+	err = client.StartGame()
+	require.NoError(t, err)
+	for heartbeat.Status != pb.GameStatus_GAME_STATUS_ACTIVE {
+		heartbeat = recvHeartbeat()
+	}
+	require.Greater(t, heartbeat.RemainingTime, int32(0))
+	require.LessOrEqual(t, heartbeat.RemainingTime, int32(30))
+}
+
+func TestStreamEnd(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(2, 200, 400, 30, 20, 1, 1, 1, 0, 1, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	client1.Username = "Ingrid"
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	err = client1.OpenStream()
+	require.NoError(t, err)
+	client2 := server.NewSampleClient()
+	client2.Username = "Ivan"
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+	err = client2.OpenStream()
+	require.NoError(t, err)
+
+	// streams end right away, without waiting for the next poll
+	recvUntilEnd := func(stream pb.Game_StreamClient) {
+		deadline := time.Now().Add(500 * time.Millisecond)
+		for {
+			_, err := stream.Recv()
+			if err != nil {
+				require.Equal(t, io.EOF, err)
+				require.True(t, time.Now().Before(deadline))
+				return
+			}
+		}
+	}
+
+	// the player leaves the game
+	err = client1.LeaveGame()
+	require.NoError(t, err)
+	recvUntilEnd(client1.Stream)
+
+	// the player replaces the stream
+	stream := client2.Stream
+	err = client2.Reconnect()
+	require.NoError(t, err)
+	recvUntilEnd(stream)
+
+	// the game finishes after the final events are sent
+	err = client2.StartGame()
+	require.NoError(t, err)
+	for {
+		streamRes, err := client2.Stream.Recv()
+		require.NoError(t, err)
+		if streamRes.GetFinish() != nil {
+			break
+		}
+	}
+	recvUntilEnd(client2.Stream)
+}
+
+func TestStreamSubscriptions(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client1 := server.NewSampleClient()
+	client1.Username = "Jasper"
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	_, err = client1.JoinGame()
+	require.NoError(t, err)
+	client1.Categories = []pb.EventCategory{pb.EventCategory_EVENT_CATEGORY_CHAT, pb.EventCategory(100)}
+	err = client1.OpenStream()
+	require.NoError(t, err)
+	// unknown categories are rejected
+	_, err = client1.Stream.Recv()
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	client1.Categories = []pb.EventCategory{pb.EventCategory_EVENT_CATEGORY_CHAT}
+	err = client1.OpenStream()
+	require.NoError(t, err)
+
+	client2 := server.NewSampleClient()
+	client2.Username = "Juno"
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	_, err = client2.JoinGame()
+	require.NoError(t, err)
+	err = client2.OpenStream()
+	require.NoError(t, err)
+	err = client1.StartGame()
+	require.NoError(t, err)
+	_, err = client1.TakeCredit(50)
+	require.NoError(t, err)
+	_, err = client2.SendChat("hello")
+	require.NoError(t, err)
+
+	// join, start, and the transaction are skipped
+	streamRes, err := client1.Stream.Recv()
+	require.NoError(t, err)
+	require.NotNil(t, streamRes.GetChat())
+	require.Equal(t, "hello", streamRes.GetChat().Text)
+
+	// other players get all events
+	streamRes, err = client2.Stream.Recv()
+	require.NoError(t, err)
+	require.Nil(t, streamRes.GetChat())
+}
+
+func TestStreamBatching(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Kira"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	client.BatchInterval = 200
+	client.BatchSize = 101
+	err = client.OpenStream()
+	require.NoError(t, err)
+	// batch size is limited
+	_, err = client.Stream.Recv()
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	client.BatchSize = 0
+	err = client.OpenStream()
+	require.NoError(t, err)
+
+	err = client.StartGame()
+	require.NoError(t, err)
+	_, err = client.TakeCredit(50)
+	require.NoError(t, err)
+	_, err = client.TakeDeposit(20)
+	require.NoError(t, err)
+	_, err = client.TakeCredit(30)
+	require.NoError(t, err)
+
+	// the events are collected into batches, and
+	// single events are sent without the batch
+	var transactions []*pb.StreamResponse
+	batched := false
+	for len(transactions) < 3 {
+		streamRes, err := client.Stream.Recv()
+		require.NoError(t, err)
+		events := []*pb.StreamResponse{streamRes}
+		if batch := streamRes.GetBatch(); batch != nil {
+			require.Greater(t, len(batch.Events), 1)
+			require.Equal(t, batch.Events[len(batch.Events)-1].Sequence, streamRes.Sequence)
+			events = batch.Events
+			batched = true
+		}
+		var last *pb.StreamResponse_Transaction
+		for _, event := range events {
+			if transaction := event.GetTransaction(); transaction != nil {
+				// players are listed only in the latest transaction
+				if last != nil {
+					require.Empty(t, last.Players)
+				}
+				last = transaction
+				transactions = append(transactions, event)
+			}
+		}
+		if last != nil {
+			require.NotEmpty(t, last.Players)
+		}
+	}
+	require.True(t, batched)
+	require.NotNil(t, transactions[0].GetTransaction().GetUseCredit())
+	require.NotNil(t, transactions[1].GetTransaction().GetUseDeposit())
+	require.NotNil(t, transactions[2].GetTransaction().GetUseCredit())
+}
+
+func TestTimeTicks(t *testing.T) {
+	var err error
+
+	gameConfig := server.NewGameConfig(11, 200, 400, 30, 20, 10, 10, 10, 0, 10, 150, 150, server.WithTimeTicks(5))
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Lior"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(5), joinRes.TimeTickTime)
+	err = client.OpenStream()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	recvTimeTick := func() *pb.StreamResponse_TimeTick {
+		for {
+			streamRes, err := client.Stream.Recv()
+			require.NoError(t, err)
+			if timeTick := streamRes.GetTimeTick(); timeTick != nil {
+				return timeTick
+			}
+		}
+	}
+
+	// the marker comes before the regular tick
+	timeTick := recvTimeTick()
+	require.Equal(t, int32(10), timeTick.RemainingTime)
+	require.Equal(t, pb.StreamResponse_TimeTick_MARKER_TEN_SECONDS, timeTick.Marker)
+	endTime := time.Unix(0, timeTick.EndTime*int64(time.Millisecond))
+	require.WithinDuration(t, time.Now().Add(10*time.Second), endTime, time.Second)
+
+	timeTick = recvTimeTick()
+	require.Equal(t, int32(5), timeTick.RemainingTime)
+	require.Equal(t, pb.StreamResponse_TimeTick_MARKER_NONE, timeTick.Marker)
+}
+
+func TestManualClock(t *testing.T) {
+	var err error
+
+	clock := server.NewManualClock(time.Date(2020, 11, 30, 12, 0, 0, 0, time.UTC))
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithClock(clock))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Mateo"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.OpenStream()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	recvUntil := func(found func(*pb.StreamResponse) bool) {
+		for {
+			streamRes, err := client.Stream.Recv()
+			require.NoError(t, err)
+			if found(streamRes) {
+				return
+			}
+		}
+	}
+
+	_, err = client.TakeCredit(50)
+	require.NoError(t, err)
+	recvUntil(func(streamRes *pb.StreamResponse) bool {
+		return streamRes.GetTransaction().GetUseCredit() != nil
+	})
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(30), stateRes.State.RemainingTime)
+
+	// the credit is returned, once its time has passed on the clock
+	clock.Advance(20 * time.Second)
+	recvUntil(func(streamRes *pb.StreamResponse) bool {
+		return streamRes.GetTransaction().GetReturnCredit() != nil
+	})
+	stateRes, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(10), stateRes.State.RemainingTime)
+
+	// the game is finished without waiting for its duration
+	clock.Advance(10 * time.Second)
+	recvUntil(func(streamRes *pb.StreamResponse) bool {
+		return streamRes.GetFinish() != nil
+	})
+}
+
+func TestSeed(t *testing.T) {
+	// games with the same seed draw the same lottery cells
+	playLottery := func() [][]int32 {
+		clock := server.NewManualClock(time.Now())
+		gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 10, 10, 25, 15, 1, 150, 150)
+		s := server.NewServer(gameConfig, server.WithClock(clock), server.WithSeed(42))
+		addr, err := s.Listen("localhost:0")
+		require.NoError(t, err)
+		go s.Launch()
+
+		client := server.NewSampleClient()
+		err = client.Connect(addr)
+		require.NoError(t, err)
+		_, err = client.JoinGame()
+		require.NoError(t, err)
+		err = client.StartGame()
+		require.NoError(t, err)
+
+		var draws [][]int32
+		for i := 0; i < 3; i++ {
+			clock.Advance(time.Second)
+			res, err := client.PlayLottery(1)
+			require.NoError(t, err)
+			require.True(t, res.Success)
+			draws = append(draws, res.CellValues)
+		}
+		return draws
+	}
+
+	require.Equal(t, playLottery(), playLottery())
+}
+
+func TestConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	// missing settings keep their defaults
+	conf, err := config.Load(writeConfig("server.yaml", `
+addr: localhost:0
+limits:
+  user_rate: 10
+game:
+  duration: 600
+  player_points: 300
+  lottery_rows: 2
+  tax_time: 30
+  tax_brackets: [{threshold: 0, percentage: 5}]
+`))
+	require.NoError(t, err)
+	require.Equal(t, int32(10), conf.Limits.UserRate)
+	require.Equal(t, config.Default().Limits.UserBurst, conf.Limits.UserBurst)
+
+	gameConfig, err := conf.GameConfig()
+	require.NoError(t, err)
+	opts, err := conf.ServerOptions(zap.NewNop())
+	require.NoError(t, err)
+	s := server.NewServer(gameConfig, opts...)
+	addr, err := s.Listen(conf.Addr)
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(600), joinRes.Duration)
+	require.Equal(t, int32(300), joinRes.PlayerPoints)
+	require.Equal(t, int32(2), joinRes.LotteryRows)
+	require.Equal(t, int32(3), joinRes.LotteryColumns)
+	require.Equal(t, int32(30), joinRes.TaxTime)
+
+	// empty file is the default config
+	_, err = config.Load(writeConfig("empty.yaml", ""))
+	require.NoError(t, err)
+
+	// presets are decoded on top of the default game section
+	conf, err = config.Load(writeConfig("presets.yaml", "presets:\n  long:\n    duration: 900\n"))
+	require.NoError(t, err)
+	require.Equal(t, int32(900), conf.Presets["long"].Duration)
+	require.Equal(t, config.Default().Game.PlayerPoints, conf.Presets["long"].PlayerPoints)
+	// times are longer than the preset duration
+	_, err = config.Load(writeConfig("invalid.yaml", "presets:\n  short:\n    duration: 10\n"))
+	require.Error(t, err)
+
+	// translations have to keep the verbs of their formats
+	conf, err = config.Load(writeConfig("messages.yaml", "messages:\n  fr:\n    \"player has only %d shares\": \"le joueur n'a que %d actions\"\n"))
+	require.NoError(t, err)
+	require.Equal(t, "le joueur n'a que %d actions", conf.Messages["fr"]["player has only %d shares"])
+	_, err = config.Load(writeConfig("invalid.yaml", "messages:\n  fr:\n    \"player has only %d shares\": \"le joueur n'a que %s actions\"\n"))
+	require.Error(t, err)
+
+	invalid := []string{
+		"game:\n  duratoin: 600\n",           // misspelled setting
+		"game:\n  mode: solo\n",              // unknown mode
+		"game:\n  credit_interest: 10\n",     // lower than deposit interest
+		"tls:\n  cert: server.pem\n",         // certificate without key
+		"limits:\n  user_burst: 0\n",         // burst has to be positive
+		"bots:\n  profiles: [reckless]\n",    // unknown bot profile
+		"game:\n  bank_strategy: generous\n", // unknown bank strategy
+	}
+	for _, content := range invalid {
+		_, err = config.Load(writeConfig("invalid.yaml", content))
+		require.Error(t, err, content)
+	}
+
+	_, err = config.Load(writeConfig("server.toml", "addr = \"localhost:0\"\n"))
+	require.Error(t, err)
+}
+
+func TestConfigEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("addr: localhost:9090\ngame:\n  duration: 600\n  player_points: 300\n"), 0600))
+	env := map[string]string{
+		"LISTEN_ADDR":            "localhost:0",
+		"GAME_DURATION":          "900",
+		"BANK_POINTS_PER_PLAYER": "500",
+		"MASK_THIEVES":           "true",
+		"MARKET_ASSETS":          "gold, oil",
+		"TAX_BRACKETS":           "0:5,500:20",
+		"TLS_CERT":               "",
+	}
+	lookup := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+
+	// variables override the file, which overrides the defaults
+	conf, err := config.LoadWithEnv(path, lookup)
+	require.NoError(t, err)
+	require.Equal(t, "localhost:0", conf.Addr)
+	require.Equal(t, int32(900), conf.Game.Duration)
+	require.Equal(t, int32(300), conf.Game.PlayerPoints)
+	require.Equal(t, int32(500), conf.Game.BankPointsPerPlayer)
+	require.Equal(t, config.Default().Game.CreditInterest, conf.Game.CreditInterest)
+	require.True(t, conf.Game.MaskThieves)
+	require.Equal(t, []string{"gold", "oil"}, conf.Game.MarketAssets)
+	require.Equal(t, []server.TaxBracket{{Threshold: 0, Percentage: 5}, {Threshold: 500, Percentage: 20}}, conf.Game.TaxBrackets)
+
+	// defaults are used without the file
+	conf, err = config.LoadWithEnv("", lookup)
+	require.NoError(t, err)
+	require.Equal(t, config.Default().Game.PlayerPoints, conf.Game.PlayerPoints)
+	require.Equal(t, int32(900), conf.Game.Duration)
+
+	env["GAME_DURATION"] = "long"
+	_, err = config.LoadWithEnv(path, lookup)
+	require.Error(t, err)
+
+	// overridden config is validated as a whole
+	env["GAME_DURATION"] = "10"
+	_, err = config.LoadWithEnv(path, lookup)
+	require.Error(t, err)
+}
+
+func TestGameConfigValidate(t *testing.T) {
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	require.NoError(t, gameConfig.Validate())
+
+	// all violations are reported at once
+	gameConfig = server.NewGameConfig(0, 200, 400, 120, 20, 20, 20, 25, 15, 20, 150, 150, server.WithJackpot(150, 1))
+	err := gameConfig.Validate()
+	require.Error(t, err)
+	configErr, ok := err.(*server.ConfigError)
+	require.True(t, ok)
+	require.Len(t, configErr.Violations, 5)
+	require.Contains(t, err.Error(), "duration has to be positive")
+	require.Contains(t, err.Error(), "less than 100 percent")
+	require.Contains(t, err.Error(), "jackpot percentage (150)")
+
+	// server with invalid config doesn't listen
+	s := server.NewServer(gameConfig)
+	_, err = s.Listen("localhost:0")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "duration has to be positive")
+
+	// invalid overrides are rejected
+	gameConfig = server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s = server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+	client := server.NewSampleClient()
+	client.Username = "Nadia"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.StartGameWithConfig(&pb.GameConfig{CreditTime: 60})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "InvalidArgument")
+}
+
+func TestSetDefaultConfig(t *testing.T) {
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithAdminToken("secret"))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := pb.NewAdminClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), server.AdminTokenMetadataKey, "secret")
+
+	// empty waiting game is replaced
+	setRes, err := admin.SetDefaultConfig(ctx, &pb.SetDefaultConfigRequest{Config: &pb.GameConfig{Duration: 45}})
+	require.NoError(t, err)
+	require.Equal(t, int32(45), setRes.Config.Duration)
+	require.Equal(t, int32(200), setRes.Config.PlayerPoints)
+
+	client1 := server.NewSampleClient()
+	client1.Username = "Oscar"
+	err = client1.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err := client1.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(45), joinRes.Duration)
+
+	// waiting game with players keeps its config
+	_, err = admin.SetDefaultConfig(ctx, &pb.SetDefaultConfigRequest{Config: &pb.GameConfig{Duration: 60}})
+	require.NoError(t, err)
+	client2 := server.NewSampleClient()
+	client2.Username = "Olga"
+	err = client2.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err = client2.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, client1.GameID, client2.GameID)
+	require.Equal(t, int32(45), joinRes.Duration)
+	err = client1.StartGame()
+	require.NoError(t, err)
+
+	client3 := server.NewSampleClient()
+	client3.Username = "Otto"
+	err = client3.Connect(addr)
+	require.NoError(t, err)
+	joinRes, err = client3.JoinGame()
+	require.NoError(t, err)
+	require.NotEqual(t, client1.GameID, client3.GameID)
+	require.Equal(t, int32(60), joinRes.Duration)
+
+	_, err = admin.SetDefaultConfig(ctx, &pb.SetDefaultConfigRequest{Config: &pb.GameConfig{CreditTime: 90}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPresets(t *testing.T) {
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	custom := server.NewGameConfig(60, 100, 200, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithPreset("custom", custom))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.CreateRoom("unknown", &pb.GameConfig{Preset: "unknown"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "InvalidArgument")
+
+	_, err = client.CreateRoom("marathon", &pb.GameConfig{Preset: server.PresetMarathon})
+	require.NoError(t, err)
+	// other overrides are applied on top of the preset
+	_, err = client.CreateRoom("custom", &pb.GameConfig{Preset: "custom", PlayerPoints: 150})
+	require.NoError(t, err)
+
+	listRes, err := client.ListRooms()
+	require.NoError(t, err)
+	require.Len(t, listRes.Rooms, 3) // with the waiting game
+	for _, room := range listRes.Rooms {
+		switch room.Name {
+		case "marathon":
+			require.Equal(t, int32(7200), room.Config.Duration)
+			require.NotEmpty(t, room.Config.MarketAssets)
+		case "custom":
+			require.Equal(t, int32(60), room.Config.Duration)
+			require.Equal(t, int32(150), room.Config.PlayerPoints)
+			require.Equal(t, int32(200), room.Config.BankPointsPerPlayer)
+		}
+		require.Empty(t, room.Config.Preset)
+	}
+
+	// presets keep the lobby settings of the game
+	client.Username = "Pavel"
+	_, err = client.JoinPractice(1)
+	require.NoError(t, err)
+	err = client.StartGameWithConfig(&pb.GameConfig{Preset: server.PresetQuick})
+	require.NoError(t, err)
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, pb.GameStatus_GAME_STATUS_ACTIVE, stateRes.State.Status)
+}
+
+func TestDebugEndpoint(t *testing.T) {
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithDebug("0.0.0.0:0"))
+	_, err := s.Listen("localhost:0")
+	require.Error(t, err)
+
+	s = server.NewServer(gameConfig, server.WithDebug("localhost:0"))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Rosalind"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.OpenStream()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+
+	res, err := http.Get("http://" + s.DebugAddr() + "/debug/info")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	var info struct {
+		Goroutines  int `json:"goroutines"`
+		ActiveGames int `json:"active_games"`
+		Games       []struct {
+			Players int `json:"players"`
+			Streams int `json:"streams"`
+		} `json:"games"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&info)
+	require.NoError(t, err)
+	require.True(t, info.Goroutines > 0)
+	require.Equal(t, 1, info.ActiveGames)
+	require.Len(t, info.Games, 1)
+	require.Equal(t, 1, info.Games[0].Players)
+	require.Equal(t, 1, info.Games[0].Streams)
+
+	res, err = http.Get("http://" + s.DebugAddr() + "/debug/pprof/mutex?debug=1")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestRequestLogging(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150)
+	s := server.NewServer(gameConfig, server.WithLogger(zap.New(core)), server.WithLogSampling(3))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Ruben"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	_, err = client.TakeCredit(50)
+	require.Error(t, err)
+	for i := 0; i < 6; i++ {
+		_, err = client.GetGameState()
+		require.NoError(t, err)
+	}
+
+	// ids are taken from the response, if the request doesn't carry them
+	joinLogs := logs.FilterMessage("RPC finished").FilterField(zap.String("rpc", "/server.Game/Join")).All()
+	require.Len(t, joinLogs, 1)
+	require.Equal(t, "Ruben", joinLogs[0].ContextMap()["username"])
+	require.Equal(t, string(client.UserID), joinLogs[0].ContextMap()["user_id"])
+	require.Equal(t, string(client.GameID), joinLogs[0].ContextMap()["game_id"])
+	require.Contains(t, joinLogs[0].ContextMap(), "duration")
+
+	failedLogs := logs.FilterMessage("RPC failed").All()
+	require.Len(t, failedLogs, 1)
+	require.Equal(t, string(client.UserID), failedLogs[0].ContextMap()["user_id"])
+	require.Equal(t, codes.InvalidArgument.String(), failedLogs[0].ContextMap()["code"])
+
+	// polled methods are sampled
+	stateLogs := logs.FilterMessage("RPC finished").FilterField(zap.String("rpc", "/server.Game/GetGameState")).All()
+	require.Len(t, stateLogs, 2)
+}
+
+func TestRequestValidation(t *testing.T) {
+	gameConfig := server.NewGameConfig(30, 200, 400, 30, 20, 20, 20, 25, 15, 20, 150, 150, server.WithLotteryGrid(2, 2))
+	s := server.NewServer(gameConfig)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Sybil"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+
+	// bounds, which depend on the config, are checked against the game
+	err = client.ChooseTeam(1)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "game has no teams")
+	err = client.StartGame()
+	require.NoError(t, err)
+	_, err = client.PlayLottery(5)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cell index has to be from 1 to 4, received: 5")
+
+	_, err = client.TakeCredit(0)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "requested value has to be positive, received: 0")
+	_, err = client.CreateRoom("", nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "room name has to be non-empty")
+
+	// requests outside of the games are validated the same way
+	ctx := context.Background()
+	_, err = client.GameClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Limit: 101})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.GameClient.ListGameHistory(ctx, &pb.ListGameHistoryRequest{Username: "Uma", Limit: 101})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.GameClient.ListGameHistory(ctx, &pb.ListGameHistoryRequest{Username: "Uma", Since: 2, Until: 1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.GameClient.GetPlayerStats(ctx, &pb.GetPlayerStatsRequest{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "username has to be non-empty")
+}
+
+func TestAFK(t *testing.T) {
+	var err error
+
+	clock := server.NewManualClock(time.Now())
+	gameConfig := server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 0, 20, 150, 150,
+		server.WithAFK(5, server.ForfeitAFK),
+	)
+	s := server.NewServer(gameConfig, server.WithClock(clock))
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	active := server.NewSampleClient()
+	active.Username = "Rhea"
+	err = active.Connect(addr)
+	require.NoError(t, err)
+	res, err := active.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(5), res.AfkTime)
+	require.Equal(t, pb.AfkPolicy_AFK_POLICY_FORFEIT, res.AfkPolicy)
+	idle := server.NewSampleClient()
+	idle.Username = "Percival"
+	err = idle.Connect(addr)
+	require.NoError(t, err)
+	_, err = idle.JoinGame()
+	require.NoError(t, err)
+	err = active.OpenStream()
+	require.NoError(t, err)
+	err = active.StartGame()
+	require.NoError(t, err)
+
+	// players, who haven't acted for the AFK time, forfeit
+	clock.Advance(3 * time.Second)
+	_, err = active.TakeDeposit(1)
+	require.NoError(t, err)
+	clock.Advance(3 * time.Second)
+	getPlayers := func() map[string]*pb.Player {
+		stateRes, err := active.GetGameState()
+		require.NoError(t, err)
+		players := make(map[string]*pb.Player)
+		for _, player := range stateRes.State.Players {
+			players[player.UserId] = player
+		}
+		return players
+	}
+	players := getPlayers()
+	require.False(t, players[string(active.UserID)].Afk)
+	require.True(t, players[string(idle.UserID)].Afk)
+	require.True(t, players[string(idle.UserID)].Forfeited)
+
+	// the flag is cleared by the next action, but the forfeit is final,
+	// so the player is ranked last even with more points
+	_, err = idle.TakeCredit(100)
+	require.NoError(t, err)
+	clock.Advance(time.Second)
+	players = getPlayers()
+	require.False(t, players[string(idle.UserID)].Afk)
+	require.True(t, players[string(idle.UserID)].Forfeited)
+	for i := 0; i < 6; i++ {
+		_, err = active.TakeDeposit(1)
+		require.NoError(t, err)
+		clock.Advance(4 * time.Second)
+	}
+
+	var afkEvents []*pb.StreamResponse_Afk
+	var results *pb.GameResults
+	for {
+		streamRes, err := active.Stream.Recv()
+		require.NoError(t, err)
+		if afk := streamRes.GetAfk(); afk != nil {
+			afkEvents = append(afkEvents, afk)
+		}
+		if res := streamRes.GetResults(); res != nil {
+			results = res
+		}
+		if streamRes.GetFinish() != nil {
+			break
+		}
+	}
+	// the player, who has forfeited, is flagged again after the action
+	require.Len(t, afkEvents, 3)
+	for i, afk := range afkEvents {
+		require.Equal(t, string(idle.UserID), afk.UserId)
+		require.Equal(t, i != 1, afk.Afk)
+		require.True(t, afk.Forfeited)
+	}
+	require.NotNil(t, results)
+	require.Len(t, results.Rankings, 2)
+	require.Equal(t, string(active.UserID), results.Rankings[0].UserId)
+	require.False(t, results.Rankings[0].Forfeited)
+	require.Equal(t, string(idle.UserID), results.Rankings[1].UserId)
+	require.Equal(t, int32(2), results.Rankings[1].Rank)
+	require.True(t, results.Rankings[1].Forfeited)
+
+	// AFK players keep their deposits under the manage policy
+	clock = server.NewManualClock(time.Now())
+	gameConfig = server.NewGameConfig(
+		30, 200, 400, 30, 20, 20, 20, 25, 0, 20, 150, 150,
+		server.WithAFK(5, server.ManageAFK),
+	)
+	s = server.NewServer(gameConfig, server.WithClock(clock))
+	addr, err = s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	client := server.NewSampleClient()
+	client.Username = "Ines"
+	err = client.Connect(addr)
+	require.NoError(t, err)
+	_, err = client.JoinGame()
+	require.NoError(t, err)
+	err = client.StartGame()
+	require.NoError(t, err)
+	_, err = client.TakeDeposit(100)
+	require.NoError(t, err)
+	clock.Advance(21 * time.Second)
+	stateRes, err := client.GetGameState()
+	require.NoError(t, err)
+	require.True(t, stateRes.State.Players[0].Afk)
+	require.False(t, stateRes.State.Players[0].Forfeited)
+	require.Len(t, stateRes.Deposits, 1)
+	require.Greater(t, stateRes.Deposits[0].Value, int32(100))
+}
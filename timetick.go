@@ -0,0 +1,68 @@
+package server
+
+import (
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+)
+
+// Seconds before the end of the game, when the remaining
+// time is broadcast with the marker.
+var timeMarkers = map[int32]pb.StreamResponse_TimeTick_Marker{
+	60: pb.StreamResponse_TimeTick_MARKER_ONE_MINUTE,
+	10: pb.StreamResponse_TimeTick_MARKER_TEN_SECONDS,
+}
+
+// scheduleTimeTick schedules the next broadcast of the remaining time
+// after the tick time or at the next marker, whichever comes first. The
+// calling function has to acquire write lock.
+func (g *game) scheduleTimeTick() {
+	untilEnd := g.getEndTime().Sub(g.clock.Now())
+	next := time.Duration(g.config.timeTickTime) * time.Second
+	for seconds := range timeMarkers {
+		untilMarker := untilEnd - time.Duration(seconds)*time.Second
+		if untilMarker > 0 && untilMarker < next {
+			next = untilMarker
+		}
+	}
+	g.clock.AfterFunc(next, func() {
+		g.doTimeTick()
+	})
+}
+
+// doTimeTick broadcasts the remaining time of the game by the server
+// clock. It is repeated, until the time runs out, so no ticks are sent
+// during overtime.
+func (g *game) doTimeTick() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state != activeState || g.deferWhilePaused(g.doTimeTick) {
+		return
+	}
+	remainingTime := g.getRemainingTime()
+	if remainingTime <= 0 {
+		return
+	}
+
+	msg := getTimeTickMessage(remainingTime, g.getEndTime(), timeMarkers[remainingTime])
+	go func() {
+		g.broadcast(msg)
+	}()
+
+	g.scheduleTimeTick()
+}
+
+func getTimeTickMessage(
+	remainingTime int32, endTime time.Time, marker pb.StreamResponse_TimeTick_Marker,
+) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_TimeTick_{
+			TimeTick: &pb.StreamResponse_TimeTick{
+				RemainingTime: remainingTime,
+				EndTime:       endTime.UnixNano() / int64(time.Millisecond),
+				Marker:        marker,
+			},
+		},
+	}
+}
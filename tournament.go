@@ -0,0 +1,413 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Maximal number of rounds in the tournament.
+const maxTournamentRounds = 10
+
+// tournamentEntrant is the player registered for the tournament.
+type tournamentEntrant struct {
+	username    username
+	locale      string
+	appearance  appearance
+	roundPoints []int32 // final points in every finished round
+	connected   bool    // disconnected entrants don't play next rounds
+	// events, which have to be sent to the entrant, it is
+	// buffered, so that sending never blocks
+	events chan *pb.TournamentEvent
+}
+
+func (e *tournamentEntrant) getPoints() int32 {
+	points := int32(0)
+	for _, roundPoints := range e.roundPoints {
+		points += roundPoints
+	}
+	return points
+}
+
+// tournament is the series of games, which are played by the same
+// players one after another. Final points of every round are summed
+// up, and the player with the most points wins the tournament.
+type tournament struct {
+	mutex        sync.Mutex
+	tournamentID string
+	name         string
+	config       GameConfig // config of every round
+	rounds       int32
+	startTime    time.Time     // registration closes at this time
+	breakTime    time.Duration // between the rounds
+	round        int32         // last started round, 0 before the start
+	finished     bool
+	entrants     []*tournamentEntrant // in order of registration
+	logger       *zap.Logger
+}
+
+// register adds the player to the tournament, if the
+// tournament has not started yet.
+func (t *tournament) register(username username, locale string, appearance appearance) (*tournamentEntrant, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.round > 0 || t.finished {
+		return nil, fmt.Errorf("tournament has been already started")
+	}
+	for _, entrant := range t.entrants {
+		if entrant.username == username {
+			return nil, fmt.Errorf("player %v is already registered for the tournament", username)
+		}
+	}
+	entrant := &tournamentEntrant{
+		username:   username,
+		locale:     locale,
+		appearance: appearance,
+		connected:  true,
+		events:     make(chan *pb.TournamentEvent, 2*t.rounds+1),
+	}
+	t.entrants = append(t.entrants, entrant)
+	entrant.events <- getTournamentStandingsEvent(t.getPBStandings())
+	t.logger.Info("Player is registered for the tournament", zap.String("username", string(username)))
+	return entrant, nil
+}
+
+// disconnect removes the entrant, whose stream is closed, before the
+// start of the tournament. Afterwards, the entrant stays in the
+// standings, but doesn't play next rounds.
+func (t *tournament) disconnect(entrant *tournamentEntrant) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	entrant.connected = false
+	if t.round > 0 {
+		return
+	}
+	for i, e := range t.entrants {
+		if e == entrant {
+			t.entrants = append(t.entrants[:i], t.entrants[i+1:]...)
+			break
+		}
+	}
+}
+
+// beginRound moves the tournament to the next round
+// and returns the entrants, who will play it.
+func (t *tournament) beginRound() (int32, []*tournamentEntrant) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.round++
+	var entrants []*tournamentEntrant
+	for _, entrant := range t.entrants {
+		if entrant.connected {
+			entrants = append(entrants, entrant)
+		}
+	}
+	return t.round, entrants
+}
+
+// sendRoundStarted sends the join response for the game
+// of the round to the entrant.
+func (t *tournament) sendRoundStarted(entrant *tournamentEntrant, round int32, res *pb.JoinResponse) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if entrant.connected {
+		entrant.events <- &pb.TournamentEvent{
+			Event: &pb.TournamentEvent_RoundStarted_{
+				RoundStarted: &pb.TournamentEvent_RoundStarted{
+					Round: round,
+					Join:  res,
+				},
+			},
+		}
+	}
+}
+
+// endRound records final points of the round and sends the standings
+// to the entrants. After the last round, the tournament is finished.
+func (t *tournament) endRound(points map[username]int32) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, entrant := range t.entrants {
+		entrant.roundPoints = append(entrant.roundPoints, points[entrant.username])
+	}
+	if t.round >= t.rounds {
+		t.finished = true
+	}
+
+	event := getTournamentStandingsEvent(t.getPBStandings())
+	for _, entrant := range t.entrants {
+		if entrant.connected {
+			entrant.events <- event
+		}
+		if t.finished {
+			close(entrant.events)
+		}
+	}
+}
+
+// finish finishes the tournament before all rounds have been played.
+func (t *tournament) finish() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.finished {
+		return
+	}
+	t.finished = true
+	event := getTournamentStandingsEvent(t.getPBStandings())
+	for _, entrant := range t.entrants {
+		if entrant.connected {
+			entrant.events <- event
+		}
+		close(entrant.events)
+	}
+}
+
+// getPBStandings returns the entrants ordered by their points.
+// The calling function has to acquire the lock.
+func (t *tournament) getPBStandings() *pb.TournamentStandings {
+	entries := make([]*pb.TournamentStandings_Entry, len(t.entrants))
+	for i, entrant := range t.entrants {
+		roundPoints := make([]int32, len(entrant.roundPoints))
+		copy(roundPoints, entrant.roundPoints)
+		entries[i] = &pb.TournamentStandings_Entry{
+			Username:    string(entrant.username),
+			Points:      entrant.getPoints(),
+			RoundPoints: roundPoints,
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Points > entries[j].Points
+	})
+	for i, entry := range entries {
+		entry.Rank = int32(i + 1)
+	}
+	return &pb.TournamentStandings{
+		TournamentId: t.tournamentID,
+		Name:         t.name,
+		Round:        t.round,
+		Rounds:       t.rounds,
+		Finished:     t.finished,
+		StartTime:    t.startTime.UnixNano() / int64(time.Millisecond),
+		Entries:      entries,
+	}
+}
+
+func getTournamentStandingsEvent(standings *pb.TournamentStandings) *pb.TournamentEvent {
+	return &pb.TournamentEvent{
+		Event: &pb.TournamentEvent_Standings{
+			Standings: standings,
+		},
+	}
+}
+
+// getFinalPoints returns points of the players, who have finished the game.
+func (g *game) getFinalPoints() map[username]int32 {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	points := make(map[username]int32)
+	for _, player := range g.players {
+		points[player.username] = player.points
+	}
+	return points
+}
+
+// getTournament returns the tournament with provided id.
+func (s *Server) getTournament(tournamentID string) (*tournament, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	t, ok := s.tournaments[tournamentID]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "there is no tournament with id %v", tournamentID)
+	}
+	return t, nil
+}
+
+// runTournament plays the rounds of the tournament one after another.
+// Every round is the room, which only the entrants can join, since
+// nobody knows its code. It is counted down to the start like the full
+// room with auto start.
+func (s *Server) runTournament(t *tournament) {
+	sleep(s.clock, t.startTime.Sub(s.clock.Now()))
+	for {
+		round, entrants := t.beginRound()
+		if len(entrants) == 0 {
+			t.logger.Info("Tournament is finished, since all players have left")
+			t.finish()
+			return
+		}
+
+		room := s.newTournamentRoom(t, round)
+		if room == nil {
+			t.finish()
+			return
+		}
+		room.logger.Info("Tournament round is started", zap.Int32("round", round))
+		for _, entrant := range entrants {
+			userID, err := room.addPlayer(entrant.username, entrant.locale, entrant.appearance)
+			if err != nil {
+				room.logger.Warn("Could not add tournament player", zap.Error(err))
+				continue
+			}
+			s.metrics.joins.Inc()
+			res, err := s.getJoinResponseMessage(userID, room)
+			if err != nil {
+				room.logger.Warn("Could not add tournament player", zap.Error(err))
+				continue
+			}
+			t.sendRoundStarted(entrant, round, res)
+		}
+
+		// round is not full, so that the countdown is not cancelled,
+		// but it takes as long as the countdown of the full game
+		countdown := &startCountdown{config: room.config}
+		if room.beginCountdown(countdown) {
+			s.countDown(room, countdown, room.config.getCountdownTime(true))
+		}
+		// the room is removed, if all players leave it before the start
+		for !room.isFinished() {
+			sleep(s.clock, time.Second)
+			if room.isWaiting() && room.getPlayerCount() == 0 {
+				s.pruneRoom(room)
+			}
+		}
+
+		t.endRound(room.getFinalPoints())
+		if round >= t.rounds {
+			t.logger.Info("Tournament is finished")
+			return
+		}
+		sleep(s.clock, t.breakTime)
+	}
+}
+
+// newTournamentRoom creates the room for the round of the
+// tournament. Nil is returned, if the server is shutting down.
+func (s *Server) newTournamentRoom(t *tournament, round int32) *game {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.shuttingDown {
+		return nil
+	}
+	room := s.newGame(t.config)
+	room.name = fmt.Sprintf("%v (round %d)", t.name, round)
+	room.code = uuid.New().String()
+	room.fixedUsernames = true
+	s.rooms[room.gameID] = room
+	return room
+}
+
+// CreateTournament schedules the tournament, for which players can register
+// with JoinTournament until its start. Non-zero values of the provided config
+// override the server defaults for every round.
+func (s *Server) CreateTournament(_ context.Context, req *pb.CreateTournamentRequest) (*pb.CreateTournamentResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	config, err := s.getOverriddenConfig(s.gameConfig, req.GetConfig())
+	if err == nil {
+		err = config.Validate()
+	}
+	if err != nil {
+		err = fmt.Errorf("invalid game config overrides: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	// every round starts with all entrants, who are still connected
+	config.minPlayers = 1
+	config.maxPlayers = 0
+	config.autoStart = false
+
+	if s.shuttingDown {
+		return nil, newStatusError(codes.Unavailable, ReasonServerShuttingDown, 0, "server is shutting down")
+	}
+	t := &tournament{
+		tournamentID: uuid.New().String(),
+		name:         req.GetName(),
+		config:       config,
+		rounds:       req.GetRounds(),
+		startTime:    s.clock.Now().Add(time.Duration(req.GetStartDelay()) * time.Second),
+		breakTime:    time.Duration(req.GetBreakTime()) * time.Second,
+	}
+	t.logger = s.logger.With(zap.String("tournament_id", t.tournamentID))
+	s.tournaments[t.tournamentID] = t
+	go s.runTournament(t)
+	t.logger.Info("Tournament has been created", zap.String("name", t.name), zap.Int32("rounds", t.rounds))
+
+	return &pb.CreateTournamentResponse{TournamentId: t.tournamentID}, nil
+}
+
+// JoinTournament registers the player for the tournament and keeps the
+// stream open until the tournament finishes. At the start of every round,
+// the player is added to its game, and the join response is sent. After
+// every round, the standings are sent. Closing the stream before the start
+// of the tournament cancels the registration.
+func (s *Server) JoinTournament(req *pb.JoinTournamentRequest, srv pb.Game_JoinTournamentServer) error {
+	ctx := srv.Context()
+	if err := s.validateRequest(req); err != nil {
+		return newStatusError(codes.InvalidArgument, ReasonInvalidRequest, 0, "%v", err)
+	}
+	reqUsername, err := s.resolveUsername(username(req.GetUsername()), req.GetAccountToken())
+	if err != nil {
+		return err
+	}
+	if err := s.checkBan(ctx, reqUsername); err != nil {
+		return err
+	}
+
+	t, err := s.getTournament(req.GetTournamentId())
+	if err != nil {
+		return err
+	}
+	if err := firstError(t.config.checkUsername(reqUsername), checkUsernameWords(s.wordFilter, reqUsername)); err != nil {
+		return getUsernameError(err)
+	}
+	entrant, err := t.register(reqUsername, req.GetLocale(), appearance{avatarID: req.GetAvatarId(), color: req.GetColor()})
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+
+	for {
+		select {
+		case event, ok := <-entrant.events:
+			if !ok {
+				return nil
+			}
+			if err := srv.Send(event); err != nil {
+				t.disconnect(entrant)
+				return err
+			}
+		case <-ctx.Done():
+			t.disconnect(entrant)
+			return nil
+		}
+	}
+}
+
+// GetStandings returns the current standings of the tournament.
+func (s *Server) GetStandings(_ context.Context, req *pb.GetStandingsRequest) (*pb.GetStandingsResponse, error) {
+	t, err := s.getTournament(req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return &pb.GetStandingsResponse{Standings: t.getPBStandings()}, nil
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/label"
+)
+
+// WithTracerProvider makes server create spans for RPCs and game operations
+// using provided tracer provider. Without this option, global tracer
+// provider is used, which does nothing unless it is replaced.
+func WithTracerProvider(tracerProvider trace.TracerProvider) ServerOption {
+	return func(s *Server) {
+		s.tracerProvider = tracerProvider
+	}
+}
+
+// otelOptions returns options for otelgrpc interceptors.
+func (s *Server) otelOptions() []otelgrpc.Option {
+	if s.tracerProvider == nil {
+		return nil
+	}
+	return []otelgrpc.Option{otelgrpc.WithTracerProvider(s.tracerProvider)}
+}
+
+// startSpan starts the child of the span contained in the context. The child
+// is created by the same tracer as its parent, so that game operations do not
+// need to know the tracer provider of the server. If the context doesn't
+// contain span (e.g. game operation is not called by RPC), span does nothing.
+func startSpan(ctx context.Context, name string, attrs ...label.KeyValue) (context.Context, trace.Span) {
+	tracer := trace.SpanFromContext(ctx).Tracer()
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// spanAttrs returns attributes identifying the player in the game.
+func (g *game) spanAttrs(userID userID) []label.KeyValue {
+	return []label.KeyValue{
+		label.String("game_id", string(g.gameID)),
+		label.String("user_id", string(userID)),
+	}
+}
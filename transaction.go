@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cs489-team11/server/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Default and maximal number of the entries returned by GetTransactions.
+const (
+	defaultTransactionLimit = 50
+	maxTransactionLimit     = 200
+)
+
+// getPBLedger returns money movements of the player in the order, in which
+// they have happened, i.e. every event of the journal, which has changed
+// points of the player, with the points after it. Initial points of the
+// player are the first entry.
+func (g *game) getPBLedger(userID userID) ([]*pb.LedgerEntry, error) {
+	g.mutex.RLock()
+	if _, ok := g.players[userID]; !ok {
+		g.mutex.RUnlock()
+		return nil, fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	events := make([]JournalEvent, len(g.journal))
+	copy(events, g.journal)
+	g.mutex.RUnlock()
+
+	// the player may be the counterparty of the event, e.g. the lender
+	// or the robbed player, so points are compared after every event
+	var ledger []*pb.LedgerEntry
+	points := int32(0)
+	_, err := replayJournal(events, func(g *game, event JournalEvent) error {
+		player, ok := g.players[userID]
+		if !ok || player.points == points {
+			return nil
+		}
+		kind := event.Kind
+		if kind == EventQuestionAnswer {
+			kind = TransactionQuestionWin
+		}
+		ledger = append(ledger, &pb.LedgerEntry{
+			Sequence: event.Sequence,
+			Time:     event.Time.UnixNano() / int64(time.Millisecond),
+			Kind:     kind,
+			Value:    player.points - points,
+			Balance:  player.points,
+			RefId:    event.RefID,
+		})
+		points = player.points
+		return nil
+	})
+	return ledger, err
+}
+
+// GetTransactions returns the page of the ledger of the player in the game.
+func (s *Server) GetTransactions(_ context.Context, req *pb.GetTransactionsRequest) (*pb.GetTransactionsResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	offset := req.GetOffset()
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultTransactionLimit
+	}
+
+	game := s.getGame(reqGameID)
+	if game == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
+	}
+	ledger, err := game.getPBLedger(reqUserID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	total := int32(len(ledger))
+	if offset > total {
+		offset = total
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return &pb.GetTransactionsResponse{
+		Entries: ledger[offset:end],
+		Total:   total,
+	}, nil
+}
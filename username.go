@@ -0,0 +1,252 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cs489-team11/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UsernamePolicy defines, what happens, when the player joins
+// the game with the username, which is already taken in it.
+type UsernamePolicy int32
+
+const (
+	// SuffixUsernames suffixes the username with the first free number, e.g. "Bob 2".
+	SuffixUsernames UsernamePolicy = UsernamePolicy(pb.UsernamePolicy_USERNAME_POLICY_SUFFIX)
+	// RejectUsernames rejects the join with AlreadyExists error.
+	RejectUsernames UsernamePolicy = UsernamePolicy(pb.UsernamePolicy_USERNAME_POLICY_REJECT)
+)
+
+// Usernames are from 1 to 20 characters long,
+// unless it is configured otherwise.
+const (
+	defaultUsernameMinLength = 1
+	defaultUsernameMaxLength = 20
+	maxUsernameLength        = 64
+)
+
+var (
+	errInvalidUsername = errors.New("invalid username")
+	errUsernameTaken   = errors.New("username is already taken in the game")
+)
+
+// WithUsernameRules sets the bounds of the length of the usernames in
+// characters and the policy for the usernames, which are already taken
+// in the game.
+func WithUsernameRules(minLength int32, maxLength int32, policy UsernamePolicy) GameConfigOption {
+	return func(c *GameConfig) {
+		c.usernameMinLength = minLength
+		c.usernameMaxLength = maxLength
+		c.usernamePolicy = policy
+	}
+}
+
+// checkUsername returns errInvalidUsername, unless the username has the
+// allowed length and consists of letters, digits, spaces, and "_", "-",
+// or "." characters, and it neither starts nor ends with the space.
+func (c GameConfig) checkUsername(name username) error {
+	length := int32(utf8.RuneCountInString(string(name)))
+	if length < c.usernameMinLength || length > c.usernameMaxLength {
+		return fmt.Errorf(
+			"%w %q: it has to be from %d to %d characters long",
+			errInvalidUsername,
+			name,
+			c.usernameMinLength,
+			c.usernameMaxLength,
+		)
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != ' ' && r != '_' && r != '-' && r != '.' {
+			return fmt.Errorf(
+				"%w %q: it can contain only letters, digits, spaces, and \"_-.\" characters",
+				errInvalidUsername,
+				name,
+			)
+		}
+	}
+	if name[0] == ' ' || name[len(name)-1] == ' ' {
+		return fmt.Errorf("%w %q: it cannot start or end with a space", errInvalidUsername, name)
+	}
+	return nil
+}
+
+// checkUsernameWords returns errInvalidUsername, if the filter
+// finds words, which are not allowed, in the username.
+func checkUsernameWords(filter WordFilter, name username) error {
+	if filter == nil {
+		return nil
+	}
+	if _, masked := filter.Filter(string(name)); masked {
+		return fmt.Errorf("%w %q: it contains words, which are not allowed", errInvalidUsername, name)
+	}
+	return nil
+}
+
+// getUniqueUsername returns the username, if it is not taken in the game.
+// Otherwise, it returns errUsernameTaken or the username with the first
+// free number suffix, which is shortened to fit the max length, depending
+// on the policy. The suffixed username has to keep at least one character
+// of the username, so errUsernameTaken is returned, if the max length is
+// too short for that. The calling function has to acquire at least read lock.
+func (g *game) getUniqueUsername(name username) (username, error) {
+	taken := make(map[username]bool)
+	for _, player := range g.players {
+		taken[player.username] = true
+	}
+	if !taken[name] {
+		return name, nil
+	}
+	if g.config.usernamePolicy == RejectUsernames {
+		return "", fmt.Errorf("%w: %v", errUsernameTaken, name)
+	}
+	for i := 2; ; i++ {
+		suffix := fmt.Sprintf(" %d", i)
+		base := []rune(string(name))
+		if maxLength := int(g.config.usernameMaxLength) - len(suffix); len(base) > maxLength {
+			if maxLength < 0 {
+				maxLength = 0
+			}
+			base = base[:maxLength]
+		}
+		// the username cannot end with the space before the suffix
+		trimmed := strings.TrimRight(string(base), " ")
+		if trimmed == "" {
+			return "", fmt.Errorf("%w: %v", errUsernameTaken, name)
+		}
+		suffixed := username(trimmed + suffix)
+		if !taken[suffixed] {
+			return suffixed, nil
+		}
+	}
+}
+
+// rename changes the username of the player before the game starts and
+// returns the final one. New username is checked as it is on join, and
+// other players are notified with the Rename event.
+func (g *game) rename(userID userID, name username) (username, error) {
+	// rating may be loaded from the storage, so it is done without lock
+	rating := g.ratings.get(name).Rating
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.state != waitingState {
+		return "", fmt.Errorf("players can rename only before the game starts")
+	}
+	if g.fixedUsernames {
+		return "", fmt.Errorf("players of the tournament round cannot rename")
+	}
+	player, ok := g.players[userID]
+	if !ok {
+		return "", fmt.Errorf("there is no player with id %v in the game", userID)
+	}
+	if player.username == name {
+		return name, nil
+	}
+	if err := firstError(g.config.checkUsername(name), checkUsernameWords(g.words, name)); err != nil {
+		return "", err
+	}
+	uniqueUsername, err := g.getUniqueUsername(name)
+	if err != nil {
+		return "", err
+	}
+	if uniqueUsername != name {
+		rating = initialRating
+	}
+
+	event := newEvent(EventRename, userID, 0)
+	event.Username = string(uniqueUsername)
+	g.apply(event)
+	player.rating = rating
+	g.logger.Info(
+		"Player has changed the username",
+		zap.String("user_id", string(userID)),
+		zap.String("username", string(uniqueUsername)),
+	)
+
+	go func() {
+		g.broadcast(getRenameMessage(userID, uniqueUsername))
+	}()
+
+	return uniqueUsername, nil
+}
+
+func getRenameMessage(userID userID, name username) *pb.StreamResponse {
+	return &pb.StreamResponse{
+		Event: &pb.StreamResponse_Rename_{
+			Rename: &pb.StreamResponse_Rename{
+				UserId:   string(userID),
+				Username: string(name),
+			},
+		},
+	}
+}
+
+// getPlayerUsername returns the current username of the player.
+func (g *game) getPlayerUsername(userID userID) (username, bool) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	player, ok := g.players[userID]
+	if !ok {
+		return "", false
+	}
+	return player.username, true
+}
+
+// Rename changes the username of the player in the waiting game.
+func (s *Server) Rename(ctx context.Context, req *pb.RenameRequest) (*pb.RenameResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqUsername := username(req.GetUsername())
+
+	game, err := s.getLobby(reqGameID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBan(ctx, reqUsername); err != nil {
+		return nil, err
+	}
+	// usernames of the accounts are neither given up nor taken by renaming
+	if current, ok := game.getPlayerUsername(reqUserID); ok {
+		_, registered, err := s.accounts.get(current)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load account %v: %v", current, err)
+		}
+		if registered {
+			return nil, status.Errorf(codes.FailedPrecondition, "players logged in with accounts cannot rename")
+		}
+	}
+	if _, err := s.resolveUsername(reqUsername, ""); err != nil {
+		return nil, err
+	}
+
+	final, err := game.rename(reqUserID, reqUsername)
+	if err != nil {
+		if err := getUsernameError(err); err != nil {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	return &pb.RenameResponse{Username: string(final)}, nil
+}
+
+// getUsernameError returns the status error for the error of
+// the username check or nil, if the error is of other kind.
+func getUsernameError(err error) error {
+	switch {
+	case errors.Is(err, errInvalidUsername):
+		return newStatusError(codes.InvalidArgument, ReasonInvalidUsername, 0, "%v", err)
+	case errors.Is(err, errUsernameTaken):
+		return newStatusError(codes.AlreadyExists, ReasonUsernameTaken, 0, "%v", err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/cs489-team11/server/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// validateUnary is a unary interceptor, which rejects requests with fields
+// out of their ranges with InvalidArgument before the handler is called,
+// so that the handlers don't repeat the checks. Streaming handlers
+// call validateRequest themselves.
+func (s *Server) validateUnary(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := s.validateRequest(req); err != nil {
+		return nil, newStatusError(codes.InvalidArgument, ReasonInvalidRequest, 0, "%v", err)
+	}
+	return handler(ctx, req)
+}
+
+// validateRequest checks the fields of the request, which don't depend on
+// the state of the game. Bounds, which depend on the config, are checked
+// against the config of the game named in the request. They are skipped,
+// if there is no such game, since the handler reports it. Every check is
+// declared only here, so bots have to call it before the handlers too.
+func (s *Server) validateRequest(req interface{}) error {
+	switch req := req.(type) {
+	case *pb.JoinRequest:
+		if req.GetPractice() {
+			if err := checkRange("practice bots", req.GetPracticeBots(), 0, maxBotsPerRequest); err != nil {
+				return err
+			}
+		}
+		return checkAppearance(req.GetAvatarId(), req.GetColor())
+	case *pb.JoinRoomRequest:
+		return checkAppearance(req.GetAvatarId(), req.GetColor())
+	case *pb.EnqueueRequest:
+		return checkAppearance(req.GetAvatarId(), req.GetColor())
+	case *pb.JoinTournamentRequest:
+		return checkAppearance(req.GetAvatarId(), req.GetColor())
+	case *pb.RegisterRequest:
+		if err := checkNonEmpty("username", req.GetUsername()); err != nil {
+			return err
+		}
+		if length := utf8.RuneCountInString(req.GetPassword()); length < minPasswordLength {
+			return fmt.Errorf("password has to be at least %d characters long, received: %d", minPasswordLength, length)
+		}
+	case *pb.GetPlayerStatsRequest:
+		return checkNonEmpty("username", req.GetUsername())
+	case *pb.GetAchievementsRequest:
+		return checkNonEmpty("username", req.GetUsername())
+	case *pb.GetChallengesRequest:
+		return checkNonEmpty("username", req.GetUsername())
+	case *pb.GetLeaderboardRequest:
+		return firstError(
+			checkNonNegative("offset", req.GetOffset()),
+			checkRange("limit", req.GetLimit(), 0, maxLeaderboardLimit),
+		)
+	case *pb.ListGameHistoryRequest:
+		if since, until := req.GetSince(), req.GetUntil(); since != 0 && until != 0 && since >= until {
+			return fmt.Errorf("since has to be before until")
+		}
+		return firstError(
+			checkNonEmpty("username", req.GetUsername()),
+			checkNonNegative("offset", req.GetOffset()),
+			checkRange("limit", req.GetLimit(), 0, maxHistoryLimit),
+		)
+	case *pb.ReplayRequest:
+		if speed := req.GetSpeed(); speed < 0 {
+			return fmt.Errorf("speed cannot be negative, received: %f", speed)
+		}
+	case *pb.GetTransactionsRequest:
+		return firstError(
+			checkNonNegative("offset", req.GetOffset()),
+			checkRange("limit", req.GetLimit(), 0, maxTransactionLimit),
+		)
+	case *pb.AddBotsRequest:
+		return checkRange("count", req.GetCount(), 1, maxBotsPerRequest)
+	case *pb.ChooseTeamRequest:
+		if config := s.getRequestConfig(req.GetGameId(), s.getLobby); config != nil {
+			if config.teamCount == 0 {
+				return fmt.Errorf("game has no teams")
+			}
+			return checkRange("team", req.GetTeam(), 1, config.teamCount)
+		}
+	case *pb.CreateRoomRequest:
+		return checkNonEmpty("room name", req.GetName())
+	case *pb.CreateTournamentRequest:
+		return firstError(
+			checkNonEmpty("tournament name", req.GetName()),
+			checkRange("rounds", req.GetRounds(), 1, maxTournamentRounds),
+			checkPositive("start delay", req.GetStartDelay()),
+			checkNonNegative("break time", req.GetBreakTime()),
+		)
+	case *pb.CreditRequest:
+		return checkPositive("requested value", req.GetValue())
+	case *pb.RepayCreditRequest:
+		return checkNonNegative("requested value", req.GetValue())
+	case *pb.OfferLoanRequest:
+		return firstError(
+			checkPositive("requested value", req.GetValue()),
+			checkNonNegative("interest", req.GetInterest()),
+			checkPositive("loan time", req.GetTime()),
+		)
+	case *pb.PlaceOrderRequest:
+		if side := req.GetSide(); side != pb.OrderSide_ORDER_SIDE_BUY && side != pb.OrderSide_ORDER_SIDE_SELL {
+			return fmt.Errorf("unknown order side: %d", side)
+		}
+		return firstError(
+			checkPositive("quantity", req.GetQuantity()),
+			checkPositive("price", req.GetPrice()),
+		)
+	case *pb.BuySharesRequest:
+		return checkPositive("requested shares", req.GetShares())
+	case *pb.SellSharesRequest:
+		return checkPositive("requested shares", req.GetShares())
+	case *pb.DepositRequest:
+		return checkPositive("requested value", req.GetValue())
+	case *pb.WithdrawDepositRequest:
+		return checkNonNegative("requested value", req.GetValue())
+	case *pb.GenerateQuestionRequest:
+		return checkPositive("bid points", req.GetBidPoints())
+	case *pb.AnswerQuestionRequest:
+		return checkRange("user answer", req.GetAnswer(), 1, 4)
+	case *pb.BanRequest:
+		return firstError(
+			checkNonEmpty("banned username or address", req.GetValue()),
+			checkNonNegative("ban duration", req.GetDuration()),
+		)
+	}
+	return nil
+}
+
+// getRequestConfig returns the config of the game found by provided
+// lookup, or nil, if the lookup fails.
+func (s *Server) getRequestConfig(id string, lookup func(gameID) (*game, error)) *GameConfig {
+	game, err := lookup(gameID(id))
+	if err != nil {
+		return nil
+	}
+	config := game.getConfig()
+	return &config
+}
+
+// getConfig returns the config of the game, which
+// Start replaces with the overridden one.
+func (g *game) getConfig() GameConfig {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return g.config
+}
+
+func checkPositive(name string, val int32) error {
+	if val <= 0 {
+		return fmt.Errorf("%s has to be positive, received: %d", name, val)
+	}
+	return nil
+}
+
+func checkNonNegative(name string, val int32) error {
+	if val < 0 {
+		return fmt.Errorf("%s cannot be negative, received: %d", name, val)
+	}
+	return nil
+}
+
+func checkRange(name string, val int32, min int32, max int32) error {
+	if val < min || val > max {
+		return fmt.Errorf("%s has to be from %d to %d, received: %d", name, min, max, val)
+	}
+	return nil
+}
+
+func checkNonEmpty(name string, val string) error {
+	if val == "" {
+		return fmt.Errorf("%s has to be non-empty", name)
+	}
+	return nil
+}
+
+// firstError returns the first non-nil error.
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
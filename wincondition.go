@@ -0,0 +1,67 @@
+package server
+
+import (
+	"go.uber.org/zap"
+)
+
+// getPlayerScore returns the value, by which the player is ranked under
+// the win condition of the game, i.e. the points or the net worth.
+// The calling function has to acquire at least read lock.
+func (g *game) getPlayerScore(player *player) int32 {
+	if g.config.winCondition == WinByPoints {
+		return player.points
+	}
+	return player.getNetWorth()
+}
+
+// checkWinCondition checks, if the win condition of the active game has
+// been met after the event, which has just been applied. The player, who
+// has met it, wins, and the game is finished by its timer right away.
+// The calling function has to acquire write lock.
+func (g *game) checkWinCondition() {
+	if g.state != activeState || g.conditionWinnerID != "" {
+		return
+	}
+
+	winnerID := userID("")
+	switch g.config.winCondition {
+	case WinByTarget:
+		// several players may reach the target with a single event,
+		// e.g. the theft, in which case the richest wins
+		for userID, player := range g.players {
+			if player.forfeited || player.getNetWorth() < g.config.winTarget {
+				continue
+			}
+			if winnerID == "" || player.getNetWorth() > g.players[winnerID].getNetWorth() ||
+				(player.getNetWorth() == g.players[winnerID].getNetWorth() && userID < winnerID) {
+				winnerID = userID
+			}
+		}
+	case WinByLastSolvent:
+		// the player, who is left alone, hasn't outlasted anybody
+		if len(g.players) < 2 {
+			return
+		}
+		solvent := 0
+		for userID, player := range g.players {
+			if !player.forfeited && player.getNetWorth() > 0 {
+				solvent++
+				winnerID = userID
+			}
+		}
+		if solvent != 1 {
+			return
+		}
+	}
+	if winnerID == "" {
+		return
+	}
+
+	g.conditionWinnerID = winnerID
+	g.logger.Info("Win condition is met", zap.String("user_id", string(winnerID)))
+	// timer is not set yet, if the game is just being started,
+	// and it is never set for the replayed journal
+	if g.finishTimer != nil {
+		g.finishTimer.Reset(0)
+	}
+}